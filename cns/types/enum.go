@@ -29,6 +29,14 @@ const (
 	CnsVolumeTypeFile  = CnsVolumeType("FILE")
 )
 
+// Values returns all known values of the CnsVolumeType enum
+func (e CnsVolumeType) Values() []CnsVolumeType {
+	return []CnsVolumeType{
+		CnsVolumeTypeBlock,
+		CnsVolumeTypeFile,
+	}
+}
+
 func init() {
 	types.Add("CnsVolumeType", reflect.TypeOf((*CnsVolumeType)(nil)).Elem())
 }
@@ -42,6 +50,16 @@ const (
 	CnsClusterFlavorUnknown  = CnsClusterFlavor("ClusterFlavor_Unknown")
 )
 
+// Values returns all known values of the CnsClusterFlavor enum
+func (e CnsClusterFlavor) Values() []CnsClusterFlavor {
+	return []CnsClusterFlavor{
+		CnsClusterFlavorVanilla,
+		CnsClusterFlavorWorkload,
+		CnsClusterFlavorGuest,
+		CnsClusterFlavorUnknown,
+	}
+}
+
 func init() {
 	types.Add("CnsClusterFlavor", reflect.TypeOf((*CnsClusterFlavor)(nil)).Elem())
 }
@@ -57,6 +75,18 @@ const (
 	QuerySelectionNameTypeHealthStatus           = QuerySelectionNameType("HEALTH_STATUS")
 )
 
+// Values returns all known values of the QuerySelectionNameType enum
+func (e QuerySelectionNameType) Values() []QuerySelectionNameType {
+	return []QuerySelectionNameType{
+		QuerySelectionNameTypeVolumeType,
+		QuerySelectionNameTypeVolumeName,
+		QuerySelectionNameTypeBackingObjectDetails,
+		QuerySelectionNameTypeComplianceStatus,
+		QuerySelectionNameTypeDataStoreAccessibility,
+		QuerySelectionNameTypeHealthStatus,
+	}
+}
+
 func init() {
 	types.Add("QuerySelectionNameType", reflect.TypeOf((*QuerySelectionNameType)(nil)).Elem())
 }
@@ -67,6 +97,13 @@ const (
 	CnsClusterTypeKubernetes = CnsClusterType("KUBERNETES")
 )
 
+// Values returns all known values of the CnsClusterType enum
+func (e CnsClusterType) Values() []CnsClusterType {
+	return []CnsClusterType{
+		CnsClusterTypeKubernetes,
+	}
+}
+
 func init() {
 	types.Add("CnsClusterType", reflect.TypeOf((*CnsClusterType)(nil)).Elem())
 }
@@ -79,6 +116,15 @@ const (
 	CnsKubernetesEntityTypePOD = CnsKubernetesEntityType("POD")
 )
 
+// Values returns all known values of the CnsKubernetesEntityType enum
+func (e CnsKubernetesEntityType) Values() []CnsKubernetesEntityType {
+	return []CnsKubernetesEntityType{
+		CnsKubernetesEntityTypePVC,
+		CnsKubernetesEntityTypePV,
+		CnsKubernetesEntityTypePOD,
+	}
+}
+
 type CnsQuerySelectionNameType string
 
 const (
@@ -89,6 +135,17 @@ const (
 	CnsQuerySelectionName_DATASTORE_ACCESSIBILITY_STATUS = CnsQuerySelectionNameType("DATASTORE_ACCESSIBILITY_STATUS")
 )
 
+// Values returns all known values of the CnsQuerySelectionNameType enum
+func (e CnsQuerySelectionNameType) Values() []CnsQuerySelectionNameType {
+	return []CnsQuerySelectionNameType{
+		CnsQuerySelectionName_VOLUME_NAME,
+		CnsQuerySelectionName_VOLUME_TYPE,
+		CnsQuerySelectionName_BACKING_OBJECT_DETAILS,
+		CnsQuerySelectionName_COMPLIANCE_STATUS,
+		CnsQuerySelectionName_DATASTORE_ACCESSIBILITY_STATUS,
+	}
+}
+
 func init() {
 	types.Add("CnsKubernetesEntityType", reflect.TypeOf((*CnsKubernetesEntityType)(nil)).Elem())
 }
@@ -29,6 +29,14 @@ const (
 	AgencyVMPlacementPolicyVMAntiAffinitySoft = AgencyVMPlacementPolicyVMAntiAffinity("soft")
 )
 
+// Values returns all known values of the AgencyVMPlacementPolicyVMAntiAffinity enum
+func (e AgencyVMPlacementPolicyVMAntiAffinity) Values() []AgencyVMPlacementPolicyVMAntiAffinity {
+	return []AgencyVMPlacementPolicyVMAntiAffinity{
+		AgencyVMPlacementPolicyVMAntiAffinityNone,
+		AgencyVMPlacementPolicyVMAntiAffinitySoft,
+	}
+}
+
 func init() {
 	types.Add("eam:AgencyVMPlacementPolicyVMAntiAffinity", reflect.TypeOf((*AgencyVMPlacementPolicyVMAntiAffinity)(nil)).Elem())
 }
@@ -40,6 +48,14 @@ const (
 	AgencyVMPlacementPolicyVMDataAffinitySoft = AgencyVMPlacementPolicyVMDataAffinity("soft")
 )
 
+// Values returns all known values of the AgencyVMPlacementPolicyVMDataAffinity enum
+func (e AgencyVMPlacementPolicyVMDataAffinity) Values() []AgencyVMPlacementPolicyVMDataAffinity {
+	return []AgencyVMPlacementPolicyVMDataAffinity{
+		AgencyVMPlacementPolicyVMDataAffinityNone,
+		AgencyVMPlacementPolicyVMDataAffinitySoft,
+	}
+}
+
 func init() {
 	types.Add("eam:AgencyVMPlacementPolicyVMDataAffinity", reflect.TypeOf((*AgencyVMPlacementPolicyVMDataAffinity)(nil)).Elem())
 }
@@ -52,6 +68,15 @@ const (
 	AgentConfigInfoOvfDiskProvisioningThick = AgentConfigInfoOvfDiskProvisioning("thick")
 )
 
+// Values returns all known values of the AgentConfigInfoOvfDiskProvisioning enum
+func (e AgentConfigInfoOvfDiskProvisioning) Values() []AgentConfigInfoOvfDiskProvisioning {
+	return []AgentConfigInfoOvfDiskProvisioning{
+		AgentConfigInfoOvfDiskProvisioningNone,
+		AgentConfigInfoOvfDiskProvisioningThin,
+		AgentConfigInfoOvfDiskProvisioningThick,
+	}
+}
+
 func init() {
 	types.Add("eam:AgentConfigInfoOvfDiskProvisioning", reflect.TypeOf((*AgentConfigInfoOvfDiskProvisioning)(nil)).Elem())
 }
@@ -64,6 +89,15 @@ const (
 	AgentVmHookVmStatePrePowerOn  = AgentVmHookVmState("prePowerOn")
 )
 
+// Values returns all known values of the AgentVmHookVmState enum
+func (e AgentVmHookVmState) Values() []AgentVmHookVmState {
+	return []AgentVmHookVmState{
+		AgentVmHookVmStateProvisioned,
+		AgentVmHookVmStatePoweredOn,
+		AgentVmHookVmStatePrePowerOn,
+	}
+}
+
 func init() {
 	types.Add("eam:AgentVmHookVmState", reflect.TypeOf((*AgentVmHookVmState)(nil)).Elem())
 }
@@ -76,6 +110,15 @@ const (
 	EamObjectRuntimeInfoGoalStateUninstalled = EamObjectRuntimeInfoGoalState("uninstalled")
 )
 
+// Values returns all known values of the EamObjectRuntimeInfoGoalState enum
+func (e EamObjectRuntimeInfoGoalState) Values() []EamObjectRuntimeInfoGoalState {
+	return []EamObjectRuntimeInfoGoalState{
+		EamObjectRuntimeInfoGoalStateEnabled,
+		EamObjectRuntimeInfoGoalStateDisabled,
+		EamObjectRuntimeInfoGoalStateUninstalled,
+	}
+}
+
 func init() {
 	types.Add("eam:EamObjectRuntimeInfoGoalState", reflect.TypeOf((*EamObjectRuntimeInfoGoalState)(nil)).Elem())
 }
@@ -88,6 +131,15 @@ const (
 	EamObjectRuntimeInfoStatusRed    = EamObjectRuntimeInfoStatus("red")
 )
 
+// Values returns all known values of the EamObjectRuntimeInfoStatus enum
+func (e EamObjectRuntimeInfoStatus) Values() []EamObjectRuntimeInfoStatus {
+	return []EamObjectRuntimeInfoStatus{
+		EamObjectRuntimeInfoStatusGreen,
+		EamObjectRuntimeInfoStatusYellow,
+		EamObjectRuntimeInfoStatusRed,
+	}
+}
+
 func init() {
 	types.Add("eam:EamObjectRuntimeInfoStatus", reflect.TypeOf((*EamObjectRuntimeInfoStatus)(nil)).Elem())
 }
@@ -99,6 +151,14 @@ const (
 	EsxAgentManagerMaintenanceModePolicyMultipleHosts = EsxAgentManagerMaintenanceModePolicy("multipleHosts")
 )
 
+// Values returns all known values of the EsxAgentManagerMaintenanceModePolicy enum
+func (e EsxAgentManagerMaintenanceModePolicy) Values() []EsxAgentManagerMaintenanceModePolicy {
+	return []EsxAgentManagerMaintenanceModePolicy{
+		EsxAgentManagerMaintenanceModePolicySingleHost,
+		EsxAgentManagerMaintenanceModePolicyMultipleHosts,
+	}
+}
+
 func init() {
 	types.Add("eam:EsxAgentManagerMaintenanceModePolicy", reflect.TypeOf((*EsxAgentManagerMaintenanceModePolicy)(nil)).Elem())
 }
@@ -410,6 +410,13 @@ class SimpleType < Simple
     io.print "const (\n"
     enums.each { |e| e.dump(io) }
     io.print ")\n\n"
+
+    io.print "// Values returns all known values of the %s enum\n" % ucfirst(name)
+    io.print "func (e %s) Values() []%s {\n" % [ucfirst(name), ucfirst(name)]
+    io.print "return []%s{\n" % ucfirst(name)
+    enums.each { |e| io.print "%s,\n" % e.var_name }
+    io.print "}\n"
+    io.print "}\n\n"
   end
 
   def dump_init(io)
@@ -8,6 +8,7 @@ require (
 	github.com/google/uuid v1.2.0
 	github.com/dougm/pretty v0.0.0-20171025230240-2ee9d7453c02
 	github.com/vmware/vmw-guestinfo v0.0.0-20170707015358-25eff159a728
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 require github.com/kr/text v0.1.0 // indirect
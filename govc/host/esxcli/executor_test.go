@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package esxcli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+)
+
+func TestExecutor(t *testing.T) {
+	simulator.RegisterEsxcliCommand("system hostname get", &simulator.EsxcliCommand{
+		Run: func(_ *simulator.Context, host *mo.HostSystem, _ map[string][]string) (interface{}, error) {
+			return &struct {
+				HostName   string
+				DomainName string
+			}{host.Name, "localdomain"}, nil
+		},
+	})
+
+	simulator.RegisterEsxcliCommand("system hostname set", &simulator.EsxcliCommand{
+		Params: []simulator.EsxcliParam{
+			{Name: "host", Aliases: []string{"--host"}},
+		},
+		Run: func(_ *simulator.Context, _ *mo.HostSystem, args map[string][]string) (interface{}, error) {
+			if args["host"][0] == "" {
+				return nil, errors.New("invalid host name")
+			}
+			return nil, nil
+		},
+	})
+
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		host, err := finder.HostSystem(ctx, "DC0_H0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		e, err := NewExecutor(c, host)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := e.Run([]string{"network", "ip", "interface", "list"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Values) != 1 || res.Values[0]["Name"][0] != "vmk0" || res.Values[0]["Portset"][0] != "vSwitch0" {
+			t.Errorf("values=%v", res.Values)
+		}
+		if res.Info.Hints.Formatter() != "table" {
+			t.Errorf("formatter=%s", res.Info.Hints.Formatter())
+		}
+
+		res, err = e.Run([]string{"storage", "core", "device", "list", "-d", "mpx.vmhba0:C0:T0:L0"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Values) != 1 || res.Values[0]["DeviceType"][0] != "Direct-Access" {
+			t.Errorf("values=%v", res.Values)
+		}
+
+		res, err = e.Run([]string{"system", "version", "get"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Values[0]["Product"][0] != "VMware ESXi" {
+			t.Errorf("values=%v", res.Values)
+		}
+
+		res, err = e.Run([]string{"software", "vib", "list"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Values) == 0 {
+			t.Error("no vibs")
+		}
+
+		res, err = e.Run([]string{"system", "hostname", "get"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Values[0]["HostName"][0] != host.Name() {
+			t.Errorf("values=%v", res.Values)
+		}
+
+		if _, err = e.Run([]string{"system", "hostname", "set", "--host", "esx"}); err != nil {
+			t.Error(err)
+		}
+
+		if _, err = e.Run([]string{"system", "hostname", "set", "--host", ""}); err == nil {
+			t.Error("expected error")
+		}
+
+		if _, err = e.Run([]string{"no", "such", "command"}); err == nil {
+			t.Error("expected error")
+		}
+
+		g := NewGuestInfo(c)
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ip, err := g.IpAddress(vm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ip != "0.0.0.0" {
+			t.Errorf("ip=%s", ip)
+		}
+	})
+}
@@ -106,6 +106,7 @@ import (
 	_ "github.com/vmware/govmomi/govc/vm/snapshot"
 	_ "github.com/vmware/govmomi/govc/volume"
 	_ "github.com/vmware/govmomi/govc/vsan"
+	_ "github.com/vmware/govmomi/govc/vsan/fileshare"
 )
 
 func main() {
@@ -138,6 +138,48 @@ upload_file() {
   assert_failure # host test.example.com hast no shared datastores
 }
 
+@test "datastore.create" {
+  vcsim_env -lun 1
+
+  hosts=($(govc find / -type h))
+
+  disk=$(govc host.storage.info -unclaimed -json -host "${hosts[0]}" | jq -r '.StorageDeviceInfo.ScsiLun[].CanonicalName | select(startswith("naa."))')
+
+  run govc datastore.create -type vmfs -name vmfs1 -disk "$disk" "${hosts[0]}"
+  assert_success
+
+  # mounted on all hosts with access to the LUN
+  n=$(govc object.collect -json datastore/vmfs1 host | jq '.[].Val.DatastoreHostMount | length')
+  assert_equal ${#hosts[@]} "$n"
+
+  run govc datastore.create -type vmfs -name vmfs2 -disk "$disk" "${hosts[0]}"
+  assert_failure # disk is in use
+
+  run govc datastore.remove -ds vmfs1 "${hosts[0]}"
+  assert_success
+
+  run govc datastore.info vmfs1
+  assert_failure
+
+  dir="$BATS_TMPDIR/$(new_id)"
+  mkdir "$dir"
+  name=$(basename "$dir")
+
+  run govc datastore.create -type nfs -name "$dir" -remote-host nfs.example.com -remote-path /export "${hosts[@]}"
+  assert_success
+
+  n=$(govc object.collect -json "datastore/$name" host | jq '.[].Val.DatastoreHostMount | length')
+  assert_equal ${#hosts[@]} "$n"
+
+  run govc datastore.remove -ds "$name" "${hosts[0]}"
+  assert_success
+
+  n=$(govc object.collect -json "datastore/$name" host | jq '.[].Val.DatastoreHostMount | length')
+  assert_equal $((${#hosts[@]} - 1)) "$n"
+
+  rmdir "$dir"
+}
+
 
 @test "datastore.mkdir" {
   vcsim_env -esx
@@ -113,6 +113,40 @@ load test_helper
   govc host.vnic.info -json | jq .
 }
 
+@test "host.vnic.change" {
+  vcsim_env -esx
+
+  run govc host.vnic.change -mtu 9000 vmk0
+  assert_success
+
+  mtu=$(govc object.collect -json HostSystem:ha-host config.network.vnic | jq -r '.[].Val.HostVirtualNic[0].Spec.Mtu')
+  assert_equal 9000 "$mtu"
+
+  run govc host.vnic.change -mtu 9000 vmk9
+  assert_failure
+
+  run govc host.vnic.service -enable vmotion vmk0
+  assert_success
+
+  services=$(govc host.vnic.info -json | jq -r '.Info[0].Services | join(",")')
+  assert_equal management,vmotion "$services"
+
+  run govc host.vnic.service -enable vsan vmk0
+  assert_success
+
+  services=$(govc host.vnic.info -json | jq -r '.Info[0].Services | join(",")')
+  assert_equal management,vmotion,vsan "$services"
+
+  run govc host.vnic.service -enable=false vmotion vmk0
+  assert_success
+
+  run govc host.vnic.service -enable=false vsan vmk0
+  assert_success
+
+  services=$(govc host.vnic.info -json | jq -r '.Info[0].Services | join(",")')
+  assert_equal management "$services"
+}
+
 @test "host.vswitch.info" {
   vcsim_env -esx
 
@@ -207,6 +241,25 @@ load test_helper
   assert_matches "DNS:" "$result"
 }
 
+@test "host.cert vcsim" {
+  vcsim_env
+
+  run govc host.cert.info -host DC0_H0
+  assert_success
+
+  subject=$(govc host.cert.info -host DC0_H0 -json | jq -r .Subject)
+  assert_matches "CN=DC0_H0" "$subject"
+
+  result=$(govc host.cert.csr -host DC0_H0 -ip | openssl req -text -noout)
+  assert_matches "IP Address:" "$result"
+
+  result=$(govc host.cert.csr -host DC0_H0 | openssl req -text -noout)
+  assert_matches "DNS:DC0_H0" "$result"
+
+  run govc host.cert.import -host DC0_H0 enoent
+  assert_failure
+}
+
 @test "host.cert.import" {
   esx_env
 
@@ -96,3 +96,40 @@ get_nlabel() {
   assert_equal 1 "$(get_nlabel $key)"
   assert_equal "" "$(get_label $key foo)"
 }
+
+@test "license.assign" {
+  vcsim_env
+
+  run govc license.assigned.ls -json
+  assert_success
+  assert_equal 5 "$(jq length <<<"$output")" # vCenter and 4 hosts
+
+  host=DC0_C0_H0
+  id=$(govc find -i / -type h -name $host | awk -F: '{print $2}')
+
+  run govc license.assign -host $host VSSTD-00000-00000-00000-00016
+  assert_success
+
+  run govc license.assigned.ls -json -id "$id"
+  assert_success
+  assert_equal esx.standard.cpuPackage "$(jq -r .[0].AssignedLicense.EditionKey <<<"$output")"
+
+  run govc license.ls -json
+  assert_success
+  assert_equal 2 "$(get_key VSSTD-00000-00000-00000-00016 <<<"$output" | jq -r .Used)"
+
+  run govc license.assign -host $host VCSTD-00000-00000-00000-00001
+  assert_failure # vCenter key
+
+  run govc license.assign -host $host 00000-00000-00000-00000-00001
+  assert_failure # invalid key
+
+  run govc cluster.change -drs-enabled DC0_C0
+  assert_failure # Standard edition does not include DRS
+
+  run govc license.assign -host $host -remove VSSTD-00000-00000-00000-00016
+  assert_success
+
+  run govc cluster.change -drs-enabled DC0_C0
+  assert_success
+}
@@ -55,3 +55,35 @@ load test_helper
 
   govc logs | grep "$id"
 }
+
+@test "logs vcsim" {
+  vcsim_env
+
+  run govc logs.ls
+  assert_success
+  assert_line --partial vpxd:vpxd.log
+
+  run govc logs.ls -host DC0_H0
+  assert_success
+  assert_line --partial hostd
+
+  id=$(new_id)
+
+  run env GOVC_OPERATION_ID="$id" govc vm.power -off DC0_H0_VM0
+  assert_success
+
+  govc logs | grep "$id"
+  govc logs -host DC0_H0 | grep "$id"
+
+  run govc logs -log enoent
+  assert_failure
+
+  dir=$($mktemp --tmpdir -d govc-test-XXXXX)
+  pushd "$dir"
+  run govc logs.download -default DC0_H0
+  assert_success
+  tar -tzf vcsupport-*.tgz | grep vpxd.log
+  tar -tzf esx-DC0_H0-*.tgz | grep hostd.log
+  popd
+  rm -rf "$dir"
+}
@@ -25,4 +25,15 @@ load test_helper
 
     run govc storage.policy.info "vSAN Default Storage Policy"
     assert_success
+
+    run govc storage.policy.info -c -s
+    assert_success
+
+    run govc storage.policy.info -s -json "VM Encryption Policy"
+    assert_success
+    [ "$(jq -r '.Policies[].CompatibleDatastores[]' <<<"$output")" = "LocalDS_0" ]
+
+    run govc storage.policy.info -s -json "vSAN Default Storage Policy"
+    assert_success
+    [ "$(jq -r '.Policies[].CompatibleDatastores | length' <<<"$output")" = "0" ]
 }
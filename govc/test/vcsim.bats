@@ -491,6 +491,55 @@ EOF
   assert_success # issue #2016
 }
 
+@test "vcsim inventory spec" {
+  file="$BATS_TMPDIR/$(new_id).json"
+
+  cat > "$file" <<EOF
+{
+  "datacenters": [{
+    "name": "Boston",
+    "folder": "Regions/East",
+    "switches": [{"name": "DSwitch", "portgroups": [{"name": "Web", "vlan": 100}]}],
+    "clusters": [{"name": "Compute", "hosts": [{"name": "esx-01", "version": "7.0.3"}, {"name": "esx-02"}]}],
+    "datastores": [{"name": "vsan-01", "capacity": "20TB"}],
+    "vms": [{
+      "name": "web-01", "folder": "Web", "networks": ["Web"], "power": "poweredOn",
+      "tags": ["env:production"], "customValues": {"owner": "web-team"}
+    }]
+  }]
+}
+EOF
+
+  vcsim_start -inventory "$file"
+
+  run govc find / -type m
+  assert_success /Regions/East/Boston/vm/Web/web-01
+
+  run govc object.collect -s /Regions/East/Boston/vm/Web/web-01 runtime.powerState
+  assert_success poweredOn
+
+  run govc object.collect -s /Regions/East/Boston/network/Web config.defaultPortConfig.vlan.vlanId
+  assert_success 100
+
+  run govc object.collect -s /Regions/East/Boston/host/Compute/esx-01 config.product.version
+  assert_success 7.0.3
+
+  run govc tags.attached.ls -r /Regions/East/Boston/vm/Web/web-01
+  assert_success production
+
+  run govc fields.info /Regions/East/Boston/vm/Web/web-01
+  assert_success
+  assert_matches web-team
+
+  vcsim_stop
+
+  echo '{"datacenters": [{"nme": "DC0"}]}' > "$file"
+  run vcsim -inventory "$file"
+  assert_failure
+
+  rm -f "$file"
+}
+
 @test "vcsim trace file" {
   file="$BATS_TMPDIR/$(new_id).trace"
 
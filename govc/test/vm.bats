@@ -1013,6 +1013,51 @@ load test_helper
 
   run govc vm.create -on=false -version vmx-11 "$(new_id)"
   assert_success
+
+  run govc vm.create -on=false -version 6.7 "$(new_id)" # validation is opt-in
+  assert_success
+
+  run govc vm.create -on=false -validate -version 6.7 "$(new_id)" # vmx-14 not supported by ESX 6.5 hosts
+  assert_failure
+
+  run govc vm.create -on=false -validate -version 6.0 -g other4xLinux64Guest "$(new_id)"
+  assert_failure
+
+  run govc vm.create -on=false -validate -version 6.5 -g other4xLinux64Guest "$(new_id)"
+  assert_success
+}
+
+@test "vm.guest.tools" {
+  vcsim_env
+
+  vm=DC0_H0_VM0
+
+  run govc object.collect -s vm/$vm guest.toolsStatus
+  assert_success toolsNotInstalled
+
+  run govc vm.guest.tools -upgrade $vm
+  assert_failure # tools not running
+
+  run govc vm.guest.tools -mount $vm
+  assert_success
+
+  run govc vm.guest.tools -mount $vm
+  assert_failure # already mounted
+
+  run govc object.collect -s vm/$vm guest.toolsRunningStatus guest.toolsVersionStatus2 guestHeartbeatStatus
+  assert_success "$(printf "guestToolsRunning\nguestToolsCurrent\ngreen")"
+
+  run govc vm.guest.tools -unmount $vm
+  assert_success
+
+  run govc vm.guest.tools -upgrade $vm
+  assert_success
+
+  run govc vm.power -off $vm
+  assert_success
+
+  run govc object.collect -s vm/$vm guest.toolsStatus guestHeartbeatStatus
+  assert_success "$(printf "toolsNotRunning\ngray")"
 }
 
 @test "vm.markastemplate" {
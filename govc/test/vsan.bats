@@ -22,3 +22,62 @@ load test_helper
   config=$(jq .Clusters[].Info.UnmapConfig.Enable <<<"$output")
   assert_equal true "$config"
 }
+
+@test "vsan.fileshare" {
+  vcsim_env -cluster 2
+
+  export GOVC_CLUSTER=DC0_C0
+
+  run govc vsan.fileshare.create share1
+  assert_failure # file service not enabled
+
+  run govc vsan.change -file-service-enabled -file-service-network "VM Network" -file-service-domain example.com DC0_C0
+  assert_success
+
+  run govc vsan.fileshare.create -quota 1MB -soft-quota 512KB -label env=dev share1
+  assert_success
+  uuid="$output"
+
+  run govc vsan.fileshare.create share1
+  assert_failure # duplicate name
+
+  run govc vsan.fileshare.create -permission 10.0.0.0/8=READ_ONLY -root-squash -protocol NFSv4 share2
+  assert_success
+
+  run govc vsan.fileshare.ls
+  assert_success "$(printf "share1\nshare2")"
+
+  run govc vsan.fileshare.ls -json share1
+  assert_success
+  assert_equal "$uuid" "$(jq -r .shares[].Uuid <<<"$output")"
+  assert_equal example.com "$(jq -r .shares[].Config.DomainName <<<"$output")"
+
+  run govc vsan.fileshare.change -label owner=me -rm-label env -quota 2MB share1
+  assert_success
+
+  run govc vsan.fileshare.ls -json share1
+  assert_success
+  assert_equal owner "$(jq -r .shares[].Config.Labels[].Key <<<"$output")"
+  assert_equal 2MB "$(jq -r .shares[].Config.Quota <<<"$output")"
+
+  run govc vsan.fileshare.change -name share3 share1
+  assert_success
+
+  run govc vsan.fileshare.health
+  assert_success
+
+  run govc vsan.change -file-service-enabled=false DC0_C0
+  assert_failure # shares exist
+
+  run govc vsan.fileshare.rm share2 share3
+  assert_success
+
+  run govc vsan.fileshare.ls
+  assert_success ""
+
+  run govc vsan.fileshare.rm share1
+  assert_failure
+
+  run govc vsan.change -file-service-enabled=false DC0_C0
+  assert_success
+}
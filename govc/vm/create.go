@@ -28,6 +28,7 @@ import (
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/units"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -40,7 +41,11 @@ var hardwareVersions = []struct {
 	{"6.0", "vmx-11"},
 	{"6.5", "vmx-13"},
 	{"6.7", "vmx-14"},
+	{"6.7.2", "vmx-15"},
 	{"7.0", "vmx-17"},
+	{"7.0.1", "vmx-18"},
+	{"7.0.2", "vmx-19"},
+	{"8.0", "vmx-20"},
 }
 
 var (
@@ -69,6 +74,7 @@ type create struct {
 	link       bool
 	on         bool
 	force      bool
+	validate   bool
 	controller string
 	annotation string
 	firmware   string
@@ -134,6 +140,7 @@ func (cmd *create) Register(ctx context.Context, f *flag.FlagSet) {
 	f.BoolVar(&cmd.link, "link", true, "Link specified disk")
 	f.BoolVar(&cmd.on, "on", true, "Power on VM")
 	f.BoolVar(&cmd.force, "force", false, "Create VM if vmx already exists")
+	f.BoolVar(&cmd.validate, "validate", false, "Validate hardware version and guest ID against the target config options")
 	f.StringVar(&cmd.controller, "disk.controller", "scsi", "Disk controller type")
 	f.StringVar(&cmd.annotation, "annotation", "", "VM description")
 
@@ -418,6 +425,12 @@ func (cmd *create) createVM(ctx context.Context) (*object.Task, error) {
 		return nil, fmt.Errorf("please provide either a cluster, datastore or datastore-cluster")
 	}
 
+	if cmd.validate && !cmd.force {
+		if err = cmd.validateHardware(ctx, spec); err != nil {
+			return nil, err
+		}
+	}
+
 	if !cmd.force {
 		vmxPath := fmt.Sprintf("%s/%s.vmx", cmd.name, cmd.name)
 
@@ -437,6 +450,112 @@ func (cmd *create) createVM(ctx context.Context) (*object.Task, error) {
 	return folder.CreateVM(ctx, *spec, cmd.ResourcePool, cmd.HostSystem)
 }
 
+// validateHardware checks the spec against the config options of the target compute resource.
+// Validation is skipped with a warning if the config options are unavailable.
+func (cmd *create) validateHardware(ctx context.Context, spec *types.VirtualMachineConfigSpec) error {
+	warn := func(err error) error {
+		_, _ = cmd.Log(fmt.Sprintf("Warning: unable to validate hardware: %s\n", err))
+		return nil
+	}
+
+	owner, err := cmd.ResourcePool.Owner(ctx)
+	if err != nil {
+		return warn(err)
+	}
+
+	var cr mo.ComputeResource
+	err = property.DefaultCollector(cmd.Client).RetrieveOne(ctx, owner.Reference(), []string{"environmentBrowser"}, &cr)
+	if err != nil {
+		return warn(err)
+	}
+	if cr.EnvironmentBrowser == nil {
+		return nil
+	}
+
+	var host *types.ManagedObjectReference
+	if cmd.HostSystem != nil {
+		host = types.NewReference(cmd.HostSystem.Reference())
+	}
+
+	if spec.Version != "" {
+		res, err := methods.QueryConfigOptionDescriptor(ctx, cmd.Client, &types.QueryConfigOptionDescriptor{
+			This: *cr.EnvironmentBrowser,
+		})
+		if err != nil {
+			return warn(err)
+		}
+
+		var keys []string
+		supported := false
+		for _, d := range res.Returnval {
+			if d.CreateSupported == nil || !*d.CreateSupported {
+				continue
+			}
+			if host != nil && len(d.Host) != 0 && !containsRef(d.Host, *host) {
+				continue
+			}
+			keys = append(keys, d.Key)
+			if d.Key == spec.Version {
+				supported = true
+			}
+		}
+
+		if !supported {
+			return fmt.Errorf("hardware version %q not supported, valid versions: %s", spec.Version, strings.Join(keys, ","))
+		}
+	}
+
+	opt, err := methods.QueryConfigOptionEx(ctx, cmd.Client, &types.QueryConfigOptionEx{
+		This: *cr.EnvironmentBrowser,
+		Spec: &types.EnvironmentBrowserConfigOptionQuerySpec{
+			Key:     spec.Version,
+			Host:    host,
+			GuestId: []string{spec.GuestId},
+		},
+	})
+	if err != nil {
+		return warn(err)
+	}
+	if opt.Returnval == nil {
+		return nil
+	}
+
+	hw := opt.Returnval.HardwareOptions
+	version := opt.Returnval.Version
+
+	found := len(opt.Returnval.GuestOSDescriptor) == 0
+	for _, d := range opt.Returnval.GuestOSDescriptor {
+		if d.Id == spec.GuestId {
+			found = true
+			if d.SupportedMaxCPUs > 0 && spec.NumCPUs > d.SupportedMaxCPUs {
+				return fmt.Errorf("guest %s supports a maximum of %d CPUs", spec.GuestId, d.SupportedMaxCPUs)
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("guest ID %q not supported by hardware version %s", spec.GuestId, version)
+	}
+
+	if n := len(hw.NumCPU); n != 0 && spec.NumCPUs > hw.NumCPU[n-1] {
+		return fmt.Errorf("hardware version %s supports a maximum of %d CPUs", version, hw.NumCPU[n-1])
+	}
+
+	if max := hw.MemoryMB.Max; max != 0 && (spec.MemoryMB < hw.MemoryMB.Min || spec.MemoryMB > max) {
+		return fmt.Errorf("hardware version %s supports %d to %d MB of memory", version, hw.MemoryMB.Min, max)
+	}
+
+	return nil
+}
+
+func containsRef(refs []types.ManagedObjectReference, ref types.ManagedObjectReference) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
 func (cmd *create) addStorage(devices object.VirtualDeviceList) (object.VirtualDeviceList, error) {
 	if cmd.controller != "ide" {
 		if cmd.controller == "nvme" {
@@ -30,6 +30,10 @@ type change struct {
 	*flags.DatacenterFlag
 
 	unmap *bool
+	fs    *bool
+
+	network string
+	domain  string
 }
 
 func init() {
@@ -41,6 +45,9 @@ func (cmd *change) Register(ctx context.Context, f *flag.FlagSet) {
 	cmd.DatacenterFlag.Register(ctx, f)
 
 	f.Var(flags.NewOptionalBool(&cmd.unmap), "unmap-enabled", "Enable Unmap")
+	f.Var(flags.NewOptionalBool(&cmd.fs), "file-service-enabled", "Enable File Service")
+	f.StringVar(&cmd.network, "file-service-network", "", "File Service network")
+	f.StringVar(&cmd.domain, "file-service-domain", "", "File Service domain name")
 }
 
 func (cmd *change) Usage() string {
@@ -52,7 +59,9 @@ func (cmd *change) Description() string {
 
 Examples:
   govc vsan.change -unmap-enabled ClusterA # enable unmap
-  govc vsan.change -unmap-enabled=false ClusterA # disable unmap`
+  govc vsan.change -unmap-enabled=false ClusterA # disable unmap
+  govc vsan.change -file-service-enabled -file-service-network VM_Network ClusterA # enable file service
+  govc vsan.change -file-service-enabled -file-service-domain example.com ClusterA # enable with domain`
 }
 
 func (cmd *change) Run(ctx context.Context, f *flag.FlagSet) error {
@@ -82,7 +91,26 @@ func (cmd *change) Run(ctx context.Context, f *flag.FlagSet) error {
 
 	if cmd.unmap != nil {
 		spec.UnmapConfig = &types.VsanUnmapConfig{Enable: *cmd.unmap}
-	} else {
+	}
+
+	if cmd.fs != nil {
+		spec.FileServiceConfig = &types.VsanFileServiceConfig{Enabled: *cmd.fs}
+
+		if cmd.network != "" {
+			net, err := finder.Network(ctx, cmd.network)
+			if err != nil {
+				return err
+			}
+			ref := net.Reference()
+			spec.FileServiceConfig.Network = &ref
+		}
+
+		if cmd.domain != "" {
+			spec.FileServiceConfig.Domains = []types.VsanFileServiceDomainConfig{{Name: cmd.domain}}
+		}
+	}
+
+	if spec.UnmapConfig == nil && spec.FileServiceConfig == nil {
 		return flag.ErrHelp
 	}
 
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileshare
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/govc/flags"
+)
+
+type change struct {
+	*ShareFlag
+	configFlag
+
+	rm flags.StringList
+}
+
+func init() {
+	cli.Register("vsan.fileshare.change", &change{})
+}
+
+func (cmd *change) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ShareFlag, ctx = NewShareFlag(ctx)
+	cmd.ShareFlag.Register(ctx, f)
+
+	cmd.configFlag.Register(ctx, f)
+	f.StringVar(&cmd.configFlag.Name, "name", "", "New share name")
+	f.Var(&cmd.rm, "rm-label", "Remove label KEY")
+}
+
+func (cmd *change) Usage() string {
+	return "NAME"
+}
+
+func (cmd *change) Description() string {
+	return `Change vSAN file share NAME.
+
+Labels are merged with the existing labels, permissions and protocols replace the existing values.
+
+Examples:
+  govc vsan.fileshare.change -cluster ClusterA -quota 20GB my-share
+  govc vsan.fileshare.change -cluster ClusterA -label env=prod -rm-label owner my-share
+  govc vsan.fileshare.change -cluster ClusterA -name new-share my-share`
+}
+
+func (cmd *change) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 {
+		return flag.ErrHelp
+	}
+
+	config, err := cmd.Config()
+	if err != nil {
+		return err
+	}
+
+	c, cluster, err := cmd.VsanClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	share, err := cmd.Share(ctx, c, cluster.Reference(), f.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	task, err := c.VsanReconfigureFileShare(ctx, cluster.Reference(), share.Uuid, *config, cmd.rm...)
+	if err != nil {
+		return err
+	}
+
+	logger := cmd.ProgressLogger(fmt.Sprintf("Reconfiguring file share %s...", f.Arg(0)))
+	defer logger.Wait()
+
+	_, err = task.WaitForResult(ctx, logger)
+	return err
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileshare
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/govc/cli"
+)
+
+type create struct {
+	*ShareFlag
+	configFlag
+}
+
+func init() {
+	cli.Register("vsan.fileshare.create", &create{})
+}
+
+func (cmd *create) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ShareFlag, ctx = NewShareFlag(ctx)
+	cmd.ShareFlag.Register(ctx, f)
+
+	cmd.configFlag.Register(ctx, f)
+	f.StringVar(&cmd.DomainName, "domain", "", "File service domain name")
+}
+
+func (cmd *create) Usage() string {
+	return "NAME"
+}
+
+func (cmd *create) Description() string {
+	return `Create vSAN file share NAME.
+
+The file service must be enabled on the cluster, see 'govc vsan.change -file-service-enabled'.
+If no permission is specified, the share is read-write for all clients.
+
+Examples:
+  govc vsan.fileshare.create -cluster ClusterA my-share
+  govc vsan.fileshare.create -cluster ClusterA -quota 10GB -soft-quota 8GB -label env=dev my-share
+  govc vsan.fileshare.create -cluster ClusterA -protocol NFSv4 -permission 10.0.0.0/8=READ_WRITE -root-squash my-share`
+}
+
+func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() != 1 {
+		return flag.ErrHelp
+	}
+
+	config, err := cmd.Config()
+	if err != nil {
+		return err
+	}
+	config.Name = f.Arg(0)
+
+	c, cluster, err := cmd.VsanClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	task, err := c.VsanCreateFileShare(ctx, cluster.Reference(), *config)
+	if err != nil {
+		return err
+	}
+
+	logger := cmd.ProgressLogger(fmt.Sprintf("Creating file share %s...", config.Name))
+	res, err := task.WaitForResult(ctx, logger)
+	logger.Wait()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(res.Result)
+
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileshare
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/govmomi/govc/flags"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan"
+	vsantypes "github.com/vmware/govmomi/vsan/types"
+)
+
+// ShareFlag provides the vSAN client and cluster for the vsan.fileshare commands.
+type ShareFlag struct {
+	*flags.ClusterFlag
+}
+
+func NewShareFlag(ctx context.Context) (*ShareFlag, context.Context) {
+	v := &ShareFlag{}
+	v.ClusterFlag, ctx = flags.NewClusterFlag(ctx)
+	return v, ctx
+}
+
+func (f *ShareFlag) Register(ctx context.Context, fs *flag.FlagSet) {
+	f.ClusterFlag.Register(ctx, fs)
+}
+
+func (f *ShareFlag) Process(ctx context.Context) error {
+	return f.ClusterFlag.Process(ctx)
+}
+
+// VsanClient returns a vsan.Client and the target cluster.
+func (f *ShareFlag) VsanClient(ctx context.Context) (*vsan.Client, *object.ClusterComputeResource, error) {
+	vc, err := f.Client()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cluster, err := f.Cluster()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, err := vsan.NewClient(ctx, vc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.RoundTripper = f.RoundTripper(c.Client)
+
+	return c, cluster, nil
+}
+
+// Share returns the file share with the given name.
+func (f *ShareFlag) Share(ctx context.Context, c *vsan.Client, cluster types.ManagedObjectReference, name string) (*vsantypes.VsanFileShare, error) {
+	res, err := c.VsanClusterQueryFileShares(ctx, cluster, vsantypes.VsanFileShareQuerySpec{Names: []string{name}})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range res.FileShares {
+		share := &res.FileShares[i]
+		if share.Config != nil && share.Config.Name == name {
+			return share, nil
+		}
+	}
+
+	return nil, fmt.Errorf("file share %q not found", name)
+}
+
+// configFlag registers the flags common to vsan.fileshare.create and vsan.fileshare.change.
+type configFlag struct {
+	vsantypes.VsanFileShareConfig
+
+	labels      flags.StringList
+	permissions flags.StringList
+	protocols   flags.StringList
+	rootSquash  *bool
+}
+
+func (f *configFlag) Register(ctx context.Context, fs *flag.FlagSet) {
+	fs.StringVar(&f.Quota, "quota", "", "Hard quota (for example: 10GB)")
+	fs.StringVar(&f.SoftQuota, "soft-quota", "", "Soft quota, warning threshold (for example: 8GB)")
+	fs.Var(&f.labels, "label", "Label in KEY=VALUE format")
+	fs.Var(&f.permissions, "permission", "Net permission in IPS=PERMISSION format (READ_ONLY, READ_WRITE or NO_ACCESS)")
+	fs.Var(&f.protocols, "protocol", "Protocol (NFSv3, NFSv4 or SMB)")
+	fs.Var(flags.NewOptionalBool(&f.rootSquash), "root-squash", "Deny root access for net permissions")
+}
+
+// Config returns the VsanFileShareConfig with the list flags applied.
+func (f *configFlag) Config() (*vsantypes.VsanFileShareConfig, error) {
+	config := f.VsanFileShareConfig
+
+	for _, l := range f.labels {
+		kv := strings.SplitN(l, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid label: %q", l)
+		}
+		config.Labels = append(config.Labels, types.KeyValue{Key: kv[0], Value: kv[1]})
+	}
+
+	for _, p := range f.permissions {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid permission: %q", p)
+		}
+		perm := vsantypes.VsanFileShareNetPermission{
+			Ips:         kv[0],
+			Permissions: kv[1],
+		}
+		if f.rootSquash != nil {
+			perm.AllowRoot = types.NewBool(!*f.rootSquash)
+		}
+		config.Permission = append(config.Permission, perm)
+	}
+
+	config.Protocols = f.protocols
+
+	return &config, nil
+}
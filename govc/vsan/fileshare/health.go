@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileshare
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/vsan/types"
+)
+
+type health struct {
+	*ShareFlag
+}
+
+func init() {
+	cli.Register("vsan.fileshare.health", &health{})
+}
+
+func (cmd *health) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ShareFlag, ctx = NewShareFlag(ctx)
+	cmd.ShareFlag.Register(ctx, f)
+}
+
+func (cmd *health) Description() string {
+	return `Display vSAN file share health.
+
+Examples:
+  govc vsan.fileshare.health -cluster ClusterA
+  govc vsan.fileshare.health -cluster ClusterA -json`
+}
+
+func (cmd *health) Run(ctx context.Context, f *flag.FlagSet) error {
+	c, cluster, err := cmd.VsanClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.VsanClusterQueryFileServiceHealthSummary(ctx, cluster.Reference())
+	if err != nil {
+		return err
+	}
+
+	return cmd.WriteResult(&healthResult{res})
+}
+
+type healthResult struct {
+	*types.VsanClusterFileServiceHealthSummary
+}
+
+func (r *healthResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Overall:\t%s\n", r.OverallHealth)
+
+	for _, host := range r.HostResults {
+		for _, share := range host.FileShareHealth {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", share.ShareName, share.OverallHealth, host.Hostname, share.Description)
+		}
+	}
+
+	return tw.Flush()
+}
+
+func (r *healthResult) Dump() interface{} {
+	return r.VsanClusterFileServiceHealthSummary
+}
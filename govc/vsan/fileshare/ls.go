@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileshare
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/govc/cli"
+	"github.com/vmware/govmomi/units"
+	"github.com/vmware/govmomi/vsan/types"
+)
+
+type ls struct {
+	*ShareFlag
+
+	long   bool
+	domain string
+}
+
+func init() {
+	cli.Register("vsan.fileshare.ls", &ls{})
+}
+
+func (cmd *ls) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ShareFlag, ctx = NewShareFlag(ctx)
+	cmd.ShareFlag.Register(ctx, f)
+
+	f.BoolVar(&cmd.long, "l", false, "Long listing format")
+	f.StringVar(&cmd.domain, "domain", "", "Filter by file service domain name")
+}
+
+func (cmd *ls) Usage() string {
+	return "[NAME]..."
+}
+
+func (cmd *ls) Description() string {
+	return `List vSAN file shares.
+
+Examples:
+  govc vsan.fileshare.ls -cluster ClusterA
+  govc vsan.fileshare.ls -cluster ClusterA -l my-share
+  govc vsan.fileshare.ls -cluster ClusterA -json | jq .`
+}
+
+func (cmd *ls) Run(ctx context.Context, f *flag.FlagSet) error {
+	c, cluster, err := cmd.VsanClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	spec := types.VsanFileShareQuerySpec{
+		DomainName: cmd.domain,
+		Names:      f.Args(),
+	}
+
+	var shares []types.VsanFileShare
+
+	for {
+		res, err := c.VsanClusterQueryFileShares(ctx, cluster.Reference(), spec)
+		if err != nil {
+			return err
+		}
+
+		shares = append(shares, res.FileShares...)
+
+		if res.NextOffset == "" {
+			break
+		}
+		spec.Offset = res.NextOffset
+	}
+
+	return cmd.WriteResult(&lsResult{cmd, shares})
+}
+
+type lsResult struct {
+	cmd    *ls
+	Shares []types.VsanFileShare `json:"shares"`
+}
+
+func (r *lsResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	for _, share := range r.Shares {
+		config := share.Config
+		if config == nil {
+			config = new(types.VsanFileShareConfig)
+		}
+
+		if !r.cmd.long {
+			fmt.Fprintln(tw, config.Name)
+			continue
+		}
+
+		var used int64
+		var points []string
+		if share.Runtime != nil {
+			used = share.Runtime.UsedCapacity
+			for _, p := range share.Runtime.AccessPoints {
+				points = append(points, p.Value)
+			}
+		}
+
+		quota := config.Quota
+		if quota == "" {
+			quota = "-"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", config.Name, share.Uuid, config.DomainName,
+			quota, units.ByteSize(used), strings.Join(points, ","))
+	}
+
+	return tw.Flush()
+}
+
+func (r *lsResult) Dump() interface{} {
+	return r.Shares
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileshare
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/govc/cli"
+)
+
+type rm struct {
+	*ShareFlag
+
+	force bool
+}
+
+func init() {
+	cli.Register("vsan.fileshare.rm", &rm{})
+}
+
+func (cmd *rm) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.ShareFlag, ctx = NewShareFlag(ctx)
+	cmd.ShareFlag.Register(ctx, f)
+
+	f.BoolVar(&cmd.force, "force", false, "Remove share even if it contains files")
+}
+
+func (cmd *rm) Usage() string {
+	return "NAME..."
+}
+
+func (cmd *rm) Description() string {
+	return `Remove vSAN file shares.
+
+Examples:
+  govc vsan.fileshare.rm -cluster ClusterA my-share
+  govc vsan.fileshare.rm -cluster ClusterA -force my-share`
+}
+
+func (cmd *rm) Run(ctx context.Context, f *flag.FlagSet) error {
+	if f.NArg() == 0 {
+		return flag.ErrHelp
+	}
+
+	c, cluster, err := cmd.VsanClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range f.Args() {
+		share, err := cmd.Share(ctx, c, cluster.Reference(), name)
+		if err != nil {
+			return err
+		}
+
+		task, err := c.VsanRemoveFileShare(ctx, cluster.Reference(), share.Uuid, cmd.force)
+		if err != nil {
+			return err
+		}
+
+		logger := cmd.ProgressLogger(fmt.Sprintf("Removing file share %s...", name))
+		_, err = task.WaitForResult(ctx, logger)
+		logger.Wait()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -27,6 +27,10 @@ type DynamicTypeMgrQueryMoInstancesRequest struct {
 	FilterSpec BaseDynamicTypeMgrFilterSpec `xml:"filterSpec,omitempty,typeattr"`
 }
 
+func init() {
+	types.Add("DynamicTypeMgrQueryMoInstances", reflect.TypeOf((*DynamicTypeMgrQueryMoInstancesRequest)(nil)).Elem())
+}
+
 type DynamicTypeMgrQueryMoInstancesResponse struct {
 	Returnval []DynamicTypeMgrMoInstance `xml:"urn:vim25 returnval"`
 }
@@ -182,6 +186,10 @@ type DynamicTypeMgrQueryTypeInfoRequest struct {
 	FilterSpec BaseDynamicTypeMgrFilterSpec `xml:"filterSpec,omitempty,typeattr"`
 }
 
+func init() {
+	types.Add("DynamicTypeMgrQueryTypeInfo", reflect.TypeOf((*DynamicTypeMgrQueryTypeInfoRequest)(nil)).Elem())
+}
+
 type DynamicTypeMgrQueryTypeInfoResponse struct {
 	Returnval DynamicTypeMgrAllTypeInfoRequest `xml:"urn:vim25 returnval"`
 }
@@ -233,6 +241,10 @@ type RetrieveDynamicTypeManagerRequest struct {
 	This types.ManagedObjectReference `xml:"_this"`
 }
 
+func init() {
+	types.Add("RetrieveDynamicTypeManager", reflect.TypeOf((*RetrieveDynamicTypeManagerRequest)(nil)).Elem())
+}
+
 type RetrieveDynamicTypeManagerResponse struct {
 	Returnval *InternalDynamicTypeManager `xml:"urn:vim25 returnval"`
 }
@@ -265,6 +277,10 @@ type ExecuteSoapRequest struct {
 	Argument []ReflectManagedMethodExecuterSoapArgument `xml:"argument,omitempty"`
 }
 
+func init() {
+	types.Add("ExecuteSoap", reflect.TypeOf((*ExecuteSoapRequest)(nil)).Elem())
+}
+
 type ExecuteSoapResponse struct {
 	Returnval *ReflectManagedMethodExecuterSoapResult `xml:"urn:vim25 returnval"`
 }
@@ -41,6 +41,9 @@ func (l *DiagnosticLog) Seek(ctx context.Context, nlines int32) error {
 	}
 
 	l.Start = h.LineEnd - nlines
+	if l.Start < 1 {
+		l.Start = 1 // the log has fewer than nlines
+	}
 
 	return nil
 }
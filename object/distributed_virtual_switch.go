@@ -103,6 +103,16 @@ func (s DistributedVirtualSwitch) ReconfigureDVPort(ctx context.Context, spec []
 	return NewTask(s.Client(), res.Returnval), nil
 }
 
+func (s DistributedVirtualSwitch) RefreshDVPortState(ctx context.Context, portKeys []string) error {
+	req := types.RefreshDVPortState{
+		This:     s.Reference(),
+		PortKeys: portKeys,
+	}
+
+	_, err := methods.RefreshDVPortState(ctx, s.Client(), &req)
+	return err
+}
+
 func (s DistributedVirtualSwitch) ReconfigureLACP(ctx context.Context, spec []types.VMwareDvsLacpGroupSpec) (*Task, error) {
 	req := types.UpdateDVSLacpGroupConfig_Task{
 		This:          s.Reference(),
@@ -198,3 +198,85 @@ func (s HostStorageSystem) UnmountVmfsVolume(ctx context.Context, vmfsUuid strin
 
 	return nil
 }
+
+func (s HostStorageSystem) MountVmfsVolume(ctx context.Context, vmfsUuid string) error {
+	req := &types.MountVmfsVolume{
+		This:     s.Reference(),
+		VmfsUuid: vmfsUuid,
+	}
+
+	_, err := methods.MountVmfsVolume(ctx, s.Client(), req)
+
+	return err
+}
+
+func (s HostStorageSystem) DetachScsiLun(ctx context.Context, uuid string) error {
+	req := types.DetachScsiLun{
+		This:    s.Reference(),
+		LunUuid: uuid,
+	}
+
+	_, err := methods.DetachScsiLun(ctx, s.c, &req)
+
+	return err
+}
+
+func (s HostStorageSystem) UpdateSoftwareInternetScsiEnabled(ctx context.Context, enabled bool) error {
+	req := types.UpdateSoftwareInternetScsiEnabled{
+		This:    s.Reference(),
+		Enabled: enabled,
+	}
+
+	_, err := methods.UpdateSoftwareInternetScsiEnabled(ctx, s.c, &req)
+
+	return err
+}
+
+func (s HostStorageSystem) AddInternetScsiSendTargets(ctx context.Context, device string, targets []types.HostInternetScsiHbaSendTarget) error {
+	req := types.AddInternetScsiSendTargets{
+		This:           s.Reference(),
+		IScsiHbaDevice: device,
+		Targets:        targets,
+	}
+
+	_, err := methods.AddInternetScsiSendTargets(ctx, s.c, &req)
+
+	return err
+}
+
+func (s HostStorageSystem) RemoveInternetScsiSendTargets(ctx context.Context, device string, targets []types.HostInternetScsiHbaSendTarget, force bool) error {
+	req := types.RemoveInternetScsiSendTargets{
+		This:           s.Reference(),
+		IScsiHbaDevice: device,
+		Targets:        targets,
+		Force:          types.NewBool(force),
+	}
+
+	_, err := methods.RemoveInternetScsiSendTargets(ctx, s.c, &req)
+
+	return err
+}
+
+func (s HostStorageSystem) AddInternetScsiStaticTargets(ctx context.Context, device string, targets []types.HostInternetScsiHbaStaticTarget) error {
+	req := types.AddInternetScsiStaticTargets{
+		This:           s.Reference(),
+		IScsiHbaDevice: device,
+		Targets:        targets,
+	}
+
+	_, err := methods.AddInternetScsiStaticTargets(ctx, s.c, &req)
+
+	return err
+}
+
+func (s HostStorageSystem) RemoveInternetScsiStaticTargets(ctx context.Context, device string, targets []types.HostInternetScsiHbaStaticTarget) error {
+	req := types.RemoveInternetScsiStaticTargets{
+		This:           s.Reference(),
+		IScsiHbaDevice: device,
+		Targets:        targets,
+	}
+
+	_, err := methods.RemoveInternetScsiStaticTargets(ctx, s.c, &req)
+
+	return err
+}
@@ -0,0 +1,306 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/vmware/govmomi/pbm/types"
+	"github.com/vmware/govmomi/simulator"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// tagNamespace is the capability namespace of tag based placement rules,
+// where the capability ID is the tag category name.
+const tagNamespace = "http://www.vmware.com/storage/tag"
+
+// tagProperty returns the property ID of a tag based placement rule for the given category.
+func tagProperty(category string) string {
+	return "com.vmware.storage.tag." + category + ".property"
+}
+
+// datastoreTypes maps system created default profiles to the type of datastore they apply to.
+var datastoreTypes = map[string]vim.HostFileSystemVolumeFileSystemType{
+	string(types.PbmSystemCreatedProfileTypeVsanDefaultProfile): vim.HostFileSystemVolumeFileSystemTypeVsan,
+	string(types.PbmSystemCreatedProfileTypeVVolDefaultProfile): vim.HostFileSystemVolumeFileSystemTypeVVOL,
+	string(types.PbmSystemCreatedProfileTypePmemDefaultProfile): vim.HostFileSystemVolumeFileSystemTypePMEM,
+}
+
+// capabilities maps the capabilities advertised by a datastore to the values supported for each property,
+// as a PbmCapabilityRange or PbmCapabilityDiscreteSet.
+type capabilities map[types.PbmCapabilityMetadataUniqueId]map[string]vim.AnyType
+
+func (c capabilities) add(id types.PbmCapabilityMetadataUniqueId, prop string, val vim.AnyType) {
+	if c[id] == nil {
+		c[id] = make(map[string]vim.AnyType)
+	}
+	c[id][prop] = val
+}
+
+func valueRange(min, max int32) *types.PbmCapabilityRange {
+	return &types.PbmCapabilityRange{Min: min, Max: max}
+}
+
+func discreteSet(values ...vim.AnyType) *types.PbmCapabilityDiscreteSet {
+	return &types.PbmCapabilityDiscreteSet{Values: values}
+}
+
+// datastoreCapabilities returns the capabilities of the given datastore, derived from its type,
+// the vapi tags attached to it and the data services provided by the hosts it is mounted on.
+func datastoreCapabilities(ctx *simulator.Context, ds *simulator.Datastore) capabilities {
+	c := make(capabilities)
+
+	switch vim.HostFileSystemVolumeFileSystemType(ds.Summary.Type) {
+	case vim.HostFileSystemVolumeFileSystemTypeVsan:
+		vsan := func(id string, val vim.AnyType) {
+			c.add(types.PbmCapabilityMetadataUniqueId{Namespace: "VSAN", Id: id}, id, val)
+		}
+		// each failure to tolerate requires 2 more hosts to place a replica and witness
+		vsan("hostFailuresToTolerate", valueRange(0, int32(len(ds.Host)-1)/2))
+		vsan("stripeWidth", valueRange(1, 12))
+		vsan("forceProvisioning", discreteSet(true, false))
+		vsan("proportionalCapacity", valueRange(0, 100))
+		vsan("cacheReservation", valueRange(0, 1000000))
+	case vim.HostFileSystemVolumeFileSystemTypePMEM:
+		c.add(types.PbmCapabilityMetadataUniqueId{Namespace: "PMem", Id: "PMemType"}, "PMemType", discreteSet("LocalPMem"))
+	}
+
+	for _, tag := range ctx.VimMap().AttachedTags(ds.Self) {
+		id := types.PbmCapabilityMetadataUniqueId{Namespace: tagNamespace, Id: tag.ParentCategoryName}
+		prop := tagProperty(tag.ParentCategoryName)
+		set, ok := c[id][prop].(*types.PbmCapabilityDiscreteSet)
+		if !ok {
+			set = discreteSet()
+			c.add(id, prop, set)
+		}
+		set.Values = append(set.Values, tag.TagName)
+	}
+
+	if len(ds.Host) != 0 {
+		// host based data services: VM encryption and storage I/O control
+		encryption := "ad5a249d-cbc2-43af-9366-694d7664fa52"
+		c.add(types.PbmCapabilityMetadataUniqueId{Namespace: "com.vmware.storageprofile.dataservice", Id: encryption}, encryption, discreteSet(encryption))
+
+		sioc := types.PbmCapabilityMetadataUniqueId{Namespace: "spm", Id: "spm@DATASTOREIOCONTROL"}
+		c.add(sioc, "limit", valueRange(-1, math.MaxInt32))
+		c.add(sioc, "reservation", valueRange(0, math.MaxInt32))
+		c.add(sioc, "shares", valueRange(0, math.MaxInt32))
+	}
+
+	return c
+}
+
+// instance returns the values advertised for the given capability, or nil if the capability is not advertised.
+func (c capabilities) instance(id types.PbmCapabilityMetadataUniqueId) *types.PbmCapabilityInstance {
+	props, ok := c[id]
+	if !ok {
+		return nil
+	}
+
+	var constraint types.PbmCapabilityConstraintInstance
+	for prop, val := range props {
+		constraint.PropertyInstance = append(constraint.PropertyInstance, types.PbmCapabilityPropertyInstance{
+			Id:    prop,
+			Value: val,
+		})
+	}
+	sort.Slice(constraint.PropertyInstance, func(i, j int) bool {
+		return constraint.PropertyInstance[i].Id < constraint.PropertyInstance[j].Id
+	})
+
+	return &types.PbmCapabilityInstance{
+		Id:         id,
+		Constraint: []types.PbmCapabilityConstraintInstance{constraint},
+	}
+}
+
+// mismatch returns nil if any of the capability's constraints is satisfied,
+// otherwise the first property instance not satisfied by the datastore.
+// All property instances of a constraint must be satisfied.
+func (c capabilities) mismatch(capability types.PbmCapabilityInstance) *types.PbmCapabilityPropertyInstance {
+	var first *types.PbmCapabilityPropertyInstance
+
+	for _, constraint := range capability.Constraint {
+		var prop *types.PbmCapabilityPropertyInstance
+
+		for i := range constraint.PropertyInstance {
+			if !c.satisfies(capability.Id, constraint.PropertyInstance[i]) {
+				prop = &constraint.PropertyInstance[i]
+				break
+			}
+		}
+
+		if prop == nil {
+			return nil
+		}
+		if first == nil {
+			first = prop
+		}
+	}
+
+	return first
+}
+
+func (c capabilities) satisfies(id types.PbmCapabilityMetadataUniqueId, prop types.PbmCapabilityPropertyInstance) bool {
+	val, ok := c[id][prop.Id]
+	if ok {
+		ok = supports(val, prop.Value)
+	}
+
+	if prop.Operator == string(types.PbmCapabilityOperatorNOT) {
+		return !ok
+	}
+
+	return ok
+}
+
+// violations returns the capabilities not satisfied by the datastore.
+// Sub-profiles (rule sets) are alternatives: constraints are satisfied if any sub-profile is.
+// All capabilities within a sub-profile must be satisfied.
+func (c capabilities) violations(constraints types.BasePbmCapabilityConstraints) []types.PbmCapabilityInstance {
+	sub, ok := constraints.(*types.PbmCapabilitySubProfileConstraints)
+	if !ok {
+		return nil // no requirements
+	}
+
+	var violations []types.PbmCapabilityInstance
+
+	for i, profile := range sub.SubProfiles {
+		var failed []types.PbmCapabilityInstance
+
+		for _, capability := range profile.Capability {
+			if c.mismatch(capability) != nil {
+				failed = append(failed, capability)
+			}
+		}
+
+		if len(failed) == 0 {
+			return nil
+		}
+		if i == 0 {
+			violations = failed
+		}
+	}
+
+	return violations
+}
+
+// requirement is a set of capability constraints, optionally restricted to a type of datastore.
+type requirement struct {
+	datastoreType vim.HostFileSystemVolumeFileSystemType
+	constraints   types.BasePbmCapabilityConstraints
+}
+
+func profileRequirement(p *types.PbmCapabilityProfile) requirement {
+	return requirement{
+		datastoreType: datastoreTypes[p.SystemCreatedProfileType],
+		constraints:   p.Constraints,
+	}
+}
+
+// check returns a fault for each part of the requirement not satisfied by the datastore.
+func (r requirement) check(hub types.PbmPlacementHub, ds *simulator.Datastore, c capabilities) []vim.LocalizedMethodFault {
+	if r.datastoreType != "" && r.datastoreType != vim.HostFileSystemVolumeFileSystemType(ds.Summary.Type) {
+		return []vim.LocalizedMethodFault{{
+			Fault:            &types.PbmCompatibilityCheckFault{Hub: hub},
+			LocalizedMessage: fmt.Sprintf("Datastore %s is not of type %s", ds.Name, r.datastoreType),
+		}}
+	}
+
+	var faults []vim.LocalizedMethodFault
+
+	for _, capability := range c.violations(r.constraints) {
+		prop := c.mismatch(capability)
+		faults = append(faults, vim.LocalizedMethodFault{
+			Fault: &types.PbmPropertyMismatchFault{
+				PbmCompatibilityCheckFault:  types.PbmCompatibilityCheckFault{Hub: hub},
+				CapabilityInstanceId:        capability.Id,
+				RequirementPropertyInstance: *prop,
+			},
+			LocalizedMessage: fmt.Sprintf("Datastore %s does not satisfy %s.%s", ds.Name, capability.Id.Namespace, prop.Id),
+		})
+	}
+
+	return faults
+}
+
+// supports returns true if the supported value, a PbmCapabilityRange, PbmCapabilityDiscreteSet or scalar,
+// includes the required value. A required PbmCapabilityDiscreteSet is supported if any of its values are.
+func supports(supported, required vim.AnyType) bool {
+	switch val := required.(type) {
+	case types.PbmCapabilityDiscreteSet:
+		return supports(supported, &val)
+	case *types.PbmCapabilityDiscreteSet:
+		for _, v := range val.Values {
+			if supports(supported, v) {
+				return true
+			}
+		}
+		return false
+	case types.PbmCapabilityRange:
+		return supports(supported, &val)
+	case *types.PbmCapabilityRange:
+		return supports(supported, val.Min) && supports(supported, val.Max)
+	}
+
+	switch val := supported.(type) {
+	case *types.PbmCapabilityDiscreteSet:
+		for _, v := range val.Values {
+			if equal(v, required) {
+				return true
+			}
+		}
+		return false
+	case *types.PbmCapabilityRange:
+		n, ok := number(required)
+		if !ok {
+			return false
+		}
+		min, _ := number(val.Min)
+		max, _ := number(val.Max)
+		return n >= min && n <= max
+	default:
+		return equal(supported, required)
+	}
+}
+
+func number(val vim.AnyType) (int64, bool) {
+	switch n := val.(type) {
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+func equal(a, b vim.AnyType) bool {
+	x, xok := number(a)
+	y, yok := number(b)
+	if xok && yok {
+		return x == y
+	}
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/pbm/methods"
+	"github.com/vmware/govmomi/pbm/types"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+type ComplianceManager struct {
+	vim.ManagedObjectReference
+}
+
+// withVM invokes f with the VM of the given moref value, holding its lock.
+func withVM(ctx *simulator.Context, key string, f func(*simulator.VirtualMachine)) vim.BaseMethodFault {
+	vctx := *ctx
+	vctx.Map = ctx.VimMap()
+
+	vm, ok := vctx.Map.Get(vim.ManagedObjectReference{Type: "VirtualMachine", Value: key}).(*simulator.VirtualMachine)
+	if !ok {
+		return new(types.PbmFaultNotFound)
+	}
+
+	vctx.Map.WithLock(&vctx, vm, func() {
+		f(vm)
+	})
+
+	return nil
+}
+
+// vmDatastore returns the VM datastore referenced by the given datastore path.
+func vmDatastore(ctx *simulator.Context, vm *simulator.VirtualMachine, file string) *simulator.Datastore {
+	var p object.DatastorePath
+	if !p.FromString(file) {
+		return nil
+	}
+
+	for _, ref := range vm.Datastore {
+		if ds, ok := ctx.VimMap().Get(ref).(*simulator.Datastore); ok && ds.Name == p.Datastore {
+			return ds
+		}
+	}
+
+	return nil
+}
+
+// entity returns the ID of the storage policy associated with the given VM or virtual disk,
+// along with the datastore where it lives.
+// Virtual disk keys are in the form "vm-N:diskKey".
+func entity(ctx *simulator.Context, ref types.PbmServerObjectRef) (string, *simulator.Datastore, vim.BaseMethodFault) {
+	key := ref.Key
+	disk := int32(-1)
+
+	switch types.PbmObjectType(ref.ObjectType) {
+	case types.PbmObjectTypeVirtualMachine:
+	case types.PbmObjectTypeVirtualDiskId:
+		s := strings.SplitN(ref.Key, ":", 2)
+		if len(s) != 2 {
+			return "", nil, &vim.InvalidArgument{InvalidProperty: "key"}
+		}
+		n, err := strconv.Atoi(s[1])
+		if err != nil {
+			return "", nil, &vim.InvalidArgument{InvalidProperty: "key"}
+		}
+		key, disk = s[0], int32(n)
+	default:
+		return "", nil, &vim.InvalidArgument{InvalidProperty: "objectType"}
+	}
+
+	var id string
+	var ds *simulator.Datastore
+	var fault vim.BaseMethodFault
+
+	err := withVM(ctx, key, func(vm *simulator.VirtualMachine) {
+		if disk == -1 {
+			id = vm.StorageProfile()
+			ds = vmDatastore(ctx, vm, vm.Config.Files.VmPathName)
+			return
+		}
+
+		device, ok := object.VirtualDeviceList(vm.Config.Hardware.Device).FindByKey(disk).(*vim.VirtualDisk)
+		if !ok {
+			fault = new(types.PbmFaultNotFound)
+			return
+		}
+
+		id = vm.StorageProfile(disk)
+		if backing, ok := device.Backing.(vim.BaseVirtualDeviceFileBackingInfo); ok {
+			info := backing.GetVirtualDeviceFileBackingInfo()
+			if info.Datastore != nil {
+				ds, _ = ctx.VimMap().Get(*info.Datastore).(*simulator.Datastore)
+			} else {
+				ds = vmDatastore(ctx, vm, info.FileName)
+			}
+		}
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return id, ds, fault
+}
+
+type association struct {
+	entity  types.PbmServerObjectRef
+	profile string
+}
+
+// associations returns the storage policies associated with all VMs and their virtual disks.
+func associations(ctx *simulator.Context) []association {
+	var res []association
+
+	for _, obj := range ctx.VimMap().All("VirtualMachine") {
+		ref := obj.Reference()
+
+		_ = withVM(ctx, ref.Value, func(vm *simulator.VirtualMachine) {
+			if id := vm.StorageProfile(); id != "" {
+				res = append(res, association{
+					entity: types.PbmServerObjectRef{
+						ObjectType: string(types.PbmObjectTypeVirtualMachine),
+						Key:        ref.Value,
+					},
+					profile: id,
+				})
+			}
+
+			for _, device := range object.VirtualDeviceList(vm.Config.Hardware.Device).SelectByType((*vim.VirtualDisk)(nil)) {
+				key := device.GetVirtualDevice().Key
+				if id := vm.StorageProfile(key); id != "" {
+					res = append(res, association{
+						entity: types.PbmServerObjectRef{
+							ObjectType: string(types.PbmObjectTypeVirtualDiskId),
+							Key:        fmt.Sprintf("%s:%d", ref.Value, key),
+						},
+						profile: id,
+					})
+				}
+			}
+		})
+	}
+
+	return res
+}
+
+// compliance checks the given entity against the given profile ID,
+// or the profile associated with the entity if id is empty.
+func compliance(ctx *simulator.Context, ref types.PbmServerObjectRef, id string) types.PbmComplianceResult {
+	res := types.PbmComplianceResult{
+		CheckTime:        time.Now(),
+		Entity:           ref,
+		ComplianceStatus: string(types.PbmComplianceStatusUnknown),
+	}
+
+	associated, ds, fault := entity(ctx, ref)
+	if fault != nil {
+		res.ErrorCause = []vim.LocalizedMethodFault{{Fault: fault, LocalizedMessage: ref.Key}}
+		return res
+	}
+
+	if id == "" {
+		id = associated
+	}
+	if id == "" {
+		res.ComplianceStatus = string(types.PbmComplianceStatusNotApplicable)
+		return res
+	}
+	res.Profile = &types.PbmProfileId{UniqueId: id}
+
+	p := profile(id)
+	if p == nil || ds == nil {
+		res.ErrorCause = []vim.LocalizedMethodFault{{Fault: new(types.PbmFaultNotFound), LocalizedMessage: id}}
+		return res
+	}
+
+	c := datastoreCapabilities(ctx, ds)
+	r := profileRequirement(p)
+	hub := types.PbmPlacementHub{HubType: ds.Self.Type, HubId: ds.Self.Value}
+
+	if faults := r.check(hub, ds, c); len(faults) != 0 {
+		res.ComplianceStatus = string(types.PbmComplianceStatusNonCompliant)
+		for _, capability := range c.violations(p.Constraints) {
+			res.ViolatedPolicies = append(res.ViolatedPolicies, types.PbmCompliancePolicyStatus{
+				ExpectedValue: capability,
+				CurrentValue:  c.instance(capability.Id),
+			})
+		}
+		return res
+	}
+
+	res.ComplianceStatus = string(types.PbmComplianceStatusCompliant)
+
+	return res
+}
+
+// PbmCheckCompliance checks the given entities against the datastores where they currently live.
+func (m *ComplianceManager) PbmCheckCompliance(ctx *simulator.Context, req *types.PbmCheckCompliance) soap.HasFault {
+	body := new(methods.PbmCheckComplianceBody)
+	body.Res = new(types.PbmCheckComplianceResponse)
+
+	var id string
+	if req.Profile != nil {
+		id = req.Profile.UniqueId
+	}
+
+	for _, ref := range req.Entities {
+		body.Res.Returnval = append(body.Res.Returnval, compliance(ctx, ref, id))
+	}
+
+	return body
+}
+
+// PbmFetchComplianceResult does not cache results, compliance is computed as with PbmCheckCompliance.
+func (m *ComplianceManager) PbmFetchComplianceResult(ctx *simulator.Context, req *types.PbmFetchComplianceResult) soap.HasFault {
+	body := new(methods.PbmFetchComplianceResultBody)
+	body.Res = new(types.PbmFetchComplianceResultResponse)
+
+	var id string
+	if req.Profile != nil {
+		id = req.Profile.UniqueId
+	}
+
+	for _, ref := range req.Entities {
+		body.Res.Returnval = append(body.Res.Returnval, compliance(ctx, ref, id))
+	}
+
+	return body
+}
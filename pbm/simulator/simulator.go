@@ -69,6 +69,10 @@ func New() *simulator.Registry {
 		ManagedObjectReference: content.PlacementSolver,
 	})
 
+	r.Put(&ComplianceManager{
+		ManagedObjectReference: content.ComplianceManager,
+	})
+
 	return r
 }
 
@@ -119,9 +123,74 @@ func (m *ProfileManager) PbmQueryProfile(req *types.PbmQueryProfile) soap.HasFau
 	return body
 }
 
-func (m *ProfileManager) PbmQueryAssociatedProfile(req *types.PbmQueryAssociatedProfile) soap.HasFault {
+func (m *ProfileManager) PbmQueryAssociatedProfile(ctx *simulator.Context, req *types.PbmQueryAssociatedProfile) soap.HasFault {
 	body := new(methods.PbmQueryAssociatedProfileBody)
+
+	id, _, fault := entity(ctx, req.Entity)
+	if fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
 	body.Res = new(types.PbmQueryAssociatedProfileResponse)
+	if id != "" {
+		body.Res.Returnval = []types.PbmProfileId{{UniqueId: id}}
+	}
+
+	return body
+}
+
+func (m *ProfileManager) PbmQueryAssociatedProfiles(ctx *simulator.Context, req *types.PbmQueryAssociatedProfiles) soap.HasFault {
+	body := new(methods.PbmQueryAssociatedProfilesBody)
+	body.Res = new(types.PbmQueryAssociatedProfilesResponse)
+
+	for _, ref := range req.Entities {
+		res := types.PbmQueryProfileResult{Object: ref}
+
+		id, _, fault := entity(ctx, ref)
+		if fault != nil {
+			res.Fault = &vim.LocalizedMethodFault{Fault: fault, LocalizedMessage: ref.Key}
+		} else if id != "" {
+			res.ProfileId = []types.PbmProfileId{{UniqueId: id}}
+		}
+
+		body.Res.Returnval = append(body.Res.Returnval, res)
+	}
+
+	return body
+}
+
+func (m *ProfileManager) PbmQueryAssociatedEntity(ctx *simulator.Context, req *types.PbmQueryAssociatedEntity) soap.HasFault {
+	body := new(methods.PbmQueryAssociatedEntityBody)
+	body.Res = new(types.PbmQueryAssociatedEntityResponse)
+
+	for _, a := range associations(ctx) {
+		if a.profile != req.Profile.UniqueId {
+			continue
+		}
+		if req.EntityType != "" && req.EntityType != a.entity.ObjectType {
+			continue
+		}
+		body.Res.Returnval = append(body.Res.Returnval, a.entity)
+	}
+
+	return body
+}
+
+func (m *ProfileManager) PbmQueryAssociatedEntities(ctx *simulator.Context, req *types.PbmQueryAssociatedEntities) soap.HasFault {
+	body := new(methods.PbmQueryAssociatedEntitiesBody)
+	body.Res = new(types.PbmQueryAssociatedEntitiesResponse)
+
+	for _, a := range associations(ctx) {
+		for _, id := range req.Profiles {
+			if a.profile == id.UniqueId {
+				body.Res.Returnval = append(body.Res.Returnval, types.PbmQueryProfileResult{
+					Object:    a.entity,
+					ProfileId: []types.PbmProfileId{id},
+				})
+			}
+		}
+	}
 
 	return body
 }
@@ -213,25 +282,119 @@ type PlacementSolver struct {
 	vim.ManagedObjectReference
 }
 
-func (m *PlacementSolver) PbmCheckRequirements(req *types.PbmCheckRequirements) soap.HasFault {
-	body := new(methods.PbmCheckRequirementsBody)
-	body.Res = new(types.PbmCheckRequirementsResponse)
+// profile returns the capability profile with the given ID, or nil if not found.
+func profile(id string) *types.PbmCapabilityProfile {
+	for _, p := range profiles {
+		if p, ok := p.(*types.PbmCapabilityProfile); ok && p.ProfileId.UniqueId == id {
+			return p
+		}
+	}
+	return nil
+}
 
-	for _, ds := range simulator.Map.All("Datastore") {
-		// TODO: filter
-		ref := ds.Reference()
-		body.Res.Returnval = append(body.Res.Returnval, types.PbmPlacementCompatibilityResult{
-			Hub: types.PbmPlacementHub{
+// datastores returns the datastores for the given hubs, or all datastores if no hubs are given.
+func datastores(ctx *simulator.Context, hubs []types.PbmPlacementHub) ([]types.PbmPlacementHub, []*simulator.Datastore, vim.BaseMethodFault) {
+	vimMap := ctx.VimMap()
+
+	if len(hubs) == 0 {
+		for _, ds := range vimMap.All("Datastore") {
+			ref := ds.Reference()
+			hubs = append(hubs, types.PbmPlacementHub{
 				HubType: ref.Type,
 				HubId:   ref.Value,
-			},
-			MatchingResources: nil,
-			HowMany:           0,
-			Utilization:       nil,
-			Warning:           nil,
-			Error:             nil,
-		})
+			})
+		}
 	}
 
+	var list []*simulator.Datastore
+	var invalid []types.PbmPlacementHub
+
+	for _, hub := range hubs {
+		ds, ok := vimMap.Get(vim.ManagedObjectReference{Type: hub.HubType, Value: hub.HubId}).(*simulator.Datastore)
+		if !ok {
+			invalid = append(invalid, hub)
+			continue
+		}
+		list = append(list, ds)
+	}
+
+	if len(invalid) != 0 {
+		return nil, nil, &types.PbmNonExistentHubs{Hubs: invalid}
+	}
+
+	return hubs, list, nil
+}
+
+// checkPlacement evaluates the requirements against each of the given hubs.
+// Hubs that do not satisfy all requirements have a fault for each capability not satisfied.
+func checkPlacement(ctx *simulator.Context, hubs []types.PbmPlacementHub, reqs []requirement) ([]types.PbmPlacementCompatibilityResult, vim.BaseMethodFault) {
+	hubs, list, fault := datastores(ctx, hubs)
+	if fault != nil {
+		return nil, fault
+	}
+
+	var res []types.PbmPlacementCompatibilityResult
+
+	for i, ds := range list {
+		c := datastoreCapabilities(ctx, ds)
+		result := types.PbmPlacementCompatibilityResult{Hub: hubs[i]}
+
+		for _, r := range reqs {
+			result.Error = append(result.Error, r.check(hubs[i], ds, c)...)
+		}
+
+		res = append(res, result)
+	}
+
+	return res, nil
+}
+
+func (m *PlacementSolver) PbmCheckRequirements(ctx *simulator.Context, req *types.PbmCheckRequirements) soap.HasFault {
+	body := new(methods.PbmCheckRequirementsBody)
+
+	var reqs []requirement
+
+	for _, r := range req.PlacementSubjectRequirement {
+		switch r := r.(type) {
+		case *types.PbmPlacementCapabilityProfileRequirement:
+			p := profile(r.ProfileId.UniqueId)
+			if p == nil {
+				body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "profileId"})
+				return body
+			}
+			reqs = append(reqs, profileRequirement(p))
+		case *types.PbmPlacementCapabilityConstraintsRequirement:
+			reqs = append(reqs, requirement{constraints: r.Constraints})
+		}
+	}
+
+	res, fault := checkPlacement(ctx, req.HubsToSearch, reqs)
+	if fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.PbmCheckRequirementsResponse{Returnval: res}
+
+	return body
+}
+
+func (m *PlacementSolver) PbmCheckCompatibility(ctx *simulator.Context, req *types.PbmCheckCompatibility) soap.HasFault {
+	body := new(methods.PbmCheckCompatibilityBody)
+
+	p := profile(req.Profile.UniqueId)
+	if p == nil {
+		body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "profile"})
+		return body
+	}
+
+	res, fault := checkPlacement(ctx, req.HubsToSearch, []requirement{profileRequirement(p)})
+	if fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	body.Res = &types.PbmCheckCompatibilityResponse{Returnval: res}
+
 	return body
 }
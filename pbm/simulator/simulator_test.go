@@ -18,19 +18,27 @@ package simulator
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"reflect"
 	"sort"
 	"testing"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/pbm"
+	"github.com/vmware/govmomi/pbm/methods"
 	"github.com/vmware/govmomi/pbm/types"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vapi/tags"
 	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	vim "github.com/vmware/govmomi/vim25/types"
+
+	_ "github.com/vmware/govmomi/vapi/simulator"
 )
 
 // TestSimulator is a copy of pbm/client_test.go:ClientTest
@@ -313,3 +321,316 @@ func TestSimulator(t *testing.T) {
 	}
 	t.Logf("Profile: %+v successfully deleted", []types.PbmProfileId{*vsanProfileID, *vsansiocProfileID})
 }
+
+func TestPlacementCompliance(t *testing.T) {
+	model := simulator.VPX()
+	model.Datastore = 2
+
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		pc, err := pbm.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		finder := find.NewFinder(c)
+		vsan, err := finder.Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tagged, err := finder.Datastore(ctx, "LocalDS_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		simulator.Map.Get(vsan.Reference()).(*simulator.Datastore).Summary.Type = string(vim.HostFileSystemVolumeFileSystemTypeVsan)
+
+		rc := rest.NewClient(c)
+		if err = rc.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+		tm := tags.NewManager(rc)
+		category, err := tm.CreateCategory(ctx, &tags.Category{Name: "tier"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		gold, err := tm.CreateTag(ctx, &tags.Tag{CategoryID: category, Name: "gold"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = tm.AttachTag(ctx, gold, tagged); err != nil {
+			t.Fatal(err)
+		}
+
+		rtype := types.PbmProfileResourceType{
+			ResourceType: string(types.PbmProfileResourceTypeEnumSTORAGE),
+		}
+
+		tagRule := types.PbmCapabilityInstance{
+			Id: types.PbmCapabilityMetadataUniqueId{
+				Namespace: tagNamespace,
+				Id:        "tier",
+			},
+			Constraint: []types.PbmCapabilityConstraintInstance{{
+				PropertyInstance: []types.PbmCapabilityPropertyInstance{{
+					Id:    tagProperty("tier"),
+					Value: types.PbmCapabilityDiscreteSet{Values: []vim.AnyType{"silver", "gold"}},
+				}},
+			}},
+		}
+
+		tagPolicy, err := pc.CreateProfile(ctx, types.PbmCapabilityProfileCreateSpec{
+			Name:         "gold",
+			Category:     string(types.PbmProfileCategoryEnumREQUIREMENT),
+			ResourceType: rtype,
+			Constraints: &types.PbmCapabilitySubProfileConstraints{
+				SubProfiles: []types.PbmCapabilitySubProfile{{
+					Name:       "Tag based placement",
+					Capability: []types.PbmCapabilityInstance{tagRule},
+				}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec, err := pbm.CreateCapabilityProfileSpec(pbm.CapabilityProfileCreateSpec{
+			Name:     "FTT-2",
+			Category: string(types.PbmProfileCategoryEnumREQUIREMENT),
+			CapabilityList: []pbm.Capability{{
+				ID:        "hostFailuresToTolerate",
+				Namespace: "VSAN",
+				PropertyList: []pbm.Property{{
+					ID:       "hostFailuresToTolerate",
+					Value:    "2",
+					DataType: "int",
+				}},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ftt2, err := pc.CreateProfile(ctx, *spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		compatible := func(res pbm.PlacementCompatibilityResult) []string {
+			var ids []string
+			for _, hub := range res.CompatibleDatastores() {
+				ids = append(ids, hub.HubId)
+			}
+			sort.Strings(ids)
+			return ids
+		}
+
+		vsanDefault := types.PbmProfileId{UniqueId: "aa6d5a82-1c88-45da-85d3-3d74b91a5bad"}
+		vvolDefault := types.PbmProfileId{UniqueId: "f4e5bade-15a2-4805-bf8e-52318c4ce443"}
+		encryption := types.PbmProfileId{UniqueId: "4d5f673c-536f-11e6-beb8-9e71128cae77"}
+		pmemDefault := types.PbmProfileId{UniqueId: "c268da1b-b343-49f7-a468-b1deeb7078e0"}
+
+		tests := []struct {
+			name     string
+			req      types.BasePbmPlacementRequirement
+			expected []string
+		}{
+			{"vSAN default", &types.PbmPlacementCapabilityProfileRequirement{ProfileId: vsanDefault}, []string{vsan.Reference().Value}},
+			{"VVol default", &types.PbmPlacementCapabilityProfileRequirement{ProfileId: vvolDefault}, nil},
+			{"PMem default", &types.PbmPlacementCapabilityProfileRequirement{ProfileId: pmemDefault}, nil},
+			{"encryption", &types.PbmPlacementCapabilityProfileRequirement{ProfileId: encryption}, []string{vsan.Reference().Value, tagged.Reference().Value}},
+			{"FTT=2", &types.PbmPlacementCapabilityProfileRequirement{ProfileId: *ftt2}, nil},
+			{"tag", &types.PbmPlacementCapabilityProfileRequirement{ProfileId: *tagPolicy}, []string{tagged.Reference().Value}},
+		}
+
+		for _, test := range tests {
+			res, err := pc.CheckRequirements(ctx, nil, nil, []types.BasePbmPlacementRequirement{test.req})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != 2 {
+				t.Errorf("%s: %d results", test.name, len(res))
+			}
+			ids := compatible(res)
+			if !reflect.DeepEqual(ids, test.expected) {
+				t.Errorf("%s: compatible=%v, expected %v", test.name, ids, test.expected)
+			}
+		}
+
+		// rules with the NOT operator select datastores that do not satisfy the constraint
+		notRule := tagRule
+		notRule.Constraint = []types.PbmCapabilityConstraintInstance{{
+			PropertyInstance: []types.PbmCapabilityPropertyInstance{{
+				Id:       tagProperty("tier"),
+				Operator: string(types.PbmCapabilityOperatorNOT),
+				Value:    types.PbmCapabilityDiscreteSet{Values: []vim.AnyType{"gold"}},
+			}},
+		}}
+		res, err := pc.CheckRequirements(ctx, nil, nil, []types.BasePbmPlacementRequirement{
+			&types.PbmPlacementCapabilityConstraintsRequirement{
+				Constraints: &types.PbmCapabilitySubProfileConstraints{
+					SubProfiles: []types.PbmCapabilitySubProfile{{
+						Name:       "Tag based placement",
+						Capability: []types.PbmCapabilityInstance{notRule},
+					}},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := compatible(res); len(ids) != 1 || ids[0] != vsan.Reference().Value {
+			t.Errorf("NOT: compatible=%v", ids)
+		}
+
+		// incompatible hubs report the capability not satisfied
+		hubs := []types.PbmPlacementHub{{HubType: "Datastore", HubId: vsan.Reference().Value}}
+		res, err = pc.CheckRequirements(ctx, hubs, nil, []types.BasePbmPlacementRequirement{
+			&types.PbmPlacementCapabilityProfileRequirement{ProfileId: *ftt2},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) != 1 || len(res[0].Error) != 1 {
+			t.Fatalf("FTT=2: %#v", res)
+		}
+		fault, ok := res[0].Error[0].Fault.(*types.PbmPropertyMismatchFault)
+		if !ok {
+			t.Fatalf("fault=%T", res[0].Error[0].Fault)
+		}
+		if fault.CapabilityInstanceId.Id != "hostFailuresToTolerate" {
+			t.Errorf("fault=%#v", fault.CapabilityInstanceId)
+		}
+
+		hubs = append(hubs, types.PbmPlacementHub{HubType: "Datastore", HubId: "enoent"})
+		_, err = pc.CheckRequirements(ctx, hubs, nil, nil)
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		compat, err := methods.PbmCheckCompatibility(ctx, pc, &types.PbmCheckCompatibility{
+			This:    pc.ServiceContent.PlacementSolver,
+			Profile: *tagPolicy,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := compatible(compat.Returnval); len(ids) != 1 || ids[0] != tagged.Reference().Value {
+			t.Errorf("CheckCompatibility: compatible=%v", ids)
+		}
+
+		// associate the VM home with the vSAN default policy and its disk with the tag based policy
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		disk := devices.SelectByType((*vim.VirtualDisk)(nil))[0]
+
+		task, err := vm.Reconfigure(ctx, vim.VirtualMachineConfigSpec{
+			VmProfile: []vim.BaseVirtualMachineProfileSpec{
+				&vim.VirtualMachineDefinedProfileSpec{ProfileId: vsanDefault.UniqueId},
+			},
+			DeviceChange: []vim.BaseVirtualDeviceConfigSpec{
+				&vim.VirtualDeviceConfigSpec{
+					Operation: vim.VirtualDeviceConfigSpecOperationEdit,
+					Device:    disk,
+					Profile: []vim.BaseVirtualMachineProfileSpec{
+						&vim.VirtualMachineDefinedProfileSpec{ProfileId: tagPolicy.UniqueId},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		vmRef := types.PbmServerObjectRef{
+			ObjectType: string(types.PbmObjectTypeVirtualMachine),
+			Key:        vm.Reference().Value,
+		}
+		diskRef := types.PbmServerObjectRef{
+			ObjectType: string(types.PbmObjectTypeVirtualDiskId),
+			Key:        fmt.Sprintf("%s:%d", vm.Reference().Value, disk.GetVirtualDevice().Key),
+		}
+
+		entities, err := pc.QueryAssociatedEntity(ctx, *tagPolicy, string(types.PbmObjectTypeVirtualDiskId))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entities) != 1 || entities[0].Key != diskRef.Key {
+			t.Errorf("entities=%#v", entities)
+		}
+
+		status := func() []string {
+			res, err := pc.FetchComplianceResult(ctx, []types.PbmServerObjectRef{vmRef, diskRef})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var s []string
+			for _, r := range res {
+				s = append(s, r.ComplianceStatus)
+				if r.ComplianceStatus == string(types.PbmComplianceStatusNonCompliant) {
+					if len(r.ViolatedPolicies) != 1 || r.ViolatedPolicies[0].ExpectedValue.Id.Id != "tier" {
+						t.Errorf("violated=%#v", r.ViolatedPolicies)
+					}
+				}
+			}
+			return s
+		}
+
+		// the disk lives on the vSAN datastore, which is not tagged
+		expect := []string{string(types.PbmComplianceStatusCompliant), string(types.PbmComplianceStatusNonCompliant)}
+		if s := status(); !reflect.DeepEqual(s, expect) {
+			t.Errorf("compliance=%v", s)
+		}
+
+		if err = tm.AttachTag(ctx, gold, vsan); err != nil {
+			t.Fatal(err)
+		}
+
+		expect[1] = string(types.PbmComplianceStatusCompliant)
+		if s := status(); !reflect.DeepEqual(s, expect) {
+			t.Errorf("compliance=%v", s)
+		}
+
+		missing, err := pc.FetchComplianceResult(ctx, []types.PbmServerObjectRef{{
+			ObjectType: string(types.PbmObjectTypeVirtualMachine),
+			Key:        "enoent",
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(missing[0].ErrorCause) != 1 {
+			t.Errorf("compliance=%#v", missing[0])
+		}
+
+		// editing the disk without a profile drops the association
+		task, err = vm.Reconfigure(ctx, vim.VirtualMachineConfigSpec{
+			DeviceChange: []vim.BaseVirtualDeviceConfigSpec{
+				&vim.VirtualDeviceConfigSpec{
+					Operation: vim.VirtualDeviceConfigSpecOperationEdit,
+					Device:    disk,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		entities, err = pc.QueryAssociatedEntity(ctx, *tagPolicy, string(types.PbmObjectTypeVirtualDiskId))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entities) != 0 {
+			t.Errorf("entities=%#v", entities)
+		}
+	}, model)
+}
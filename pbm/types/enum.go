@@ -30,6 +30,15 @@ const (
 	PbmAssociateAndApplyPolicyStatusPolicyStatusInvalid = PbmAssociateAndApplyPolicyStatusPolicyStatus("invalid")
 )
 
+// Values returns all known values of the PbmAssociateAndApplyPolicyStatusPolicyStatus enum
+func (e PbmAssociateAndApplyPolicyStatusPolicyStatus) Values() []PbmAssociateAndApplyPolicyStatusPolicyStatus {
+	return []PbmAssociateAndApplyPolicyStatusPolicyStatus{
+		PbmAssociateAndApplyPolicyStatusPolicyStatusSuccess,
+		PbmAssociateAndApplyPolicyStatusPolicyStatusFailed,
+		PbmAssociateAndApplyPolicyStatusPolicyStatusInvalid,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmAssociateAndApplyPolicyStatusPolicyStatus", reflect.TypeOf((*PbmAssociateAndApplyPolicyStatusPolicyStatus)(nil)).Elem())
 }
@@ -41,6 +50,14 @@ const (
 	PbmBuiltinGenericTypeVMW_SET   = PbmBuiltinGenericType("VMW_SET")
 )
 
+// Values returns all known values of the PbmBuiltinGenericType enum
+func (e PbmBuiltinGenericType) Values() []PbmBuiltinGenericType {
+	return []PbmBuiltinGenericType{
+		PbmBuiltinGenericTypeVMW_RANGE,
+		PbmBuiltinGenericTypeVMW_SET,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmBuiltinGenericType", reflect.TypeOf((*PbmBuiltinGenericType)(nil)).Elem())
 }
@@ -60,6 +77,22 @@ const (
 	PbmBuiltinTypeVMW_POLICY   = PbmBuiltinType("VMW_POLICY")
 )
 
+// Values returns all known values of the PbmBuiltinType enum
+func (e PbmBuiltinType) Values() []PbmBuiltinType {
+	return []PbmBuiltinType{
+		PbmBuiltinTypeXSD_LONG,
+		PbmBuiltinTypeXSD_SHORT,
+		PbmBuiltinTypeXSD_INTEGER,
+		PbmBuiltinTypeXSD_INT,
+		PbmBuiltinTypeXSD_STRING,
+		PbmBuiltinTypeXSD_BOOLEAN,
+		PbmBuiltinTypeXSD_DOUBLE,
+		PbmBuiltinTypeXSD_DATETIME,
+		PbmBuiltinTypeVMW_TIMESPAN,
+		PbmBuiltinTypeVMW_POLICY,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmBuiltinType", reflect.TypeOf((*PbmBuiltinType)(nil)).Elem())
 }
@@ -70,6 +103,13 @@ const (
 	PbmCapabilityOperatorNOT = PbmCapabilityOperator("NOT")
 )
 
+// Values returns all known values of the PbmCapabilityOperator enum
+func (e PbmCapabilityOperator) Values() []PbmCapabilityOperator {
+	return []PbmCapabilityOperator{
+		PbmCapabilityOperatorNOT,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmCapabilityOperator", reflect.TypeOf((*PbmCapabilityOperator)(nil)).Elem())
 }
@@ -86,6 +126,19 @@ const (
 	PbmCapabilityTimeUnitTypeYEARS   = PbmCapabilityTimeUnitType("YEARS")
 )
 
+// Values returns all known values of the PbmCapabilityTimeUnitType enum
+func (e PbmCapabilityTimeUnitType) Values() []PbmCapabilityTimeUnitType {
+	return []PbmCapabilityTimeUnitType{
+		PbmCapabilityTimeUnitTypeSECONDS,
+		PbmCapabilityTimeUnitTypeMINUTES,
+		PbmCapabilityTimeUnitTypeHOURS,
+		PbmCapabilityTimeUnitTypeDAYS,
+		PbmCapabilityTimeUnitTypeWEEKS,
+		PbmCapabilityTimeUnitTypeMONTHS,
+		PbmCapabilityTimeUnitTypeYEARS,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmCapabilityTimeUnitType", reflect.TypeOf((*PbmCapabilityTimeUnitType)(nil)).Elem())
 }
@@ -98,6 +151,15 @@ const (
 	PbmComplianceResultComplianceTaskStatusFailed     = PbmComplianceResultComplianceTaskStatus("failed")
 )
 
+// Values returns all known values of the PbmComplianceResultComplianceTaskStatus enum
+func (e PbmComplianceResultComplianceTaskStatus) Values() []PbmComplianceResultComplianceTaskStatus {
+	return []PbmComplianceResultComplianceTaskStatus{
+		PbmComplianceResultComplianceTaskStatusInProgress,
+		PbmComplianceResultComplianceTaskStatusSuccess,
+		PbmComplianceResultComplianceTaskStatusFailed,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmComplianceResultComplianceTaskStatus", reflect.TypeOf((*PbmComplianceResultComplianceTaskStatus)(nil)).Elem())
 }
@@ -112,6 +174,17 @@ const (
 	PbmComplianceStatusOutOfDate     = PbmComplianceStatus("outOfDate")
 )
 
+// Values returns all known values of the PbmComplianceStatus enum
+func (e PbmComplianceStatus) Values() []PbmComplianceStatus {
+	return []PbmComplianceStatus{
+		PbmComplianceStatusCompliant,
+		PbmComplianceStatusNonCompliant,
+		PbmComplianceStatusUnknown,
+		PbmComplianceStatusNotApplicable,
+		PbmComplianceStatusOutOfDate,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmComplianceStatus", reflect.TypeOf((*PbmComplianceStatus)(nil)).Elem())
 }
@@ -125,6 +198,16 @@ const (
 	PbmHealthStatusForEntityUnknown = PbmHealthStatusForEntity("unknown")
 )
 
+// Values returns all known values of the PbmHealthStatusForEntity enum
+func (e PbmHealthStatusForEntity) Values() []PbmHealthStatusForEntity {
+	return []PbmHealthStatusForEntity{
+		PbmHealthStatusForEntityRed,
+		PbmHealthStatusForEntityYellow,
+		PbmHealthStatusForEntityGreen,
+		PbmHealthStatusForEntityUnknown,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmHealthStatusForEntity", reflect.TypeOf((*PbmHealthStatusForEntity)(nil)).Elem())
 }
@@ -141,6 +224,19 @@ const (
 	PbmIofilterInfoFilterTypeDATASTOREIOCONTROL = PbmIofilterInfoFilterType("DATASTOREIOCONTROL")
 )
 
+// Values returns all known values of the PbmIofilterInfoFilterType enum
+func (e PbmIofilterInfoFilterType) Values() []PbmIofilterInfoFilterType {
+	return []PbmIofilterInfoFilterType{
+		PbmIofilterInfoFilterTypeINSPECTION,
+		PbmIofilterInfoFilterTypeCOMPRESSION,
+		PbmIofilterInfoFilterTypeENCRYPTION,
+		PbmIofilterInfoFilterTypeREPLICATION,
+		PbmIofilterInfoFilterTypeCACHE,
+		PbmIofilterInfoFilterTypeDATAPROVIDER,
+		PbmIofilterInfoFilterTypeDATASTOREIOCONTROL,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmIofilterInfoFilterType", reflect.TypeOf((*PbmIofilterInfoFilterType)(nil)).Elem())
 }
@@ -159,6 +255,21 @@ const (
 	PbmLineOfServiceInfoLineOfServiceEnumDATA_PROTECTION      = PbmLineOfServiceInfoLineOfServiceEnum("DATA_PROTECTION")
 )
 
+// Values returns all known values of the PbmLineOfServiceInfoLineOfServiceEnum enum
+func (e PbmLineOfServiceInfoLineOfServiceEnum) Values() []PbmLineOfServiceInfoLineOfServiceEnum {
+	return []PbmLineOfServiceInfoLineOfServiceEnum{
+		PbmLineOfServiceInfoLineOfServiceEnumINSPECTION,
+		PbmLineOfServiceInfoLineOfServiceEnumCOMPRESSION,
+		PbmLineOfServiceInfoLineOfServiceEnumENCRYPTION,
+		PbmLineOfServiceInfoLineOfServiceEnumREPLICATION,
+		PbmLineOfServiceInfoLineOfServiceEnumCACHING,
+		PbmLineOfServiceInfoLineOfServiceEnumPERSISTENCE,
+		PbmLineOfServiceInfoLineOfServiceEnumDATA_PROVIDER,
+		PbmLineOfServiceInfoLineOfServiceEnumDATASTORE_IO_CONTROL,
+		PbmLineOfServiceInfoLineOfServiceEnumDATA_PROTECTION,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmLineOfServiceInfoLineOfServiceEnum", reflect.TypeOf((*PbmLineOfServiceInfoLineOfServiceEnum)(nil)).Elem())
 }
@@ -176,6 +287,20 @@ const (
 	PbmObjectTypeUnknown                = PbmObjectType("unknown")
 )
 
+// Values returns all known values of the PbmObjectType enum
+func (e PbmObjectType) Values() []PbmObjectType {
+	return []PbmObjectType{
+		PbmObjectTypeVirtualMachine,
+		PbmObjectTypeVirtualMachineAndDisks,
+		PbmObjectTypeVirtualDiskId,
+		PbmObjectTypeVirtualDiskUUID,
+		PbmObjectTypeDatastore,
+		PbmObjectTypeVsanObjectId,
+		PbmObjectTypeFileShareId,
+		PbmObjectTypeUnknown,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmObjectType", reflect.TypeOf((*PbmObjectType)(nil)).Elem())
 }
@@ -190,6 +315,17 @@ const (
 	PbmOperationCLONE       = PbmOperation("CLONE")
 )
 
+// Values returns all known values of the PbmOperation enum
+func (e PbmOperation) Values() []PbmOperation {
+	return []PbmOperation{
+		PbmOperationCREATE,
+		PbmOperationREGISTER,
+		PbmOperationRECONFIGURE,
+		PbmOperationMIGRATE,
+		PbmOperationCLONE,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmOperation", reflect.TypeOf((*PbmOperation)(nil)).Elem())
 }
@@ -202,6 +338,15 @@ const (
 	PbmProfileCategoryEnumDATA_SERVICE_POLICY = PbmProfileCategoryEnum("DATA_SERVICE_POLICY")
 )
 
+// Values returns all known values of the PbmProfileCategoryEnum enum
+func (e PbmProfileCategoryEnum) Values() []PbmProfileCategoryEnum {
+	return []PbmProfileCategoryEnum{
+		PbmProfileCategoryEnumREQUIREMENT,
+		PbmProfileCategoryEnumRESOURCE,
+		PbmProfileCategoryEnumDATA_SERVICE_POLICY,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmProfileCategoryEnum", reflect.TypeOf((*PbmProfileCategoryEnum)(nil)).Elem())
 }
@@ -212,6 +357,13 @@ const (
 	PbmProfileResourceTypeEnumSTORAGE = PbmProfileResourceTypeEnum("STORAGE")
 )
 
+// Values returns all known values of the PbmProfileResourceTypeEnum enum
+func (e PbmProfileResourceTypeEnum) Values() []PbmProfileResourceTypeEnum {
+	return []PbmProfileResourceTypeEnum{
+		PbmProfileResourceTypeEnumSTORAGE,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmProfileResourceTypeEnum", reflect.TypeOf((*PbmProfileResourceTypeEnum)(nil)).Elem())
 }
@@ -224,6 +376,15 @@ const (
 	PbmSystemCreatedProfileTypePmemDefaultProfile = PbmSystemCreatedProfileType("PmemDefaultProfile")
 )
 
+// Values returns all known values of the PbmSystemCreatedProfileType enum
+func (e PbmSystemCreatedProfileType) Values() []PbmSystemCreatedProfileType {
+	return []PbmSystemCreatedProfileType{
+		PbmSystemCreatedProfileTypeVsanDefaultProfile,
+		PbmSystemCreatedProfileTypeVVolDefaultProfile,
+		PbmSystemCreatedProfileTypePmemDefaultProfile,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmSystemCreatedProfileType", reflect.TypeOf((*PbmSystemCreatedProfileType)(nil)).Elem())
 }
@@ -237,6 +398,16 @@ const (
 	PbmVmOperationCLONE       = PbmVmOperation("CLONE")
 )
 
+// Values returns all known values of the PbmVmOperation enum
+func (e PbmVmOperation) Values() []PbmVmOperation {
+	return []PbmVmOperation{
+		PbmVmOperationCREATE,
+		PbmVmOperationRECONFIGURE,
+		PbmVmOperationMIGRATE,
+		PbmVmOperationCLONE,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmVmOperation", reflect.TypeOf((*PbmVmOperation)(nil)).Elem())
 }
@@ -249,6 +420,15 @@ const (
 	PbmVvolTypeSwap   = PbmVvolType("Swap")
 )
 
+// Values returns all known values of the PbmVvolType enum
+func (e PbmVvolType) Values() []PbmVvolType {
+	return []PbmVvolType{
+		PbmVvolTypeConfig,
+		PbmVvolTypeData,
+		PbmVvolTypeSwap,
+	}
+}
+
 func init() {
 	types.Add("pbm:PbmVvolType", reflect.TypeOf((*PbmVvolType)(nil)).Elem())
 }
@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+//go:generate go run api_version_gen.go
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25"
+)
+
+// apiVersions are the released vim25 API versions, in order.
+// ServiceVersions lists those prior to the About.ApiVersion of a Service as its priorVersions.
+var apiVersions = []string{
+	"2.5", "4.0", "4.1", "5.0", "5.1", "5.5",
+	"6.0", "6.5", "6.7", "6.7.1", "6.7.2", "6.7.3",
+	"7.0", "7.0.1.0", "7.0.2.0", "7.0.3.0",
+	"8.0.0.0",
+}
+
+// unreleasedVersion is newer than any released API version, see unreleasedMethods.
+const unreleasedVersion = "unreleased"
+
+// methodVersions maps methods to the API version they were introduced in.
+// Methods not listed here are available in all versions.
+var methodVersions = map[string]string{
+	"CreateDisk_Task":                     "6.5",
+	"RegisterDisk":                        "6.5",
+	"ExtendDisk_Task":                     "6.5",
+	"InflateDisk_Task":                    "6.5",
+	"RenameVStorageObject":                "6.5",
+	"DeleteVStorageObject_Task":           "6.5",
+	"RetrieveVStorageObject":              "6.5",
+	"RetrieveVStorageObjectState":         "6.5",
+	"ListVStorageObject":                  "6.5",
+	"CloneVStorageObject_Task":            "6.5",
+	"RelocateVStorageObject_Task":         "6.5",
+	"SetVStorageObjectControlFlags":       "6.5",
+	"ClearVStorageObjectControlFlags":     "6.5",
+	"ReconcileDatastoreInventory_Task":    "6.5",
+	"ScheduleReconcileDatastoreInventory": "6.5",
+	"AttachDisk_Task":                     "6.5",
+	"DetachDisk_Task":                     "6.5",
+	"AddKey":                              "6.5",
+	"AddKeys":                             "6.5",
+	"RemoveKey":                           "6.5",
+	"RemoveKeys":                          "6.5",
+	"ListKeys":                            "6.5",
+	"InstantClone_Task":                   "6.7",
+	"AttachTagToVStorageObject":           "6.7",
+	"DetachTagFromVStorageObject":         "6.7",
+	"ListTagsAttachedToVStorageObject":    "6.7",
+	"ListVStorageObjectsAttachedToTag":    "6.7",
+	"UpdateVStorageObjectPolicy_Task":     "6.7",
+	"VStorageObjectCreateSnapshot_Task":   "6.7",
+	"DeleteSnapshot_Task":                 "6.7",
+	"RetrieveSnapshotInfo":                "6.7",
+	"RevertVStorageObject_Task":           "6.7",
+	"CreateDiskFromSnapshot_Task":         "6.7",
+	"ConfigureHCI_Task":                   "6.7.1",
+	"ExtendHCI_Task":                      "6.7.1",
+	"ValidateHCIConfiguration":            "6.7.1",
+	"AbandonHciWorkflow":                  "6.7.1",
+	"MarkServiceProviderEntities":         "6.9.1",
+	"UnmarkServiceProviderEntities":       "6.9.1",
+	"RetrieveServiceProviderEntities":     "6.9.1",
+}
+
+// propertyVersions maps managed object and data object properties to the API version they were introduced in.
+// Properties not listed here are available in all versions.
+var propertyVersions = map[string]string{
+	"ClusterComputeResource.hciConfig":           "6.7.1",
+	"ServiceContent.ioFilterManager":             "6.0",
+	"ServiceContent.overheadMemoryManager":       "6.0",
+	"ServiceContent.certificateManager":          "6.0",
+	"ServiceContent.vStorageObjectManager":       "6.5",
+	"ServiceContent.hostSpecManager":             "6.5",
+	"ServiceContent.cryptoManager":               "6.5",
+	"ServiceContent.healthUpdateManager":         "6.5",
+	"ServiceContent.failoverClusterConfigurator": "6.5",
+	"ServiceContent.failoverClusterManager":      "6.5",
+	"ServiceContent.tenantManager":               "6.9.1",
+}
+
+// typeVersions indexes propertyVersions by type name
+var typeVersions = map[string]map[string]apiVersion{}
+
+func init() {
+	for _, method := range unreleasedMethods {
+		methodVersions[method] = unreleasedVersion
+	}
+
+	for key, version := range propertyVersions {
+		p := strings.SplitN(key, ".", 2)
+		if typeVersions[p[0]] == nil {
+			typeVersions[p[0]] = make(map[string]apiVersion)
+		}
+		typeVersions[p[0]][p[1]] = parseVersion(version)
+	}
+}
+
+// apiVersion is a parsed API version, such as "6.7.3" or "7.0.1.0".
+// A nil apiVersion, parsed from an internal or unknown version string, is newer than any other version.
+type apiVersion []int
+
+func parseVersion(s string) apiVersion {
+	var v apiVersion
+
+	for _, p := range strings.Split(s, ".") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return nil
+		}
+		v = append(v, n)
+	}
+
+	return v
+}
+
+// less returns true if version v is older than version o
+func (v apiVersion) less(o apiVersion) bool {
+	if v == nil {
+		return false
+	}
+	if o == nil {
+		return true
+	}
+
+	for i := 0; i < len(v) || i < len(o); i++ {
+		var x, y int
+		if i < len(v) {
+			x = v[i]
+		}
+		if i < len(o) {
+			y = o[i]
+		}
+		if x != y {
+			return x < y
+		}
+	}
+
+	return false
+}
+
+// priorVersions returns the released API versions older than the given version, newest first
+func priorVersions(version string) []string {
+	var prior []string
+	v := parseVersion(version)
+
+	for i := len(apiVersions) - 1; i >= 0; i-- {
+		if parseVersion(apiVersions[i]).less(v) {
+			prior = append(prior, apiVersions[i])
+		}
+	}
+
+	return prior
+}
+
+// apiVersion returns the API version negotiated with the client, which is the version of the request's SOAPAction header.
+// The Service About.ApiVersion is used if the request has no SOAPAction version.
+func (c *Context) apiVersion() apiVersion {
+	if c.req != nil {
+		action := strings.Trim(c.req.Header.Get("SOAPAction"), `"`)
+		if i := strings.LastIndex(action, "/"); i > 0 && action[:i] == "urn:"+vim25.Namespace {
+			return parseVersion(action[i+1:])
+		}
+	}
+
+	return parseVersion(c.VimMap().content().About.ApiVersion)
+}
+
+// supports returns false if the given method was introduced after the negotiated API version
+func (c *Context) supports(method string) bool {
+	if c.Map.Namespace != vim25.Namespace {
+		return true
+	}
+
+	version, ok := methodVersions[method]
+	if !ok {
+		return true
+	}
+
+	return !c.apiVersion().less(parseVersion(version))
+}
+
+// unset returns true if the given property of kind was introduced after the negotiated API version
+func (c *Context) unset(kind, name string) bool {
+	props, ok := typeVersions[kind]
+	if !ok {
+		return false
+	}
+
+	version, ok := props[strings.SplitN(name, ".", 2)[0]]
+	if !ok {
+		return false
+	}
+
+	return c.apiVersion().less(version)
+}
+
+// versioned returns a copy of the data object val, with properties introduced after the negotiated API version unset
+func (c *Context) versioned(val interface{}) interface{} {
+	rval := reflect.ValueOf(val)
+	if rval.Kind() != reflect.Struct {
+		return val
+	}
+
+	props, ok := typeVersions[rval.Type().Name()]
+	if !ok {
+		return val
+	}
+
+	version := c.apiVersion()
+	obj := reflect.New(rval.Type()).Elem()
+	obj.Set(rval)
+
+	for name, since := range props {
+		if version.less(since) {
+			field := obj.FieldByName(ucFirst(name))
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+
+	return obj.Interface()
+}
@@ -0,0 +1,95 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This program generates api_version_unreleased.go from the vim25 methods of unreleased.go,
+// which are not part of a released WSDL.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"sort"
+)
+
+const header = `/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by api_version_gen.go; DO NOT EDIT.
+
+package simulator
+
+// unreleasedMethods are the methods of vim25/methods/unreleased.go,
+// which are only available to clients using an internal API version.
+var unreleasedMethods = []string{
+`
+
+func main() {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, "../vim25/methods/unreleased.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var names []string
+
+	// each method has a func of the same name, without a receiver
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && ast.IsExported(fn.Name.Name) {
+			names = append(names, fn.Name.Name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q,\n", name)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = ioutil.WriteFile("api_version_unreleased.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
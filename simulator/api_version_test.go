@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/simulator/vpx"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestAPIVersionLess(t *testing.T) {
+	tests := []struct {
+		a, b string
+		less bool
+	}{
+		{"6.5", "6.7", true},
+		{"6.7", "6.5", false},
+		{"6.7", "6.7", false},
+		{"6.7", "6.7.1", true},
+		{"7.0", "7.0.0.0", false},
+		{"7.0", "7.0.1.0", true},
+		{"8.0.0.0", "7.0.3.0", false},
+		{"7.0", "uE53DA", true},
+		{"uE53DA", "8.0.0.0", false},
+	}
+
+	for _, test := range tests {
+		less := parseVersion(test.a).less(parseVersion(test.b))
+		if less != test.less {
+			t.Errorf("%s < %s=%t", test.a, test.b, less)
+		}
+	}
+
+	prior := priorVersions("6.0")
+	if !reflect.DeepEqual(prior, []string{"5.5", "5.1", "5.0", "4.1", "4.0", "2.5"}) {
+		t.Errorf("prior=%v", prior)
+	}
+}
+
+func TestAPIVersion(t *testing.T) {
+	ctx := context.Background()
+
+	for _, version := range []string{"6.5", "6.7.3", "7.0"} {
+		m := VPX()
+		m.ServiceContent.About.ApiVersion = version
+
+		err := m.Create()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := m.Service.NewServer()
+
+		c, err := vim25.NewClient(ctx, soap.NewClient(s.URL, true))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = c.UseServiceVersion(); err != nil {
+			t.Fatal(err)
+		}
+		if c.Version != version {
+			t.Errorf("client version=%s", c.Version)
+		}
+
+		c.ServiceContent, err = methods.GetServiceContent(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// TenantManager was introduced in 6.9.1
+		if version == "7.0" {
+			if c.ServiceContent.TenantManager == nil {
+				t.Errorf("%s: expected TenantManager", version)
+			}
+		} else if c.ServiceContent.TenantManager != nil {
+			t.Errorf("%s: unexpected TenantManager", version)
+		}
+
+		if err = session.NewManager(c).Login(ctx, s.URL.User); err != nil {
+			t.Fatal(err)
+		}
+
+		// PlaceVmsXCluster is not part of a released version
+		_, err = object.NewRootFolder(c).PlaceVmsXCluster(ctx, types.PlaceVmsXClusterSpec{})
+		if _, ok := soap.ToSoapFault(err).VimFault().(types.MethodNotFound); !ok {
+			t.Errorf("%s: expected MethodNotFound, err=%v", version, err)
+		}
+
+		// MarkServiceProviderEntities was introduced in 6.9.1
+		_, err = methods.MarkServiceProviderEntities(ctx, c, &types.MarkServiceProviderEntities{
+			This: *vpx.ServiceContent.TenantManager,
+		})
+		ok := err != nil && soap.IsSoapFault(err)
+		if ok {
+			_, ok = soap.ToSoapFault(err).VimFault().(types.MethodNotFound)
+		}
+		if ok != (version != "7.0") {
+			t.Errorf("%s: err=%v", version, err)
+		}
+
+		// unreleased methods are available to clients using an internal version
+		c.Version = "uE53DA"
+		_, err = object.NewRootFolder(c).PlaceVmsXCluster(ctx, types.PlaceVmsXClusterSpec{})
+		if err != nil {
+			t.Errorf("%s: %s", version, err)
+		}
+
+		s.Close()
+		m.Remove()
+	}
+}
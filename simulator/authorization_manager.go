@@ -66,8 +66,8 @@ func (m *AuthorizationManager) init(r *Registry) {
 	}
 }
 
-func (m *AuthorizationManager) RetrieveEntityPermissions(req *types.RetrieveEntityPermissions) soap.HasFault {
-	e := Map.Get(req.Entity).(mo.Entity)
+func (m *AuthorizationManager) RetrieveEntityPermissions(ctx *Context, req *types.RetrieveEntityPermissions) soap.HasFault {
+	e := ctx.Map.Get(req.Entity).(mo.Entity)
 
 	p := m.permissions[e.Reference()]
 
@@ -78,7 +78,7 @@ func (m *AuthorizationManager) RetrieveEntityPermissions(req *types.RetrieveEnti
 				break
 			}
 
-			e = Map.Get(parent.Reference()).(mo.Entity)
+			e = ctx.Map.Get(parent.Reference()).(mo.Entity)
 
 			p = append(p, m.permissions[e.Reference()]...)
 		}
@@ -65,8 +65,8 @@ func (add *addHost) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		template.Network = cr.Network[:1] // VM Network
 	}
 
-	host := NewHostSystem(template)
-	host.configure(spec, add.req.AsConnected)
+	host := newHostSystem(task.ctx, template)
+	host.configure(task.ctx, spec, add.req.AsConnected)
 
 	task.ctx.Map.PutEntity(cr, task.ctx.Map.NewEntity(host))
 	host.Summary.Host = &host.Self
@@ -80,7 +80,7 @@ func (add *addHost) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 func (c *ClusterComputeResource) AddHostTask(ctx *Context, add *types.AddHost_Task) soap.HasFault {
 	return &methods.AddHost_TaskBody{
 		Res: &types.AddHost_TaskResponse{
-			Returnval: NewTask(&addHost{c, add}).Run(ctx),
+			Returnval: NewTask(ctx, &addHost{c, add}).Run(ctx),
 		},
 	}
 }
@@ -319,14 +319,60 @@ func (c *ClusterComputeResource) updateOverridesVmOrchestration(cfg *types.Clust
 	return nil
 }
 
+func (c *ClusterComputeResource) updateConfig(cfg *types.ClusterConfigInfoEx, cspec *types.ClusterConfigSpecEx) types.BaseMethodFault {
+	if spec := cspec.DrsConfig; spec != nil {
+		if spec.Enabled != nil {
+			cfg.DrsConfig.Enabled = spec.Enabled
+		}
+		if spec.DefaultVmBehavior != "" {
+			cfg.DrsConfig.DefaultVmBehavior = spec.DefaultVmBehavior
+		}
+	}
+
+	if spec := cspec.DasConfig; spec != nil {
+		if spec.Enabled != nil {
+			cfg.DasConfig.Enabled = spec.Enabled
+		}
+	}
+
+	return nil
+}
+
+// checkLicense returns a fault if enabling DRS or HA is not permitted by the licenses assigned to the cluster's hosts
+func (c *ClusterComputeResource) checkLicense(ctx *Context, spec *types.ClusterConfigSpecEx) types.BaseMethodFault {
+	var features []string
+	if spec.DrsConfig != nil && isTrue(spec.DrsConfig.Enabled) {
+		features = append(features, "drs")
+	}
+	if spec.DasConfig != nil && isTrue(spec.DasConfig.Enabled) {
+		features = append(features, "das")
+	}
+
+	lm := ctx.Map.LicenseManager()
+	for _, feature := range features {
+		for _, host := range c.Host {
+			if err := lm.checkFeature(ctx, host, feature); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (c *ClusterComputeResource) ReconfigureComputeResourceTask(ctx *Context, req *types.ReconfigureComputeResource_Task) soap.HasFault {
-	task := CreateTask(c, "reconfigureCluster", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, c, "reconfigureCluster", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		spec, ok := req.Spec.(*types.ClusterConfigSpecEx)
 		if !ok {
 			return nil, new(types.InvalidArgument)
 		}
 
+		if err := c.checkLicense(ctx, spec); err != nil {
+			return nil, err
+		}
+
 		updates := []func(*types.ClusterConfigInfoEx, *types.ClusterConfigSpecEx) types.BaseMethodFault{
+			c.updateConfig,
 			c.updateRules,
 			c.updateGroups,
 			c.updateOverridesDAS,
@@ -415,7 +461,7 @@ func CreateClusterComputeResource(ctx *Context, f *Folder, name string, spec typ
 	}
 
 	cluster := &ClusterComputeResource{}
-	cluster.EnvironmentBrowser = newEnvironmentBrowser()
+	cluster.EnvironmentBrowser = newEnvironmentBrowser(ctx)
 	cluster.Name = name
 	cluster.Network = ctx.Map.getEntityDatacenter(f).defaultNetwork()
 	cluster.Summary = &types.ClusterComputeResourceSummary{
@@ -428,7 +474,7 @@ func CreateClusterComputeResource(ctx *Context, f *Folder, name string, spec typ
 	config.VmSwapPlacement = string(types.VirtualMachineConfigInfoSwapPlacementTypeVmDirectory)
 	config.DrsConfig.Enabled = types.NewBool(true)
 
-	pool := NewResourcePool()
+	pool := newResourcePool(ctx.Map)
 	ctx.Map.PutEntity(cluster, ctx.Map.NewEntity(pool))
 	cluster.ResourcePool = &pool.Self
 
@@ -0,0 +1,405 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const controlPath = "/vcsim/api"
+
+// Control API actions, see ControlRequest
+const (
+	// ControlPowerState changes VirtualMachine power state, as if done by the guest or host
+	ControlPowerState = "vm.power"
+	// ControlToolsStatus changes VirtualMachine guest tools status, as if done by the guest
+	ControlToolsStatus = "vm.tools"
+	// ControlFreeSpace changes Datastore free space, as if filled or freed by another client
+	ControlFreeSpace = "datastore.space"
+	// ControlEvent posts an Event of any type, optionally with the given Object as the event argument
+	ControlEvent = "event"
+	// ControlLicenseClock advances the LicenseManager clock, expiring licenses such as the evaluation license
+	ControlLicenseClock = "license.clock"
+)
+
+// ControlRequest is the JSON request body of the /vcsim/api endpoint.
+// Changes are applied via Registry.Update, such that PropertyCollector updates are sent to clients.
+type ControlRequest struct {
+	// Action is one of the Control* action constants
+	Action string `json:"action"`
+	// Object is the target of the Action, in ManagedObjectReference "Type:value" form or an inventory object name
+	Object string `json:"object,omitempty"`
+
+	PowerState  types.VirtualMachinePowerState  `json:"powerState,omitempty"`
+	ToolsStatus types.VirtualMachineToolsStatus `json:"toolsStatus,omitempty"`
+	FreeSpace   *int64                          `json:"freeSpace,omitempty"`
+
+	// Event is the Event type name, such as "GeneralUserEvent" or "VmGuestShutdownEvent"
+	Event string `json:"event,omitempty"`
+	// Message is the Event message, unless the Event type defines its own message format
+	Message string `json:"message,omitempty"`
+
+	// Advance is the license clock adjustment, in time.ParseDuration form such as "1440h"
+	Advance string `json:"advance,omitempty"`
+}
+
+// ControlResponse is the JSON response body of the /vcsim/api endpoint
+type ControlResponse struct {
+	Error string `json:"error,omitempty"`
+}
+
+// controlError is an error with the corresponding http status code
+type controlError struct {
+	status int
+	msg    string
+}
+
+func (e *controlError) Error() string {
+	return e.msg
+}
+
+func controlErrorf(status int, format string, args ...interface{}) error {
+	return &controlError{status, fmt.Sprintf(format, args...)}
+}
+
+// ServeControl implements the /vcsim/api endpoint, see ControlRequest.
+func (s *Service) ServeControl(w http.ResponseWriter, r *http.Request) {
+	var res ControlResponse
+	status := http.StatusOK
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := &Context{
+		req:     r,
+		res:     w,
+		svc:     s,
+		Map:     s.registry,
+		Context: r.Context(),
+	}
+
+	var req ControlRequest
+	err := ctx.controlAuth()
+	if err == nil {
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			err = controlErrorf(http.StatusBadRequest, "decoding request: %s", err)
+		} else {
+			err = ctx.control(&req)
+		}
+	}
+
+	if err != nil {
+		res.Error = err.Error()
+		status = http.StatusInternalServerError
+		if cerr, ok := err.(*controlError); ok {
+			status = cerr.status
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// controlAuth requires a valid session cookie, or HTTP basic auth credentials accepted by SessionManager.Login
+func (c *Context) controlAuth() error {
+	c.mapSession()
+	if c.Session != nil {
+		return nil
+	}
+
+	if user, pass, ok := c.req.BasicAuth(); ok {
+		if c.svc.sm.validLogin(c, &types.Login{UserName: user, Password: pass}) {
+			c.Session = c.svc.session
+			return nil
+		}
+	}
+
+	return controlErrorf(http.StatusUnauthorized, "%s requires a valid session or credentials", controlPath)
+}
+
+// controlObject returns the object referenced by ControlRequest.Object
+func (c *Context) controlObject(req *ControlRequest) (mo.Reference, error) {
+	if req.Object == "" {
+		return nil, nil
+	}
+
+	var ref types.ManagedObjectReference
+	if ref.FromString(req.Object) {
+		if obj := c.Map.Get(ref); obj != nil {
+			return obj, nil
+		}
+	}
+
+	for _, e := range c.Map.All("") {
+		if e.Entity().Name == req.Object {
+			return e, nil
+		}
+	}
+
+	return nil, controlErrorf(http.StatusNotFound, "object %q not found", req.Object)
+}
+
+// control applies the given ControlRequest
+func (c *Context) control(req *ControlRequest) error {
+	obj, err := c.controlObject(req)
+	if err != nil {
+		return err
+	}
+
+	switch req.Action {
+	case ControlPowerState, ControlToolsStatus:
+		vm, ok := obj.(*VirtualMachine)
+		if !ok {
+			return controlErrorf(http.StatusBadRequest, "%s requires a VirtualMachine object", req.Action)
+		}
+		c.WithLock(vm, func() {
+			if req.Action == ControlPowerState {
+				err = c.controlPowerState(vm, req.PowerState)
+			} else {
+				err = c.controlToolsStatus(vm, req.ToolsStatus)
+			}
+		})
+		return err
+	case ControlFreeSpace:
+		ds, ok := obj.(*Datastore)
+		if !ok {
+			return controlErrorf(http.StatusBadRequest, "%s requires a Datastore object", req.Action)
+		}
+		if req.FreeSpace == nil || *req.FreeSpace < 0 || *req.FreeSpace > ds.Summary.Capacity {
+			return controlErrorf(http.StatusBadRequest, "freeSpace must be between 0 and %d", ds.Summary.Capacity)
+		}
+		c.WithLock(ds, func() {
+			info := ds.Info.GetDatastoreInfo()
+			info.FreeSpace = *req.FreeSpace
+			c.Map.Update(ds, []types.PropertyChange{
+				{Name: "summary.freeSpace", Val: *req.FreeSpace},
+				{Name: "info", Val: ds.Info},
+			})
+		})
+		return nil
+	case ControlEvent:
+		return c.controlEvent(obj, req)
+	case ControlLicenseClock:
+		d, err := time.ParseDuration(req.Advance)
+		if err != nil || d < 0 {
+			return controlErrorf(http.StatusBadRequest, "invalid advance %q", req.Advance)
+		}
+		c.Map.LicenseManager().advance(c, d)
+		return nil
+	default:
+		return controlErrorf(http.StatusBadRequest, "unknown action %q", req.Action)
+	}
+}
+
+func (c *Context) controlPowerState(vm *VirtualMachine, state types.VirtualMachinePowerState) error {
+	switch state {
+	case types.VirtualMachinePowerStatePoweredOn, types.VirtualMachinePowerStatePoweredOff, types.VirtualMachinePowerStateSuspended:
+	default:
+		return controlErrorf(http.StatusBadRequest, "invalid powerState %q", state)
+	}
+
+	run := &powerVMTask{vm, state, c}
+	if _, fault := run.Run(nil); fault != nil {
+		return controlErrorf(http.StatusConflict, "%s: %s", vm.Self, reflect.TypeOf(fault).Elem().Name())
+	}
+
+	return nil
+}
+
+func (c *Context) controlToolsStatus(vm *VirtualMachine, status types.VirtualMachineToolsStatus) error {
+	running := types.VirtualMachineToolsRunningStatusGuestToolsNotRunning
+
+	switch status {
+	case types.VirtualMachineToolsStatusToolsOk, types.VirtualMachineToolsStatusToolsOld:
+		running = types.VirtualMachineToolsRunningStatusGuestToolsRunning
+	case types.VirtualMachineToolsStatusToolsNotInstalled, types.VirtualMachineToolsStatusToolsNotRunning:
+	default:
+		return controlErrorf(http.StatusBadRequest, "invalid toolsStatus %q", status)
+	}
+
+	c.Map.Update(vm, []types.PropertyChange{
+		{Name: "guest.toolsStatus", Val: status},
+		{Name: "guest.toolsRunningStatus", Val: string(running)},
+		{Name: "summary.guest.toolsStatus", Val: status},
+		{Name: "summary.guest.toolsRunningStatus", Val: string(running)},
+	})
+
+	return nil
+}
+
+func (c *Context) controlEvent(obj mo.Reference, req *ControlRequest) error {
+	kind, ok := c.Map.typeFunc(req.Event)
+	if !ok {
+		return controlErrorf(http.StatusBadRequest, "unknown event type %q", req.Event)
+	}
+
+	event, ok := reflect.New(kind).Interface().(types.BaseEvent)
+	if !ok {
+		return controlErrorf(http.StatusBadRequest, "%q is not an Event type", req.Event)
+	}
+
+	e := event.GetEvent()
+	if msg := reflect.ValueOf(event).Elem().FieldByName("Message"); msg.Kind() == reflect.String {
+		msg.SetString(req.Message) // GeneralEvent types
+	}
+
+	switch x := obj.(type) {
+	case *VirtualMachine:
+		*e = x.event(c).Event
+	case *HostSystem:
+		*e = x.event(c).Event
+	case *Datastore:
+		e.Datacenter = datacenterEventArgument(c, x)
+		e.Ds = x.eventArgument()
+	case *Datacenter:
+		e.Datacenter = datacenterEventArgument(c, x)
+	case nil:
+	default:
+		return controlErrorf(http.StatusBadRequest, "unsupported event object %s", obj.Reference())
+	}
+	e.FullFormattedMessage = req.Message
+
+	c.postEvent(event)
+
+	return nil
+}
+
+// Control is a client for the vcsim control API, see ControlRequest.
+type Control struct {
+	URL    *url.URL
+	Client *http.Client
+	// User credentials are sent via HTTP basic auth, the control API requires a valid session or credentials
+	User *url.Userinfo
+}
+
+// NewControl returns a Control client for the simulator at the given URL, such as Server.URL,
+// authenticating with the URL's user credentials
+func NewControl(u *url.URL, insecure bool) *Control {
+	return &Control{
+		URL:    &url.URL{Scheme: u.Scheme, Host: u.Host, Path: controlPath},
+		Client: &soap.NewClient(u, insecure).Client,
+		User:   u.User,
+	}
+}
+
+// NewControlClient returns a Control client sharing the session of the given client, such as vim25.Client.Client
+func NewControlClient(c *soap.Client) *Control {
+	u := c.URL()
+	return &Control{
+		URL:    &url.URL{Scheme: u.Scheme, Host: u.Host, Path: controlPath},
+		Client: &c.Client,
+	}
+}
+
+// Do sends the given request to the control API
+func (c *Control) Do(ctx context.Context, req *ControlRequest) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL.String(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	if c.User != nil {
+		pass, _ := c.User.Password()
+		hreq.SetBasicAuth(c.User.Username(), pass)
+	}
+
+	res, err := c.Client.Do(hreq)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var cres ControlResponse
+	if err = json.NewDecoder(res.Body).Decode(&cres); err != nil {
+		return fmt.Errorf("%s %s: %s", hreq.Method, c.URL, res.Status)
+	}
+
+	if cres.Error != "" {
+		return errors.New(cres.Error)
+	}
+
+	return nil
+}
+
+// PowerState changes the power state of the given VirtualMachine, as if done by the guest or host
+func (c *Control) PowerState(ctx context.Context, vm mo.Reference, state types.VirtualMachinePowerState) error {
+	return c.Do(ctx, &ControlRequest{
+		Action:     ControlPowerState,
+		Object:     vm.Reference().String(),
+		PowerState: state,
+	})
+}
+
+// ToolsStatus changes the guest tools status of the given VirtualMachine
+func (c *Control) ToolsStatus(ctx context.Context, vm mo.Reference, status types.VirtualMachineToolsStatus) error {
+	return c.Do(ctx, &ControlRequest{
+		Action:      ControlToolsStatus,
+		Object:      vm.Reference().String(),
+		ToolsStatus: status,
+	})
+}
+
+// FreeSpace changes the free space in bytes of the given Datastore
+func (c *Control) FreeSpace(ctx context.Context, ds mo.Reference, free int64) error {
+	return c.Do(ctx, &ControlRequest{
+		Action:    ControlFreeSpace,
+		Object:    ds.Reference().String(),
+		FreeSpace: &free,
+	})
+}
+
+// PostEvent posts an Event of the given type name, with the given entity argument if obj is not nil
+func (c *Control) PostEvent(ctx context.Context, obj mo.Reference, kind, message string) error {
+	req := &ControlRequest{
+		Action:  ControlEvent,
+		Event:   kind,
+		Message: message,
+	}
+	if obj != nil {
+		req.Object = obj.Reference().String()
+	}
+	return c.Do(ctx, req)
+}
+
+// AdvanceLicenseClock moves the LicenseManager clock forward by the given duration
+func (c *Control) AdvanceLicenseClock(ctx context.Context, d time.Duration) error {
+	return c.Do(ctx, &ControlRequest{
+		Action:  ControlLicenseClock,
+		Advance: d.String(),
+	})
+}
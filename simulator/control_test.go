@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestControl(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		ctl := NewControlClient(c.Client)
+		finder := find.NewFinder(c)
+		pc := property.DefaultCollector(c)
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// PropertyCollector watchers see the out-of-band power off
+		errc := make(chan error)
+		go func() {
+			errc <- property.Wait(ctx, pc, vm.Reference(), []string{"runtime.powerState"}, func(changes []types.PropertyChange) bool {
+				for _, change := range changes {
+					if change.Val == types.VirtualMachinePowerStatePoweredOff {
+						return true
+					}
+				}
+				return false
+			})
+		}()
+
+		if err = ctl.PowerState(ctx, vm, types.VirtualMachinePowerStatePoweredOff); err != nil {
+			t.Fatal(err)
+		}
+		if err = <-errc; err != nil {
+			t.Fatal(err)
+		}
+
+		err = ctl.PowerState(ctx, vm, types.VirtualMachinePowerStatePoweredOff)
+		if err == nil || !strings.Contains(err.Error(), "InvalidPowerState") {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = ctl.ToolsStatus(ctx, vm, types.VirtualMachineToolsStatusToolsNotRunning); err != nil {
+			t.Fatal(err)
+		}
+
+		var mvm mo.VirtualMachine
+		if err = vm.Properties(ctx, vm.Reference(), []string{"guest", "summary.guest"}, &mvm); err != nil {
+			t.Fatal(err)
+		}
+		if mvm.Guest.ToolsRunningStatus != string(types.VirtualMachineToolsRunningStatusGuestToolsNotRunning) {
+			t.Errorf("toolsRunningStatus=%s", mvm.Guest.ToolsRunningStatus)
+		}
+		if mvm.Summary.Guest.ToolsStatus != types.VirtualMachineToolsStatusToolsNotRunning {
+			t.Errorf("summary.guest.toolsStatus=%s", mvm.Summary.Guest.ToolsStatus)
+		}
+
+		ds, err := finder.Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = ctl.FreeSpace(ctx, ds, 0); err != nil {
+			t.Fatal(err)
+		}
+
+		var mds mo.Datastore
+		if err = ds.Properties(ctx, ds.Reference(), []string{"summary", "info"}, &mds); err != nil {
+			t.Fatal(err)
+		}
+		if mds.Summary.FreeSpace != 0 || mds.Info.GetDatastoreInfo().FreeSpace != 0 {
+			t.Errorf("freeSpace=%d", mds.Summary.FreeSpace)
+		}
+
+		if err = ctl.FreeSpace(ctx, ds, -1); err == nil {
+			t.Error("expected error")
+		}
+
+		if err = ctl.PostEvent(ctx, vm, "GeneralUserEvent", "guest panic"); err != nil {
+			t.Fatal(err)
+		}
+
+		events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{
+			Entity: &types.EventFilterSpecByEntity{
+				Entity:    vm.Reference(),
+				Recursion: types.EventFilterSpecRecursionOptionSelf,
+			},
+			EventTypeId: []string{"GeneralUserEvent"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 1 || events[0].(*types.GeneralUserEvent).Message != "guest panic" {
+			t.Errorf("events=%#v", events)
+		}
+
+		if err = ctl.PostEvent(ctx, nil, "NoSuchEvent", ""); err == nil {
+			t.Error("expected error")
+		}
+
+		err = ctl.Do(ctx, &ControlRequest{Action: ControlPowerState, Object: "enoent"})
+		if err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("err=%v", err)
+		}
+
+		err = ctl.Do(ctx, &ControlRequest{Action: "enoent"})
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		// the control API requires a valid session or credentials
+		anon := NewControl(c.URL(), true)
+		err = anon.PowerState(ctx, vm, types.VirtualMachinePowerStatePoweredOn)
+		if err == nil || !strings.Contains(err.Error(), "requires a valid session") {
+			t.Errorf("err=%v", err)
+		}
+
+		anon.User = url.UserPassword("user", "")
+		if err = anon.PowerState(ctx, vm, types.VirtualMachinePowerStatePoweredOn); err == nil {
+			t.Error("expected error")
+		}
+
+		anon.User = url.UserPassword("user", "pass")
+		if err = anon.PowerState(ctx, vm, types.VirtualMachinePowerStatePoweredOn); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -110,7 +110,7 @@ func (dc *Datacenter) defaultNetwork() []types.ManagedObjectReference {
 }
 
 // folder returns the Datacenter folder that can contain the given object type
-func (dc *Datacenter) folder(obj mo.Entity) *mo.Folder {
+func (dc *Datacenter) folder(ctx *Context, obj mo.Entity) *mo.Folder {
 	folders := []types.ManagedObjectReference{
 		dc.VmFolder,
 		dc.HostFolder,
@@ -121,7 +121,7 @@ func (dc *Datacenter) folder(obj mo.Entity) *mo.Folder {
 	rtype := obj.Reference().Type
 
 	for i := range folders {
-		folder, _ := asFolderMO(Map.Get(folders[i]))
+		folder, _ := asFolderMO(ctx.Map.Get(folders[i]))
 		for _, kind := range folder.ChildType {
 			if rtype == kind {
 				return folder
@@ -136,10 +136,10 @@ func (dc *Datacenter) folder(obj mo.Entity) *mo.Folder {
 	return nil
 }
 
-func datacenterEventArgument(obj mo.Entity) *types.DatacenterEventArgument {
+func datacenterEventArgument(ctx *Context, obj mo.Entity) *types.DatacenterEventArgument {
 	dc, ok := obj.(*Datacenter)
 	if !ok {
-		dc = Map.getEntityDatacenter(obj)
+		dc = ctx.Map.getEntityDatacenter(obj)
 	}
 	return &types.DatacenterEventArgument{
 		Datacenter:          dc.Self,
@@ -148,7 +148,7 @@ func datacenterEventArgument(obj mo.Entity) *types.DatacenterEventArgument {
 }
 
 func (dc *Datacenter) PowerOnMultiVMTask(ctx *Context, req *types.PowerOnMultiVM_Task) soap.HasFault {
-	task := CreateTask(dc, "powerOnMultiVM", func(_ *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, dc, "powerOnMultiVM", func(_ *Task) (types.AnyType, types.BaseMethodFault) {
 		if dc.isESX {
 			return nil, new(types.NotImplemented)
 		}
@@ -193,7 +193,7 @@ func (dc *Datacenter) PowerOnMultiVMTask(ctx *Context, req *types.PowerOnMultiVM
 }
 
 func (d *Datacenter) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
-	task := CreateTask(d, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, d, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		folders := []types.ManagedObjectReference{
 			d.VmFolder,
 			d.HostFolder,
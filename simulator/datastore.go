@@ -87,7 +87,7 @@ func (ds *Datastore) RefreshDatastore(*types.RefreshDatastore) soap.HasFault {
 }
 
 func (ds *Datastore) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
-	task := CreateTask(ds, "destroy", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, ds, "destroy", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		if len(ds.Vm) != 0 {
 			return nil, &types.ResourceInUse{
 				Type: ds.Self.Type,
@@ -98,7 +98,7 @@ func (ds *Datastore) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.Has
 		for _, mount := range ds.Host {
 			host := ctx.Map.Get(mount.Key).(*HostSystem)
 			ctx.Map.RemoveReference(ctx, host, &host.Datastore, ds.Self)
-			parent := hostParent(&host.HostSystem)
+			parent := hostParent(ctx, &host.HostSystem)
 			ctx.Map.RemoveReference(ctx, parent, &parent.Datastore, ds.Self)
 		}
 
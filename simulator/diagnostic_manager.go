@@ -0,0 +1,524 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+var (
+	logBundlePrefix = "/downloads/"
+	logBundleTTL    = 10 * time.Minute // bundles not downloaded within the TTL are removed
+)
+
+type DiagnosticManager struct {
+	mo.DiagnosticManager
+
+	boot time.Time
+}
+
+func (m *DiagnosticManager) init(r *Registry) {
+	m.boot = time.Now()
+}
+
+// diagnosticLog is a log file synthesized from the recent SOAP calls and events
+type diagnosticLog struct {
+	types.DiagnosticManagerLogDescriptor
+
+	// lines renders the log content
+	lines func(ctx *Context, host *HostSystem) []string
+}
+
+var vpxdLogs = []diagnosticLog{
+	{
+		DiagnosticManagerLogDescriptor: types.DiagnosticManagerLogDescriptor{
+			Key:      "vpxd:vpxd.log",
+			FileName: "/var/log/vmware/vpxd/vpxd.log",
+			Creator:  string(types.DiagnosticManagerLogCreatorVpxd),
+			Format:   string(types.DiagnosticManagerLogFormatPlain),
+			MimeType: "text/plain",
+			Info: &types.Description{
+				Label:   "vpxd.log",
+				Summary: "vCenter server log",
+			},
+		},
+		lines: vpxdLog,
+	},
+	{
+		DiagnosticManagerLogDescriptor: types.DiagnosticManagerLogDescriptor{
+			Key:      "vpxd:vpxd-alert.log",
+			FileName: "/var/log/vmware/vpxd/vpxd-alert.log",
+			Creator:  string(types.DiagnosticManagerLogCreatorVpxd),
+			Format:   string(types.DiagnosticManagerLogFormatPlain),
+			MimeType: "text/plain",
+			Info: &types.Description{
+				Label:   "vpxd-alert.log",
+				Summary: "vCenter server alert log",
+			},
+		},
+		lines: vpxdAlertLog,
+	},
+}
+
+var hostdLogs = []diagnosticLog{
+	{
+		DiagnosticManagerLogDescriptor: types.DiagnosticManagerLogDescriptor{
+			Key:      "hostd",
+			FileName: "/var/log/hostd.log",
+			Creator:  string(types.DiagnosticManagerLogCreatorHostd),
+			Format:   string(types.DiagnosticManagerLogFormatPlain),
+			MimeType: "text/plain",
+			Info: &types.Description{
+				Label:   "hostd.log",
+				Summary: "Server log in 'plain' format",
+			},
+		},
+		lines: hostdLog,
+	},
+	{
+		DiagnosticManagerLogDescriptor: types.DiagnosticManagerLogDescriptor{
+			Key:      "vmkernel",
+			FileName: "/var/log/vmkernel.log",
+			Creator:  "vmkernel",
+			Format:   string(types.DiagnosticManagerLogFormatPlain),
+			MimeType: "text/plain",
+			Info: &types.Description{
+				Label:   "vmkernel.log",
+				Summary: "Server log in 'plain' format",
+			},
+		},
+		lines: vmkernelLog,
+	},
+}
+
+const logTimeFormat = "2006-01-02T15:04:05.000Z"
+
+// logEntry is a timestamped log line, used to merge calls and events
+type logEntry struct {
+	time time.Time
+	text string
+}
+
+// sortLog returns the formatted log lines, the first entry is the log header and remains the first line
+func sortLog(entries []logEntry) []string {
+	body := entries[1:]
+	sort.SliceStable(body, func(i, j int) bool {
+		return body[i].time.Before(body[j].time)
+	})
+
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = e.time.UTC().Format(logTimeFormat) + " " + strings.TrimSpace(e.text)
+	}
+	return lines
+}
+
+// recentCalls returns the calls recorded by the Service, if any
+func (c *Context) recentCalls() []Call {
+	if c.svc == nil {
+		return nil // in-memory client
+	}
+	return c.svc.metrics.recent()
+}
+
+// recentEvents returns the EventManager history, oldest first
+func (c *Context) recentEvents() []types.BaseEvent {
+	m := c.Map.EventManager()
+	if m == nil {
+		return nil
+	}
+
+	var events []types.BaseEvent
+	c.WithLock(m, func() {
+		for e := m.history.Front(); e != nil; e = e.Next() {
+			events = append(events, e.Value.(types.BaseEvent))
+		}
+	})
+	return events
+}
+
+// callOpID returns the client's operationID for the given call, generating one if the client did not provide one
+func callOpID(i int, call Call) string {
+	if call.OpID != "" {
+		return call.OpID
+	}
+	return fmt.Sprintf("%08x", i)
+}
+
+func eventTypeName(event types.BaseEvent) string {
+	return reflect.ValueOf(event).Elem().Type().Name()
+}
+
+func vpxdLog(ctx *Context, _ *HostSystem) []string {
+	about := ctx.Map.content().About
+	m := ctx.Map.Get(*ctx.Map.content().DiagnosticManager).(*DiagnosticManager)
+
+	entries := []logEntry{{m.boot, fmt.Sprintf("info vpxd[07164] [Originator@6876 sub=Default] Log for %s, pid=7164, version=%s, build=%s, option=Release",
+		about.FullName, about.Version, about.Build)}}
+
+	for i, call := range ctx.recentCalls() {
+		level, status := "info", "FINISH"
+		opID := callOpID(i, call)
+		if call.Fault != "" {
+			level, status = "error", "ERROR"
+		}
+		entries = append(entries, logEntry{call.Time, fmt.Sprintf("%s vpxd[07164] [Originator@6876 sub=vpxLro opID=%s] [VpxLRO] -- %s %s -- %s -- %s.%s -- %s %s",
+			level, opID, status, call.This.Value, call.User, call.This.Type, call.Method, call.Duration, call.Fault)})
+	}
+
+	for _, event := range ctx.recentEvents() {
+		e := event.GetEvent()
+		dc := ""
+		if e.Datacenter != nil {
+			dc = e.Datacenter.Name
+		}
+		entries = append(entries, logEntry{e.CreatedTime, fmt.Sprintf("info vpxd[07164] [Originator@6876 sub=vpxdVpxdEvent] Event [%d] [1-1] [%s] [%s] [info] [%s] [%s] [%d] [%s]",
+			e.Key, e.CreatedTime.UTC().Format(logTimeFormat), eventTypeName(event), e.UserName, dc, e.ChainId, e.FullFormattedMessage)})
+	}
+
+	return sortLog(entries)
+}
+
+func vpxdAlertLog(ctx *Context, host *HostSystem) []string {
+	var lines []string
+	for i, line := range vpxdLog(ctx, host) {
+		if i == 0 || strings.Contains(line, " error vpxd[") {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func hostBootTime(host *HostSystem) time.Time {
+	if host.Runtime.BootTime != nil {
+		return *host.Runtime.BootTime
+	}
+	return time.Now()
+}
+
+func hostdLog(ctx *Context, host *HostSystem) []string {
+	about := host.Config.Product
+
+	entries := []logEntry{{hostBootTime(host), fmt.Sprintf("In(166) Hostd[2099584]: Log for %s, pid=2099584, version=%s, build=%s, option=Release",
+		about.FullName, about.Version, about.Build)}}
+
+	// calls and events are included for the host and its VMs, all when the host is not managed by vCenter
+	owned := map[types.ManagedObjectReference]bool{host.Self: true}
+	for _, vm := range host.Vm {
+		owned[vm] = true
+	}
+	all := ctx.Map.IsESX()
+
+	for i, call := range ctx.recentCalls() {
+		if !all && !owned[call.This] {
+			continue
+		}
+		level, status := "In(166)", "Task Completed"
+		opID := callOpID(i, call)
+		if call.Fault != "" {
+			level, status = "Er(163)", "Task Failed"
+		}
+		entries = append(entries, logEntry{call.Time, fmt.Sprintf("%s Hostd[2099584]: [Originator@6876 sub=Vimsvc.TaskManager opID=%s user=%s] %s : %s.%s on %s %s",
+			level, opID, call.User, status, call.This.Type, call.Method, call.This.Value, call.Fault)})
+	}
+
+	for _, event := range ctx.recentEvents() {
+		e := event.GetEvent()
+		match := all || doEntityEventArgument(event, func(ref types.ManagedObjectReference, _ *types.EntityEventArgument) bool {
+			return owned[ref]
+		})
+		if !match {
+			continue
+		}
+		entries = append(entries, logEntry{e.CreatedTime, fmt.Sprintf("In(166) Hostd[2099584]: [Originator@6876 sub=Vimsvc.ha-eventmgr] Event %d : %s",
+			e.Key, e.FullFormattedMessage)})
+	}
+
+	return sortLog(entries)
+}
+
+func vmkernelLog(_ *Context, host *HostSystem) []string {
+	boot := hostBootTime(host)
+	about := host.Config.Product
+	hw := host.Summary.Hardware
+
+	entries := []logEntry{
+		{boot, fmt.Sprintf("cpu0:2097152)Boot Successful, %s %s build-%s", about.Name, about.Version, about.Build)},
+	}
+	if hw != nil {
+		entries = append(entries,
+			logEntry{boot, fmt.Sprintf("cpu0:2097152)CpuSched: %d CPU cores, %d threads, %s", hw.NumCpuCores, hw.NumCpuThreads, hw.CpuModel)},
+			logEntry{boot, fmt.Sprintf("cpu0:2097152)MemSched: %d MB of memory", hw.MemorySize/(1024*1024))},
+		)
+	}
+	if host.Config.Network != nil {
+		for _, nic := range host.Config.Network.Vnic {
+			entries = append(entries, logEntry{boot, fmt.Sprintf("cpu0:2097152)Tcpip_Vmk: %s: interface created, mac %s, portgroup %s", nic.Device, nic.Spec.Mac, nic.Portgroup)})
+		}
+	}
+
+	return sortLog(entries)
+}
+
+// logHost returns the HostSystem whose logs are served, or nil for the vCenter logs.
+func (m *DiagnosticManager) logHost(ctx *Context, ref *types.ManagedObjectReference) (*HostSystem, types.BaseMethodFault) {
+	if ref != nil && ctx.Map.IsVPX() && ctx.hostView() == nil {
+		host, ok := ctx.Map.Get(*ref).(*HostSystem)
+		if !ok {
+			return nil, &types.ManagedObjectNotFound{Obj: *ref}
+		}
+		return host, nil
+	}
+
+	// the host parameter is ignored when connected directly to a host
+	if v := ctx.hostView(); v != nil {
+		return v.host, nil
+	}
+	if ctx.Map.IsESX() {
+		return ctx.Map.Any("HostSystem").(*HostSystem), nil
+	}
+
+	return nil, nil
+}
+
+func (m *DiagnosticManager) logs(host *HostSystem) []diagnosticLog {
+	if host == nil {
+		return vpxdLogs
+	}
+	return hostdLogs
+}
+
+func (m *DiagnosticManager) QueryDescriptions(ctx *Context, req *types.QueryDescriptions) soap.HasFault {
+	body := new(methods.QueryDescriptionsBody)
+
+	host, fault := m.logHost(ctx, req.Host)
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	res := new(types.QueryDescriptionsResponse)
+	for _, l := range m.logs(host) {
+		res.Returnval = append(res.Returnval, l.DiagnosticManagerLogDescriptor)
+	}
+	body.Res = res
+
+	return body
+}
+
+func (m *DiagnosticManager) BrowseDiagnosticLog(ctx *Context, req *types.BrowseDiagnosticLog) soap.HasFault {
+	body := new(methods.BrowseDiagnosticLogBody)
+
+	host, fault := m.logHost(ctx, req.Host)
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	var lines []string
+	for _, l := range m.logs(host) {
+		if l.Key == req.Key {
+			lines = l.lines(ctx, host)
+			break
+		}
+	}
+	if lines == nil {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "key"})
+		return body
+	}
+
+	// line numbers start at 1, lineEnd is the last line of the log
+	n := int32(len(lines))
+	header := types.DiagnosticManagerLogHeader{
+		LineStart: 1,
+		LineEnd:   n,
+	}
+	if req.Start > 1 {
+		header.LineStart = req.Start
+	}
+
+	if header.LineStart <= n {
+		end := n
+		if req.Lines > 0 && header.LineStart+req.Lines-1 < n {
+			end = header.LineStart + req.Lines - 1
+		}
+		header.LineText = lines[header.LineStart-1 : end]
+	}
+
+	body.Res = &types.BrowseDiagnosticLogResponse{Returnval: header}
+
+	return body
+}
+
+// logBundleURL returns the download URL of a log bundle, the "*" host is replaced by the client.
+func logBundleURL(ctx *Context, name string) string {
+	scheme := "https"
+	if ctx.svc != nil && ctx.svc.Listen != nil && ctx.svc.Listen.Scheme != "" {
+		scheme = ctx.svc.Listen.Scheme
+	}
+	return (&url.URL{
+		Scheme: scheme,
+		Host:   "*",
+		Path:   logBundlePrefix + name,
+	}).String()
+}
+
+// logBundle returns a tgz archive containing each of the given logs
+func logBundle(ctx *Context, dir string, host *HostSystem, logs []diagnosticLog) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+
+	for _, l := range logs {
+		data := []byte(strings.Join(l.lines(ctx, host), "\n") + "\n")
+		err := tw.WriteHeader(&tar.Header{
+			Name:    path.Join(dir, l.FileName),
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (m *DiagnosticManager) GenerateLogBundlesTask(ctx *Context, req *types.GenerateLogBundles_Task) soap.HasFault {
+	body := new(methods.GenerateLogBundles_TaskBody)
+
+	type bundle struct {
+		name string
+		host *HostSystem
+	}
+	var bundles []bundle
+	id := uuid.New().String()
+	date := time.Now().UTC().Format("2006-01-02--15.04")
+
+	esxName := func(h *HostSystem) string {
+		return fmt.Sprintf("esx-%s-%s-%s", h.Name, date, id[:8])
+	}
+
+	if host, _ := m.logHost(ctx, nil); host != nil {
+		// the host parameters are ignored when connected directly to a host
+		bundles = append(bundles, bundle{esxName(host), host})
+	} else {
+		if req.IncludeDefault {
+			bundles = append(bundles, bundle{"vcsupport-" + id, nil})
+		}
+		for _, ref := range req.Host {
+			host, ok := ctx.Map.Get(ref).(*HostSystem)
+			if !ok {
+				body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: ref})
+				return body
+			}
+			bundles = append(bundles, bundle{esxName(host), host})
+		}
+	}
+
+	task := CreateTask(ctx, m, "generateLogBundles", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		var res types.ArrayOfDiagnosticManagerBundleInfo
+
+		for _, b := range bundles {
+			data, err := logBundle(ctx, b.name, b.host, m.logs(b.host))
+			if err != nil {
+				return nil, &types.LogBundlingFailed{}
+			}
+
+			name := path.Join(id, b.name+".tgz")
+			ctx.storeLogBundle(name, data)
+
+			info := types.DiagnosticManagerBundleInfo{Url: logBundleURL(ctx, name)}
+			if b.host != nil && ctx.Map.IsVPX() {
+				info.System = &b.host.Self
+			}
+			res.DiagnosticManagerBundleInfo = append(res.DiagnosticManagerBundleInfo, info)
+		}
+
+		return res, nil
+	})
+
+	body.Res = &types.GenerateLogBundles_TaskResponse{
+		Returnval: task.Run(ctx),
+	}
+
+	return body
+}
+
+// storeLogBundle stores a bundle for a single download via logBundlePrefix, removing it after logBundleTTL
+func (c *Context) storeLogBundle(name string, data []byte) {
+	if c.svc == nil {
+		return // in-memory client
+	}
+
+	c.svc.bundles.Store(name, data)
+
+	time.AfterFunc(logBundleTTL, func() {
+		c.svc.bundles.Delete(name)
+	})
+}
+
+// ServeLogBundle handles log bundle downloads.
+// HTTP access to log bundles is token based and does not require Session auth,
+// each bundle is removed once downloaded.
+func (s *Service) ServeLogBundle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, logBundlePrefix)
+
+	data, ok := s.bundles.LoadAndDelete(name)
+	if !ok {
+		log.Printf("invalid log bundle: %s", name)
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-gzip")
+	_, _ = w.Write(data.([]byte))
+}
@@ -0,0 +1,203 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestDiagnosticManager(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		m := object.NewDiagnosticManager(c)
+
+		host, err := find.NewFinder(c).HostSystem(ctx, "DC0_H0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vm := Map.Any("VirtualMachine").(*VirtualMachine)
+		if _, err = object.NewVirtualMachine(c, vm.Self).PowerOff(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			host *object.HostSystem
+			keys []string
+		}{
+			{nil, []string{"vpxd:vpxd.log", "vpxd:vpxd-alert.log"}},
+			{host, []string{"hostd", "vmkernel"}},
+		}
+
+		for _, test := range tests {
+			desc, err := m.QueryDescriptions(ctx, test.host)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(desc) != len(test.keys) {
+				t.Fatalf("desc=%#v", desc)
+			}
+			for i, key := range test.keys {
+				if desc[i].Key != key {
+					t.Errorf("key=%s", desc[i].Key)
+				}
+			}
+
+			l := m.Log(ctx, test.host, test.keys[0])
+			if err = l.Seek(ctx, 5); err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			if _, err = l.Copy(ctx, &buf); err != nil {
+				t.Fatal(err)
+			}
+			if buf.Len() == 0 {
+				t.Errorf("%s: empty log", test.keys[0])
+			}
+
+			// logs with fewer than the requested number of lines
+			l = m.Log(ctx, test.host, test.keys[1])
+			if err = l.Seek(ctx, 1000); err != nil {
+				t.Fatal(err)
+			}
+			if l.Start != 1 {
+				t.Errorf("start=%d", l.Start)
+			}
+		}
+
+		h, err := m.BrowseLog(ctx, nil, "vpxd:vpxd.log", 1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int(h.LineEnd) != len(h.LineText) || !strings.Contains(h.LineText[0], "Log for") {
+			t.Errorf("header=%d/%d", h.LineEnd, len(h.LineText))
+		}
+		if !strings.Contains(strings.Join(h.LineText, "\n"), "PowerOffVM_Task") {
+			t.Error("missing PowerOffVM_Task")
+		}
+
+		h, err = m.BrowseLog(ctx, host, "hostd", 2, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.LineStart != 2 || len(h.LineText) != 1 {
+			t.Errorf("header=%#v", h)
+		}
+
+		if _, err = m.BrowseLog(ctx, nil, "hostd", 1, 0); err == nil {
+			t.Error("expected error")
+		}
+
+		task, err := m.GenerateLogBundles(ctx, true, []*object.HostSystem{host})
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := task.WaitForResult(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		bundles := info.Result.(types.ArrayOfDiagnosticManagerBundleInfo).DiagnosticManagerBundleInfo
+		if len(bundles) != 2 {
+			t.Fatalf("bundles=%#v", bundles)
+		}
+		if bundles[0].System != nil || bundles[1].System == nil || *bundles[1].System != host.Reference() {
+			t.Errorf("bundles=%#v", bundles)
+		}
+
+		for i, expect := range []string{"/var/log/vmware/vpxd/vpxd.log", "/var/log/hostd.log"} {
+			u, err := c.ParseURL(bundles[i].Url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, _, err := c.Download(ctx, u, &soap.DefaultDownload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gz, err := gzip.NewReader(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			found := false
+			tr := tar.NewReader(gz)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if strings.HasSuffix(hdr.Name, expect) {
+					found = true
+				}
+			}
+			_ = f.Close()
+
+			if !found {
+				t.Errorf("%s not found in %s", expect, u)
+			}
+
+			// bundles can only be downloaded once
+			if _, _, err = c.Download(ctx, u, &soap.DefaultDownload); err == nil {
+				t.Errorf("%s: expected error", u)
+			}
+		}
+	})
+}
+
+func TestDiagnosticManagerESX(t *testing.T) {
+	ESX().Run(func(ctx context.Context, c *vim25.Client) error {
+		m := object.NewDiagnosticManager(c)
+
+		desc, err := m.QueryDescriptions(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if desc[0].Key != "hostd" {
+			t.Errorf("key=%s", desc[0].Key)
+		}
+
+		task, err := m.GenerateLogBundles(ctx, true, nil)
+		if err != nil {
+			return err
+		}
+		info, err := task.WaitForResult(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		bundles := info.Result.(types.ArrayOfDiagnosticManagerBundleInfo).DiagnosticManagerBundleInfo
+		if len(bundles) != 1 || bundles[0].System != nil {
+			t.Errorf("bundles=%#v", bundles)
+		}
+
+		return nil
+	})
+}
@@ -18,11 +18,15 @@ package simulator
 
 import (
 	"fmt"
+	"math/rand"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -36,13 +40,17 @@ type DistributedVirtualSwitch struct {
 }
 
 func (s *DistributedVirtualSwitch) AddDVPortgroupTask(ctx *Context, c *types.AddDVPortgroup_Task) soap.HasFault {
-	task := CreateTask(s, "addDVPortgroup", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, s, "addDVPortgroup", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		f := ctx.Map.getEntityParent(s, "Folder").(*Folder)
 
 		portgroups := s.Portgroup
 		portgroupNames := s.Summary.PortgroupName
 
 		for _, spec := range c.Spec {
+			if err := validateDVPortSetting(spec.DefaultPortConfig); err != nil {
+				return nil, err
+			}
+
 			pg := &DistributedVirtualPortgroup{}
 			pg.Name = spec.Name
 			pg.Entity().Name = pg.Name
@@ -133,10 +141,12 @@ func (s *DistributedVirtualSwitch) AddDVPortgroupTask(ctx *Context, c *types.Add
 				}
 			}
 
-			for i := 0; i < int(spec.NumPorts); i++ {
-				pg.PortKeys = append(pg.PortKeys, strconv.Itoa(i))
+			if pg.Config.Type == "" {
+				pg.Config.Type = string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding)
 			}
 
+			s.syncDVPorts(ctx, pg)
+
 			portgroups = append(portgroups, pg.Self)
 			portgroupNames = append(portgroupNames, pg.Name)
 
@@ -147,7 +157,7 @@ func (s *DistributedVirtualSwitch) AddDVPortgroupTask(ctx *Context, c *types.Add
 				ctx.Map.AppendReference(ctx, host, &host.Network, pg.Reference())
 
 				parent := ctx.Map.Get(*host.HostSystem.Parent)
-				computeNetworks := append(hostParent(&host.HostSystem).Network, pg.Reference())
+				computeNetworks := append(hostParent(ctx, &host.HostSystem).Network, pg.Reference())
 				ctx.Map.Update(parent, []types.PropertyChange{
 					{Name: "network", Val: computeNetworks},
 				})
@@ -170,25 +180,26 @@ func (s *DistributedVirtualSwitch) AddDVPortgroupTask(ctx *Context, c *types.Add
 }
 
 func (s *DistributedVirtualSwitch) ReconfigureDvsTask(ctx *Context, req *types.ReconfigureDvs_Task) soap.HasFault {
-	task := CreateTask(s, "reconfigureDvs", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, s, "reconfigureDvs", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		spec := req.Spec.GetDVSConfigSpec()
 
+		// the spec is validated in full before any change is applied
+		if err := s.validateConfig(req.Spec); err != nil {
+			return nil, err
+		}
+		if err := s.validateHostMembers(ctx, spec.Host); err != nil {
+			return nil, err
+		}
+
+		s.reconfigure(req.Spec)
+
 		members := s.Summary.HostMember
 
 		for _, member := range spec.Host {
-			h := ctx.Map.Get(member.Host)
-			if h == nil {
-				return nil, &types.ManagedObjectNotFound{Obj: member.Host}
-			}
-
-			host := h.(*HostSystem)
+			host := ctx.Map.Get(member.Host).(*HostSystem)
 
 			switch types.ConfigSpecOperation(member.Operation) {
 			case types.ConfigSpecOperationAdd:
-				if FindReference(s.Summary.HostMember, member.Host) != nil {
-					return nil, &types.AlreadyExists{Name: host.Name}
-				}
-
 				hostNetworks := append(host.Network, s.Portgroup...)
 				ctx.Map.Update(host, []types.PropertyChange{
 					{Name: "network", Val: hostNetworks},
@@ -206,7 +217,7 @@ func (s *DistributedVirtualSwitch) ReconfigureDvsTask(ctx *Context, req *types.R
 						{Name: "host", Val: pgHosts},
 					})
 
-					cr := hostParent(&host.HostSystem)
+					cr := hostParent(ctx, &host.HostSystem)
 					if FindReference(cr.Network, ref) == nil {
 						computeNetworks := append(cr.Network, ref)
 						ctx.Map.Update(parent, []types.PropertyChange{
@@ -216,24 +227,13 @@ func (s *DistributedVirtualSwitch) ReconfigureDvsTask(ctx *Context, req *types.R
 				}
 
 			case types.ConfigSpecOperationRemove:
-				for _, ref := range host.Vm {
-					vm := ctx.Map.Get(ref).(*VirtualMachine)
-					if pg := FindReference(vm.Network, s.Portgroup...); pg != nil {
-						return nil, &types.ResourceInUse{
-							Type: pg.Type,
-							Name: pg.Value,
-						}
-					}
-				}
-
 				RemoveReference(&members, member.Host)
-			case types.ConfigSpecOperationEdit:
-				return nil, &types.NotSupported{}
 			}
 		}
 
 		ctx.Map.Update(s, []types.PropertyChange{
 			{Name: "summary.hostMember", Val: members},
+			{Name: "config", Val: s.Config},
 		})
 
 		return nil, nil
@@ -246,6 +246,44 @@ func (s *DistributedVirtualSwitch) ReconfigureDvsTask(ctx *Context, req *types.R
 	}
 }
 
+// validateHostMembers validates the host member changes of a ReconfigureDvs_Task spec
+func (s *DistributedVirtualSwitch) validateHostMembers(ctx *Context, members []types.DistributedVirtualSwitchHostMemberConfigSpec) types.BaseMethodFault {
+	added := make(map[types.ManagedObjectReference]bool)
+
+	for _, member := range members {
+		host, ok := ctx.Map.Get(member.Host).(*HostSystem)
+		if !ok {
+			return &types.ManagedObjectNotFound{Obj: member.Host}
+		}
+
+		switch types.ConfigSpecOperation(member.Operation) {
+		case types.ConfigSpecOperationAdd:
+			if added[member.Host] || FindReference(s.Summary.HostMember, member.Host) != nil {
+				return &types.AlreadyExists{Name: host.Name}
+			}
+			added[member.Host] = true
+
+			if err := ctx.Map.LicenseManager().checkFeature(ctx, member.Host, "dvs"); err != nil {
+				return err
+			}
+		case types.ConfigSpecOperationRemove:
+			for _, ref := range host.Vm {
+				vm := ctx.Map.Get(ref).(*VirtualMachine)
+				if pg := FindReference(vm.Network, s.Portgroup...); pg != nil {
+					return &types.ResourceInUse{
+						Type: pg.Type,
+						Name: pg.Value,
+					}
+				}
+			}
+		case types.ConfigSpecOperationEdit:
+			return &types.NotSupported{}
+		}
+	}
+
+	return nil
+}
+
 func (s *DistributedVirtualSwitch) FetchDVPorts(req *types.FetchDVPorts) soap.HasFault {
 	body := &methods.FetchDVPortsBody{}
 	body.Res = &types.FetchDVPortsResponse{
@@ -254,8 +292,148 @@ func (s *DistributedVirtualSwitch) FetchDVPorts(req *types.FetchDVPorts) soap.Ha
 	return body
 }
 
+func (s *DistributedVirtualSwitch) ReconfigureDVPortTask(ctx *Context, req *types.ReconfigureDVPort_Task) soap.HasFault {
+	task := CreateTask(ctx, s, "reconfigureDVPort", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		for _, spec := range req.Port {
+			if err := s.reconfigureDVPort(ctx, spec); err != nil {
+				return nil, err
+			}
+		}
+
+		s.updateDVPortCount(ctx)
+
+		return nil, nil
+	})
+
+	return &methods.ReconfigureDVPort_TaskBody{
+		Res: &types.ReconfigureDVPort_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (s *DistributedVirtualSwitch) RefreshDVPortState(ctx *Context, req *types.RefreshDVPortState) soap.HasFault {
+	body := &methods.RefreshDVPortStateBody{}
+
+	ports := s.FetchDVPortsResponse.Returnval
+
+	if len(req.PortKeys) == 0 {
+		for i := range ports {
+			s.refreshDVPortState(ctx, &ports[i], nil)
+		}
+	}
+
+	for _, key := range req.PortKeys {
+		i := s.dvPortIndex(key)
+		if i == -1 {
+			body.Fault_ = Fault("", &types.NotFound{})
+			return body
+		}
+		s.refreshDVPortState(ctx, &ports[i], nil)
+	}
+
+	body.Res = new(types.RefreshDVPortStateResponse)
+	return body
+}
+
+func (s *DistributedVirtualSwitch) UpdateDVSLacpGroupConfigTask(ctx *Context, req *types.UpdateDVSLacpGroupConfig_Task) soap.HasFault {
+	task := CreateTask(ctx, s, "updateDVSLacpGroupConfig", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		config, ok := s.Config.(*types.VMwareDVSConfigInfo)
+		if !ok {
+			return nil, new(types.NotSupported)
+		}
+
+		if config.LacpApiVersion != string(types.VMwareDvsLacpApiVersionMultipleLag) {
+			return nil, &types.InvalidArgument{InvalidProperty: "lacpApiVersion"}
+		}
+
+		groups := config.LacpGroupConfig
+
+		for _, spec := range req.LacpGroupSpec {
+			group := spec.LacpGroupConfig
+
+			if err := validateLacpGroup(&group); err != nil {
+				return nil, err
+			}
+
+			index := -1
+			for i := range groups {
+				if groups[i].Key == group.Key {
+					index = i
+					break
+				}
+			}
+
+			switch types.ConfigSpecOperation(spec.Operation) {
+			case types.ConfigSpecOperationAdd:
+				if group.Name == "" {
+					return nil, &types.InvalidArgument{InvalidProperty: "lacpGroupSpec.lacpGroupConfig.name"}
+				}
+
+				next := 1
+				for i := range groups {
+					if groups[i].Name == group.Name {
+						return nil, &types.DuplicateName{Name: group.Name, Object: s.Self}
+					}
+					if n, err := strconv.Atoi(strings.TrimPrefix(groups[i].Key, "lag")); err == nil && n >= next {
+						next = n + 1
+					}
+				}
+
+				group.Key = fmt.Sprintf("lag%d", next)
+				group.UplinkName = nil
+				for i := 0; i < int(group.UplinkNum); i++ {
+					group.UplinkName = append(group.UplinkName, fmt.Sprintf("%s-%d", group.Name, i))
+				}
+				groups = append(groups, group)
+			case types.ConfigSpecOperationEdit:
+				if index == -1 {
+					return nil, new(types.NotFound)
+				}
+				if len(group.UplinkName) == 0 {
+					group.UplinkName = groups[index].UplinkName
+				}
+				groups[index] = group
+			case types.ConfigSpecOperationRemove:
+				if index == -1 {
+					return nil, new(types.NotFound)
+				}
+				groups = append(groups[:index], groups[index+1:]...)
+			default:
+				return nil, &types.InvalidArgument{InvalidProperty: "lacpGroupSpec.operation"}
+			}
+		}
+
+		config.LacpGroupConfig = groups
+
+		ctx.Map.Update(s, []types.PropertyChange{
+			{Name: "config", Val: s.Config},
+		})
+
+		return nil, nil
+	})
+
+	return &methods.UpdateDVSLacpGroupConfig_TaskBody{
+		Res: &types.UpdateDVSLacpGroupConfig_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (s *DistributedVirtualSwitch) EnableNetworkResourceManagement(ctx *Context, req *types.EnableNetworkResourceManagement) soap.HasFault {
+	s.Config.GetDVSConfigInfo().NetworkResourceManagementEnabled = types.NewBool(req.Enable)
+
+	ctx.Map.Update(s, []types.PropertyChange{
+		{Name: "config", Val: s.Config},
+	})
+
+	return &methods.EnableNetworkResourceManagementBody{
+		Res: new(types.EnableNetworkResourceManagementResponse),
+	}
+}
+
 func (s *DistributedVirtualSwitch) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
-	task := CreateTask(s, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, s, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		f := ctx.Map.getEntityParent(s, "Folder").(*Folder)
 		folderRemoveChild(ctx, &f.Folder, s.Reference())
 		return nil, nil
@@ -269,25 +447,7 @@ func (s *DistributedVirtualSwitch) DestroyTask(ctx *Context, req *types.Destroy_
 }
 
 func (s *DistributedVirtualSwitch) dvPortgroups(criteria *types.DistributedVirtualSwitchPortCriteria) []types.DistributedVirtualPort {
-	res := s.FetchDVPortsResponse.Returnval
-	if len(res) != 0 {
-		return res
-	}
-
-	for _, ref := range s.Portgroup {
-		pg := Map.Get(ref).(*DistributedVirtualPortgroup)
-
-		for _, key := range pg.PortKeys {
-			res = append(res, types.DistributedVirtualPort{
-				DvsUuid:      s.Uuid,
-				Key:          key,
-				PortgroupKey: pg.Key,
-				Config: types.DVPortConfigInfo{
-					Setting: pg.Config.DefaultPortConfig,
-				},
-			})
-		}
-	}
+	res := append([]types.DistributedVirtualPort(nil), s.FetchDVPortsResponse.Returnval...)
 
 	// filter ports by criteria
 	res = s.filterDVPorts(res, criteria)
@@ -393,3 +553,605 @@ func (s *DistributedVirtualSwitch) filterDVPortsByConnected(
 
 	return filtered
 }
+
+// validateConfig validates the settings of a ReconfigureDvs_Task spec, see reconfigure
+func (s *DistributedVirtualSwitch) validateConfig(spec types.BaseDVSConfigSpec) types.BaseMethodFault {
+	cs := spec.GetDVSConfigSpec()
+	info := s.Config.GetDVSConfigInfo()
+
+	if err := validateDVPortSetting(cs.DefaultPortConfig); err != nil {
+		return err
+	}
+
+	version := info.NetworkResourceControlVersion
+	if cs.NetworkResourceControlVersion != "" {
+		switch types.DistributedVirtualSwitchNetworkResourceControlVersion(cs.NetworkResourceControlVersion) {
+		case types.DistributedVirtualSwitchNetworkResourceControlVersionVersion2,
+			types.DistributedVirtualSwitchNetworkResourceControlVersionVersion3:
+			version = cs.NetworkResourceControlVersion
+		default:
+			return &types.InvalidArgument{InvalidProperty: "spec.networkResourceControlVersion"}
+		}
+	}
+
+	if len(cs.InfrastructureTrafficResourceConfig) != 0 {
+		if version != string(types.DistributedVirtualSwitchNetworkResourceControlVersionVersion3) {
+			return &types.InvalidArgument{InvalidProperty: "spec.infrastructureTrafficResourceConfig"}
+		}
+		if err := validateTrafficResources(cs.InfrastructureTrafficResourceConfig); err != nil {
+			return err
+		}
+	}
+
+	if vs, ok := spec.(*types.VMwareDVSConfigSpec); ok && vs.LacpApiVersion != "" {
+		switch types.VMwareDvsLacpApiVersion(vs.LacpApiVersion) {
+		case types.VMwareDvsLacpApiVersionSingleLag, types.VMwareDvsLacpApiVersionMultipleLag:
+		default:
+			return &types.InvalidArgument{InvalidProperty: "spec.lacpApiVersion"}
+		}
+	}
+
+	return nil
+}
+
+// reconfigure applies the settings of a ReconfigureDvs_Task spec, which must be validated by validateConfig
+func (s *DistributedVirtualSwitch) reconfigure(spec types.BaseDVSConfigSpec) {
+	cs := spec.GetDVSConfigSpec()
+	info := s.Config.GetDVSConfigInfo()
+
+	if cs.DefaultPortConfig != nil {
+		info.DefaultPortConfig = cs.DefaultPortConfig
+	}
+
+	if cs.NetworkResourceControlVersion != "" {
+		info.NetworkResourceControlVersion = cs.NetworkResourceControlVersion
+	}
+
+	for _, res := range cs.InfrastructureTrafficResourceConfig {
+		found := false
+		for i := range info.InfrastructureTrafficResourceConfig {
+			if info.InfrastructureTrafficResourceConfig[i].Key == res.Key {
+				info.InfrastructureTrafficResourceConfig[i] = res
+				found = true
+				break
+			}
+		}
+		if !found {
+			info.InfrastructureTrafficResourceConfig = append(info.InfrastructureTrafficResourceConfig, res)
+		}
+	}
+
+	if vs, ok := spec.(*types.VMwareDVSConfigSpec); ok && vs.LacpApiVersion != "" {
+		if config, ok := s.Config.(*types.VMwareDVSConfigInfo); ok {
+			config.LacpApiVersion = vs.LacpApiVersion
+		}
+	}
+}
+
+// findSwitch returns the DistributedVirtualSwitch with the given uuid in the datacenter, or nil if not found.
+func findSwitch(ctx *Context, dc *Datacenter, id string) *DistributedVirtualSwitch {
+	var dswitch *DistributedVirtualSwitch
+
+	var find func(types.ManagedObjectReference)
+	find = func(child types.ManagedObjectReference) {
+		s, ok := ctx.Map.Get(child).(*DistributedVirtualSwitch)
+		if ok && s.Uuid == id {
+			dswitch = s
+			return
+		}
+		walk(ctx.Map.Get(child), find)
+	}
+	walk(ctx.Map.Get(dc.NetworkFolder), find) // search in NetworkFolder and any sub folders
+
+	return dswitch
+}
+
+// dvPortIndex returns the index of the port with the given key, or -1 if not found.
+func (s *DistributedVirtualSwitch) dvPortIndex(key string) int {
+	for i := range s.FetchDVPortsResponse.Returnval {
+		if s.FetchDVPortsResponse.Returnval[i].Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// dvPortgroup returns the portgroup of this switch with the given key, or nil if not found.
+func (s *DistributedVirtualSwitch) dvPortgroup(ctx *Context, key string) *DistributedVirtualPortgroup {
+	for _, ref := range s.Portgroup {
+		pg, ok := ctx.Map.Get(ref).(*DistributedVirtualPortgroup)
+		if ok && pg.Key == key {
+			return pg
+		}
+	}
+	return nil
+}
+
+// newDVPort creates a port in the given portgroup, using the next free port key if key is empty.
+// Standalone ports are created when pg is nil.
+func (s *DistributedVirtualSwitch) newDVPort(ctx *Context, pg *DistributedVirtualPortgroup, key string) int {
+	if key == "" {
+		next := 0
+		for _, p := range s.FetchDVPortsResponse.Returnval {
+			if n, err := strconv.Atoi(p.Key); err == nil && n >= next {
+				next = n + 1
+			}
+		}
+		key = strconv.Itoa(next)
+	}
+
+	port := types.DistributedVirtualPort{
+		DvsUuid: s.Uuid,
+		Key:     key,
+		Config: types.DVPortConfigInfo{
+			ConfigVersion: "0",
+		},
+		LastStatusChange: time.Now(),
+	}
+
+	if pg != nil {
+		port.PortgroupKey = pg.Key
+		port.Config.Setting = pg.Config.DefaultPortConfig
+		ctx.Map.Update(pg, []types.PropertyChange{
+			{Name: "portKeys", Val: append(pg.PortKeys, key)},
+		})
+	} else {
+		port.Config.Setting = s.Config.GetDVSConfigInfo().DefaultPortConfig
+	}
+
+	port.State = &types.DVPortState{RuntimeInfo: &types.DVPortStatus{Blocked: dvPortBlocked(&port)}}
+
+	s.FetchDVPortsResponse.Returnval = append(s.FetchDVPortsResponse.Returnval, port)
+
+	return len(s.FetchDVPortsResponse.Returnval) - 1
+}
+
+// removeDVPort removes the port at the given index, along with its key in the portgroup.
+func (s *DistributedVirtualSwitch) removeDVPort(ctx *Context, i int) {
+	ports := s.FetchDVPortsResponse.Returnval
+	port := ports[i]
+
+	if pg := s.dvPortgroup(ctx, port.PortgroupKey); pg != nil {
+		ctx.Map.removeString(ctx, pg, &pg.PortKeys, port.Key)
+	}
+
+	s.FetchDVPortsResponse.Returnval = append(ports[:i], ports[i+1:]...)
+}
+
+// syncDVPorts creates or removes free ports such that the portgroup has Config.NumPorts ports.
+// Ephemeral portgroups only hold ports while they are connected.
+func (s *DistributedVirtualSwitch) syncDVPorts(ctx *Context, pg *DistributedVirtualPortgroup) {
+	n := int(pg.Config.NumPorts)
+	if pg.Config.Type == string(types.DistributedVirtualPortgroupPortgroupTypeEphemeral) {
+		n = 0
+	}
+
+	for i := len(pg.PortKeys); i < n; i++ {
+		s.newDVPort(ctx, pg, "")
+	}
+
+	ports := s.FetchDVPortsResponse.Returnval
+	for i := len(ports) - 1; i >= 0 && len(pg.PortKeys) > n; i-- {
+		if ports[i].PortgroupKey == pg.Key && ports[i].Connectee == nil {
+			s.removeDVPort(ctx, i)
+		}
+	}
+
+	s.updateDVPortCount(ctx)
+}
+
+// updateDVPortCount updates the switch summary and config port counts.
+func (s *DistributedVirtualSwitch) updateDVPortCount(ctx *Context) {
+	n := int32(len(s.FetchDVPortsResponse.Returnval))
+	s.Config.GetDVSConfigInfo().NumPorts = n
+
+	ctx.Map.Update(s, []types.PropertyChange{
+		{Name: "summary.numPorts", Val: n},
+	})
+}
+
+// connectDVPort connects a VM NIC or host VMkernel NIC to a port of this switch.
+// If conn.PortKey is empty, a free port is allocated from the portgroup, expanding the portgroup
+// if it is ephemeral or AutoExpand is enabled.
+// The port key and connection cookie are written back to conn.
+func (s *DistributedVirtualSwitch) connectDVPort(
+	ctx *Context,
+	connectee *types.DistributedVirtualSwitchPortConnectee,
+	host *types.ManagedObjectReference,
+	nic *types.VirtualEthernetCard,
+	conn *types.DistributedVirtualSwitchPortConnection,
+) types.BaseMethodFault {
+	var pg *DistributedVirtualPortgroup
+	if conn.PortgroupKey != "" {
+		pg = s.dvPortgroup(ctx, conn.PortgroupKey)
+		if pg == nil {
+			return &types.InvalidArgument{InvalidProperty: "port.portgroupKey"}
+		}
+	} else if conn.PortKey == "" {
+		return &types.InvalidArgument{InvalidProperty: "port.portKey"}
+	}
+
+	ephemeral := pg != nil && pg.Config.Type == string(types.DistributedVirtualPortgroupPortgroupTypeEphemeral)
+	ports := s.FetchDVPortsResponse.Returnval
+	i := -1
+
+	if conn.PortKey != "" {
+		i = s.dvPortIndex(conn.PortKey)
+		if i == -1 {
+			if !ephemeral {
+				return &types.NotFound{}
+			}
+			i = s.newDVPort(ctx, pg, conn.PortKey)
+		}
+
+		port := &s.FetchDVPortsResponse.Returnval[i]
+		if pg != nil && port.PortgroupKey != pg.Key {
+			return &types.InvalidArgument{InvalidProperty: "port.portKey"}
+		}
+		if c := port.Connectee; c != nil && !sameConnectee(c, connectee) {
+			return &types.ResourceInUse{Type: "DistributedVirtualPort", Name: port.Key}
+		}
+	} else {
+		for j := range ports {
+			if ports[j].PortgroupKey == pg.Key && ports[j].Connectee == nil {
+				i = j
+				break
+			}
+		}
+	}
+
+	if i == -1 {
+		switch {
+		case ephemeral:
+			i = s.newDVPort(ctx, pg, "")
+		case isTrue(pg.Config.AutoExpand):
+			i = s.newDVPort(ctx, pg, "")
+			pg.Config.NumPorts = int32(len(pg.PortKeys))
+			ctx.Map.Update(pg, []types.PropertyChange{
+				{Name: "config.numPorts", Val: pg.Config.NumPorts},
+			})
+		default:
+			return &types.ResourceNotAvailable{
+				ContainerType: pg.Self.Type,
+				ContainerName: pg.Name,
+				Type:          "DistributedVirtualPort",
+			}
+		}
+		s.updateDVPortCount(ctx)
+	}
+
+	port := &s.FetchDVPortsResponse.Returnval[i]
+	port.Connectee = connectee
+	port.ProxyHost = host
+	port.ConnectionCookie = rand.Int31()
+	s.refreshDVPortState(ctx, port, nic)
+
+	conn.PortKey = port.Key
+	conn.PortgroupKey = port.PortgroupKey
+	conn.ConnectionCookie = port.ConnectionCookie
+
+	return nil
+}
+
+// disconnectDVPort releases the port connected to the given connectee.
+// Ephemeral ports are removed, other ports may have their config reset to the portgroup default.
+func (s *DistributedVirtualSwitch) disconnectDVPort(ctx *Context, connectee *types.DistributedVirtualSwitchPortConnectee, conn *types.DistributedVirtualSwitchPortConnection) {
+	i := s.dvPortIndex(conn.PortKey)
+	if i == -1 {
+		return
+	}
+
+	port := &s.FetchDVPortsResponse.Returnval[i]
+	if port.Connectee == nil || !sameConnectee(port.Connectee, connectee) {
+		return
+	}
+
+	pg := s.dvPortgroup(ctx, port.PortgroupKey)
+	if pg != nil && pg.Config.Type == string(types.DistributedVirtualPortgroupPortgroupTypeEphemeral) {
+		s.removeDVPort(ctx, i)
+		s.updateDVPortCount(ctx)
+		return
+	}
+
+	port.Connectee = nil
+	port.ProxyHost = nil
+	port.ConnectionCookie = 0
+
+	if pg != nil && pg.Config.Policy != nil && pg.Config.Policy.GetDVPortgroupPolicy().PortConfigResetAtDisconnect {
+		port.Config.Setting = pg.Config.DefaultPortConfig
+	}
+
+	s.refreshDVPortState(ctx, port, nil)
+}
+
+// refreshConnectedDVPort refreshes the state of the port with the given key, if connected to the given connectee.
+func (s *DistributedVirtualSwitch) refreshConnectedDVPort(ctx *Context, connectee *types.DistributedVirtualSwitchPortConnectee, nic *types.VirtualEthernetCard, key string) {
+	i := s.dvPortIndex(key)
+	if i == -1 {
+		return
+	}
+
+	port := &s.FetchDVPortsResponse.Returnval[i]
+	if port.Connectee == nil || !sameConnectee(port.Connectee, connectee) {
+		return
+	}
+
+	s.refreshDVPortState(ctx, port, nic)
+}
+
+// reconfigureDVPort applies a single ReconfigureDVPort_Task spec.
+func (s *DistributedVirtualSwitch) reconfigureDVPort(ctx *Context, spec types.DVPortConfigSpec) types.BaseMethodFault {
+	if err := validateDVPortSetting(spec.Setting); err != nil {
+		return err
+	}
+
+	i := -1
+	if spec.Key != "" {
+		i = s.dvPortIndex(spec.Key)
+	}
+
+	switch types.ConfigSpecOperation(spec.Operation) {
+	case types.ConfigSpecOperationAdd:
+		if i != -1 {
+			return &types.AlreadyExists{Name: spec.Key}
+		}
+		i = s.newDVPort(ctx, nil, spec.Key)
+	case types.ConfigSpecOperationRemove:
+		if i == -1 {
+			return new(types.NotFound)
+		}
+		if s.FetchDVPortsResponse.Returnval[i].Connectee != nil {
+			return &types.ResourceInUse{Type: "DistributedVirtualPort", Name: spec.Key}
+		}
+		s.removeDVPort(ctx, i)
+		return nil
+	case types.ConfigSpecOperationEdit:
+		if i == -1 {
+			return new(types.NotFound)
+		}
+	default:
+		return &types.InvalidArgument{InvalidProperty: "port.operation"}
+	}
+
+	port := &s.FetchDVPortsResponse.Returnval[i]
+
+	if spec.ConfigVersion != "" && spec.ConfigVersion != port.Config.ConfigVersion {
+		return new(types.ConcurrentAccess)
+	}
+
+	if spec.Setting != nil {
+		if pg := s.dvPortgroup(ctx, port.PortgroupKey); pg != nil && pg.Config.Policy != nil {
+			policy := pg.Config.Policy.GetDVPortgroupPolicy()
+			setting := spec.Setting.GetDVPortSetting()
+			defaults := new(types.DVPortSetting)
+			if pg.Config.DefaultPortConfig != nil {
+				defaults = pg.Config.DefaultPortConfig.GetDVPortSetting()
+			}
+
+			if !policy.BlockOverrideAllowed && !reflect.DeepEqual(setting.Blocked, defaults.Blocked) {
+				return &types.InvalidArgument{InvalidProperty: "port.setting.blocked"}
+			}
+			if !policy.ShapingOverrideAllowed &&
+				(!reflect.DeepEqual(setting.InShapingPolicy, defaults.InShapingPolicy) ||
+					!reflect.DeepEqual(setting.OutShapingPolicy, defaults.OutShapingPolicy)) {
+				return &types.InvalidArgument{InvalidProperty: "port.setting.shapingPolicy"}
+			}
+		}
+		port.Config.Setting = spec.Setting
+	}
+
+	if spec.Name != "" {
+		port.Config.Name = spec.Name
+	}
+	if spec.Description != "" {
+		port.Config.Description = spec.Description
+	}
+	if spec.Scope != nil {
+		port.Config.Scope = spec.Scope
+	}
+
+	version, _ := strconv.Atoi(port.Config.ConfigVersion)
+	port.Config.ConfigVersion = strconv.Itoa(version + 1)
+
+	s.refreshDVPortState(ctx, port, nil)
+
+	return nil
+}
+
+// refreshDVPortState updates the runtime state of the given port.
+// The link is up when the port is not blocked and the connected VM is powered on with its NIC connected,
+// or the connected host is connected.
+// If nic is nil, the connected NIC is looked up in the VM's device list.
+func (s *DistributedVirtualSwitch) refreshDVPortState(ctx *Context, port *types.DistributedVirtualPort, nic *types.VirtualEthernetCard) {
+	info := &types.DVPortStatus{
+		Blocked: dvPortBlocked(port),
+		Mtu:     1500,
+	}
+
+	if c := port.Connectee; c != nil && c.ConnectedEntity != nil {
+		if vm, ok := ctx.Map.Get(*c.ConnectedEntity).(*VirtualMachine); ok {
+			if nic == nil {
+				key, _ := strconv.Atoi(c.NicKey)
+				if card, ok := object.VirtualDeviceList(vm.Config.Hardware.Device).FindByKey(int32(key)).(types.BaseVirtualEthernetCard); ok {
+					nic = card.GetVirtualEthernetCard()
+				}
+			}
+
+			connected := false
+			if nic != nil {
+				info.MacAddress = nic.MacAddress
+				connected = nic.Connectable == nil || nic.Connectable.Connected
+			}
+
+			info.LinkUp = !info.Blocked && connected && vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn
+		}
+
+		if host, ok := ctx.Map.Get(*c.ConnectedEntity).(*HostSystem); ok {
+			for _, vnic := range host.Config.Network.Vnic {
+				if vnic.Device == c.NicKey {
+					info.MacAddress = vnic.Spec.Mac
+				}
+			}
+
+			info.LinkUp = !info.Blocked && host.Runtime.ConnectionState == types.HostSystemConnectionStateConnected
+		}
+	}
+
+	if port.State == nil || port.State.RuntimeInfo == nil ||
+		port.State.RuntimeInfo.LinkUp != info.LinkUp || port.State.RuntimeInfo.Blocked != info.Blocked {
+		port.LastStatusChange = time.Now()
+	}
+
+	port.State = &types.DVPortState{RuntimeInfo: info}
+}
+
+// sameConnectee returns true if a and b refer to the same entity NIC.
+func sameConnectee(a, b *types.DistributedVirtualSwitchPortConnectee) bool {
+	if a.ConnectedEntity == nil || b.ConnectedEntity == nil {
+		return false
+	}
+	return *a.ConnectedEntity == *b.ConnectedEntity && a.NicKey == b.NicKey
+}
+
+// dvPortBlocked returns true if the port's blocked policy is set.
+func dvPortBlocked(port *types.DistributedVirtualPort) bool {
+	if port.Config.Setting == nil {
+		return false
+	}
+	if b := port.Config.Setting.GetDVPortSetting().Blocked; b != nil {
+		return isTrue(b.Value)
+	}
+	return false
+}
+
+// validateDVPortSetting validates the traffic shaping and LACP policies of the given port setting.
+func validateDVPortSetting(setting types.BaseDVPortSetting) types.BaseMethodFault {
+	if setting == nil {
+		return nil
+	}
+
+	s := setting.GetDVPortSetting()
+
+	if err := validateShapingPolicy("inShapingPolicy", s.InShapingPolicy); err != nil {
+		return err
+	}
+
+	if err := validateShapingPolicy("outShapingPolicy", s.OutShapingPolicy); err != nil {
+		return err
+	}
+
+	if vs, ok := setting.(*types.VMwareDVSPortSetting); ok && vs.LacpPolicy != nil && vs.LacpPolicy.Mode != nil {
+		switch types.VMwareUplinkLacpMode(vs.LacpPolicy.Mode.Value) {
+		case "", types.VMwareUplinkLacpModeActive, types.VMwareUplinkLacpModePassive:
+		default:
+			return &types.InvalidArgument{InvalidProperty: "setting.lacpPolicy.mode"}
+		}
+	}
+
+	return nil
+}
+
+// validateShapingPolicy checks that bandwidth and burst size values are positive,
+// and that the peak bandwidth is not below the average bandwidth.
+func validateShapingPolicy(name string, policy *types.DVSTrafficShapingPolicy) types.BaseMethodFault {
+	if policy == nil {
+		return nil
+	}
+
+	invalid := func(field string) types.BaseMethodFault {
+		return &types.InvalidArgument{InvalidProperty: "setting." + name + "." + field}
+	}
+
+	values := []struct {
+		field  string
+		policy *types.LongPolicy
+	}{
+		{"averageBandwidth", policy.AverageBandwidth},
+		{"peakBandwidth", policy.PeakBandwidth},
+		{"burstSize", policy.BurstSize},
+	}
+
+	for _, v := range values {
+		if v.policy != nil && v.policy.Value < 0 {
+			return invalid(v.field)
+		}
+	}
+
+	if policy.Enabled == nil || !isTrue(policy.Enabled.Value) {
+		return nil
+	}
+
+	for _, v := range values {
+		if v.policy == nil || v.policy.Value == 0 {
+			return invalid(v.field)
+		}
+	}
+
+	if policy.PeakBandwidth.Value < policy.AverageBandwidth.Value {
+		return invalid("peakBandwidth")
+	}
+
+	return nil
+}
+
+// validateTrafficResources validates NIOC infrastructure traffic resource allocations.
+func validateTrafficResources(resources []types.DvsHostInfrastructureTrafficResource) types.BaseMethodFault {
+	invalid := func(field string) types.BaseMethodFault {
+		return &types.InvalidArgument{InvalidProperty: "spec.infrastructureTrafficResourceConfig." + field}
+	}
+
+	for _, res := range resources {
+		if res.Key == "" {
+			return invalid("key")
+		}
+
+		a := res.AllocationInfo
+
+		if a.Limit != nil && *a.Limit < -1 {
+			return invalid("allocationInfo.limit")
+		}
+
+		if a.Reservation != nil {
+			if *a.Reservation < 0 {
+				return invalid("allocationInfo.reservation")
+			}
+			if a.Limit != nil && *a.Limit >= 0 && *a.Reservation > *a.Limit {
+				return invalid("allocationInfo.reservation")
+			}
+		}
+
+		if a.Shares != nil && a.Shares.Level == types.SharesLevelCustom && a.Shares.Shares <= 0 {
+			return invalid("allocationInfo.shares")
+		}
+	}
+
+	return nil
+}
+
+// validateLacpGroup validates a link aggregation group config, applying defaults where unset.
+func validateLacpGroup(group *types.VMwareDvsLacpGroupConfig) types.BaseMethodFault {
+	invalid := func(field string) types.BaseMethodFault {
+		return &types.InvalidArgument{InvalidProperty: "lacpGroupSpec.lacpGroupConfig." + field}
+	}
+
+	switch types.VMwareUplinkLacpMode(group.Mode) {
+	case "":
+		group.Mode = string(types.VMwareUplinkLacpModePassive)
+	case types.VMwareUplinkLacpModeActive, types.VMwareUplinkLacpModePassive:
+	default:
+		return invalid("mode")
+	}
+
+	if group.UplinkNum == 0 {
+		group.UplinkNum = 2
+	}
+	if group.UplinkNum < 1 || group.UplinkNum > 32 {
+		return invalid("uplinkNum")
+	}
+
+	if group.LoadbalanceAlgorithm == "" {
+		group.LoadbalanceAlgorithm = string(types.VMwareDvsLacpLoadBalanceAlgorithmSrcDestIpTcpUdpPortVlan)
+	}
+
+	return nil
+}
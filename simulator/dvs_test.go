@@ -24,6 +24,8 @@ import (
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -57,7 +59,7 @@ func TestDVS(t *testing.T) {
 		if len(host.Network) == 0 {
 			t.Fatalf("%s.Network=%v", ref, host.Network)
 		}
-		parent := hostParent(&host.HostSystem)
+		parent := hostParent(SpoofContext(), &host.HostSystem)
 		if len(parent.Network) != len(host.Network) {
 			t.Fatalf("%s.Network=%v", parent.Reference(), parent.Network)
 		}
@@ -204,7 +206,10 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 			&types.DistributedVirtualSwitchPortCriteria{},
 			[]types.DistributedVirtualPort{
 				{PortgroupKey: pgs[0].Value, Key: "0"},
-				{PortgroupKey: pgs[1].Value, Key: "0"},
+				{PortgroupKey: pgs[1].Value, Key: "1"},
+				{PortgroupKey: pgs[1].Value, Key: "2"},
+				{PortgroupKey: pgs[1].Value, Key: "3"},
+				{PortgroupKey: pgs[1].Value, Key: "4"},
 			},
 		},
 		{
@@ -224,7 +229,10 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 				Inside:       types.NewBool(false),
 			},
 			[]types.DistributedVirtualPort{
-				{PortgroupKey: pgs[1].Value, Key: "0"},
+				{PortgroupKey: pgs[1].Value, Key: "1"},
+				{PortgroupKey: pgs[1].Value, Key: "2"},
+				{PortgroupKey: pgs[1].Value, Key: "3"},
+				{PortgroupKey: pgs[1].Value, Key: "4"},
 			},
 		},
 		{
@@ -232,6 +240,15 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 			&types.DistributedVirtualSwitchPortCriteria{
 				PortKey: []string{"1"},
 			},
+			[]types.DistributedVirtualPort{
+				{PortgroupKey: pgs[1].Value, Key: "1"},
+			},
+		},
+		{
+			"unknown PortKeys",
+			&types.DistributedVirtualSwitchPortCriteria{
+				PortKey: []string{"5"},
+			},
 			[]types.DistributedVirtualPort{},
 		},
 		{
@@ -239,7 +256,12 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 			&types.DistributedVirtualSwitchPortCriteria{
 				Connected: types.NewBool(true),
 			},
-			[]types.DistributedVirtualPort{},
+			[]types.DistributedVirtualPort{
+				{PortgroupKey: pgs[1].Value, Key: "1"},
+				{PortgroupKey: pgs[1].Value, Key: "2"},
+				{PortgroupKey: pgs[1].Value, Key: "3"},
+				{PortgroupKey: pgs[1].Value, Key: "4"},
+			},
 		},
 		{
 			"not connected",
@@ -248,7 +270,6 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 			},
 			[]types.DistributedVirtualPort{
 				{PortgroupKey: pgs[0].Value, Key: "0"},
-				{PortgroupKey: pgs[1].Value, Key: "0"},
 			},
 		},
 	}
@@ -279,3 +300,290 @@ func TestFetchDVPortsCriteria(t *testing.T) {
 		})
 	}
 }
+
+func TestDVPortLifecycle(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		net, err := finder.Network(ctx, "DVS0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		dvs := net.(*object.DistributedVirtualSwitch)
+
+		vm0, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		vm1, err := finder.VirtualMachine(ctx, "DC0_H0_VM1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fault := func(err error) types.BaseMethodFault {
+			if terr, ok := err.(task.Error); ok {
+				return terr.Fault()
+			}
+			return nil
+		}
+
+		dtask, err := dvs.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{
+			{Name: "early", Type: string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding), NumPorts: 1},
+			{Name: "ephemeral", Type: string(types.DistributedVirtualPortgroupPortgroupTypeEphemeral)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = dtask.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		addNIC := func(vm *object.VirtualMachine, name string) error {
+			net, err := finder.Network(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			backing, err := net.EthernetCardBackingInfo(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nic, _ := object.EthernetCardTypes().CreateEthernetCard("", backing)
+			return vm.AddDevice(ctx, nic)
+		}
+
+		ports := func(name string) []types.DistributedVirtualPort {
+			net, err := finder.Network(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ports, err := dvs.FetchDVPorts(ctx, &types.DistributedVirtualSwitchPortCriteria{
+				PortgroupKey: []string{net.Reference().Value},
+				Inside:       types.NewBool(true),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return ports
+		}
+
+		// early binding: the port is bound to the NIC
+		if err = addNIC(vm0, "early"); err != nil {
+			t.Fatal(err)
+		}
+
+		p := ports("early")
+		if len(p) != 1 {
+			t.Fatalf("ports=%d", len(p))
+		}
+		port := p[0]
+		if port.Connectee == nil || *port.Connectee.ConnectedEntity != vm0.Reference() ||
+			port.Connectee.Type != string(types.DistributedVirtualSwitchPortConnecteeConnecteeTypeVmVnic) {
+			t.Errorf("connectee=%#v", port.Connectee)
+		}
+
+		devices, err := vm0.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, d := range devices.SelectByType((*types.VirtualEthernetCard)(nil)) {
+			b, ok := d.GetVirtualDevice().Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo)
+			if ok && b.Port.PortKey == port.Key && b.Port.PortgroupKey == port.PortgroupKey {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("no NIC backing for port %s", port.Key)
+		}
+
+		// early binding: numPorts exhausted
+		err = addNIC(vm1, "early")
+		if _, ok := fault(err).(*types.ResourceNotAvailable); !ok {
+			t.Errorf("expected ResourceNotAvailable, got %v", err)
+		}
+
+		// ephemeral: ports are created on connect and removed on disconnect
+		if err = addNIC(vm1, "ephemeral"); err != nil {
+			t.Fatal(err)
+		}
+		if p = ports("ephemeral"); len(p) != 1 {
+			t.Fatalf("ports=%d", len(p))
+		}
+
+		devices, err = vm1.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, d := range devices.SelectByType((*types.VirtualEthernetCard)(nil)) {
+			b, ok := d.GetVirtualDevice().Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo)
+			if ok && b.Port.PortKey == p[0].Key {
+				if err = vm1.RemoveDevice(ctx, false, d); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		if p = ports("ephemeral"); len(p) != 0 {
+			t.Errorf("ports=%d", len(p))
+		}
+
+		// blocked ports are link down
+		block := func(blocked bool) {
+			dtask, err := dvs.ReconfigureDVPort(ctx, []types.DVPortConfigSpec{{
+				Operation: string(types.ConfigSpecOperationEdit),
+				Key:       port.Key,
+				Setting: &types.VMwareDVSPortSetting{
+					DVPortSetting: types.DVPortSetting{
+						Blocked: &types.BoolPolicy{Value: types.NewBool(blocked)},
+					},
+				},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = dtask.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if err = dvs.RefreshDVPortState(ctx, []string{port.Key}); err != nil {
+				t.Fatal(err)
+			}
+
+			info := ports("early")[0].State.RuntimeInfo
+			if info.Blocked != blocked || info.LinkUp == blocked {
+				t.Errorf("blocked=%t, state=%#v", blocked, info)
+			}
+		}
+
+		block(true)
+		block(false)
+
+		// the link is down while the VM is powered off
+		power := func(on bool) {
+			ptask, err := vm0.PowerOff(ctx)
+			if on {
+				ptask, err = vm0.PowerOn(ctx)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = ptask.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			info := ports("early")[0].State.RuntimeInfo
+			if info.LinkUp != on {
+				t.Errorf("on=%t, state=%#v", on, info)
+			}
+		}
+
+		power(false)
+		power(true)
+
+		// traffic shaping validation
+		dtask, err = dvs.Reconfigure(ctx, &types.VMwareDVSConfigSpec{
+			DVSConfigSpec: types.DVSConfigSpec{
+				DefaultPortConfig: &types.VMwareDVSPortSetting{
+					DVPortSetting: types.DVPortSetting{
+						InShapingPolicy: &types.DVSTrafficShapingPolicy{
+							Enabled:          &types.BoolPolicy{Value: types.NewBool(true)},
+							AverageBandwidth: &types.LongPolicy{Value: 1000},
+							PeakBandwidth:    &types.LongPolicy{Value: 100},
+							BurstSize:        &types.LongPolicy{Value: 100},
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := fault(dtask.Wait(ctx)).(*types.InvalidArgument); !ok {
+			t.Error("expected InvalidArgument")
+		}
+
+		// NIOC validation
+		dtask, err = dvs.Reconfigure(ctx, &types.VMwareDVSConfigSpec{
+			DVSConfigSpec: types.DVSConfigSpec{
+				NetworkResourceControlVersion: string(types.DistributedVirtualSwitchNetworkResourceControlVersionVersion3),
+				InfrastructureTrafficResourceConfig: []types.DvsHostInfrastructureTrafficResource{{
+					Key: "vmotion",
+					AllocationInfo: types.DvsHostInfrastructureTrafficResourceAllocation{
+						Limit:       types.NewInt64(100),
+						Reservation: types.NewInt64(200),
+					},
+				}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := fault(dtask.Wait(ctx)).(*types.InvalidArgument); !ok {
+			t.Error("expected InvalidArgument")
+		}
+
+		// settings are not applied when any part of the spec is invalid
+		var before mo.DistributedVirtualSwitch
+		if err = dvs.Properties(ctx, dvs.Reference(), []string{"config", "summary"}, &before); err != nil {
+			t.Fatal(err)
+		}
+		version := string(types.DistributedVirtualSwitchNetworkResourceControlVersionVersion2)
+		if before.Config.GetDVSConfigInfo().NetworkResourceControlVersion == version {
+			version = string(types.DistributedVirtualSwitchNetworkResourceControlVersionVersion3)
+		}
+		dtask, err = dvs.Reconfigure(ctx, &types.VMwareDVSConfigSpec{
+			DVSConfigSpec: types.DVSConfigSpec{
+				NetworkResourceControlVersion: version,
+				Host: []types.DistributedVirtualSwitchHostMemberConfigSpec{{
+					Operation: string(types.ConfigSpecOperationEdit),
+					Host:      before.Summary.HostMember[0],
+				}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := fault(dtask.Wait(ctx)).(*types.NotSupported); !ok {
+			t.Error("expected NotSupported")
+		}
+		var after mo.DistributedVirtualSwitch
+		if err = dvs.Properties(ctx, dvs.Reference(), []string{"config"}, &after); err != nil {
+			t.Fatal(err)
+		}
+		if after.Config.GetDVSConfigInfo().NetworkResourceControlVersion == version {
+			t.Errorf("networkResourceControlVersion=%s", version)
+		}
+
+		// LACP validation
+		lag := types.VMwareDvsLacpGroupSpec{
+			Operation: string(types.ConfigSpecOperationAdd),
+			LacpGroupConfig: types.VMwareDvsLacpGroupConfig{
+				Name: "lag",
+				Mode: "invalid",
+			},
+		}
+		dtask, err = dvs.ReconfigureLACP(ctx, []types.VMwareDvsLacpGroupSpec{lag})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := fault(dtask.Wait(ctx)).(*types.InvalidArgument); !ok {
+			t.Error("expected InvalidArgument")
+		}
+
+		lag.LacpGroupConfig.Mode = string(types.VMwareUplinkLacpModeActive)
+		dtask, err = dvs.ReconfigureLACP(ctx, []types.VMwareDvsLacpGroupSpec{lag})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = dtask.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		var s mo.DistributedVirtualSwitch
+		if err = dvs.Properties(ctx, dvs.Reference(), []string{"config"}, &s); err != nil {
+			t.Fatal(err)
+		}
+		groups := s.Config.(*types.VMwareDVSConfigInfo).LacpGroupConfig
+		if len(groups) != 1 || groups[0].Key != "lag1" || len(groups[0].UplinkName) != 2 {
+			t.Errorf("lacp=%#v", groups)
+		}
+	})
+}
@@ -24,7 +24,7 @@ import (
 )
 
 func RenameTask(ctx *Context, e mo.Entity, r *types.Rename_Task, dup ...bool) soap.HasFault {
-	task := CreateTask(e, "rename", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, e, "rename", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		obj := ctx.Map.Get(r.This).(mo.Entity).Entity()
 
 		canDup := len(dup) == 1 && dup[0]
@@ -17,6 +17,7 @@ limitations under the License.
 package simulator
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/vmware/govmomi/simulator/esx"
@@ -32,39 +33,302 @@ type EnvironmentBrowser struct {
 	types.QueryConfigOptionResponse
 }
 
-func newEnvironmentBrowser() *types.ManagedObjectReference {
+func newEnvironmentBrowser(ctx *Context) *types.ManagedObjectReference {
 	env := new(EnvironmentBrowser)
-	Map.Put(env)
+	ctx.Map.Put(env)
 	return &env.Self
 }
 
 func (b *EnvironmentBrowser) hosts(ctx *Context) []types.ManagedObjectReference {
-	ctx.Map.m.Lock()
-	defer ctx.Map.m.Unlock()
-	for _, obj := range ctx.Map.objects {
-		switch e := obj.(type) {
-		case *mo.ComputeResource:
-			if b.Self == *e.EnvironmentBrowser {
-				return e.Host
-			}
-		case *ClusterComputeResource:
-			if b.Self == *e.EnvironmentBrowser {
-				return e.Host
-			}
+	ctx.Map.m.RLock()
+	defer ctx.Map.m.RUnlock()
+	for _, obj := range ctx.Map.kinds["ComputeResource"] {
+		if e, ok := obj.(*mo.ComputeResource); ok && b.Self == *e.EnvironmentBrowser {
+			return e.Host
+		}
+	}
+	for _, obj := range ctx.Map.kinds["ClusterComputeResource"] {
+		if e, ok := obj.(*ClusterComputeResource); ok && b.Self == *e.EnvironmentBrowser {
+			return e.Host
 		}
 	}
 	return nil
 }
 
-func (b *EnvironmentBrowser) QueryConfigOption(req *types.QueryConfigOption) soap.HasFault {
-	body := new(methods.QueryConfigOptionBody)
+// hardwareVersion describes a virtual machine hardware version and the ESX release that introduced it
+type hardwareVersion struct {
+	key         string
+	description string
+	esx         string // minimum host product version
+	maxCPUs     int32
+	maxMemoryMB int64
+}
+
+// hardwareVersions supported by the simulator, oldest first.
+// Note that ESX 6.7 U2 reports product version 6.7.0, the same as 6.7 GA.
+var hardwareVersions = []hardwareVersion{
+	{"vmx-8", "ESXi 5.0 virtual machine", "5.0.0", 32, 1011 * 1024},
+	{"vmx-9", "ESXi 5.1 virtual machine", "5.1.0", 64, 1011 * 1024},
+	{"vmx-10", "ESXi 5.5 virtual machine", "5.5.0", 64, 4080 * 1024},
+	{"vmx-11", "ESXi 6.0 virtual machine", "6.0.0", 128, 4080 * 1024},
+	{"vmx-13", "ESXi 6.5 virtual machine", "6.5.0", 128, 6128 * 1024},
+	{"vmx-14", "ESXi 6.7 virtual machine", "6.7.0", 128, 6128 * 1024},
+	{"vmx-15", "ESXi 6.7 U2 virtual machine", "6.7.0", 256, 6128 * 1024},
+	{"vmx-17", "ESXi 7.0 virtual machine", "7.0.0", 256, 6128 * 1024},
+	{"vmx-18", "ESXi 7.0 U1 virtual machine", "7.0.1", 768, 24560 * 1024},
+	{"vmx-19", "ESXi 7.0 U2 virtual machine", "7.0.2", 768, 24560 * 1024},
+	{"vmx-20", "ESXi 8.0 virtual machine", "8.0.0", 768, 24560 * 1024},
+	{"vmx-21", "ESXi 8.0 U2 virtual machine", "8.0.2", 768, 24560 * 1024},
+}
+
+// guestMinHardwareVersion is the minimum hardware version for guest IDs added after vmx-8
+var guestMinHardwareVersion = map[types.VirtualMachineGuestOsIdentifier]int32{
+	types.VirtualMachineGuestOsIdentifierWindows9_64Guest:           10,
+	types.VirtualMachineGuestOsIdentifierWindows9Guest:              10,
+	types.VirtualMachineGuestOsIdentifierWindows9Server64Guest:      10,
+	types.VirtualMachineGuestOsIdentifierWindows2019srv_64Guest:     14,
+	types.VirtualMachineGuestOsIdentifierWindows2019srvNext_64Guest: 17,
+	types.VirtualMachineGuestOsIdentifierOther4xLinuxGuest:          13,
+	types.VirtualMachineGuestOsIdentifierOther4xLinux64Guest:        13,
+	types.VirtualMachineGuestOsIdentifierOther5xLinuxGuest:          17,
+	types.VirtualMachineGuestOsIdentifierOther5xLinux64Guest:        17,
+	types.VirtualMachineGuestOsIdentifierVmkernel7Guest:             17,
+	types.VirtualMachineGuestOsIdentifierDebian11Guest:              17,
+	types.VirtualMachineGuestOsIdentifierDebian11_64Guest:           17,
+	types.VirtualMachineGuestOsIdentifierFreebsd13Guest:             18,
+	types.VirtualMachineGuestOsIdentifierFreebsd13_64Guest:          18,
+	types.VirtualMachineGuestOsIdentifierDarwin20_64Guest:           18,
+	types.VirtualMachineGuestOsIdentifierDarwin21_64Guest:           19,
+	types.VirtualMachineGuestOsIdentifierRhel9_64Guest:              19,
+	types.VirtualMachineGuestOsIdentifierSles16_64Guest:             19,
+	types.VirtualMachineGuestOsIdentifierAmazonlinux3_64Guest:       19,
+}
+
+func (v *hardwareVersion) number() int32 {
+	n, _ := strconv.Atoi(strings.TrimPrefix(v.key, "vmx-"))
+	return int32(n)
+}
+
+// compareVersion compares dotted version strings, returning -1, 0 or 1
+func compareVersion(a, b string) int {
+	x, y := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(x) || i < len(y); i++ {
+		var m, n int
+		if i < len(x) {
+			m, _ = strconv.Atoi(x[i])
+		}
+		if i < len(y) {
+			n, _ = strconv.Atoi(y[i])
+		}
+		switch {
+		case m < n:
+			return -1
+		case m > n:
+			return 1
+		}
+	}
+	return 0
+}
+
+// supports returns true if the given host can create and run VMs with this hardware version
+func (v *hardwareVersion) supports(host *HostSystem) bool {
+	return compareVersion(host.Config.Product.Version, v.esx) >= 0
+}
+
+func findHardwareVersion(key string) *hardwareVersion {
+	if key == "" {
+		key = esx.HardwareVersion
+	}
+	for i := range hardwareVersions {
+		if hardwareVersions[i].key == key {
+			return &hardwareVersions[i]
+		}
+	}
+	return nil
+}
 
-	opt := b.QueryConfigOptionResponse.Returnval
-	if opt == nil {
-		opt = &types.VirtualMachineConfigOption{
-			Version:       esx.HardwareVersion,
-			DefaultDevice: esx.VirtualDevice,
+// host returns the given host, or the first host of the EnvironmentBrowser's compute resource
+func (b *EnvironmentBrowser) host(ctx *Context, ref *types.ManagedObjectReference) (*HostSystem, types.BaseMethodFault) {
+	if ref == nil {
+		hosts := b.hosts(ctx)
+		if len(hosts) == 0 {
+			return nil, nil
 		}
+		ref = &hosts[0]
+	}
+
+	host, ok := ctx.Map.Get(*ref).(*HostSystem)
+	if !ok {
+		return nil, &types.ManagedObjectNotFound{Obj: *ref}
+	}
+	return host, nil
+}
+
+func guestOsDescriptor(id string, v *hardwareVersion) types.GuestOsDescriptor {
+	family := guestFamily(id)
+
+	desc := types.GuestOsDescriptor{
+		Id:                    id,
+		Family:                family,
+		SupportedMaxCPUs:      v.maxCPUs,
+		SupportedMinMemMB:     4,
+		SupportedMaxMemMB:     int32(v.maxMemoryMB),
+		RecommendedMemMB:      1024,
+		RecommendedDiskSizeMB: 8 * 1024,
+		RecommendedFirmware:   string(types.GuestOsDescriptorFirmwareTypeBios),
+		SupportedFirmware: []string{
+			string(types.GuestOsDescriptorFirmwareTypeBios),
+			string(types.GuestOsDescriptorFirmwareTypeEfi),
+		},
+	}
+
+	switch family {
+	case string(types.VirtualMachineGuestOsFamilyWindowsGuest):
+		desc.RecommendedMemMB = 4096
+		desc.RecommendedDiskSizeMB = 40 * 1024
+	case string(types.VirtualMachineGuestOsFamilyLinuxGuest):
+		desc.RecommendedMemMB = 2048
+		desc.RecommendedDiskSizeMB = 16 * 1024
+	case string(types.VirtualMachineGuestOsFamilyDarwinGuestFamily):
+		desc.RecommendedMemMB = 4096
+		desc.RecommendedDiskSizeMB = 40 * 1024
+		desc.RecommendedFirmware = string(types.GuestOsDescriptorFirmwareTypeEfi)
+	}
+
+	return desc
+}
+
+// configOption returns the VirtualMachineConfigOption for the given hardware version
+func configOption(v *hardwareVersion) *types.VirtualMachineConfigOption {
+	hw := v.number()
+
+	opt := &types.VirtualMachineConfigOption{
+		Version:     v.key,
+		Description: v.description,
+		HardwareOptions: types.VirtualHardwareOption{
+			HwVersion: hw,
+			NumCoresPerSocket: &types.IntOption{
+				Min:          1,
+				Max:          v.maxCPUs,
+				DefaultValue: 1,
+			},
+			MemoryMB: types.LongOption{
+				Min:          4,
+				Max:          v.maxMemoryMB,
+				DefaultValue: 32,
+			},
+			NumPCIControllers: types.IntOption{Min: 1, Max: 1, DefaultValue: 1},
+			NumIDEControllers: types.IntOption{Min: 2, Max: 2, DefaultValue: 2},
+			NumUSBControllers: types.IntOption{Min: 0, Max: 1, DefaultValue: 0},
+			NumSIOControllers: types.IntOption{Min: 1, Max: 1, DefaultValue: 1},
+			NumPS2Controllers: types.IntOption{Min: 1, Max: 1, DefaultValue: 1},
+			NumUSBXHCIControllers: &types.IntOption{
+				Min: 0, Max: 1, DefaultValue: 0,
+			},
+		},
+		Capabilities: types.VirtualMachineCapability{
+			SnapshotOperationsSupported:            true,
+			MultipleSnapshotsSupported:             true,
+			SnapshotConfigSupported:                true,
+			PoweredOffSnapshotsSupported:           true,
+			MemorySnapshotsSupported:               true,
+			RevertToSnapshotSupported:              true,
+			QuiescedSnapshotsSupported:             true,
+			ConsolePreferencesSupported:            true,
+			CpuFeatureMaskSupported:                true,
+			S1AcpiManagementSupported:              true,
+			SettingScreenResolutionSupported:       true,
+			ToolsAutoUpdateSupported:               true,
+			VmNpivWwnSupported:                     true,
+			NpivWwnOnNonRdmVmSupported:             true,
+			SwapPlacementSupported:                 true,
+			ToolsSyncTimeSupported:                 true,
+			VirtualMmuUsageSupported:               true,
+			DiskSharesSupported:                    true,
+			BootOptionsSupported:                   true,
+			SettingVideoRamSizeSupported:           true,
+			SettingDisplayTopologySupported:        types.NewBool(true),
+			RecordReplaySupported:                  types.NewBool(false),
+			ChangeTrackingSupported:                types.NewBool(true),
+			MultipleCoresPerSocketSupported:        types.NewBool(true),
+			HostBasedReplicationSupported:          types.NewBool(true),
+			GuestAutoLockSupported:                 types.NewBool(true),
+			MemoryReservationLockSupported:         types.NewBool(true),
+			FeatureRequirementSupported:            types.NewBool(true),
+			PoweredOnMonitorTypeChangeSupported:    types.NewBool(true),
+			SeSparseDiskSupported:                  types.NewBool(true),
+			NestedHVSupported:                      types.NewBool(true),
+			VPMCSupported:                          types.NewBool(true),
+			SecureBootSupported:                    types.NewBool(hw >= 13),
+			PerVmEvcSupported:                      types.NewBool(hw >= 14),
+			VirtualMmuUsageIgnored:                 types.NewBool(true),
+			VirtualExecUsageIgnored:                types.NewBool(true),
+			DiskOnlySnapshotOnSuspendedVMSupported: types.NewBool(true),
+		},
+		DefaultDevice:                    esx.VirtualDevice,
+		SupportedMonitorType:             []string{"release", "debug", "stats"},
+		SupportedOvfEnvironmentTransport: []string{"com.vmware.guestInfo", "iso"},
+		SupportedOvfInstallTransport:     []string{"com.vmware.guestInfo"},
+	}
+
+	for i := int32(1); i <= v.maxCPUs; i++ {
+		opt.HardwareOptions.NumCPU = append(opt.HardwareOptions.NumCPU, i)
+	}
+
+	if hw >= 14 {
+		opt.HardwareOptions.NumTPMDevices = &types.IntOption{Min: 0, Max: 1}
+		opt.HardwareOptions.NumNVDIMMControllers = &types.IntOption{Min: 0, Max: 1}
+	}
+	if hw >= 17 {
+		opt.HardwareOptions.NumWDTDevices = &types.IntOption{Min: 0, Max: 1}
+		opt.HardwareOptions.NumPrecisionClockDevices = &types.IntOption{Min: 0, Max: 1}
+		opt.HardwareOptions.EpcMemoryMB = &types.LongOption{Min: 0, Max: 65536}
+	}
+
+	for _, id := range GuestID {
+		if min, ok := guestMinHardwareVersion[id]; ok && hw < min {
+			continue
+		}
+		opt.GuestOSDescriptor = append(opt.GuestOSDescriptor, guestOsDescriptor(string(id), v))
+	}
+
+	return opt
+}
+
+// queryConfigOption returns the config option for the given key and host.
+// The Returnval field of the embedded QueryConfigOptionResponse can be set to override the default config option.
+func (b *EnvironmentBrowser) queryConfigOption(ctx *Context, key string, ref *types.ManagedObjectReference) (*types.VirtualMachineConfigOption, types.BaseMethodFault) {
+	if opt := b.QueryConfigOptionResponse.Returnval; opt != nil {
+		clone := *opt
+		return &clone, nil
+	}
+
+	v := findHardwareVersion(key)
+	if v == nil {
+		return nil, &types.InvalidArgument{InvalidProperty: "key"}
+	}
+
+	host, fault := b.host(ctx, ref)
+	if fault != nil {
+		return nil, fault
+	}
+	if host != nil && !v.supports(host) {
+		return nil, &types.VirtualHardwareVersionNotSupported{
+			HostName: host.Name,
+			Host:     host.Self,
+		}
+	}
+
+	return configOption(v), nil
+}
+
+func (b *EnvironmentBrowser) QueryConfigOption(ctx *Context, req *types.QueryConfigOption) soap.HasFault {
+	body := new(methods.QueryConfigOptionBody)
+
+	opt, fault := b.queryConfigOption(ctx, req.Key, req.Host)
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
 	}
 
 	body.Res = &types.QueryConfigOptionResponse{
@@ -87,42 +351,40 @@ func guestFamily(id string) string {
 	}
 }
 
-func (b *EnvironmentBrowser) QueryConfigOptionEx(req *types.QueryConfigOptionEx) soap.HasFault {
+func (b *EnvironmentBrowser) QueryConfigOptionEx(ctx *Context, req *types.QueryConfigOptionEx) soap.HasFault {
 	body := new(methods.QueryConfigOptionExBody)
 
-	opt := b.QueryConfigOptionResponse.Returnval
-	if opt == nil {
-		opt = &types.VirtualMachineConfigOption{
-			Version:       esx.HardwareVersion,
-			DefaultDevice: esx.VirtualDevice,
-		}
+	spec := req.Spec
+	if spec == nil {
+		spec = new(types.EnvironmentBrowserConfigOptionQuerySpec)
 	}
 
-	if req.Spec != nil {
-		// From the SDK QueryConfigOptionEx doc:
-		// "If guestId is nonempty, the guestOSDescriptor array of the config option is filtered to match against the guest IDs in the spec.
-		//  If there is no match, the whole list is returned."
-		for _, id := range req.Spec.GuestId {
-			for _, gid := range GuestID {
-				if string(gid) == id {
-					opt.GuestOSDescriptor = []types.GuestOsDescriptor{{
-						Id:     id,
-						Family: guestFamily(id),
-					}}
+	opt, fault := b.queryConfigOption(ctx, spec.Key, spec.Host)
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
 
-					break
-				}
+	// From the SDK QueryConfigOptionEx doc:
+	// "If guestId is nonempty, the guestOSDescriptor array of the config option is filtered to match against the guest IDs in the spec.
+	//  If there is no match, the whole list is returned."
+	var guests []types.GuestOsDescriptor
+	for _, id := range spec.GuestId {
+		for _, desc := range opt.GuestOSDescriptor {
+			if desc.Id == id {
+				guests = append(guests, desc)
+				break
 			}
 		}
 	}
+	if len(guests) != 0 {
+		opt.GuestOSDescriptor = guests
+	}
 
 	if len(opt.GuestOSDescriptor) == 0 {
+		v := findHardwareVersion("")
 		for i := range GuestID {
-			id := string(GuestID[i])
-			opt.GuestOSDescriptor = append(opt.GuestOSDescriptor, types.GuestOsDescriptor{
-				Id:     id,
-				Family: guestFamily(id),
-			})
+			opt.GuestOSDescriptor = append(opt.GuestOSDescriptor, guestOsDescriptor(string(GuestID[i]), v))
 		}
 	}
 
@@ -138,19 +400,63 @@ func (b *EnvironmentBrowser) QueryConfigOptionDescriptor(ctx *Context, req *type
 		Res: new(types.QueryConfigOptionDescriptorResponse),
 	}
 
-	body.Res.Returnval = []types.VirtualMachineConfigOptionDescriptor{{
-		Key:                 esx.HardwareVersion,
-		Description:         esx.HardwareVersion,
-		Host:                b.hosts(ctx),
-		CreateSupported:     types.NewBool(true),
-		DefaultConfigOption: types.NewBool(false),
-		RunSupported:        types.NewBool(true),
-		UpgradeSupported:    types.NewBool(true),
-	}}
+	hosts := b.hosts(ctx)
+
+	for i := range hardwareVersions {
+		v := &hardwareVersions[i]
+		desc := types.VirtualMachineConfigOptionDescriptor{
+			Key:                 v.key,
+			Description:         v.description,
+			DefaultConfigOption: types.NewBool(v.key == esx.HardwareVersion),
+		}
+
+		for _, ref := range hosts {
+			if host, ok := ctx.Map.Get(ref).(*HostSystem); ok && v.supports(host) {
+				desc.Host = append(desc.Host, ref)
+			}
+		}
+
+		supported := len(desc.Host) != 0
+		desc.CreateSupported = types.NewBool(supported)
+		desc.RunSupported = types.NewBool(supported)
+		desc.UpgradeSupported = types.NewBool(supported)
+
+		body.Res.Returnval = append(body.Res.Returnval, desc)
+	}
 
 	return body
 }
 
+// pciPassthrough returns the host's PCI devices that are enabled and active for passthrough
+func pciPassthrough(host *HostSystem) []types.BaseVirtualMachinePciPassthroughInfo {
+	var info []types.BaseVirtualMachinePciPassthroughInfo
+	if host.Config == nil || host.Hardware == nil {
+		return nil
+	}
+
+	for _, p := range host.Config.PciPassthruInfo {
+		pt := p.GetHostPciPassthruInfo()
+		if !pt.PassthruEnabled || !pt.PassthruActive {
+			continue
+		}
+
+		for _, dev := range host.Hardware.PciDevice {
+			if dev.Id != pt.Id {
+				continue
+			}
+			info = append(info, &types.VirtualMachinePciPassthroughInfo{
+				VirtualMachineTargetInfo: types.VirtualMachineTargetInfo{
+					Name: dev.DeviceName,
+				},
+				PciDevice: dev,
+				SystemId:  host.Hardware.SystemInfo.Uuid,
+			})
+		}
+	}
+
+	return info
+}
+
 func (b *EnvironmentBrowser) QueryConfigTarget(ctx *Context, req *types.QueryConfigTarget) soap.HasFault {
 	body := &methods.QueryConfigTargetBody{
 		Res: &types.QueryConfigTargetResponse{
@@ -171,11 +477,29 @@ func (b *EnvironmentBrowser) QueryConfigTarget(ctx *Context, req *types.QueryCon
 	seen := make(map[types.ManagedObjectReference]bool)
 
 	for i := range hosts {
-		host := ctx.Map.Get(hosts[i]).(*HostSystem)
+		host, ok := ctx.Map.Get(hosts[i]).(*HostSystem)
+		if !ok {
+			body.Res = nil
+			body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: hosts[i]})
+			return body
+		}
 		target.NumCpus += int32(host.Summary.Hardware.NumCpuPkgs)
 		target.NumCpuCores += int32(host.Summary.Hardware.NumCpuCores)
 		target.NumNumaNodes++
 
+		if threads := int32(host.Summary.Hardware.NumCpuThreads); threads > target.MaxCpusPerHost {
+			target.MaxCpusPerHost = threads
+		}
+		if mem := int32(host.Summary.Hardware.MemorySize / (1024 * 1024)); mem > target.SupportedMaxMemMB {
+			target.SupportedMaxMemMB = mem
+			target.MaxMemMBOptimalPerf = mem
+		}
+		if host.Hardware != nil && host.Hardware.SmcPresent != nil && *host.Hardware.SmcPresent {
+			target.SmcPresent = types.NewBool(true)
+		}
+
+		target.PciPassthrough = append(target.PciPassthrough, pciPassthrough(host)...)
+
 		for _, ref := range host.Datastore {
 			if seen[ref] {
 				continue
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestEnvironmentBrowser(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		var host *HostSystem
+		for _, e := range Map.All("HostSystem") {
+			if e.Entity().Parent.Type == "ComputeResource" {
+				host = e.(*HostSystem) // standalone host
+			}
+		}
+		cr := Map.Get(*host.Parent).(*mo.ComputeResource)
+		env := *cr.EnvironmentBrowser
+
+		descriptors := func() map[string]types.VirtualMachineConfigOptionDescriptor {
+			res, err := methods.QueryConfigOptionDescriptor(ctx, c, &types.QueryConfigOptionDescriptor{This: env})
+			if err != nil {
+				t.Fatal(err)
+			}
+			m := make(map[string]types.VirtualMachineConfigOptionDescriptor)
+			for _, d := range res.Returnval {
+				m[d.Key] = d
+			}
+			return m
+		}
+
+		desc := descriptors()
+		for _, key := range []string{"vmx-13", "vmx-17", "vmx-19", "vmx-20"} {
+			if _, ok := desc[key]; !ok {
+				t.Errorf("missing %s", key)
+			}
+		}
+		if !*desc[esx.HardwareVersion].DefaultConfigOption {
+			t.Errorf("%s is not the default", esx.HardwareVersion)
+		}
+		if len(desc["vmx-13"].Host) != 1 || !*desc["vmx-13"].CreateSupported {
+			t.Errorf("vmx-13=%#v", desc["vmx-13"])
+		}
+		if len(desc["vmx-14"].Host) != 0 || *desc["vmx-14"].CreateSupported {
+			t.Errorf("vmx-14=%#v", desc["vmx-14"])
+		}
+
+		_, err := methods.QueryConfigOption(ctx, c, &types.QueryConfigOption{This: env, Key: "vmx-14"})
+		if fault, ok := soap.ToSoapFault(err).VimFault().(types.VirtualHardwareVersionNotSupported); !ok || fault.Host != host.Self {
+			t.Errorf("err=%v", err)
+		}
+
+		_, err = methods.QueryConfigOption(ctx, c, &types.QueryConfigOption{This: env, Key: "vmx-99"})
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		// host upgrade enables the newer hardware versions
+		host.Config.Product.Version = "7.0.2"
+		desc = descriptors()
+		if len(desc["vmx-19"].Host) != 1 || len(desc["vmx-20"].Host) != 0 {
+			t.Errorf("vmx-19=%#v", desc["vmx-19"])
+		}
+
+		opt, err := methods.QueryConfigOption(ctx, c, &types.QueryConfigOption{This: env, Key: "vmx-19"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		hw := opt.Returnval.HardwareOptions
+		if hw.HwVersion != 19 || hw.NumCPU[len(hw.NumCPU)-1] != 768 {
+			t.Errorf("hw=%d, cpus=%d", hw.HwVersion, len(hw.NumCPU))
+		}
+
+		vmx11 := 0
+		for _, id := range GuestID {
+			if min, ok := guestMinHardwareVersion[id]; !ok || min <= 11 {
+				vmx11++
+			}
+		}
+
+		tests := []struct {
+			key    string
+			guest  string
+			expect int
+		}{
+			{"vmx-11", "windows2019srvNext_64Guest", vmx11},
+			{"vmx-17", "windows2019srvNext_64Guest", 1},
+			{"", "ubuntu64Guest", 1},
+		}
+
+		for _, test := range tests {
+			res, err := methods.QueryConfigOptionEx(ctx, c, &types.QueryConfigOptionEx{
+				This: env,
+				Spec: &types.EnvironmentBrowserConfigOptionQuerySpec{
+					Key:     test.key,
+					GuestId: []string{test.guest},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := len(res.Returnval.GuestOSDescriptor); n != test.expect {
+				t.Errorf("%s/%s: %d guests", test.key, test.guest, n)
+			}
+		}
+
+		// PCI devices are included when enabled and active for passthrough
+		dev := host.Hardware.PciDevice[0]
+		host.Config.PciPassthruInfo = []types.BaseHostPciPassthruInfo{
+			&types.HostPciPassthruInfo{Id: dev.Id, PassthruCapable: true, PassthruEnabled: true, PassthruActive: true},
+			&types.HostPciPassthruInfo{Id: host.Hardware.PciDevice[1].Id, PassthruCapable: true},
+		}
+
+		target, err := methods.QueryConfigTarget(ctx, c, &types.QueryConfigTarget{This: env})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(target.Returnval.PciPassthrough) != 1 {
+			t.Fatalf("pci=%#v", target.Returnval.PciPassthrough)
+		}
+		if target.Returnval.PciPassthrough[0].GetVirtualMachinePciPassthroughInfo().PciDevice.Id != dev.Id {
+			t.Errorf("pci=%#v", target.Returnval.PciPassthrough[0])
+		}
+		if len(target.Returnval.Datastore) != len(host.Datastore) {
+			t.Errorf("datastores=%d", len(target.Returnval.Datastore))
+		}
+		if target.Returnval.MaxCpusPerHost == 0 || target.Returnval.SupportedMaxMemMB == 0 {
+			t.Errorf("target=%#v", target.Returnval)
+		}
+	})
+}
@@ -0,0 +1,381 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/vmware/govmomi/internal"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vim25/xml"
+)
+
+const (
+	esxcliTypePrefix = "vim.EsxCLI."
+	esxcliInfoMoid   = "ha-dynamic-type-manager-local-cli-cliinfo"
+	esxcliInfoFetch  = "vim.CLIInfo.FetchCLIInfo"
+)
+
+// EsxcliParam describes a parameter of an EsxcliCommand.
+type EsxcliParam struct {
+	Name    string
+	Help    string
+	Aliases []string // command line flags, for example: "--name", "-n"
+	Flag    bool     // parameter is a boolean flag
+}
+
+// EsxcliCommand implements an esxcli command, see RegisterEsxcliCommand.
+type EsxcliCommand struct {
+	Help   string
+	Params []EsxcliParam
+
+	// Fields are the column names for table formatted output, or empty for the simple list format.
+	// Column names map to result field names with spaces removed, "MAC Address" -> "MACAddress" for example.
+	Fields []string
+
+	// Run returns the result of the command for the given host, where args are keyed by EsxcliParam.Name.
+	// The result can be a string, a struct or a slice of structs, where struct field names are used as the
+	// esxcli result field names. A non-nil error is returned to the client as the command's fault message.
+	Run func(ctx *Context, host *mo.HostSystem, args map[string][]string) (interface{}, error)
+}
+
+var esxcliCommands = map[string]*EsxcliCommand{}
+
+// RegisterEsxcliCommand registers an esxcli command with the given name, for example: "network ip interface list".
+// A command registered with the same name as an existing command replaces the existing command.
+// Commands should be registered before the simulator is started.
+func RegisterEsxcliCommand(name string, cmd *EsxcliCommand) {
+	esxcliCommands[strings.Join(strings.Fields(name), " ")] = cmd
+}
+
+// ReflectManagedMethodExecuter invokes esxcli commands on a host, via the internal ExecuteSoap method.
+type ReflectManagedMethodExecuter struct {
+	Self types.ManagedObjectReference
+
+	Host *mo.HostSystem
+}
+
+func (m *ReflectManagedMethodExecuter) Reference() types.ManagedObjectReference {
+	return m.Self
+}
+
+// InternalDynamicTypeManager provides the esxcli managed object instances of a host.
+type InternalDynamicTypeManager struct {
+	Self types.ManagedObjectReference
+
+	Host *mo.HostSystem
+}
+
+func (m *InternalDynamicTypeManager) Reference() types.ManagedObjectReference {
+	return m.Self
+}
+
+func (h *HostSystem) RetrieveManagedMethodExecuter(ctx *Context, req *internal.RetrieveManagedMethodExecuterRequest) soap.HasFault {
+	if h.mme == nil {
+		h.mme = &ReflectManagedMethodExecuter{Host: &h.HostSystem}
+		ctx.Map.Put(h.mme)
+	}
+
+	return &internal.RetrieveManagedMethodExecuterBody{
+		Res: &internal.RetrieveManagedMethodExecuterResponse{
+			Returnval: &internal.ReflectManagedMethodExecuter{ManagedObjectReference: h.mme.Self},
+		},
+	}
+}
+
+func (h *HostSystem) RetrieveDynamicTypeManager(ctx *Context, req *internal.RetrieveDynamicTypeManagerRequest) soap.HasFault {
+	if h.dtm == nil {
+		h.dtm = &InternalDynamicTypeManager{Host: &h.HostSystem}
+		ctx.Map.Put(h.dtm)
+	}
+
+	return &internal.RetrieveDynamicTypeManagerBody{
+		Res: &internal.RetrieveDynamicTypeManagerResponse{
+			Returnval: &internal.InternalDynamicTypeManager{ManagedObjectReference: h.dtm.Self},
+		},
+	}
+}
+
+// esxcliNamespaces returns the registered esxcli command names, grouped by namespace
+func esxcliNamespaces() map[string][]string {
+	namespaces := make(map[string][]string)
+
+	for name := range esxcliCommands {
+		path := strings.Fields(name)
+		ns := strings.Join(path[:len(path)-1], ".")
+		namespaces[ns] = append(namespaces[ns], path[len(path)-1])
+	}
+
+	for _, methods := range namespaces {
+		sort.Strings(methods)
+	}
+
+	return namespaces
+}
+
+func (m *InternalDynamicTypeManager) DynamicTypeMgrQueryMoInstances(req *internal.DynamicTypeMgrQueryMoInstancesRequest) soap.HasFault {
+	res := []internal.DynamicTypeMgrMoInstance{
+		{Id: esxcliInfoMoid, MoType: "vim.CLIInfo"},
+	}
+
+	namespaces := esxcliNamespaces()
+	var names []string
+	for ns := range namespaces {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+
+	for _, ns := range names {
+		res = append(res, internal.DynamicTypeMgrMoInstance{
+			Id:     "ha-cli-handler-" + strings.Replace(ns, ".", "-", -1),
+			MoType: esxcliTypePrefix + ns,
+		})
+	}
+
+	if spec, ok := req.FilterSpec.(*internal.DynamicTypeMgrMoFilterSpec); ok {
+		var matches []internal.DynamicTypeMgrMoInstance
+		for _, instance := range res {
+			if (spec.Id == "" || spec.Id == instance.Id) && strings.Contains(instance.MoType, spec.TypeSubstr) {
+				matches = append(matches, instance)
+			}
+		}
+		res = matches
+	}
+
+	return &internal.DynamicTypeMgrQueryMoInstancesBody{
+		Res: &internal.DynamicTypeMgrQueryMoInstancesResponse{
+			Returnval: res,
+		},
+	}
+}
+
+func (m *ReflectManagedMethodExecuter) ExecuteSoap(ctx *Context, req *internal.ExecuteSoapRequest) soap.HasFault {
+	args := make(map[string][]string)
+	for _, arg := range req.Argument {
+		args[arg.Name] = append(args[arg.Name], esxcliArgument(arg.Val)...)
+	}
+
+	var res interface{}
+	var err error
+
+	if req.Moid == esxcliInfoMoid && req.Method == esxcliInfoFetch {
+		res, err = esxcliInfo(args["typeName"])
+	} else {
+		name := strings.Replace(strings.TrimPrefix(req.Method, esxcliTypePrefix), ".", " ", -1)
+		cmd, ok := esxcliCommands[name]
+		if ok {
+			res, err = cmd.Run(ctx, m.Host, args)
+		} else {
+			err = fmt.Errorf("unknown method %s", req.Method)
+		}
+	}
+
+	result := new(internal.ReflectManagedMethodExecuterSoapResult)
+	if err == nil {
+		result.Response, err = esxcliResponse(req.Method, res)
+	}
+	if err != nil {
+		result.Fault = &internal.ReflectManagedMethodExecuterSoapFault{FaultMsg: err.Error()}
+	}
+
+	return &internal.ExecuteSoapBody{
+		Res: &internal.ExecuteSoapResponse{
+			Returnval: result,
+		},
+	}
+}
+
+// esxcliArgument decodes the values of an ExecuteSoap argument, encoded as a sequence of XML elements
+func esxcliArgument(val string) []string {
+	var vals []string
+
+	dec := xml.NewDecoder(strings.NewReader("<arg>" + val + "</arg>"))
+	depth := 0
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if err != io.EOF && len(vals) == 0 {
+				vals = append(vals, val) // not XML encoded
+			}
+			return vals
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 {
+				vals = append(vals, "")
+			}
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 2 {
+				vals[len(vals)-1] += string(t)
+			}
+		}
+	}
+}
+
+type esxcliInfoItem struct {
+	Name        string `xml:"name"`
+	DisplayName string `xml:"displayName"`
+	Help        string `xml:"help"`
+}
+
+type esxcliInfoParam struct {
+	esxcliInfoItem
+	Aliases []string `xml:"aliases"`
+	Flag    bool     `xml:"flag"`
+}
+
+type esxcliInfoHint struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type esxcliInfoMethod struct {
+	esxcliInfoItem
+	Param []esxcliInfoParam `xml:"param"`
+	Hints []esxcliInfoHint  `xml:"hints"`
+}
+
+type esxcliCLIInfo struct {
+	esxcliInfoItem
+	Method []esxcliInfoMethod `xml:"method"`
+}
+
+// esxcliInfo returns the vim.CLIInfo for the given esxcli namespace type name
+func esxcliInfo(typeName []string) (interface{}, error) {
+	if len(typeName) != 1 {
+		return nil, fmt.Errorf("invalid typeName: %v", typeName)
+	}
+
+	ns := strings.TrimPrefix(typeName[0], esxcliTypePrefix)
+	methods, ok := esxcliNamespaces()[ns]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s", typeName[0])
+	}
+
+	info := &esxcliCLIInfo{
+		esxcliInfoItem: esxcliInfoItem{Name: typeName[0], DisplayName: ns},
+	}
+
+	for _, name := range methods {
+		cmd := esxcliCommands[strings.Replace(ns, ".", " ", -1)+" "+name]
+
+		method := esxcliInfoMethod{
+			esxcliInfoItem: esxcliInfoItem{Name: name, DisplayName: name, Help: cmd.Help},
+		}
+
+		for _, p := range cmd.Params {
+			method.Param = append(method.Param, esxcliInfoParam{
+				esxcliInfoItem: esxcliInfoItem{Name: p.Name, DisplayName: p.Name, Help: p.Help},
+				Aliases:        p.Aliases,
+				Flag:           p.Flag,
+			})
+		}
+
+		if len(cmd.Fields) == 0 {
+			method.Hints = append(method.Hints, esxcliInfoHint{Key: "formatter", Value: "simple"})
+		} else {
+			method.Hints = append(method.Hints,
+				esxcliInfoHint{Key: "formatter", Value: "table"},
+				esxcliInfoHint{Key: "fields:" + name, Value: strings.Join(cmd.Fields, ",")},
+			)
+		}
+
+		info.Method = append(info.Method, method)
+	}
+
+	return info, nil
+}
+
+// esxcliResponse encodes the result of the given method as an esxcli response object
+func esxcliResponse(method string, res interface{}) (string, error) {
+	start := xml.StartElement{
+		Name: xml.Name{Local: "obj"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns:xsd"}, Value: "http://www.w3.org/2001/XMLSchema"},
+			{Name: xml.Name{Local: "xmlns:xsi"}, Value: "http://www.w3.org/2001/XMLSchema-instance"},
+			{Name: xml.Name{Local: "xmlns"}, Value: "urn:vim25"},
+			{Name: xml.Name{Local: "versionId"}, Value: "5.0"},
+		},
+	}
+
+	xsiType := func(name string) xml.Attr {
+		return xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: name}
+	}
+
+	// data object type names are the method name without dots and the Go type name, for example:
+	// "VimEsxCLIsystemversiongetVersionGet", where the built-in result types have an "esxcli" prefix.
+	dataType := func(val reflect.Value) xml.Attr {
+		name := strings.TrimPrefix(reflect.Indirect(val).Type().Name(), "esxcli")
+		return xsiType("VimEsxCLI" + strings.Replace(strings.TrimPrefix(method, esxcliTypePrefix), ".", "", -1) + name)
+	}
+
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	var err error
+
+	switch val := reflect.ValueOf(res); val.Kind() {
+	case reflect.Invalid: // commands without output, such as "set" commands, return true
+		start.Attr = append(start.Attr, xsiType("xsd:boolean"))
+		err = enc.EncodeElement(true, start)
+	case reflect.Bool:
+		start.Attr = append(start.Attr, xsiType("xsd:boolean"))
+		err = enc.EncodeElement(res, start)
+	case reflect.String:
+		start.Attr = append(start.Attr, xsiType("xsd:string"))
+		err = enc.EncodeElement(res, start)
+	case reflect.Slice:
+		start.Attr = append(start.Attr, xsiType("ArrayOfDataObject"))
+		if err = enc.EncodeToken(start); err != nil {
+			return "", err
+		}
+		for i := 0; i < val.Len(); i++ {
+			item := xml.StartElement{
+				Name: xml.Name{Local: "DataObject"},
+				Attr: []xml.Attr{dataType(val.Index(i))},
+			}
+			if err = enc.EncodeElement(val.Index(i).Interface(), item); err != nil {
+				return "", err
+			}
+		}
+		err = enc.EncodeToken(start.End())
+	default:
+		if _, ok := res.(*esxcliCLIInfo); ok {
+			start.Attr = append(start.Attr, xsiType("VimCLIInfo"))
+		} else {
+			start.Attr = append(start.Attr, dataType(val))
+		}
+		err = enc.EncodeElement(res, start)
+	}
+
+	if err == nil {
+		err = enc.Flush()
+	}
+
+	return buf.String(), err
+}
@@ -0,0 +1,334 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Built-in esxcli commands, with results derived from the simulated host state.
+
+func init() {
+	RegisterEsxcliCommand("network ip interface list", &EsxcliCommand{
+		Help:   "This command will list the VMkernel network interfaces currently known to the system.",
+		Fields: []string{"Name", "MAC Address", "Enabled", "Portset", "Portgroup", "Netstack Instance", "MTU"},
+		Run:    esxcliNetworkInterfaceList,
+	})
+
+	RegisterEsxcliCommand("storage core device list", &EsxcliCommand{
+		Help: "List the devices currently registered with the PSA.",
+		Params: []EsxcliParam{
+			{Name: "device", Help: "Filter the output of this command to only show a single device.", Aliases: []string{"--device", "-d"}},
+		},
+		Run: esxcliStorageDeviceList,
+	})
+
+	RegisterEsxcliCommand("system version get", &EsxcliCommand{
+		Help: "Display the product name, version and build information.",
+		Run:  esxcliSystemVersionGet,
+	})
+
+	RegisterEsxcliCommand("software vib list", &EsxcliCommand{
+		Help:   "Lists the installed VIB packages.",
+		Fields: []string{"Name", "Version", "Vendor", "Acceptance Level", "Install Date"},
+		Run:    esxcliSoftwareVibList,
+	})
+
+	RegisterEsxcliCommand("vm process list", &EsxcliCommand{
+		Help: "List the virtual machines on this system.",
+		Run:  esxcliVMProcessList,
+	})
+
+	RegisterEsxcliCommand("network vm port list", &EsxcliCommand{
+		Help: "List of ports for a given VM.",
+		Params: []EsxcliParam{
+			{Name: "worldid", Help: "World ID of the VM.", Aliases: []string{"--world-id", "-w"}},
+		},
+		Run: esxcliNetworkVMPortList,
+	})
+}
+
+type esxcliNetworkInterface struct {
+	Name             string
+	MACAddress       string
+	Enabled          bool
+	Portset          string
+	Portgroup        string
+	NetstackInstance string
+	MTU              int32
+}
+
+func esxcliNetworkInterfaceList(_ *Context, host *mo.HostSystem, _ map[string][]string) (interface{}, error) {
+	var res []esxcliNetworkInterface
+
+	for _, nic := range host.Config.Network.Vnic {
+		portset := ""
+		for _, pg := range host.Config.Network.Portgroup {
+			if pg.Spec.Name == nic.Portgroup {
+				portset = pg.Spec.VswitchName
+			}
+		}
+
+		res = append(res, esxcliNetworkInterface{
+			Name:             nic.Device,
+			MACAddress:       nic.Spec.Mac,
+			Enabled:          true,
+			Portset:          portset,
+			Portgroup:        nic.Portgroup,
+			NetstackInstance: nic.Spec.NetStackInstanceKey,
+			MTU:              nic.Spec.Mtu,
+		})
+	}
+
+	return res, nil
+}
+
+type esxcliDevice struct {
+	Device      string
+	DisplayName string
+	Size        int64
+	DeviceType  string
+	DevfsPath   string
+	Vendor      string
+	Model       string
+	Revision    string
+	SCSILevel   int32
+	IsLocal     bool
+	IsRemovable bool
+	IsSSD       bool
+	Status      string
+}
+
+func esxcliStorageDeviceList(_ *Context, host *mo.HostSystem, args map[string][]string) (interface{}, error) {
+	var res []esxcliDevice
+
+	deviceTypes := map[string]string{
+		string(types.ScsiLunTypeDisk):  "Direct-Access",
+		string(types.ScsiLunTypeCdrom): "CD-ROM",
+	}
+
+	for _, lun := range host.Config.StorageDevice.ScsiLun {
+		l := lun.GetScsiLun()
+		if len(args["device"]) != 0 && args["device"][0] != l.CanonicalName {
+			continue
+		}
+
+		dev := esxcliDevice{
+			Device:      l.CanonicalName,
+			DisplayName: l.DisplayName,
+			DeviceType:  deviceTypes[l.LunType],
+			DevfsPath:   l.DeviceName,
+			Vendor:      strings.TrimSpace(l.Vendor),
+			Model:       strings.TrimSpace(l.Model),
+			Revision:    l.Revision,
+			SCSILevel:   l.ScsiLevel,
+			IsRemovable: l.LunType == string(types.ScsiLunTypeCdrom),
+			Status:      "on",
+		}
+
+		if disk, ok := lun.(*types.HostScsiDisk); ok {
+			dev.Size = disk.Capacity.Block * int64(disk.Capacity.BlockSize) / (1024 * 1024)
+			dev.IsLocal = disk.LocalDisk != nil && *disk.LocalDisk
+			dev.IsSSD = disk.Ssd != nil && *disk.Ssd
+			if dev.DevfsPath == "" {
+				dev.DevfsPath = "/vmfs/devices/disks/" + l.CanonicalName
+			}
+		}
+
+		res = append(res, dev)
+	}
+
+	if len(args["device"]) != 0 && len(res) == 0 {
+		return nil, fmt.Errorf("unknown device %s", args["device"][0])
+	}
+
+	return res, nil
+}
+
+type esxcliVersionGet struct {
+	Product string
+	Version string
+	Build   string
+	Update  string
+	Patch   string
+}
+
+func esxcliSystemVersionGet(_ *Context, host *mo.HostSystem, _ map[string][]string) (interface{}, error) {
+	about := host.Config.Product
+
+	return &esxcliVersionGet{
+		Product: about.Name,
+		Version: about.Version,
+		Build:   "Releasebuild-" + about.Build,
+		Update:  "0",
+		Patch:   "0",
+	}, nil
+}
+
+type esxcliSoftwarePackage struct {
+	ID              string
+	Name            string
+	Version         string
+	Vendor          string
+	AcceptanceLevel string
+	InstallDate     string
+}
+
+func esxcliSoftwareVibList(_ *Context, host *mo.HostSystem, _ map[string][]string) (interface{}, error) {
+	var res []esxcliSoftwarePackage
+
+	about := host.Config.Product
+	version := fmt.Sprintf("%s-0.0.%s", about.Version, about.Build)
+	date := time.Now()
+	if host.Runtime.BootTime != nil {
+		date = *host.Runtime.BootTime
+	}
+
+	vibs := []struct {
+		name    string
+		version string
+	}{
+		{"esx-base", version},
+		{"esx-ui", "1.34.0-15603211"},
+		{"esx-xserver", version},
+		{"native-misc-drivers", version},
+		{"vmkusb", "0.1-1vmw." + strings.Replace(about.Version, ".", "", -1) + ".0.0." + about.Build},
+		{"vsan", version},
+		{"tools-light", "11.1.0.16036546-" + about.Build},
+	}
+
+	for _, vib := range vibs {
+		res = append(res, esxcliSoftwarePackage{
+			ID:              fmt.Sprintf("VMware_bootbank_%s_%s", vib.name, vib.version),
+			Name:            vib.name,
+			Version:         vib.version,
+			Vendor:          "VMware",
+			AcceptanceLevel: "VMwareCertified",
+			InstallDate:     date.Format("2006-01-02"),
+		})
+	}
+
+	return res, nil
+}
+
+type esxcliVirtualMachine struct {
+	DisplayName string
+	WorldID     int64
+	ProcessID   int64
+	VMXCartelID int64
+	UUID        string
+	ConfigFile  string
+}
+
+// esxcliUUID formats the given uuid as esxcli does, for example: "56 4d a1 29 6d 17 1c ba-53 3a 1b 2c 3b 97 5e 5e"
+func esxcliUUID(uuid string) string {
+	id := strings.Replace(uuid, "-", "", -1)
+	var pairs []string
+	for i := 0; i+1 < len(id); i += 2 {
+		pairs = append(pairs, id[i:i+2])
+	}
+	if len(pairs) != 16 {
+		return uuid
+	}
+	return strings.Join(pairs[:8], " ") + "-" + strings.Join(pairs[8:], " ")
+}
+
+// esxcliWorldID returns a world ID for the given VM, derived from its uuid
+func esxcliWorldID(vm *VirtualMachine) int64 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(vm.Config.Uuid))
+	return int64(h.Sum32()%1000000) + 1000000
+}
+
+func esxcliVMProcessList(ctx *Context, host *mo.HostSystem, _ map[string][]string) (interface{}, error) {
+	var res []esxcliVirtualMachine
+
+	for _, ref := range host.Vm {
+		vm, ok := ctx.Map.Get(ref).(*VirtualMachine)
+		if !ok || vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			continue
+		}
+
+		id := esxcliWorldID(vm)
+
+		res = append(res, esxcliVirtualMachine{
+			DisplayName: vm.Name,
+			WorldID:     id,
+			ProcessID:   0,
+			VMXCartelID: id + 1,
+			UUID:        esxcliUUID(vm.Config.Uuid),
+			ConfigFile:  vm.Config.Files.VmPathName,
+		})
+	}
+
+	return res, nil
+}
+
+type esxcliPort struct {
+	PortID     int64
+	VSwitch    string `xml:"vSwitch"`
+	Portgroup  string
+	DVPortID   string
+	MACAddress string
+	IPAddress  string
+}
+
+func esxcliNetworkVMPortList(ctx *Context, host *mo.HostSystem, args map[string][]string) (interface{}, error) {
+	if len(args["worldid"]) == 0 {
+		return nil, errors.New("missing required parameter -w|--world-id")
+	}
+
+	for _, ref := range host.Vm {
+		vm, ok := ctx.Map.Get(ref).(*VirtualMachine)
+		if !ok || vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			continue
+		}
+
+		id := esxcliWorldID(vm)
+		if strconv.FormatInt(id, 10) != args["worldid"][0] {
+			continue
+		}
+
+		var res []esxcliPort
+
+		for i, nic := range vm.Guest.Net {
+			port := esxcliPort{
+				PortID:     id + int64(i) + 2,
+				VSwitch:    "vSwitch0",
+				Portgroup:  nic.Network,
+				MACAddress: nic.MacAddress,
+				IPAddress:  "0.0.0.0",
+			}
+			if len(nic.IpAddress) != 0 {
+				port.IPAddress = nic.IpAddress[0]
+			}
+			res = append(res, port)
+		}
+
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("invalid world ID %s", args["worldid"][0])
+}
@@ -101,7 +101,7 @@ func (m *EventManager) CreateCollectorForEvents(ctx *Context, req *types.CreateC
 }
 
 func (m *EventManager) QueryEvents(ctx *Context, req *types.QueryEvents) soap.HasFault {
-	if Map.IsESX() {
+	if ctx.Map.IsESX() {
 		return &methods.QueryEventsBody{
 			Fault_: Fault("", new(types.NotImplemented)),
 		}
@@ -173,7 +173,7 @@ func (m *EventManager) PostEvent(ctx *Context, req *types.PostEvent) soap.HasFau
 		ctx.WithLock(c, func() {
 			if c.eventMatches(req.EventToPost) {
 				pushEvent(c.page, req.EventToPost)
-				Map.Update(c, []types.PropertyChange{{Name: "latestPage", Val: c.GetLatestPage()}})
+				ctx.Map.Update(c, []types.PropertyChange{{Name: "latestPage", Val: c.GetLatestPage()}})
 			}
 		})
 	}
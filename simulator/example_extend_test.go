@@ -36,7 +36,7 @@ type BusyVM struct {
 
 // Override simulator.VirtualMachine.PowerOffVMTask to inject faults
 func (vm *BusyVM) PowerOffVMTask(ctx *simulator.Context, req *types.PowerOffVM_Task) soap.HasFault {
-	task := simulator.CreateTask(req.This, "powerOff", func(*simulator.Task) (types.AnyType, types.BaseMethodFault) {
+	task := simulator.CreateTask(ctx, req.This, "powerOff", func(*simulator.Task) (types.AnyType, types.BaseMethodFault) {
 		return nil, &types.TaskInProgress{}
 	})
 
@@ -186,7 +186,7 @@ func (f *Folder) AddStandaloneHostTask(ctx *Context, a *types.AddStandaloneHost_
 
 	if folderHasChildType(&f.Folder, "ComputeResource") && folderHasChildType(&f.Folder, "Folder") {
 		r.Res = &types.AddStandaloneHost_TaskResponse{
-			Returnval: NewTask(&addStandaloneHost{f, ctx, a}).Run(ctx),
+			Returnval: NewTask(ctx, &addStandaloneHost{f, ctx, a}).Run(ctx),
 		}
 	} else {
 		r.Fault_ = f.typeNotSupported()
@@ -273,7 +273,7 @@ func (f *Folder) CreateStoragePod(ctx *Context, c *types.CreateStoragePod) soap.
 }
 
 func (p *StoragePod) MoveIntoFolderTask(ctx *Context, c *types.MoveIntoFolder_Task) soap.HasFault {
-	task := CreateTask(p, "moveIntoFolder", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, p, "moveIntoFolder", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		f := &Folder{Folder: p.Folder}
 		id := f.MoveIntoFolderTask(ctx, c).(*methods.MoveIntoFolder_TaskBody).Res.Returnval
 		ftask := ctx.Map.Get(id).(*Task)
@@ -308,7 +308,7 @@ func (f *Folder) CreateDatacenter(ctx *Context, c *types.CreateDatacenter) soap.
 		ctx.postEvent(&types.DatacenterCreatedEvent{
 			DatacenterEvent: types.DatacenterEvent{
 				Event: types.Event{
-					Datacenter: datacenterEventArgument(dc),
+					Datacenter: datacenterEventArgument(ctx, dc),
 				},
 			},
 			Parent: folderEventArgument(&f.Folder),
@@ -350,14 +350,14 @@ type createVM struct {
 }
 
 // hostsWithDatastore returns hosts that have access to the given datastore path
-func hostsWithDatastore(hosts []types.ManagedObjectReference, path string) []types.ManagedObjectReference {
+func hostsWithDatastore(ctx *Context, hosts []types.ManagedObjectReference, path string) []types.ManagedObjectReference {
 	attached := hosts[:0]
 	var p object.DatastorePath
 	p.FromString(path)
 
 	for _, host := range hosts {
-		h := Map.Get(host).(*HostSystem)
-		if Map.FindByName(p.Datastore, h.Datastore) != nil {
+		h := ctx.Map.Get(host).(*HostSystem)
+		if ctx.Map.FindByName(p.Datastore, h.Datastore) != nil {
 			attached = append(attached, host)
 		}
 	}
@@ -387,7 +387,7 @@ func (c *createVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 				hosts = cr.Host
 			}
 
-			hosts = hostsWithDatastore(hosts, c.req.Config.Files.VmPathName)
+			hosts = hostsWithDatastore(c.ctx, hosts, c.req.Config.Files.VmPathName)
 			host := hosts[rand.Intn(len(hosts))]
 			vm.Runtime.Host = &host
 		})
@@ -396,13 +396,19 @@ func (c *createVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 	}
 
 	vm.Guest = &types.GuestInfo{
-		ToolsStatus:        types.VirtualMachineToolsStatusToolsNotInstalled,
-		ToolsVersion:       "0",
-		ToolsRunningStatus: string(types.VirtualMachineToolsRunningStatusGuestToolsNotRunning),
+		ToolsStatus:         types.VirtualMachineToolsStatusToolsNotInstalled,
+		ToolsVersion:        "0",
+		ToolsVersionStatus:  string(types.VirtualMachineToolsVersionStatusGuestToolsNotInstalled),
+		ToolsVersionStatus2: string(types.VirtualMachineToolsVersionStatusGuestToolsNotInstalled),
+		ToolsRunningStatus:  string(types.VirtualMachineToolsRunningStatusGuestToolsNotRunning),
+		GuestState:          string(types.VirtualMachineGuestStateNotRunning),
 	}
 
 	vm.Summary.Guest = &types.VirtualMachineGuestSummary{
-		ToolsStatus: vm.Guest.ToolsStatus,
+		ToolsStatus:         vm.Guest.ToolsStatus,
+		ToolsVersionStatus:  vm.Guest.ToolsVersionStatus,
+		ToolsVersionStatus2: vm.Guest.ToolsVersionStatus2,
+		ToolsRunningStatus:  vm.Guest.ToolsRunningStatus,
 	}
 	vm.Summary.Config.VmPathName = vm.Config.Files.VmPathName
 	vm.Summary.Runtime.Host = vm.Runtime.Host
@@ -415,7 +421,7 @@ func (c *createVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 
 	host := c.ctx.Map.Get(*vm.Runtime.Host).(*HostSystem)
 	c.ctx.Map.AppendReference(c.ctx, host, &host.Vm, vm.Self)
-	vm.EnvironmentBrowser = *hostParent(&host.HostSystem).EnvironmentBrowser
+	vm.EnvironmentBrowser = *hostParent(c.ctx, &host.HostSystem).EnvironmentBrowser
 
 	for i := range vm.Datastore {
 		ds := c.ctx.Map.Get(vm.Datastore[i]).(*Datastore)
@@ -433,7 +439,7 @@ func (c *createVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		}
 	})
 
-	event := vm.event()
+	event := vm.event(c.ctx)
 	c.ctx.postEvent(
 		&types.VmBeingCreatedEvent{
 			VmEvent:    event,
@@ -464,7 +470,7 @@ func (c *createVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 func (f *Folder) CreateVMTask(ctx *Context, c *types.CreateVM_Task) soap.HasFault {
 	return &methods.CreateVM_TaskBody{
 		Res: &types.CreateVM_TaskResponse{
-			Returnval: NewTask(&createVM{f, ctx, c, false}).Run(ctx),
+			Returnval: NewTask(ctx, &createVM{f, ctx, c, false}).Run(ctx),
 		},
 	}
 }
@@ -487,7 +493,7 @@ func (c *registerVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 			return nil, &types.InvalidArgument{InvalidProperty: "pool"}
 		}
 
-		pool = hostParent(&c.ctx.Map.Get(*host).(*HostSystem).HostSystem).ResourcePool
+		pool = hostParent(c.ctx, &c.ctx.Map.Get(*host).(*HostSystem).HostSystem).ResourcePool
 	} else {
 		if pool == nil {
 			return nil, &types.InvalidArgument{InvalidProperty: "pool"}
@@ -499,7 +505,7 @@ func (c *registerVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 	}
 
 	s := c.ctx.Map.SearchIndex()
-	r := s.FindByDatastorePath(&types.FindByDatastorePath{
+	r := s.FindByDatastorePath(c.ctx, &types.FindByDatastorePath{
 		This:       s.Reference(),
 		Path:       c.req.Path,
 		Datacenter: c.ctx.Map.getEntityDatacenter(c.Folder).Reference(),
@@ -518,7 +524,7 @@ func (c *registerVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		c.req.Name = path.Dir(p.Path)
 	}
 
-	create := NewTask(&createVM{
+	create := NewTask(c.ctx, &createVM{
 		Folder:   c.Folder,
 		register: true,
 		ctx:      c.ctx,
@@ -547,13 +553,13 @@ func (c *registerVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 func (f *Folder) RegisterVMTask(ctx *Context, c *types.RegisterVM_Task) soap.HasFault {
 	return &methods.RegisterVM_TaskBody{
 		Res: &types.RegisterVM_TaskResponse{
-			Returnval: NewTask(&registerVM{f, ctx, c}).Run(ctx),
+			Returnval: NewTask(ctx, &registerVM{f, ctx, c}).Run(ctx),
 		},
 	}
 }
 
 func (f *Folder) MoveIntoFolderTask(ctx *Context, c *types.MoveIntoFolder_Task) soap.HasFault {
-	task := CreateTask(f, "moveIntoFolder", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, f, "moveIntoFolder", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		for _, ref := range c.List {
 			obj := ctx.Map.Get(ref).(mo.Entity)
 
@@ -578,7 +584,7 @@ func (f *Folder) MoveIntoFolderTask(ctx *Context, c *types.MoveIntoFolder_Task)
 }
 
 func (f *Folder) CreateDVSTask(ctx *Context, req *types.CreateDVS_Task) soap.HasFault {
-	task := CreateTask(f, "createDVS", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, f, "createDVS", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		spec := req.Spec.ConfigSpec.GetDVSConfigSpec()
 		dvs := &DistributedVirtualSwitch{}
 		dvs.Name = spec.Name
@@ -625,6 +631,11 @@ func (f *Folder) CreateDVSTask(ctx *Context, req *types.CreateDVS_Task) soap.Has
 			configInfo.Contact = *spec.Contact
 		}
 
+		configInfo.LacpApiVersion = string(types.VMwareDvsLacpApiVersionMultipleLag)
+		if vspec, ok := req.Spec.ConfigSpec.(*types.VMwareDVSConfigSpec); ok && vspec.LacpApiVersion != "" {
+			configInfo.LacpApiVersion = vspec.LacpApiVersion
+		}
+
 		dvs.Config = configInfo
 
 		if dvs.Summary.ProductInfo == nil {
@@ -685,7 +696,7 @@ func (f *Folder) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFaul
 		DestroyTask(*types.Destroy_Task) soap.HasFault
 	}
 
-	task := CreateTask(f, "destroy", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, f, "destroy", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		// Attempt to destroy all children
 		for _, c := range f.ChildEntity {
 			obj, ok := ctx.Map.Get(c).(destroyer)
@@ -634,6 +634,9 @@ func TestPlaceVmsXCluster(t *testing.T) {
 			},
 		}}
 
+		// PlaceVmsXCluster is only available to clients using an internal version
+		c.Version = "uE53DA"
+
 		folder := object.NewRootFolder(c)
 		res, err := folder.PlaceVmsXCluster(ctx, spec)
 		if err != nil {
@@ -0,0 +1,381 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/vmware/govmomi/simulator/internal"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Fork returns a new Model with a copy of this Model's inventory and datastore directories.
+// The Model must be created (see Model.Create and Model.Load) and should not be serving
+// requests while it is forked. The Service of the returned Model does not share any objects
+// with this Model or the global Map, such that forks of the same base Model can be used
+// by tests running in parallel, without paying the cost of Model.Create for each test.
+// Note that SDK endpoints other than vim25, such as vapi, still use the global Map.
+//
+// The following state of this Model is not carried into the fork:
+//   - func fields, such as Registry.Handler and SessionManager.TLSCert, are nil.
+//     Task.Execute is set to fail with InvalidState, as the Task belongs to this Model.
+//   - locks, channels, timers, open files and containers (such as VM docker containers) are zeroed.
+//   - the Registry's tag manager, which is set when the vapi endpoint is registered.
+//
+// Datastore directories are copied and only the path fields Datastore.Info, Datastore.Summary.Url
+// and VirtualMachine.Config.Files (and the VM's log file) are rewritten to the fork's directories.
+func (m *Model) Fork() (*Model, error) {
+	if m.Service == nil {
+		return nil, errors.New("simulator: Fork requires a created Model")
+	}
+
+	fork := *m
+	fork.dirs = nil
+
+	dirs := make(map[string]string, len(m.dirs))
+	for _, dir := range m.dirs {
+		dst, err := ioutil.TempDir("", filepath.Base(dir)+"fork-")
+		if err != nil {
+			fork.Remove()
+			return nil, err
+		}
+		fork.dirs = append(fork.dirs, dst)
+		dirs[dir] = dst
+
+		if err = copyDir(dir, dst); err != nil {
+			fork.Remove()
+			return nil, err
+		}
+	}
+
+	r := m.Service.registry
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	f := newForker(dirs)
+	fork.Service = newService(f.fork(r))
+	fork.Service.delay = &fork.DelayConfig
+	fork.Service.strict = fork.StrictValidation
+	fork.Service.toolsDelay = fork.GuestToolsDelay
+	fork.Service.hostListen = fork.HostListen
+	fork.Service.tempDir = fork.createTempDir
+
+	return &fork, nil
+}
+
+// copyDir copies the contents of directory src to directory dst
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, _ := filepath.Rel(src, path)
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.IsDir():
+			if rel == "." {
+				return nil
+			}
+			return os.Mkdir(target, info.Mode().Perm())
+		case info.Mode().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		default:
+			return nil // e.g. sockets and symlinks created by vm containers
+		}
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	r, err := os.Open(filepath.Clean(src))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(w, r); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// forkKey identifies a value by address and type, as a struct and its first field share the same address.
+type forkKey struct {
+	addr uintptr
+	kind reflect.Type
+}
+
+// forkSlot is a location in the forked object graph that holds a pointer.
+type forkSlot struct {
+	val reflect.Value // settable field, slice or array element
+	m   reflect.Value // map, if the pointer is a map value
+	key reflect.Value // map key
+	ptr forkKey
+}
+
+// forker deep copies a Registry object graph, including unexported fields.
+// Pointers to the same address are copied once, preserving shared references and
+// pointers to fields of other objects, such as HostSystem.Summary.Runtime.
+type forker struct {
+	ptrs  map[forkKey]reflect.Value
+	slots []forkSlot
+	dirs  map[string]string
+}
+
+var (
+	forkRegistryType = reflect.TypeOf(Registry{})
+	forkZeroTypes    = map[reflect.Type]bool{
+		reflect.TypeOf(sync.Mutex{}):     true,
+		reflect.TypeOf(sync.RWMutex{}):   true,
+		reflect.TypeOf(sync.Map{}):       true,
+		reflect.TypeOf(sync.Once{}):      true,
+		reflect.TypeOf(sync.WaitGroup{}): true,
+		reflect.TypeOf(container{}):      true, // containers are not forked
+	}
+	forkNilTypes = map[reflect.Type]bool{
+		reflect.TypeOf(&Context{}):             true, // only used by in-flight tasks
+		reflect.TypeOf(&Service{}):             true,
+		reflect.TypeOf(&time.Timer{}):          true,
+		reflect.TypeOf(&os.File{}):             true,
+		reflect.TypeOf(&internal.ObjectLock{}): true,
+	}
+)
+
+func newForker(dirs map[string]string) *forker {
+	return &forker{
+		ptrs: make(map[forkKey]reflect.Value),
+		dirs: dirs,
+	}
+}
+
+// fork returns a deep copy of the given Registry
+func (f *forker) fork(r *Registry) *Registry {
+	src := reflect.ValueOf(r)
+	dst := f.pointer(src)
+
+	// resolve pointers that were copied before the object containing their target
+	for _, s := range f.slots {
+		p := f.ptrs[s.ptr]
+		if s.m.IsValid() {
+			s.m.SetMapIndex(s.key, p)
+		} else {
+			s.val.Set(p)
+		}
+	}
+
+	r = dst.Interface().(*Registry)
+	f.rebind(r)
+	f.paths(r)
+
+	return r
+}
+
+// rebind sets the func fields of forked objects, which are not copied.
+// SessionManager.TLSCert is set by the fork's Service.NewServer.
+func (f *forker) rebind(r *Registry) {
+	for _, obj := range r.objects {
+		if task, ok := obj.(*Task); ok {
+			// the base Model's Task has already run, or runs against the base Model's objects
+			task.Execute = func(*Task) (types.AnyType, types.BaseMethodFault) {
+				return nil, &types.InvalidState{}
+			}
+		}
+	}
+}
+
+// settable returns a settable (and readable) Value for the addressable v, including unexported fields.
+func settable(v reflect.Value) reflect.Value {
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// paths rewrites the fields of forked objects that refer to the base Model's datastore directories.
+// Other strings are copied as-is, even if they happen to start with a datastore directory.
+func (f *forker) paths(r *Registry) {
+	for _, obj := range r.objects {
+		switch x := obj.(type) {
+		case *Datastore:
+			if info, ok := x.Info.(*types.LocalDatastoreInfo); ok {
+				info.Path = f.path(info.Path)
+			}
+			info := x.Info.GetDatastoreInfo()
+			info.Url = f.path(info.Url)
+			x.Summary.Url = f.path(x.Summary.Url)
+		case *VirtualMachine:
+			x.log = f.path(x.log)
+			if x.Config != nil {
+				files := &x.Config.Files
+				for _, p := range []*string{&files.VmPathName, &files.SnapshotDirectory, &files.SuspendDirectory, &files.LogDirectory} {
+					*p = f.path(*p)
+				}
+			}
+		}
+	}
+}
+
+// path rewrites the given datastore directory path of the base Model to the forked Model's directory
+func (f *forker) path(s string) string {
+	for src, dst := range f.dirs {
+		if s == src || strings.HasPrefix(s, src+string(filepath.Separator)) {
+			return dst + strings.TrimPrefix(s, src)
+		}
+	}
+	return s
+}
+
+// pointer returns a copy of the given pointer, without resolving pending slots
+func (f *forker) pointer(src reflect.Value) reflect.Value {
+	if src.IsNil() || forkNilTypes[src.Type()] {
+		return reflect.Zero(src.Type())
+	}
+
+	key := forkKey{src.Pointer(), src.Type().Elem()}
+	if p, ok := f.ptrs[key]; ok {
+		return p
+	}
+
+	p := reflect.New(src.Type().Elem())
+	f.ptrs[key] = p
+	f.copy(p.Elem(), src.Elem())
+
+	return p
+}
+
+// copy deep copies src into the settable dst
+func (f *forker) copy(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() || forkNilTypes[src.Type()] {
+			return
+		}
+		dst.Set(f.pointer(src))
+		f.slots = append(f.slots, forkSlot{val: dst, ptr: forkKey{src.Pointer(), src.Type().Elem()}})
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		elem := src.Elem()
+		if elem.Kind() == reflect.Ptr {
+			if elem.IsNil() || forkNilTypes[elem.Type()] {
+				return
+			}
+			dst.Set(f.pointer(elem))
+			f.slots = append(f.slots, forkSlot{val: dst, ptr: forkKey{elem.Pointer(), elem.Type().Elem()}})
+			return
+		}
+		val := reflect.New(elem.Type()).Elem()
+		f.copy(val, f.readable(elem))
+		dst.Set(val)
+	case reflect.Struct:
+		if forkZeroTypes[src.Type()] {
+			return
+		}
+		if src.CanAddr() {
+			// register the field address, in case it is referenced by pointer elsewhere
+			f.ptrs[forkKey{src.UnsafeAddr(), src.Type()}] = dst.Addr()
+		} else {
+			val := reflect.New(src.Type()).Elem()
+			val.Set(src)
+			src = val // unexported fields are only accessible when addressable
+		}
+		for i := 0; i < src.NumField(); i++ {
+			f.copy(settable(dst.Field(i)), f.readable(src.Field(i)))
+		}
+		if src.Type() == forkRegistryType {
+			r := dst.Addr().Interface().(*Registry)
+			r.locks = make(map[types.ManagedObjectReference]*internal.ObjectLock) // created on demand
+			r.tagManager = nil                                                    // set when vapi is registered
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			key := reflect.New(src.Type().Key()).Elem()
+			f.copy(key, f.readable(iter.Key()))
+			val := reflect.New(src.Type().Elem()).Elem()
+			f.copy(val, f.readable(iter.Value()))
+			m.SetMapIndex(key, val)
+			f.mapSlot(m, key, val, iter.Value())
+		}
+		dst.Set(m)
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		if src.Type().Elem().Kind() == reflect.Uint8 {
+			reflect.Copy(s, src)
+		} else {
+			for i := 0; i < src.Len(); i++ {
+				f.copy(s.Index(i), src.Index(i))
+			}
+		}
+		dst.Set(s)
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			f.copy(dst.Index(i), src.Index(i))
+		}
+	case reflect.String:
+		dst.SetString(src.String()) // see forker.paths
+	case reflect.Chan:
+		// channels are created on demand, see PropertyCollector.wakeup
+	case reflect.Func:
+		// funcs are not copied, as closures such as SessionManager.TLSCert and Task.Execute
+		// reference objects of the base Model, see forker.rebind
+	default: // bool and numbers
+		dst.Set(src)
+	}
+}
+
+// readable returns a Value that can be passed to Set, including unexported fields
+func (f *forker) readable(src reflect.Value) reflect.Value {
+	if src.CanAddr() {
+		return settable(src)
+	}
+	return src
+}
+
+// mapSlot records a map value that holds a pointer, to be resolved by fork
+func (f *forker) mapSlot(m, key, val, src reflect.Value) {
+	if src.Kind() == reflect.Interface && !src.IsNil() {
+		src = src.Elem()
+	}
+	if src.Kind() != reflect.Ptr || src.IsNil() || forkNilTypes[src.Type()] {
+		return
+	}
+	// the slot recorded by copy(val) is not settable in place, replace it with the map entry
+	f.slots[len(f.slots)-1] = forkSlot{m: m, key: key, ptr: forkKey{src.Pointer(), src.Type().Elem()}}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestModelFork(t *testing.T) {
+	base := VPX()
+	if _, err := base.Fork(); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if err := base.Create(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(base.Remove) // after the parallel subtests complete
+
+	base.Service.sm.TLSCert = func() string { return "base" }
+	vm0 := base.Service.registry.Any("VirtualMachine").(*VirtualMachine)
+	ctx := &Context{Context: context.Background(), Map: base.Service.registry}
+	queued := CreateTask(ctx, vm0, "powerOff", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		vm0.Runtime.PowerState = types.VirtualMachinePowerStatePoweredOff
+		return nil, nil
+	})
+	if base.Service.registry.Get(queued.Self) != queued {
+		t.Fatal("expected CreateTask to register the Task")
+	}
+
+	count := base.Count()
+	tasks := len(base.Service.registry.AllReference("Task"))
+	ds := base.Service.registry.Any("Datastore").(*Datastore)
+	dir := ds.Info.GetDatastoreInfo().Url
+	vm0.Config.Annotation = dir // only known path fields are rewritten
+
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprintf("fork%d", i)
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := base.Fork()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := m.Service.registry
+			if r == base.Service.registry || r == Map {
+				t.Fatal("fork shares the base registry")
+			}
+
+			fds := r.Get(ds.Self).(*Datastore)
+			if fds == ds || fds.Info.GetDatastoreInfo().Url == dir {
+				t.Fatalf("fork shares datastore %s", dir)
+			}
+			if fds.Summary.Url != fds.Info.GetDatastoreInfo().Url {
+				t.Errorf("fork datastore summary url=%s", fds.Summary.Url)
+			}
+
+			fvm := r.Get(vm0.Self).(*VirtualMachine)
+			if !strings.HasPrefix(fvm.log, fds.Summary.Url) {
+				t.Errorf("fork vm log=%s", fvm.log)
+			}
+			if fvm.Config.Annotation != dir {
+				t.Errorf("fork vm annotation=%s", fvm.Config.Annotation)
+			}
+
+			if r.SessionManager().TLSCert != nil {
+				t.Error("fork shares SessionManager.TLSCert")
+			}
+
+			task := r.Get(queued.Self).(*Task)
+			if _, fault := task.Execute(task); fault == nil {
+				t.Error("fork shares Task.Execute")
+			}
+
+			h := r.Any("HostSystem").(*HostSystem)
+			if h.Summary.Runtime != &h.Runtime {
+				t.Error("expected h.Summary.Runtime == &h.Runtime")
+			}
+
+			err = m.Run(func(ctx context.Context, c *vim25.Client) error {
+				finder := find.NewFinder(c)
+
+				vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+				if err != nil {
+					return err
+				}
+
+				task, err := vm.PowerOff(ctx)
+				if err != nil {
+					return err
+				}
+				if err = task.Wait(ctx); err != nil {
+					return err
+				}
+
+				task, err = vm.Destroy(ctx)
+				if err != nil {
+					return err
+				}
+				if err = task.Wait(ctx); err != nil {
+					return err
+				}
+
+				dc, err := finder.Datacenter(ctx, "DC0")
+				if err != nil {
+					return err
+				}
+
+				fm := object.NewFileManager(c)
+				return fm.MakeDirectory(ctx, fmt.Sprintf("[%s] %s", ds.Name, name), dc, false)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if m.Count().Machine != count.Machine-1 {
+				t.Errorf("fork Machine=%d", m.Count().Machine)
+			}
+
+			if _, err = os.Stat(filepath.Join(dir, name)); err == nil {
+				t.Errorf("%s created in base datastore", name)
+			}
+
+			if n := len(base.Service.registry.AllReference("Task")); n != tasks {
+				t.Errorf("fork tasks registered with the base Model: %d", n-tasks)
+			}
+		})
+	}
+
+	t.Run("base", func(t *testing.T) {
+		t.Parallel()
+
+		if base.Count().total != count.total {
+			t.Error("base Model was modified")
+		}
+
+		vm := base.Service.registry.Any("VirtualMachine").(*VirtualMachine)
+		if vm.Runtime.PowerState != "poweredOn" {
+			t.Errorf("base %s was powered off", vm.Name)
+		}
+	})
+}
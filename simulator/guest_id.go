@@ -54,8 +54,16 @@ var GuestID = []types.VirtualMachineGuestOsIdentifier{
 	types.VirtualMachineGuestOsIdentifierWindows9_64Guest,
 	types.VirtualMachineGuestOsIdentifierWindows9Server64Guest,
 	types.VirtualMachineGuestOsIdentifierWindowsHyperVGuest,
+	types.VirtualMachineGuestOsIdentifierWindows2019srv_64Guest,
+	types.VirtualMachineGuestOsIdentifierWindows2019srvNext_64Guest,
 	types.VirtualMachineGuestOsIdentifierFreebsdGuest,
 	types.VirtualMachineGuestOsIdentifierFreebsd64Guest,
+	types.VirtualMachineGuestOsIdentifierFreebsd11Guest,
+	types.VirtualMachineGuestOsIdentifierFreebsd11_64Guest,
+	types.VirtualMachineGuestOsIdentifierFreebsd12Guest,
+	types.VirtualMachineGuestOsIdentifierFreebsd12_64Guest,
+	types.VirtualMachineGuestOsIdentifierFreebsd13Guest,
+	types.VirtualMachineGuestOsIdentifierFreebsd13_64Guest,
 	types.VirtualMachineGuestOsIdentifierRedhatGuest,
 	types.VirtualMachineGuestOsIdentifierRhel2Guest,
 	types.VirtualMachineGuestOsIdentifierRhel3Guest,
@@ -68,18 +76,24 @@ var GuestID = []types.VirtualMachineGuestOsIdentifier{
 	types.VirtualMachineGuestOsIdentifierRhel6_64Guest,
 	types.VirtualMachineGuestOsIdentifierRhel7Guest,
 	types.VirtualMachineGuestOsIdentifierRhel7_64Guest,
+	types.VirtualMachineGuestOsIdentifierRhel8_64Guest,
+	types.VirtualMachineGuestOsIdentifierRhel9_64Guest,
 	types.VirtualMachineGuestOsIdentifierCentosGuest,
 	types.VirtualMachineGuestOsIdentifierCentos64Guest,
 	types.VirtualMachineGuestOsIdentifierCentos6Guest,
 	types.VirtualMachineGuestOsIdentifierCentos6_64Guest,
 	types.VirtualMachineGuestOsIdentifierCentos7Guest,
 	types.VirtualMachineGuestOsIdentifierCentos7_64Guest,
+	types.VirtualMachineGuestOsIdentifierCentos8_64Guest,
+	types.VirtualMachineGuestOsIdentifierCentos9_64Guest,
 	types.VirtualMachineGuestOsIdentifierOracleLinuxGuest,
 	types.VirtualMachineGuestOsIdentifierOracleLinux64Guest,
 	types.VirtualMachineGuestOsIdentifierOracleLinux6Guest,
 	types.VirtualMachineGuestOsIdentifierOracleLinux6_64Guest,
 	types.VirtualMachineGuestOsIdentifierOracleLinux7Guest,
 	types.VirtualMachineGuestOsIdentifierOracleLinux7_64Guest,
+	types.VirtualMachineGuestOsIdentifierOracleLinux8_64Guest,
+	types.VirtualMachineGuestOsIdentifierOracleLinux9_64Guest,
 	types.VirtualMachineGuestOsIdentifierSuseGuest,
 	types.VirtualMachineGuestOsIdentifierSuse64Guest,
 	types.VirtualMachineGuestOsIdentifierSlesGuest,
@@ -90,6 +104,8 @@ var GuestID = []types.VirtualMachineGuestOsIdentifier{
 	types.VirtualMachineGuestOsIdentifierSles11_64Guest,
 	types.VirtualMachineGuestOsIdentifierSles12Guest,
 	types.VirtualMachineGuestOsIdentifierSles12_64Guest,
+	types.VirtualMachineGuestOsIdentifierSles15_64Guest,
+	types.VirtualMachineGuestOsIdentifierSles16_64Guest,
 	types.VirtualMachineGuestOsIdentifierNld9Guest,
 	types.VirtualMachineGuestOsIdentifierOesGuest,
 	types.VirtualMachineGuestOsIdentifierSjdsGuest,
@@ -114,12 +130,16 @@ var GuestID = []types.VirtualMachineGuestOsIdentifier{
 	types.VirtualMachineGuestOsIdentifierDebian9_64Guest,
 	types.VirtualMachineGuestOsIdentifierDebian10Guest,
 	types.VirtualMachineGuestOsIdentifierDebian10_64Guest,
+	types.VirtualMachineGuestOsIdentifierDebian11Guest,
+	types.VirtualMachineGuestOsIdentifierDebian11_64Guest,
 	types.VirtualMachineGuestOsIdentifierAsianux3Guest,
 	types.VirtualMachineGuestOsIdentifierAsianux3_64Guest,
 	types.VirtualMachineGuestOsIdentifierAsianux4Guest,
 	types.VirtualMachineGuestOsIdentifierAsianux4_64Guest,
 	types.VirtualMachineGuestOsIdentifierAsianux5_64Guest,
 	types.VirtualMachineGuestOsIdentifierAsianux7_64Guest,
+	types.VirtualMachineGuestOsIdentifierAsianux8_64Guest,
+	types.VirtualMachineGuestOsIdentifierAsianux9_64Guest,
 	types.VirtualMachineGuestOsIdentifierOpensuseGuest,
 	types.VirtualMachineGuestOsIdentifierOpensuse64Guest,
 	types.VirtualMachineGuestOsIdentifierFedoraGuest,
@@ -130,10 +150,14 @@ var GuestID = []types.VirtualMachineGuestOsIdentifier{
 	types.VirtualMachineGuestOsIdentifierOther26xLinuxGuest,
 	types.VirtualMachineGuestOsIdentifierOtherLinuxGuest,
 	types.VirtualMachineGuestOsIdentifierOther3xLinuxGuest,
+	types.VirtualMachineGuestOsIdentifierOther4xLinuxGuest,
+	types.VirtualMachineGuestOsIdentifierOther5xLinuxGuest,
 	types.VirtualMachineGuestOsIdentifierGenericLinuxGuest,
 	types.VirtualMachineGuestOsIdentifierOther24xLinux64Guest,
 	types.VirtualMachineGuestOsIdentifierOther26xLinux64Guest,
 	types.VirtualMachineGuestOsIdentifierOther3xLinux64Guest,
+	types.VirtualMachineGuestOsIdentifierOther4xLinux64Guest,
+	types.VirtualMachineGuestOsIdentifierOther5xLinux64Guest,
 	types.VirtualMachineGuestOsIdentifierOtherLinux64Guest,
 	types.VirtualMachineGuestOsIdentifierSolaris6Guest,
 	types.VirtualMachineGuestOsIdentifierSolaris7Guest,
@@ -162,10 +186,19 @@ var GuestID = []types.VirtualMachineGuestOsIdentifier{
 	types.VirtualMachineGuestOsIdentifierDarwin14_64Guest,
 	types.VirtualMachineGuestOsIdentifierDarwin15_64Guest,
 	types.VirtualMachineGuestOsIdentifierDarwin16_64Guest,
+	types.VirtualMachineGuestOsIdentifierDarwin17_64Guest,
+	types.VirtualMachineGuestOsIdentifierDarwin18_64Guest,
+	types.VirtualMachineGuestOsIdentifierDarwin19_64Guest,
+	types.VirtualMachineGuestOsIdentifierDarwin20_64Guest,
+	types.VirtualMachineGuestOsIdentifierDarwin21_64Guest,
 	types.VirtualMachineGuestOsIdentifierVmkernelGuest,
 	types.VirtualMachineGuestOsIdentifierVmkernel5Guest,
 	types.VirtualMachineGuestOsIdentifierVmkernel6Guest,
 	types.VirtualMachineGuestOsIdentifierVmkernel65Guest,
+	types.VirtualMachineGuestOsIdentifierVmkernel7Guest,
+	types.VirtualMachineGuestOsIdentifierAmazonlinux2_64Guest,
+	types.VirtualMachineGuestOsIdentifierAmazonlinux3_64Guest,
+	types.VirtualMachineGuestOsIdentifierCrxPod1Guest,
 	types.VirtualMachineGuestOsIdentifierOtherGuest,
 	types.VirtualMachineGuestOsIdentifierOtherGuest64,
 }
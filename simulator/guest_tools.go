@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// toolsVersion is the version of the tools installer bundled with simulated hosts,
+// reported by guest.toolsVersion once tools are installed or upgraded.
+const toolsVersion = 11333
+
+// toolsInstalled returns true if tools are installed in the guest.
+// Container backed VMs are considered to have tools installed, see container.go
+func (vm *VirtualMachine) toolsInstalled() bool {
+	if vm.run.id != "" {
+		return true
+	}
+	return vm.Guest.ToolsVersion != "" && vm.Guest.ToolsVersion != "0"
+}
+
+// toolsState returns the property changes for the given tools running status
+func (vm *VirtualMachine) toolsState(running types.VirtualMachineToolsRunningStatus) []types.PropertyChange {
+	status := types.VirtualMachineToolsStatusToolsNotInstalled
+	heartbeat := types.ManagedEntityStatusGray
+	state := types.VirtualMachineGuestStateNotRunning
+
+	if vm.toolsInstalled() {
+		status = types.VirtualMachineToolsStatusToolsNotRunning
+		if running == types.VirtualMachineToolsRunningStatusGuestToolsRunning {
+			status = types.VirtualMachineToolsStatusToolsOk
+			if vm.Guest.ToolsVersion != strconv.Itoa(toolsVersion) {
+				status = types.VirtualMachineToolsStatusToolsOld
+			}
+			heartbeat = types.ManagedEntityStatusGreen
+			state = types.VirtualMachineGuestStateRunning
+		}
+	} else {
+		running = types.VirtualMachineToolsRunningStatusGuestToolsNotRunning
+	}
+
+	return []types.PropertyChange{
+		{Name: "guest.toolsStatus", Val: status},
+		{Name: "guest.toolsRunningStatus", Val: string(running)},
+		{Name: "guest.guestState", Val: string(state)},
+		{Name: "summary.guest.toolsStatus", Val: status},
+		{Name: "summary.guest.toolsRunningStatus", Val: string(running)},
+		{Name: "summary.quickStats.guestHeartbeatStatus", Val: heartbeat},
+		{Name: "guestHeartbeatStatus", Val: heartbeat},
+	}
+}
+
+// toolsVersionState returns the property changes for the given installed tools version, 0 meaning not installed
+func (vm *VirtualMachine) toolsVersionState(version int) []types.PropertyChange {
+	status := types.VirtualMachineToolsVersionStatusGuestToolsNotInstalled
+	switch {
+	case version == toolsVersion:
+		status = types.VirtualMachineToolsVersionStatusGuestToolsCurrent
+	case version != 0:
+		status = types.VirtualMachineToolsVersionStatusGuestToolsNeedUpgrade
+	}
+
+	return []types.PropertyChange{
+		{Name: "guest.toolsVersion", Val: strconv.Itoa(version)},
+		{Name: "guest.toolsVersionStatus", Val: string(status)},
+		{Name: "guest.toolsVersionStatus2", Val: string(status)},
+		{Name: "summary.guest.toolsVersionStatus", Val: string(status)},
+		{Name: "summary.guest.toolsVersionStatus2", Val: string(status)},
+		{Name: "config.tools.toolsVersion", Val: int32(version)},
+	}
+}
+
+// toolsInstall installs the current tools version, as if the guest ran the installer.
+func (vm *VirtualMachine) toolsInstall(ctx *Context) {
+	ctx.Map.Update(vm, vm.toolsVersionState(toolsVersion))
+	ctx.Map.Update(vm, vm.toolsState(types.VirtualMachineToolsRunningStatusGuestToolsNotRunning))
+}
+
+// toolsDelay returns the configured delay between guest boot and tools running
+func (c *Context) toolsDelay() time.Duration {
+	if c.svc == nil {
+		return 0
+	}
+	return c.svc.toolsDelay
+}
+
+// toolsStart reports tools as running, after the configured delay if any.
+// This is a no-op if tools are not installed or the VM is backed by a container, see container.go
+func (vm *VirtualMachine) toolsStart(ctx *Context) {
+	if vm.run.id != "" || !vm.toolsInstalled() {
+		return
+	}
+
+	start := func(ctx *Context) {
+		if vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn {
+			ctx.Map.Update(vm, vm.toolsState(types.VirtualMachineToolsRunningStatusGuestToolsRunning))
+		}
+	}
+
+	delay := ctx.toolsDelay()
+	if delay == 0 {
+		start(ctx)
+		return
+	}
+
+	// the request ctx may be done by the time the timer fires, use our own for locking
+	tctx := &Context{Context: context.Background(), Session: ctx.Session, Map: ctx.Map}
+
+	vm.tt = time.AfterFunc(delay, func() {
+		tctx.WithLock(vm, func() {
+			start(tctx)
+		})
+	})
+}
+
+// toolsStop cancels any pending tools start and reports tools as not running.
+func (vm *VirtualMachine) toolsStop(ctx *Context) {
+	if vm.tt != nil {
+		vm.tt.Stop()
+		vm.tt = nil
+	}
+
+	if vm.run.id != "" {
+		return // container.go
+	}
+
+	ctx.Map.Update(vm, vm.toolsState(types.VirtualMachineToolsRunningStatusGuestToolsNotRunning))
+}
+
+func (vm *VirtualMachine) MountToolsInstaller(ctx *Context, req *types.MountToolsInstaller) soap.HasFault {
+	body := new(methods.MountToolsInstallerBody)
+
+	if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+		body.Fault_ = Fault("", &types.InvalidPowerState{
+			RequestedState: types.VirtualMachinePowerStatePoweredOn,
+			ExistingState:  vm.Runtime.PowerState,
+		})
+		return body
+	}
+
+	if vm.Runtime.ToolsInstallerMounted {
+		body.Fault_ = Fault("VMware Tools installer is already mounted", new(types.InvalidState))
+		return body
+	}
+
+	ctx.Map.Update(vm, []types.PropertyChange{
+		{Name: "runtime.toolsInstallerMounted", Val: true},
+		{Name: "summary.runtime.toolsInstallerMounted", Val: true},
+	})
+
+	if !vm.toolsInstalled() {
+		// The simulated guest runs the installer from the mounted CD
+		vm.toolsInstall(ctx)
+		vm.toolsStart(ctx)
+	}
+
+	body.Res = new(types.MountToolsInstallerResponse)
+
+	return body
+}
+
+func (vm *VirtualMachine) UnmountToolsInstaller(ctx *Context, req *types.UnmountToolsInstaller) soap.HasFault {
+	ctx.Map.Update(vm, []types.PropertyChange{
+		{Name: "runtime.toolsInstallerMounted", Val: false},
+		{Name: "summary.runtime.toolsInstallerMounted", Val: false},
+	})
+
+	return &methods.UnmountToolsInstallerBody{
+		Res: new(types.UnmountToolsInstallerResponse),
+	}
+}
+
+func (vm *VirtualMachine) UpgradeToolsTask(ctx *Context, req *types.UpgradeTools_Task) soap.HasFault {
+	task := CreateTask(ctx, vm, "upgradeTools", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			return nil, &types.InvalidPowerState{
+				RequestedState: types.VirtualMachinePowerStatePoweredOn,
+				ExistingState:  vm.Runtime.PowerState,
+			}
+		}
+
+		if vm.Guest.ToolsRunningStatus != string(types.VirtualMachineToolsRunningStatusGuestToolsRunning) {
+			return nil, new(types.ToolsUnavailable)
+		}
+
+		ctx.Map.Update(vm, vm.toolsVersionState(toolsVersion))
+
+		if vm.run.id == "" {
+			// tools restart after the upgrade
+			vm.toolsStop(ctx)
+			vm.toolsStart(ctx)
+		}
+
+		return nil, nil
+	})
+
+	return &methods.UpgradeTools_TaskBody{
+		Res: &types.UpgradeTools_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
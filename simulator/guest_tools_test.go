@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestGuestTools(t *testing.T) {
+	m := VPX()
+	m.GuestToolsDelay = 50 * time.Millisecond
+
+	defer m.Remove()
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		props := []string{"guest", "summary", "guestHeartbeatStatus", "runtime", "config.tools"}
+
+		state := func() mo.VirtualMachine {
+			var mvm mo.VirtualMachine
+			if err := vm.Properties(ctx, vm.Reference(), props, &mvm); err != nil {
+				t.Fatal(err)
+			}
+			return mvm
+		}
+
+		waitForTools := func() {
+			tctx, cancel := context.WithTimeout(ctx, time.Second)
+			defer cancel()
+
+			running := string(types.VirtualMachineToolsRunningStatusGuestToolsRunning)
+			err := property.Wait(tctx, property.DefaultCollector(c), vm.Reference(), []string{"guest.toolsRunningStatus"}, func(changes []types.PropertyChange) bool {
+				for _, change := range changes {
+					if change.Val == running {
+						return true
+					}
+				}
+				return false
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		mvm := state()
+		if mvm.Guest.ToolsStatus != types.VirtualMachineToolsStatusToolsNotInstalled {
+			t.Errorf("toolsStatus=%s", mvm.Guest.ToolsStatus)
+		}
+		if mvm.Guest.ToolsVersionStatus2 != string(types.VirtualMachineToolsVersionStatusGuestToolsNotInstalled) {
+			t.Errorf("toolsVersionStatus2=%s", mvm.Guest.ToolsVersionStatus2)
+		}
+		if mvm.GuestHeartbeatStatus != types.ManagedEntityStatusGray {
+			t.Errorf("guestHeartbeatStatus=%s", mvm.GuestHeartbeatStatus)
+		}
+
+		// tools must be running to upgrade
+		upgrade, err := vm.UpgradeTools(ctx, "")
+		if err != nil {
+			return err
+		}
+		err = upgrade.Wait(ctx)
+		if _, ok := err.(task.Error).Fault().(*types.ToolsUnavailable); !ok {
+			t.Errorf("err=%#v", err)
+		}
+
+		// the guest installs tools from the mounted installer
+		if err = vm.MountToolsInstaller(ctx); err != nil {
+			return err
+		}
+
+		err = vm.MountToolsInstaller(ctx)
+		if _, ok := soap.ToSoapFault(err).VimFault().(types.InvalidState); !ok {
+			t.Errorf("err=%#v", err)
+		}
+
+		waitForTools()
+
+		mvm = state()
+		if !mvm.Runtime.ToolsInstallerMounted {
+			t.Error("expected toolsInstallerMounted")
+		}
+		if mvm.Guest.ToolsStatus != types.VirtualMachineToolsStatusToolsOk {
+			t.Errorf("toolsStatus=%s", mvm.Guest.ToolsStatus)
+		}
+		if mvm.Guest.ToolsVersionStatus2 != string(types.VirtualMachineToolsVersionStatusGuestToolsCurrent) {
+			t.Errorf("toolsVersionStatus2=%s", mvm.Guest.ToolsVersionStatus2)
+		}
+		if mvm.Config.Tools.ToolsVersion != toolsVersion {
+			t.Errorf("toolsVersion=%d", mvm.Config.Tools.ToolsVersion)
+		}
+		if mvm.GuestHeartbeatStatus != types.ManagedEntityStatusGreen {
+			t.Errorf("guestHeartbeatStatus=%s", mvm.GuestHeartbeatStatus)
+		}
+		if mvm.Summary.QuickStats.GuestHeartbeatStatus != types.ManagedEntityStatusGreen {
+			t.Errorf("summary.quickStats.guestHeartbeatStatus=%s", mvm.Summary.QuickStats.GuestHeartbeatStatus)
+		}
+
+		if err = vm.UnmountToolsInstaller(ctx); err != nil {
+			return err
+		}
+		if state().Runtime.ToolsInstallerMounted {
+			t.Error("expected !toolsInstallerMounted")
+		}
+
+		// tools stop on power off and start again after power on
+		for _, op := range []func(context.Context) (*object.Task, error){vm.PowerOff, vm.PowerOn} {
+			task, err := op(ctx)
+			if err != nil {
+				return err
+			}
+			if err = task.Wait(ctx); err != nil {
+				return err
+			}
+
+			mvm = state()
+			if mvm.Guest.ToolsStatus != types.VirtualMachineToolsStatusToolsNotRunning {
+				t.Errorf("toolsStatus=%s", mvm.Guest.ToolsStatus)
+			}
+			if mvm.GuestHeartbeatStatus != types.ManagedEntityStatusGray {
+				t.Errorf("guestHeartbeatStatus=%s", mvm.GuestHeartbeatStatus)
+			}
+		}
+
+		waitForTools()
+
+		upgrade, err = vm.UpgradeTools(ctx, "")
+		if err != nil {
+			return err
+		}
+		if err = upgrade.Wait(ctx); err != nil {
+			return err
+		}
+
+		waitForTools()
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestModelGuestTools(t *testing.T) {
+	m := VPX()
+	m.GuestTools = true
+
+	defer m.Remove()
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		check := func(running types.VirtualMachineToolsRunningStatus, heartbeat types.ManagedEntityStatus) {
+			var mvm mo.VirtualMachine
+			if err := vm.Properties(ctx, vm.Reference(), []string{"guest", "guestHeartbeatStatus"}, &mvm); err != nil {
+				t.Fatal(err)
+			}
+			if mvm.Guest.ToolsVersionStatus2 != string(types.VirtualMachineToolsVersionStatusGuestToolsCurrent) {
+				t.Errorf("toolsVersionStatus2=%s", mvm.Guest.ToolsVersionStatus2)
+			}
+			if mvm.Guest.ToolsRunningStatus != string(running) {
+				t.Errorf("toolsRunningStatus=%s", mvm.Guest.ToolsRunningStatus)
+			}
+			if mvm.GuestHeartbeatStatus != heartbeat {
+				t.Errorf("guestHeartbeatStatus=%s", mvm.GuestHeartbeatStatus)
+			}
+		}
+
+		check(types.VirtualMachineToolsRunningStatusGuestToolsRunning, types.ManagedEntityStatusGreen)
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		check(types.VirtualMachineToolsRunningStatusGuestToolsNotRunning, types.ManagedEntityStatusGray)
+
+		task, err = vm.PowerOn(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		check(types.VirtualMachineToolsRunningStatusGuestToolsRunning, types.ManagedEntityStatusGreen)
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
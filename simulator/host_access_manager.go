@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// HostAccessManager implements host lockdown mode.
+// While in lockdown mode, direct host logins are rejected unless the user is a lockdown exception or system user.
+// Direct host logins are those made to a standalone ESX simulator or to a host's listener, see Model.HostListen.
+type HostAccessManager struct {
+	mo.HostAccessManager
+
+	Host *mo.HostSystem
+
+	exceptions  []string
+	systemUsers []string
+}
+
+func NewHostAccessManager(h *mo.HostSystem) *HostAccessManager {
+	return &HostAccessManager{
+		HostAccessManager: mo.HostAccessManager{
+			LockdownMode: types.HostLockdownModeLockdownDisabled,
+		},
+		Host: h,
+	}
+}
+
+// allowed returns true if the given user can login directly to the host
+func (m *HostAccessManager) allowed(user string) bool {
+	if m.LockdownMode == types.HostLockdownModeLockdownDisabled {
+		return true
+	}
+
+	for _, users := range [][]string{m.exceptions, m.systemUsers} {
+		for _, name := range users {
+			if name == user {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// lockdown sets the host lockdown mode
+func (m *HostAccessManager) lockdown(ctx *Context, mode types.HostLockdownMode) {
+	ctx.Map.Update(m, []types.PropertyChange{{Name: "lockdownMode", Val: mode}})
+
+	m.Host.Config.AdminDisabled = types.NewBool(mode != types.HostLockdownModeLockdownDisabled)
+	ctx.Map.Update(m.Host, []types.PropertyChange{{Name: "config.lockdownMode", Val: mode}})
+}
+
+func (m *HostAccessManager) ChangeLockdownMode(ctx *Context, req *types.ChangeLockdownMode) soap.HasFault {
+	body := new(methods.ChangeLockdownModeBody)
+
+	switch req.Mode {
+	case types.HostLockdownModeLockdownDisabled, types.HostLockdownModeLockdownNormal, types.HostLockdownModeLockdownStrict:
+	default:
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "mode"})
+		return body
+	}
+
+	if req.Mode == m.LockdownMode {
+		body.Fault_ = Fault("", &types.InvalidState{})
+		return body
+	}
+
+	m.lockdown(ctx, req.Mode)
+
+	body.Res = new(types.ChangeLockdownModeResponse)
+
+	return body
+}
+
+func (m *HostAccessManager) QueryLockdownExceptions(req *types.QueryLockdownExceptions) soap.HasFault {
+	return &methods.QueryLockdownExceptionsBody{
+		Res: &types.QueryLockdownExceptionsResponse{
+			Returnval: m.exceptions,
+		},
+	}
+}
+
+func (m *HostAccessManager) UpdateLockdownExceptions(req *types.UpdateLockdownExceptions) soap.HasFault {
+	m.exceptions = req.Users
+
+	return &methods.UpdateLockdownExceptionsBody{
+		Res: new(types.UpdateLockdownExceptionsResponse),
+	}
+}
+
+func (m *HostAccessManager) QuerySystemUsers(req *types.QuerySystemUsers) soap.HasFault {
+	return &methods.QuerySystemUsersBody{
+		Res: &types.QuerySystemUsersResponse{
+			Returnval: m.systemUsers,
+		},
+	}
+}
+
+func (m *HostAccessManager) UpdateSystemUsers(req *types.UpdateSystemUsers) soap.HasFault {
+	m.systemUsers = req.Users
+
+	return &methods.UpdateSystemUsersBody{
+		Res: new(types.UpdateSystemUsersResponse),
+	}
+}
+
+// directHost returns the HostSystem if the request is a direct host request,
+// either to a standalone ESX simulator or to a host's listener.
+func (c *Context) directHost() *HostSystem {
+	if c.Map.IsESX() {
+		return c.Map.Get(esx.HostSystem.Reference()).(*HostSystem)
+	}
+
+	if v := c.hostView(); v != nil {
+		return v.host
+	}
+
+	return nil
+}
+
+// lockdown returns a NoPermission fault if the request is a direct host login
+// and the host's lockdown mode does not allow the given user.
+func (c *Context) lockdown(user string) *soap.Fault {
+	h := c.directHost()
+	if h == nil || h.ConfigManager.HostAccessManager == nil {
+		return nil
+	}
+
+	m, ok := c.Map.Get(*h.ConfigManager.HostAccessManager).(*HostAccessManager)
+	if !ok || m.allowed(user) {
+		return nil
+	}
+
+	return Fault("Host is in lockdown mode", &types.NoPermission{Object: h.Self, PrivilegeId: "System.Anonymous"})
+}
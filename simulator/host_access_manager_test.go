@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"crypto/tls"
+	"net/url"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// testLogin logs in to the given URL with a new client
+func testLogin(ctx context.Context, u *url.URL) error {
+	c, err := vim25.NewClient(ctx, soap.NewClient(u, true))
+	if err != nil {
+		return err
+	}
+
+	return session.NewManager(c).Login(ctx, u.User)
+}
+
+// testLockdown enables lockdown mode on the given host and verifies logins to the given URL are rejected,
+// unless the user is a lockdown exception.
+func testLockdown(t *testing.T, ctx context.Context, c *vim25.Client, host *object.HostSystem, u *url.URL) {
+	var h mo.HostSystem
+	err := host.Properties(ctx, host.Reference(), []string{"configManager.hostAccessManager"}, &h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := *h.ConfigManager.HostAccessManager
+
+	if err = testLogin(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = methods.ChangeLockdownMode(ctx, c, &types.ChangeLockdownMode{This: ref, Mode: types.HostLockdownModeLockdownNormal})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = host.Properties(ctx, host.Reference(), []string{"config.lockdownMode", "config.adminDisabled"}, &h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Config.LockdownMode != types.HostLockdownModeLockdownNormal || !*h.Config.AdminDisabled {
+		t.Errorf("lockdownMode=%s", h.Config.LockdownMode)
+	}
+
+	err = testLogin(ctx, u)
+	if _, ok := soap.ToSoapFault(err).VimFault().(types.NoPermission); !ok {
+		t.Errorf("err=%#v", err)
+	}
+
+	_, err = methods.UpdateLockdownExceptions(ctx, c, &types.UpdateLockdownExceptions{This: ref, Users: []string{u.User.Username()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := methods.QueryLockdownExceptions(ctx, c, &types.QueryLockdownExceptions{This: ref})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Returnval) != 1 {
+		t.Errorf("exceptions=%v", res.Returnval)
+	}
+
+	if err = testLogin(ctx, u); err != nil {
+		t.Error(err)
+	}
+
+	_, err = methods.ExitLockdownMode(ctx, c, &types.ExitLockdownMode{This: host.Reference()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = methods.ExitLockdownMode(ctx, c, &types.ExitLockdownMode{This: host.Reference()})
+	if _, ok := soap.ToSoapFault(err).VimFault().(types.AdminNotDisabled); !ok {
+		t.Errorf("err=%#v", err)
+	}
+}
+
+func TestHostAccessManager(t *testing.T) {
+	ctx := context.Background()
+
+	for _, model := range []*Model{ESX(), VPX()} {
+		model.HostListen = true
+
+		defer model.Remove()
+
+		err := model.Create()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		model.Service.TLS = new(tls.Config)
+		s := model.Service.NewServer()
+		defer s.Close()
+
+		c, err := vim25.NewClient(ctx, soap.NewClient(s.URL, true))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = session.NewManager(c).Login(ctx, s.URL.User); err != nil {
+			t.Fatal(err)
+		}
+
+		finder := find.NewFinder(c)
+		host, err := finder.DefaultHostSystem(ctx)
+		if c.IsVC() {
+			host, err = finder.HostSystem(ctx, "DC0_C0_H0")
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.IsVC() {
+			// vCenter logins are not subject to host lockdown mode
+			_, err = methods.EnterLockdownMode(ctx, c, &types.EnterLockdownMode{This: host.Reference()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = testLogin(ctx, s.URL); err != nil {
+				t.Fatal(err)
+			}
+			_, err = methods.ExitLockdownMode(ctx, c, &types.ExitLockdownMode{This: host.Reference()})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testLockdown(t, ctx, c, host, s.HostURL(host))
+		} else {
+			testLockdown(t, ctx, c, host, s.URL)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator/internal"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// HostCertificateManager generates a self-signed certificate for its host,
+// which can be replaced via GenerateCertificateSigningRequest and InstallServerCertificate.
+// The installed certificate is served by the host's TLS listener, see Model.HostListen.
+type HostCertificateManager struct {
+	mo.HostCertificateManager
+
+	Host *mo.HostSystem
+
+	cert   atomic.Value  // *tls.Certificate, read by the host's TLS listener
+	csrKey crypto.Signer // private key of the most recent signing request, if any
+	caCert []string
+	caCrl  []string
+}
+
+func NewHostCertificateManager(h *mo.HostSystem) *HostCertificateManager {
+	m := &HostCertificateManager{Host: h}
+
+	m.generate()
+
+	return m
+}
+
+// generate creates a new self-signed certificate for the host
+func (m *HostCertificateManager) generate() {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	serial, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	now := time.Now()
+	subject := m.subject(false)
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               subject,
+		Issuer:                subject,
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.AddDate(5, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{m.Host.Summary.Config.Name},
+	}
+
+	if ip := net.ParseIP(m.ipAddress()); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		panic(err)
+	}
+
+	m.install(&tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key})
+}
+
+// install sets the given certificate as the host certificate
+func (m *HostCertificateManager) install(cert *tls.Certificate) {
+	m.cert.Store(cert)
+
+	x, _ := x509.ParseCertificate(cert.Certificate[0])
+	info := new(object.HostCertificateInfo).FromCertificate(x)
+	info.Status = string(certificateStatus(x))
+
+	m.CertificateInfo = info.HostCertificateManagerCertificateInfo
+	m.Host.Summary.Config.SslThumbprint = info.ThumbprintSHA1
+}
+
+// certificateStatus returns the status of the given certificate based on its expiration date
+func certificateStatus(cert *x509.Certificate) types.HostCertificateManagerCertificateInfoCertificateStatus {
+	left := time.Until(cert.NotAfter)
+
+	switch {
+	case left <= 0:
+		return types.HostCertificateManagerCertificateInfoCertificateStatusExpired
+	case left < 7*24*time.Hour:
+		return types.HostCertificateManagerCertificateInfoCertificateStatusExpirationImminent
+	case left < 30*24*time.Hour:
+		return types.HostCertificateManagerCertificateInfoCertificateStatusExpiring
+	default:
+		return types.HostCertificateManagerCertificateInfoCertificateStatusGood
+	}
+}
+
+// certificate returns the installed host certificate
+func (m *HostCertificateManager) certificate() *tls.Certificate {
+	return m.cert.Load().(*tls.Certificate)
+}
+
+// tlsConfig returns a tls.Config for the host's TLS listener, which serves the currently installed certificate
+func (m *HostCertificateManager) tlsConfig() *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{*m.certificate()},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				Certificates: []tls.Certificate{*m.certificate()},
+				ClientAuth:   tls.RequestClientCert,
+				NextProtos:   []string{"http/1.1"},
+			}, nil
+		},
+	}
+}
+
+func (m *HostCertificateManager) ipAddress() string {
+	if net := m.Host.Config.Network; net != nil && len(net.Vnic) != 0 {
+		return net.Vnic[0].Spec.Ip.IpAddress
+	}
+	return ""
+}
+
+func (m *HostCertificateManager) subject(useIP bool) pkix.Name {
+	name := m.Host.Summary.Config.Name
+	if useIP {
+		if ip := m.ipAddress(); ip != "" {
+			name = ip
+		}
+	}
+
+	return pkix.Name{
+		CommonName:         name,
+		Organization:       []string{"VMware, Inc."},
+		OrganizationalUnit: []string{"VMware ESX Server Default Certificate"},
+		Locality:           []string{"Palo Alto"},
+		Province:           []string{"California"},
+		Country:            []string{"US"},
+	}
+}
+
+func (m *HostCertificateManager) GenerateCertificateSigningRequest(ctx *Context, req *types.GenerateCertificateSigningRequest) soap.HasFault {
+	body := new(methods.GenerateCertificateSigningRequestBody)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		body.Fault_ = Fault(err.Error(), new(types.HostConfigFault))
+		return body
+	}
+
+	template := &x509.CertificateRequest{
+		Subject: m.subject(req.UseIpAddressAsCommonName),
+	}
+
+	ip := net.ParseIP(m.ipAddress())
+	if req.UseIpAddressAsCommonName && ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{m.Host.Summary.Config.Name}
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		body.Fault_ = Fault(err.Error(), new(types.HostConfigFault))
+		return body
+	}
+
+	m.csrKey = key
+
+	body.Res = &types.GenerateCertificateSigningRequestResponse{
+		Returnval: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})),
+	}
+
+	return body
+}
+
+// parseCertificates decodes the given PEM encoded certificates
+func parseCertificates(certs ...string) ([]*x509.Certificate, bool) {
+	var res []*x509.Certificate
+
+	for _, cert := range certs {
+		block, _ := pem.Decode([]byte(cert))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return nil, false
+		}
+		x, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, false
+		}
+		res = append(res, x)
+	}
+
+	return res, true
+}
+
+func (m *HostCertificateManager) InstallServerCertificate(ctx *Context, req *types.InstallServerCertificate) soap.HasFault {
+	body := new(methods.InstallServerCertificateBody)
+
+	certs, ok := parseCertificates(req.Cert)
+	if !ok {
+		body.Fault_ = Fault("invalid certificate", &types.InvalidArgument{InvalidProperty: "cert"})
+		return body
+	}
+
+	if m.csrKey == nil || !m.csrKey.Public().(interface{ Equal(crypto.PublicKey) bool }).Equal(certs[0].PublicKey) {
+		body.Fault_ = Fault("certificate does not match the signing request private key", &types.InvalidArgument{InvalidProperty: "cert"})
+		return body
+	}
+
+	m.install(&tls.Certificate{Certificate: [][]byte{certs[0].Raw}, PrivateKey: m.csrKey})
+	m.csrKey = nil
+
+	ctx.Map.Update(m, []types.PropertyChange{{Name: "certificateInfo", Val: m.CertificateInfo}})
+	ctx.Map.Update(m.Host, []types.PropertyChange{{Name: "summary.config.sslThumbprint", Val: m.Host.Summary.Config.SslThumbprint}})
+
+	body.Res = new(types.InstallServerCertificateResponse)
+
+	return body
+}
+
+// NotifyAffectedServices is called by clients after InstallServerCertificate, see object.HostCertificateManager
+func (m *HostCertificateManager) NotifyAffectedServices(req *internal.NotifyAffectedServices) soap.HasFault {
+	return &internal.NotifyAffectedServicesBody{
+		Res: new(internal.NotifyAffectedServicesResponse),
+	}
+}
+
+func (m *HostCertificateManager) ReplaceCACertificatesAndCRLs(req *types.ReplaceCACertificatesAndCRLs) soap.HasFault {
+	body := new(methods.ReplaceCACertificatesAndCRLsBody)
+
+	if _, ok := parseCertificates(req.CaCert...); !ok {
+		body.Fault_ = Fault("invalid certificate", &types.InvalidArgument{InvalidProperty: "caCert"})
+		return body
+	}
+
+	for _, crl := range req.CaCrl {
+		block, _ := pem.Decode([]byte(crl))
+		if block == nil {
+			body.Fault_ = Fault("invalid CRL", &types.InvalidArgument{InvalidProperty: "caCrl"})
+			return body
+		}
+		if _, err := x509.ParseCRL(block.Bytes); err != nil {
+			body.Fault_ = Fault(err.Error(), &types.InvalidArgument{InvalidProperty: "caCrl"})
+			return body
+		}
+	}
+
+	m.caCert = req.CaCert
+	m.caCrl = req.CaCrl
+
+	body.Res = new(types.ReplaceCACertificatesAndCRLsResponse)
+
+	return body
+}
+
+func (m *HostCertificateManager) ListCACertificates(req *types.ListCACertificates) soap.HasFault {
+	return &methods.ListCACertificatesBody{
+		Res: &types.ListCACertificatesResponse{
+			Returnval: m.caCert,
+		},
+	}
+}
+
+func (m *HostCertificateManager) ListCACertificateRevocationLists(req *types.ListCACertificateRevocationLists) soap.HasFault {
+	return &methods.ListCACertificateRevocationListsBody{
+		Res: &types.ListCACertificateRevocationListsResponse{
+			Returnval: m.caCrl,
+		},
+	}
+}
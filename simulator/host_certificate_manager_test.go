@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+)
+
+// testCA signs certificate requests
+type testCA struct {
+	key  *ecdsa.PrivateKey
+	cert *x509.Certificate
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "vcsim test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, _ := x509.ParseCertificate(der)
+
+	return &testCA{key, cert}
+}
+
+func (ca *testCA) pem() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}))
+}
+
+func (ca *testCA) sign(t *testing.T, csr string) string {
+	block, _ := pem.Decode([]byte(csr))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		t.Fatalf("invalid csr: %s", csr)
+	}
+
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      req.Subject,
+		DNSNames:     req.DNSNames,
+		IPAddresses:  req.IPAddresses,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour * 24 * 365),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, req.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestHostCertificateManager(t *testing.T) {
+	ctx := context.Background()
+
+	m := VPX()
+	m.HostListen = true
+
+	defer m.Remove()
+
+	err := m.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.Service.TLS = new(tls.Config)
+	s := m.Service.NewServer()
+	defer s.Close()
+
+	c, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	host, err := find.NewFinder(c.Client).HostSystem(ctx, "DC0_H0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cm, err := host.ConfigManager().CertificateManager(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// listenerInfo returns the certificate served by the host listener
+	listenerInfo := func() *object.HostCertificateInfo {
+		u := s.HostURL(host)
+		if u == nil {
+			t.Fatal("no host listener")
+		}
+
+		info := new(object.HostCertificateInfo)
+		if err := info.FromURL(u, &tls.Config{InsecureSkipVerify: true}); err != nil {
+			t.Fatal(err)
+		}
+
+		return info
+	}
+
+	info, err := cm.CertificateInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(info.Subject, "CN=DC0_H0") {
+		t.Errorf("subject=%s", info.Subject)
+	}
+	if info.Status != "good" {
+		t.Errorf("status=%s", info.Status)
+	}
+	if info.ThumbprintSHA1 == "" || info.ThumbprintSHA1 != listenerInfo().ThumbprintSHA1 {
+		t.Errorf("thumbprint=%s", info.ThumbprintSHA1)
+	}
+
+	ca := newTestCA(t)
+
+	// certificate must match the private key of a signing request
+	if err = cm.InstallServerCertificate(ctx, ca.pem()); err == nil {
+		t.Error("expected error")
+	}
+
+	csr, err := cm.GenerateCertificateSigningRequest(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = cm.InstallServerCertificate(ctx, ca.sign(t, csr)); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err = cm.CertificateInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Issuer != "CN=vcsim test CA" {
+		t.Errorf("issuer=%s", info.Issuer)
+	}
+	if served := listenerInfo(); served.ThumbprintSHA1 != info.ThumbprintSHA1 || served.Issuer != info.Issuer {
+		t.Errorf("listener serves %s, installed %s", served.ThumbprintSHA1, info.ThumbprintSHA1)
+	}
+
+	if err = cm.ReplaceCACertificatesAndCRLs(ctx, []string{"enoent"}, nil); err == nil {
+		t.Error("expected error")
+	}
+
+	if err = cm.ReplaceCACertificatesAndCRLs(ctx, []string{ca.pem()}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	certs, err := cm.ListCACertificates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(certs) != 1 || certs[0] != ca.pem() {
+		t.Errorf("certs=%v", certs)
+	}
+}
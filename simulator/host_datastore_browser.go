@@ -188,7 +188,7 @@ func (s *searchDatastore) Run(task *Task) (types.AnyType, types.BaseMethodFault)
 		return nil, fault
 	}
 
-	ref := Map.FindByName(p.Datastore, s.Datastore)
+	ref := task.ctx.Map.FindByName(p.Datastore, s.Datastore)
 	if ref == nil {
 		return nil, &types.InvalidDatastore{Name: p.Datastore}
 	}
@@ -196,8 +196,8 @@ func (s *searchDatastore) Run(task *Task) (types.AnyType, types.BaseMethodFault)
 	ds := ref.(*Datastore)
 
 	isolatedLockContext := &Context{} // we don't need/want to share the task lock
-	Map.WithLock(isolatedLockContext, task, func() {
-		task.Info.Entity = &ds.Self // TODO: CreateTask() should require mo.Entity, rather than mo.Reference
+	task.ctx.Map.WithLock(isolatedLockContext, task, func() {
+		task.Info.Entity = &ds.Self // TODO: CreateTask(ctx, ) should require mo.Entity, rather than mo.Reference
 		task.Info.EntityName = ds.Name
 	})
 
@@ -226,7 +226,7 @@ func (s *searchDatastore) Run(task *Task) (types.AnyType, types.BaseMethodFault)
 }
 
 func (b *HostDatastoreBrowser) SearchDatastoreTask(ctx *Context, s *types.SearchDatastore_Task) soap.HasFault {
-	task := NewTask(&searchDatastore{
+	task := NewTask(ctx, &searchDatastore{
 		HostDatastoreBrowser: b,
 		DatastorePath:        s.DatastorePath,
 		SearchSpec:           s.SearchSpec,
@@ -240,7 +240,7 @@ func (b *HostDatastoreBrowser) SearchDatastoreTask(ctx *Context, s *types.Search
 }
 
 func (b *HostDatastoreBrowser) SearchDatastoreSubFoldersTask(ctx *Context, s *types.SearchDatastoreSubFolders_Task) soap.HasFault {
-	task := NewTask(&searchDatastore{
+	task := NewTask(ctx, &searchDatastore{
 		HostDatastoreBrowser: b,
 		DatastorePath:        s.DatastorePath,
 		SearchSpec:           s.SearchSpec,
@@ -17,6 +17,9 @@ limitations under the License.
 package simulator
 
 import (
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 
@@ -33,13 +36,15 @@ type HostDatastoreSystem struct {
 	Host *mo.HostSystem
 }
 
-func (dss *HostDatastoreSystem) add(ctx *Context, ds *Datastore) *soap.Fault {
+// add registers the given datastore and mounts it on this host.
+// If a datastore with the same name already exists, that datastore is mounted and returned instead.
+func (dss *HostDatastoreSystem) add(ctx *Context, ds *Datastore) (*Datastore, *soap.Fault) {
 	info := ds.Info.GetDatastoreInfo()
 
 	info.Name = ds.Name
 
 	if e := ctx.Map.FindByName(ds.Name, dss.Datastore); e != nil {
-		return Fault(e.Reference().Value, &types.DuplicateName{
+		return nil, Fault(e.Reference().Value, &types.DuplicateName{
 			Name:   ds.Name,
 			Object: e.Reference(),
 		})
@@ -53,31 +58,34 @@ func (dss *HostDatastoreSystem) add(ctx *Context, ds *Datastore) *soap.Fault {
 	if err != nil {
 		switch {
 		case os.IsNotExist(err):
-			return Fault(err.Error(), &types.NotFound{})
+			return nil, Fault(err.Error(), &types.NotFound{})
 		default:
-			return Fault(err.Error(), &types.HostConfigFault{})
+			return nil, Fault(err.Error(), &types.HostConfigFault{})
 		}
 	}
 
 	folder := ctx.Map.getEntityFolder(dss.Host, "datastore")
 
-	found := false
 	if e := ctx.Map.FindByName(ds.Name, folder.ChildEntity); e != nil {
-		if e.Reference().Type != "Datastore" {
-			return Fault(e.Reference().Value, &types.DuplicateName{
+		existing, ok := e.(*Datastore)
+		if !ok || existing.Summary.Type != ds.Summary.Type || existing.Info.GetDatastoreInfo().Url != info.Url {
+			return nil, Fault(e.Reference().Value, &types.DuplicateName{
 				Name:   ds.Name,
 				Object: e.Reference(),
 			})
 		}
 
-		// if datastore already exists, use current reference
-		found = true
-		ds.Self = e.Reference()
-	} else {
-		// put datastore to folder and generate reference
-		folderPutChild(ctx, folder, ds)
+		// if datastore already exists, mount it on this host
+		ctx.WithLock(existing, func() {
+			dss.mount(ctx, existing)
+		})
+
+		return existing, nil
 	}
 
+	// put datastore to folder and generate reference
+	folderPutChild(ctx, folder, ds)
+
 	ds.Summary.Datastore = &ds.Self
 	ds.Summary.Name = ds.Name
 	ds.Summary.Url = info.Url
@@ -91,28 +99,99 @@ func (dss *HostDatastoreSystem) add(ctx *Context, ds *Datastore) *soap.Fault {
 		SeSparseSupported:                types.NewBool(true),
 	}
 
-	dss.Datastore = append(dss.Datastore, ds.Self)
-	dss.Host.Datastore = dss.Datastore
-	parent := hostParent(dss.Host)
-	ctx.Map.AddReference(ctx, parent, &parent.Datastore, ds.Self)
+	dss.mount(ctx, ds)
 
 	// NOTE: browser must be created after ds is appended to dss.Datastore
-	if !found {
-		browser := &HostDatastoreBrowser{}
-		browser.Datastore = dss.Datastore
-		ds.Browser = ctx.Map.Put(browser).Reference()
+	browser := &HostDatastoreBrowser{}
+	browser.Datastore = dss.Datastore
+	ds.Browser = ctx.Map.Put(browser).Reference()
+
+	ds.Summary.Capacity = int64(units.TB * 10)
+	ds.Summary.FreeSpace = ds.Summary.Capacity
+
+	info.FreeSpace = ds.Summary.FreeSpace
+	info.MaxMemoryFileSize = ds.Summary.Capacity
+	info.MaxFileSize = ds.Summary.Capacity
+
+	return ds, nil
+}
+
+// mount adds a host mount for this host to the given datastore.
+func (dss *HostDatastoreSystem) mount(ctx *Context, ds *Datastore) {
+	if hostMount(ds, dss.Host.Self) == nil {
+		ds.Host = append(ds.Host, types.DatastoreHostMount{
+			Key: dss.Host.Reference(),
+			MountInfo: types.HostMountInfo{
+				AccessMode: string(types.HostMountModeReadWrite),
+				Mounted:    types.NewBool(true),
+				Accessible: types.NewBool(true),
+			},
+		})
 
-		ds.Summary.Capacity = int64(units.TB * 10)
-		ds.Summary.FreeSpace = ds.Summary.Capacity
+		if ds.Self.Value != "" {
+			ctx.Map.Update(ds, []types.PropertyChange{{Name: "host", Val: ds.Host}})
+		}
+	}
 
-		info.FreeSpace = ds.Summary.FreeSpace
-		info.MaxMemoryFileSize = ds.Summary.Capacity
-		info.MaxFileSize = ds.Summary.Capacity
+	if FindReference(dss.Host.Datastore, ds.Self) == nil {
+		dss.Host.Datastore = append(dss.Host.Datastore, ds.Self)
 	}
+	dss.Datastore = dss.Host.Datastore
+	parent := hostParent(ctx, dss.Host)
+	ctx.Map.AddReference(ctx, parent, &parent.Datastore, ds.Self)
+}
 
+// unmount removes this host's mount of the given datastore,
+// removing the datastore from the inventory when no other host has it mounted.
+func (dss *HostDatastoreSystem) unmount(ctx *Context, ds *Datastore) {
+	var mounts []types.DatastoreHostMount
+	for _, m := range ds.Host {
+		if m.Key != dss.Host.Self {
+			mounts = append(mounts, m)
+		}
+	}
+	ds.Host = mounts
+
+	host := ctx.Map.Get(dss.Host.Self).(*HostSystem)
+	ctx.Map.RemoveReference(ctx, host, &host.Datastore, ds.Self)
+	dss.Datastore = host.Datastore
+
+	parent := hostParent(ctx, dss.Host)
+	if parent.Self.Type == "ClusterComputeResource" {
+		// the cluster's datastores are the union of its hosts' datastores
+		for _, ref := range parent.Host {
+			if h := ctx.Map.Get(ref).(*HostSystem); h.Self != host.Self && FindReference(h.Datastore, ds.Self) != nil {
+				parent = nil
+				break
+			}
+		}
+	}
+	if parent != nil {
+		ctx.Map.RemoveReference(ctx, parent, &parent.Datastore, ds.Self)
+	}
+
+	if len(ds.Host) != 0 {
+		ctx.Map.Update(ds, []types.PropertyChange{{Name: "host", Val: ds.Host}})
+		return
+	}
+
+	p, _ := asFolderMO(ctx.Map.Get(*ds.Parent))
+	folderRemoveChild(ctx, p, ds.Self)
+}
+
+func hostMount(ds *Datastore, host types.ManagedObjectReference) *types.DatastoreHostMount {
+	for i := range ds.Host {
+		if ds.Host[i].Key == host {
+			return &ds.Host[i]
+		}
+	}
 	return nil
 }
 
+func (dss *HostDatastoreSystem) storageSystem(ctx *Context) *HostStorageSystem {
+	return ctx.Map.Get(*dss.Host.ConfigManager.StorageSystem).(*HostStorageSystem)
+}
+
 func (dss *HostDatastoreSystem) CreateLocalDatastore(ctx *Context, c *types.CreateLocalDatastore) soap.HasFault {
 	r := &methods.CreateLocalDatastoreBody{}
 
@@ -131,20 +210,12 @@ func (dss *HostDatastoreSystem) CreateLocalDatastore(ctx *Context, c *types.Crea
 	ds.Summary.MaintenanceMode = string(types.DatastoreSummaryMaintenanceModeStateNormal)
 	ds.Summary.Accessible = true
 
-	if err := dss.add(ctx, ds); err != nil {
+	ds, err := dss.add(ctx, ds)
+	if err != nil {
 		r.Fault_ = err
 		return r
 	}
 
-	ds.Host = append(ds.Host, types.DatastoreHostMount{
-		Key: dss.Host.Reference(),
-		MountInfo: types.HostMountInfo{
-			AccessMode: string(types.HostMountModeReadWrite),
-			Mounted:    types.NewBool(true),
-			Accessible: types.NewBool(true),
-		},
-	})
-
 	_ = ds.RefreshDatastore(&types.RefreshDatastore{This: ds.Self})
 
 	r.Res = &types.CreateLocalDatastoreResponse{
@@ -194,7 +265,19 @@ func (dss *HostDatastoreSystem) CreateNasDatastore(ctx *Context, c *types.Create
 	ds.Summary.MaintenanceMode = string(types.DatastoreSummaryMaintenanceModeStateNormal)
 	ds.Summary.Accessible = true
 
-	if err := dss.add(ctx, ds); err != nil {
+	// a NAS datastore can be mounted on multiple hosts, provided each host uses the same remote volume
+	folder := ctx.Map.getEntityFolder(dss.Host, "datastore")
+	if e, ok := ctx.Map.FindByName(ds.Name, folder.ChildEntity).(*Datastore); ok {
+		if info, ok := e.Info.(*types.NasDatastoreInfo); ok {
+			if info.Nas.RemoteHost != c.Spec.RemoteHost || info.Nas.RemotePath != c.Spec.RemotePath {
+				r.Fault_ = Fault("", &types.DuplicateName{Name: ds.Name, Object: e.Self})
+				return r
+			}
+		}
+	}
+
+	ds, err := dss.add(ctx, ds)
+	if err != nil {
 		r.Fault_ = err
 		return r
 	}
@@ -207,3 +290,263 @@ func (dss *HostDatastoreSystem) CreateNasDatastore(ctx *Context, c *types.Create
 
 	return r
 }
+
+// availableDisks returns the attached disks of this host that are not in use by a VMFS volume.
+func (dss *HostDatastoreSystem) availableDisks(ctx *Context) []types.HostScsiDisk {
+	var disks []types.HostScsiDisk
+
+	for _, lun := range dss.storageSystem(ctx).StorageDeviceInfo.ScsiLun {
+		disk, ok := lun.(*types.HostScsiDisk)
+		if !ok {
+			continue
+		}
+		if len(disk.OperationalState) == 0 || disk.OperationalState[0] != string(types.ScsiLunStateOk) {
+			continue
+		}
+		if vmfsVolume(ctx, disk.CanonicalName) != nil {
+			continue
+		}
+		disks = append(disks, *disk)
+	}
+
+	return disks
+}
+
+func (dss *HostDatastoreSystem) QueryAvailableDisksForVmfs(ctx *Context, _ *types.QueryAvailableDisksForVmfs) soap.HasFault {
+	return &methods.QueryAvailableDisksForVmfsBody{
+		Res: &types.QueryAvailableDisksForVmfsResponse{
+			Returnval: dss.availableDisks(ctx),
+		},
+	}
+}
+
+func (dss *HostDatastoreSystem) QueryVmfsDatastoreCreateOptions(ctx *Context, req *types.QueryVmfsDatastoreCreateOptions) soap.HasFault {
+	r := &methods.QueryVmfsDatastoreCreateOptionsBody{}
+
+	var disk *types.HostScsiDisk
+	for _, d := range dss.availableDisks(ctx) {
+		if d.DevicePath == req.DevicePath {
+			disk = &d
+			break
+		}
+	}
+	if disk == nil {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	version := req.VmfsMajorVersion
+	if version == 0 {
+		version = 6
+	}
+
+	total := disk.Capacity
+	start := int64(2048)
+	extent := types.HostDiskPartitionBlockRange{
+		Partition: 1,
+		Type:      "vmfs",
+		Start:     types.HostDiskDimensionsLba{BlockSize: total.BlockSize, Block: start},
+		End:       types.HostDiskDimensionsLba{BlockSize: total.BlockSize, Block: total.Block - 1},
+	}
+
+	r.Res = &types.QueryVmfsDatastoreCreateOptionsResponse{
+		Returnval: []types.VmfsDatastoreOption{{
+			Info: &types.VmfsDatastoreAllExtentOption{
+				VmfsDatastoreSingleExtentOption: types.VmfsDatastoreSingleExtentOption{
+					VmfsDatastoreBaseOption: types.VmfsDatastoreBaseOption{
+						Layout: types.HostDiskPartitionLayout{
+							Total:     &total,
+							Partition: []types.HostDiskPartitionBlockRange{extent},
+						},
+					},
+					VmfsExtent: extent,
+				},
+			},
+			Spec: &types.VmfsDatastoreCreateSpec{
+				VmfsDatastoreSpec: types.VmfsDatastoreSpec{DiskUuid: disk.Uuid},
+				Partition: types.HostDiskPartitionSpec{
+					PartitionFormat: string(types.HostDiskPartitionInfoPartitionFormatGpt),
+					TotalSectors:    total.Block,
+					Partition: []types.HostDiskPartitionAttributes{{
+						Partition:   1,
+						StartSector: start,
+						EndSector:   total.Block - 1,
+						Type:        "vmfs",
+					}},
+				},
+				Vmfs: types.HostVmfsSpec{
+					Extent:       types.HostScsiDiskPartition{DiskName: disk.CanonicalName, Partition: 1},
+					MajorVersion: version,
+				},
+			},
+		}},
+	}
+
+	return r
+}
+
+// vmfsUUID generates a VMFS volume uuid, in the format used by ESX
+func vmfsUUID(name string) string {
+	sum := sha1.Sum([]byte(name))
+	return fmt.Sprintf("%x-%x-%x-%x", sum[0:4], sum[4:8], sum[8:10], sum[10:16])
+}
+
+// CreateVmfsDatastore formats the given disk, with the VMFS volume backed by a temporary local directory.
+// tempDir creates a directory for a datastore, which is removed along with the Model's directories, see Model.Remove
+func (c *Context) tempDir(dc string, name string) (string, error) {
+	if c.svc == nil || c.svc.tempDir == nil {
+		return ioutil.TempDir("", fmt.Sprintf("govcsim-%s-%s-", dc, name))
+	}
+	return c.svc.tempDir(dc, name)
+}
+
+func (dss *HostDatastoreSystem) CreateVmfsDatastore(ctx *Context, req *types.CreateVmfsDatastore) soap.HasFault {
+	r := &methods.CreateVmfsDatastoreBody{}
+
+	spec := req.Spec.Vmfs
+	if spec.VolumeName == "" {
+		r.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "spec.vmfs.volumeName"})
+		return r
+	}
+
+	var disk *types.HostScsiDisk
+	for _, lun := range dss.storageSystem(ctx).StorageDeviceInfo.ScsiLun {
+		if d, ok := lun.(*types.HostScsiDisk); ok && d.CanonicalName == spec.Extent.DiskName {
+			disk = d
+		}
+	}
+	if disk == nil {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+	if len(disk.OperationalState) == 0 || disk.OperationalState[0] != string(types.ScsiLunStateOk) {
+		r.Fault_ = Fault("", &types.InvalidState{})
+		return r
+	}
+	if ds := vmfsVolume(ctx, disk.CanonicalName); ds != nil {
+		r.Fault_ = Fault("", &types.ResourceInUse{Type: ds.Self.Type, Name: ds.Name})
+		return r
+	}
+
+	version := spec.MajorVersion
+	if version == 0 {
+		version = 6
+	}
+	capacity := disk.Capacity.Block * int64(disk.Capacity.BlockSize)
+
+	dir, err := ctx.tempDir("vmfs", spec.VolumeName)
+	if err != nil {
+		r.Fault_ = Fault(err.Error(), &types.HostConfigFault{})
+		return r
+	}
+
+	ds := &Datastore{}
+	ds.Name = spec.VolumeName
+
+	ds.Info = &types.VmfsDatastoreInfo{
+		DatastoreInfo: types.DatastoreInfo{
+			Url: dir,
+		},
+		Vmfs: &types.HostVmfsVolume{
+			HostFileSystemVolume: types.HostFileSystemVolume{
+				Type:     string(types.HostFileSystemVolumeFileSystemTypeVMFS),
+				Name:     spec.VolumeName,
+				Capacity: capacity,
+			},
+			BlockSizeMb:  1,
+			BlockSize:    1024,
+			MajorVersion: version,
+			Version:      fmt.Sprintf("%d.82", version),
+			Uuid:         vmfsUUID(disk.Uuid + dir),
+			Extent:       []types.HostScsiDiskPartition{spec.Extent},
+			Ssd:          disk.Ssd,
+			Local:        disk.LocalDisk,
+			ScsiDiskType: disk.ScsiDiskType,
+		},
+	}
+
+	ds.Summary.Type = string(types.HostFileSystemVolumeFileSystemTypeVMFS)
+	ds.Summary.MaintenanceMode = string(types.DatastoreSummaryMaintenanceModeStateNormal)
+	ds.Summary.Accessible = true
+	ds.Summary.MultipleHostAccess = types.NewBool(!isTrue(disk.LocalDisk))
+
+	ds, ferr := dss.add(ctx, ds)
+	if ferr != nil {
+		_ = os.RemoveAll(dir)
+		r.Fault_ = ferr
+		return r
+	}
+
+	info := ds.Info.GetDatastoreInfo()
+	ds.Summary.Capacity = capacity
+	ds.Summary.FreeSpace = capacity
+	info.FreeSpace = capacity
+	info.MaxFileSize = capacity
+	info.MaxMemoryFileSize = capacity
+
+	_ = ds.RefreshDatastore(&types.RefreshDatastore{This: ds.Self})
+
+	if ctx.Map.IsVPX() {
+		// vCenter mounts the new volume on the other hosts with access to the LUN
+		dc := ctx.Map.getEntityDatacenter(dss.Host)
+		for _, obj := range ctx.Map.All("HostSystem") {
+			h := obj.(*HostSystem)
+			if h.Self == dss.Host.Self || ctx.Map.getEntityDatacenter(h) != dc {
+				continue
+			}
+			hss := ctx.Map.Get(*h.ConfigManager.StorageSystem).(*HostStorageSystem)
+			ctx.WithLock(hss, func() {
+				hss.RescanVmfs(ctx, nil)
+			})
+		}
+	}
+
+	r.Res = &types.CreateVmfsDatastoreResponse{
+		Returnval: ds.Self,
+	}
+
+	return r
+}
+
+// RemoveDatastore unmounts a datastore from this host.
+// Removing a VMFS datastore deletes the VMFS volume, unmounting it from all hosts.
+func (dss *HostDatastoreSystem) RemoveDatastore(ctx *Context, req *types.RemoveDatastore) soap.HasFault {
+	r := &methods.RemoveDatastoreBody{}
+
+	ds, ok := ctx.Map.Get(req.Datastore).(*Datastore)
+	if !ok || FindReference(dss.Datastore, req.Datastore) == nil {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	_, vmfs := ds.Info.(*types.VmfsDatastoreInfo)
+
+	for _, ref := range ds.Vm {
+		vm := ctx.Map.Get(ref).(*VirtualMachine)
+		if vmfs || *vm.Runtime.Host == dss.Host.Self {
+			r.Fault_ = Fault("", &types.ResourceInUse{Type: ds.Self.Type, Name: ds.Name})
+			return r
+		}
+	}
+
+	ctx.WithLock(ds, func() {
+		if !vmfs {
+			dss.unmount(ctx, ds)
+			return
+		}
+
+		for _, mount := range ds.Host {
+			host := ctx.Map.Get(mount.Key).(*HostSystem)
+			other := ctx.Map.Get(*host.ConfigManager.DatastoreSystem).(*HostDatastoreSystem)
+			ctx.WithLock(other, func() {
+				other.unmount(ctx, ds)
+			})
+		}
+
+		_ = os.RemoveAll(ds.Info.GetDatastoreInfo().Url)
+	})
+
+	r.Res = new(types.RemoveDatastoreResponse)
+
+	return r
+}
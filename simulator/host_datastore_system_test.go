@@ -20,11 +20,15 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -156,3 +160,168 @@ func TestCreateNasDatastoreValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestHostDatastoreSystemShared(t *testing.T) {
+	m := VPX()
+	m.Lun = 2
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		hosts, err := finder.HostSystemList(ctx, "/DC0/host/DC0_C0/*")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mounts := func(ds *object.Datastore) int {
+			var mds mo.Datastore
+			if err := ds.Properties(ctx, ds.Reference(), []string{"host"}, &mds); err != nil {
+				t.Fatal(err)
+			}
+			return len(mds.Host)
+		}
+
+		// NAS datastore mounted on all hosts in the cluster
+		dir := t.TempDir()
+		spec := types.HostNasVolumeSpec{
+			Type:       string(types.HostFileSystemVolumeFileSystemTypeNFS),
+			RemoteHost: "nfs.example.com",
+			RemotePath: "/export",
+			LocalPath:  dir,
+		}
+
+		var nas *object.Datastore
+		for _, host := range hosts {
+			dss, err := host.ConfigManager().DatastoreSystem(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ds, err := dss.CreateNasDatastore(ctx, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nas != nil && nas.Reference() != ds.Reference() {
+				t.Errorf("%s != %s", nas.Reference(), ds.Reference())
+			}
+			nas = ds
+		}
+		if n := mounts(nas); n != len(hosts) {
+			t.Errorf("mounts=%d", n)
+		}
+
+		dss, err := hosts[0].ConfigManager().DatastoreSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		other := spec
+		other.RemotePath = "/other"
+		if _, err = dss.CreateNasDatastore(ctx, other); err == nil {
+			t.Error("expected error") // DuplicateName
+		}
+
+		if err = dss.Remove(ctx, nas); err != nil {
+			t.Fatal(err)
+		}
+		if n := mounts(nas); n != len(hosts)-1 {
+			t.Errorf("mounts=%d", n)
+		}
+		if err = dss.Remove(ctx, nas); err == nil {
+			t.Error("expected error") // NotFound
+		}
+
+		// VMFS datastore on a shared LUN
+		disks, err := dss.QueryAvailableDisksForVmfs(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var disk *types.HostScsiDisk
+		for i := range disks {
+			if strings.HasPrefix(disks[i].CanonicalName, "naa.") {
+				disk = &disks[i]
+				break
+			}
+		}
+		if disk == nil {
+			t.Fatalf("no LUNs in %d disks", len(disks))
+		}
+
+		options, err := dss.QueryVmfsDatastoreCreateOptions(ctx, disk.DevicePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		create := *options[0].Spec.(*types.VmfsDatastoreCreateSpec)
+		create.Vmfs.VolumeName = "vmfs1"
+
+		vmfs, err := dss.CreateVmfsDatastore(ctx, create)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var mds mo.Datastore
+		if err = vmfs.Properties(ctx, vmfs.Reference(), []string{"summary", "info"}, &mds); err != nil {
+			t.Fatal(err)
+		}
+		if mds.Summary.Type != "VMFS" || mds.Summary.Capacity != int64(lunCapacity) {
+			t.Errorf("summary=%#v", mds.Summary)
+		}
+		info := mds.Info.(*types.VmfsDatastoreInfo)
+		if dir := m.dirs[len(m.dirs)-1]; dir != info.Url {
+			t.Errorf("%s not removed by Model.Remove", info.Url)
+		}
+
+		if _, err = dss.CreateVmfsDatastore(ctx, create); err == nil {
+			t.Error("expected error") // ResourceInUse
+		}
+
+		for _, host := range hosts {
+			dss, _ := host.ConfigManager().DatastoreSystem(ctx)
+			disks, err := dss.QueryAvailableDisksForVmfs(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range disks {
+				if d.CanonicalName == disk.CanonicalName {
+					t.Errorf("%s: disk in use by vmfs1", host.Name())
+				}
+			}
+		}
+
+		// vCenter mounts the volume on all hosts with access to the LUN
+		all, err := finder.HostSystemList(ctx, "/DC0/host/*/*")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := mounts(vmfs); n != len(all) {
+			t.Errorf("mounts=%d", n)
+		}
+
+		hss, err := hosts[1].ConfigManager().StorageSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = hss.DetachScsiLun(ctx, disk.Uuid); err == nil {
+			t.Error("expected error") // ResourceInUse
+		}
+		if err = hss.UnmountVmfsVolume(ctx, info.Vmfs.Uuid); err != nil {
+			t.Fatal(err)
+		}
+		if err = hss.DetachScsiLun(ctx, disk.Uuid); err != nil {
+			t.Fatal(err)
+		}
+		if err = hss.MountVmfsVolume(ctx, "enoent"); err == nil {
+			t.Error("expected error") // NotFound
+		}
+
+		// removing a VMFS datastore deletes the volume
+		if err = dss.Remove(ctx, vmfs); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = os.Stat(info.Url); !os.IsNotExist(err) {
+			t.Errorf("stat %s: %v", info.Url, err)
+		}
+		if obj := Map.Get(vmfs.Reference()); obj != nil {
+			t.Errorf("%s not removed", vmfs.Reference())
+		}
+	}, m)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/simulator/internal"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// hostListenerKey is the request context key for the hostView of a host listener
+type hostListenerKey struct{}
+
+// hostViewComputeResource is the ComputeResource moref of a hostView, as used by ESX
+var hostViewComputeResource = types.ManagedObjectReference{Type: "ComputeResource", Value: "ha-compute-res"}
+
+// hostView is the host agent (ESX) view of a HostSystem in the vCenter inventory, served by the host's listener.
+// The view inventory contains the host along with its VMs, datastores and networks,
+// using the ESX morefs for the root Folder, Datacenter folders and ComputeResource.
+// Methods invoked on these objects are dispatched to the vCenter objects backing them.
+type hostView struct {
+	host     *HostSystem
+	registry *Registry
+	content  types.ServiceContent
+}
+
+func newHostView(r *Registry, h *HostSystem) *hostView {
+	content := r.content()
+	content.About = h.Config.Product
+	content.RootFolder = esx.RootFolder.Self
+
+	// unset the vCenter managers that are not implemented by ESX
+	val := reflect.ValueOf(&content).Elem()
+	tmpl := reflect.ValueOf(esx.ServiceContent)
+	for i := 0; i < tmpl.NumField(); i++ {
+		if f := tmpl.Field(i); f.Kind() == reflect.Ptr && f.IsNil() {
+			val.Field(i).Set(f)
+		}
+	}
+
+	return &hostView{host: h, registry: r, content: content}
+}
+
+// hostView returns the hostView if the request was made to a host listener
+func (c *Context) hostView() *hostView {
+	if c.req == nil {
+		return nil
+	}
+
+	v, _ := c.req.Context().Value(hostListenerKey{}).(*hostView)
+	return v
+}
+
+func (v *hostView) datacenter() *Datacenter {
+	return v.registry.getEntityDatacenter(v.host)
+}
+
+// networks returns the host's standard networks, ESX does not include DVS portgroups.
+func (v *hostView) networks() []types.ManagedObjectReference {
+	var refs []types.ManagedObjectReference
+	for _, ref := range v.host.Network {
+		if ref.Type == "Network" {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+func (v *hostView) folder(ref types.ManagedObjectReference, name string, kind string, children []types.ManagedObjectReference) *mo.Folder {
+	f := &mo.Folder{
+		ChildType:   []string{kind},
+		ChildEntity: children,
+	}
+
+	f.Self = ref
+	f.Name = name
+	f.Parent = &v.datacenter().Self
+	f.OverallStatus = types.ManagedEntityStatusGreen
+
+	return f
+}
+
+// get returns the view of the given object, or nil if the object is not replaced by the view
+func (v *hostView) get(ref types.ManagedObjectReference) mo.Reference {
+	dc := v.datacenter()
+
+	switch ref {
+	case esx.RootFolder.Self:
+		root := esx.RootFolder
+		root.ChildEntity = []types.ManagedObjectReference{dc.Self}
+		return &root
+	case dc.Self:
+		view := dc.Datacenter
+		view.Parent = &esx.RootFolder.Self
+		view.Name = esx.Datacenter.Name
+		view.VmFolder = esx.Datacenter.VmFolder
+		view.HostFolder = esx.Datacenter.HostFolder
+		view.DatastoreFolder = esx.Datacenter.DatastoreFolder
+		view.NetworkFolder = esx.Datacenter.NetworkFolder
+		view.Datastore = v.host.Datastore
+		view.Network = v.networks()
+		return &view
+	case esx.Datacenter.VmFolder:
+		return v.folder(ref, "vm", "VirtualMachine", v.host.Vm)
+	case esx.Datacenter.HostFolder:
+		return v.folder(ref, "host", "ComputeResource", []types.ManagedObjectReference{hostViewComputeResource})
+	case esx.Datacenter.DatastoreFolder:
+		return v.folder(ref, "datastore", "Datastore", v.host.Datastore)
+	case esx.Datacenter.NetworkFolder:
+		return v.folder(ref, "network", "Network", v.networks())
+	case hostViewComputeResource:
+		parent := hostParent(&Context{Map: v.registry}, &v.host.HostSystem)
+		if parent == nil {
+			return nil
+		}
+		cr := *parent
+		cr.Self = ref
+		cr.Parent = &esx.Datacenter.HostFolder
+		cr.Name = v.host.Name
+		cr.Host = []types.ManagedObjectReference{v.host.Self}
+		cr.Datastore = v.host.Datastore
+		cr.Network = v.networks()
+		return &cr
+	}
+
+	return nil
+}
+
+// backing returns the vCenter object backing the given view object
+func (v *hostView) backing(ref types.ManagedObjectReference) (types.ManagedObjectReference, bool) {
+	dc := v.datacenter()
+
+	switch ref {
+	case esx.RootFolder.Self:
+		return v.registry.content().RootFolder, true
+	case esx.Datacenter.VmFolder:
+		return dc.VmFolder, true
+	case esx.Datacenter.HostFolder:
+		return dc.HostFolder, true
+	case esx.Datacenter.DatastoreFolder:
+		return dc.DatastoreFolder, true
+	case esx.Datacenter.NetworkFolder:
+		return dc.NetworkFolder, true
+	case hostViewComputeResource:
+		return *v.host.Parent, true
+	}
+
+	return ref, false
+}
+
+// startHostListeners starts a TLS listener for each HostSystem, see Model.HostListen.
+// Each host's summary.config.port is set to the listener port, such that clients can connect to
+// the host using its management IP address, in the same way they would connect to a real ESX host.
+func (s *Service) startHostListeners(u *url.URL) map[types.ManagedObjectReference]*internal.Server {
+	listeners := make(map[types.ManagedObjectReference]*internal.Server)
+
+	for _, obj := range s.registry.All("HostSystem") {
+		h := obj.(*HostSystem)
+		if h.ConfigManager.CertificateManager == nil {
+			continue
+		}
+		m := s.registry.Get(*h.ConfigManager.CertificateManager).(*HostCertificateManager)
+
+		view := newHostView(s.registry, h)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r = r.WithContext(context.WithValue(r.Context(), hostListenerKey{}, view))
+			s.ServeMux.ServeHTTP(w, r)
+		})
+
+		ts := internal.NewUnstartedServer(handler, net.JoinHostPort(u.Hostname(), "0"))
+		ts.TLS = m.tlsConfig()
+		ts.StartTLS()
+
+		h.Summary.Config.Port = int32(ts.Listener.Addr().(*net.TCPAddr).Port)
+		h.Summary.ManagementServerIp = u.Hostname()
+
+		listeners[h.Self] = ts
+	}
+
+	return listeners
+}
+
+// HostURL returns the URL of the given HostSystem's listener, or nil if Model.HostListen is not enabled.
+func (s *Server) HostURL(host mo.Reference) *url.URL {
+	ts, ok := s.hosts[host.Reference()]
+	if !ok {
+		return nil
+	}
+
+	u := *s.URL
+	u.Host = ts.Listener.Addr().String()
+
+	return &u
+}
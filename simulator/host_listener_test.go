@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostView(t *testing.T) {
+	ctx := context.Background()
+
+	m := VPX()
+	m.HostListen = true
+
+	defer m.Remove()
+
+	err := m.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.Service.TLS = new(tls.Config)
+	s := m.Service.NewServer()
+	defer s.Close()
+
+	vc, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"DC0_H0", "DC0_C0_H1"} {
+		host, err := find.NewFinder(vc.Client).HostSystem(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var h mo.HostSystem
+		err = host.Properties(ctx, host.Reference(), []string{"vm", "datastore", "summary"}, &h)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the host listener is reachable via the host's management address and summary.config.port
+		u := *s.URL
+		u.Host = net.JoinHostPort(h.Summary.ManagementServerIp, strconv.Itoa(int(h.Summary.Config.Port)))
+
+		c, err := govmomi.NewClient(ctx, &u, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.IsVC() {
+			t.Error("expected HostAgent")
+		}
+
+		finder := find.NewFinder(c.Client)
+
+		esx, err := finder.DefaultHostSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if esx.Reference() != host.Reference() {
+			t.Errorf("host=%s", esx.Reference())
+		}
+
+		// cluster VMs are placed on random hosts, the host may not have any
+		vms, err := finder.VirtualMachineList(ctx, "*")
+		if _, ok := err.(*find.NotFoundError); !ok && err != nil {
+			t.Fatal(err)
+		}
+		if len(vms) != len(h.Vm) {
+			t.Errorf("%d vms, expected %d", len(vms), len(h.Vm))
+		}
+
+		ds, err := finder.DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ds.Reference() != h.Datastore[0] {
+			t.Errorf("datastore=%s", ds.Reference())
+		}
+
+		cv, err := view.NewManager(c.Client).CreateContainerView(ctx, c.ServiceContent.RootFolder, []string{"HostSystem"}, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hosts, err := cv.Find(ctx, []string{"HostSystem"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hosts) != 1 || hosts[0] != host.Reference() {
+			t.Errorf("hosts=%v", hosts)
+		}
+
+		// VMs created via the host are visible via vCenter
+		folder, err := finder.DefaultFolder(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool, err := finder.DefaultResourcePool(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := types.VirtualMachineConfigSpec{
+			Name:    fmt.Sprintf("%s_VMX", name),
+			GuestId: string(types.VirtualMachineGuestOsIdentifierOtherGuest),
+			Files:   &types.VirtualMachineFileInfo{VmPathName: "[LocalDS_0]"},
+		}
+
+		task, err := folder.CreateVM(ctx, spec, pool, esx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = find.NewFinder(vc.Client).VirtualMachine(ctx, spec.Name); err != nil {
+			t.Error(err)
+		}
+
+		vms, err = finder.VirtualMachineList(ctx, "*")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(vms) != len(h.Vm)+1 {
+			t.Errorf("%d vms, expected %d", len(vms), len(h.Vm)+1)
+		}
+	}
+}
@@ -29,9 +29,9 @@ type HostLocalAccountManager struct {
 	mo.HostLocalAccountManager
 }
 
-func (h *HostLocalAccountManager) CreateUser(req *types.CreateUser) soap.HasFault {
+func (h *HostLocalAccountManager) CreateUser(ctx *Context, req *types.CreateUser) soap.HasFault {
 	spec := req.User.GetHostAccountSpec()
-	userDirectory := Map.UserDirectory()
+	userDirectory := ctx.Map.UserDirectory()
 
 	found := userDirectory.search(true, false, compareFunc(spec.Id, true))
 	if len(found) > 0 {
@@ -47,8 +47,8 @@ func (h *HostLocalAccountManager) CreateUser(req *types.CreateUser) soap.HasFaul
 	}
 }
 
-func (h *HostLocalAccountManager) RemoveUser(req *types.RemoveUser) soap.HasFault {
-	userDirectory := Map.UserDirectory()
+func (h *HostLocalAccountManager) RemoveUser(ctx *Context, req *types.RemoveUser) soap.HasFault {
+	userDirectory := ctx.Map.UserDirectory()
 
 	found := userDirectory.search(true, false, compareFunc(req.UserName, true))
 
@@ -17,6 +17,12 @@ limitations under the License.
 package simulator
 
 import (
+	"crypto/sha1"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -40,7 +46,9 @@ func NewHostNetworkSystem(host *mo.HostSystem) *HostNetworkSystem {
 						Portgroup: []string{"VM Network"},
 					},
 				},
-				Portgroup: host.Config.Network.Portgroup,
+				Portgroup:        host.Config.Network.Portgroup,
+				Vnic:             host.Config.Network.Vnic,
+				NetStackInstance: host.Config.Network.NetStackInstance,
 			},
 		},
 	}
@@ -56,9 +64,9 @@ func (s *HostNetworkSystem) init(r *Registry) {
 	}
 }
 
-func (s *HostNetworkSystem) folder() *Folder {
-	f := Map.getEntityDatacenter(s.Host).NetworkFolder
-	return Map.Get(f).(*Folder)
+func (s *HostNetworkSystem) folder(ctx *Context) *Folder {
+	f := ctx.Map.getEntityDatacenter(s.Host).NetworkFolder
+	return ctx.Map.Get(f).(*Folder)
 }
 
 func (s *HostNetworkSystem) AddVirtualSwitch(c *types.AddVirtualSwitch) soap.HasFault {
@@ -120,13 +128,12 @@ func (s *HostNetworkSystem) AddPortGroup(ctx *Context, c *types.AddPortGroup) so
 		return r
 	}
 
-	network := &mo.Network{}
-	network.Name = c.Portgrp.Name
-	network.Entity().Name = network.Name
-
-	folder := s.folder()
+	folder := s.folder(ctx)
 
-	if obj := ctx.Map.FindByName(c.Portgrp.Name, folder.ChildEntity); obj != nil {
+	// Standard portgroups of the same name on multiple hosts share a single Network entity
+	obj := ctx.Map.FindByName(c.Portgrp.Name, folder.ChildEntity)
+	network, shared := obj.(*mo.Network)
+	if obj != nil && (!shared || s.portgroup(c.Portgrp.Name) != nil) {
 		r.Fault_ = Fault("", &types.DuplicateName{
 			Name:   c.Portgrp.Name,
 			Object: obj.Reference(),
@@ -135,7 +142,15 @@ func (s *HostNetworkSystem) AddPortGroup(ctx *Context, c *types.AddPortGroup) so
 		return r
 	}
 
-	folderPutChild(ctx, &folder.Folder, network)
+	if network == nil {
+		network = &mo.Network{}
+		network.Name = c.Portgrp.Name
+		network.Entity().Name = network.Name
+
+		folderPutChild(ctx, &folder.Folder, network)
+	}
+
+	ctx.Map.AddReference(ctx, network, &network.Host, s.Host.Self)
 
 	vswitch.Portgroup = append(vswitch.Portgroup, c.Portgrp.Name)
 
@@ -169,9 +184,13 @@ func (s *HostNetworkSystem) RemovePortGroup(ctx *Context, c *types.RemovePortGro
 		return r
 	}
 
-	folder := s.folder()
-	e := ctx.Map.FindByName(c.PgName, folder.ChildEntity)
-	folderRemoveChild(ctx, &folder.Folder, e.Reference())
+	folder := s.folder(ctx)
+	if network, ok := ctx.Map.FindByName(c.PgName, folder.ChildEntity).(*mo.Network); ok {
+		ctx.Map.RemoveReference(ctx, network, &network.Host, s.Host.Self)
+		if len(network.Host) == 0 {
+			folderRemoveChild(ctx, &folder.Folder, network.Self)
+		}
+	}
 
 	for i, pg := range s.NetworkInfo.Portgroup {
 		if pg.Spec.Name == c.PgName {
@@ -210,3 +229,351 @@ func (s *HostNetworkSystem) QueryNetworkHint(req *types.QueryNetworkHint) soap.H
 		},
 	}
 }
+
+// netStackNicType maps netstacks dedicated to a single service to that service's nic type.
+var netStackNicType = map[string]string{
+	"vmotion":             string(types.HostVirtualNicManagerNicTypeVmotion),
+	"vSphereProvisioning": string(types.HostVirtualNicManagerNicTypeVSphereProvisioning),
+}
+
+func (s *HostNetworkSystem) vnicIndex(device string) int {
+	for i, nic := range s.NetworkInfo.Vnic {
+		if nic.Device == device {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *HostNetworkSystem) portgroup(name string) *types.HostPortGroup {
+	for i := range s.NetworkInfo.Portgroup {
+		if s.NetworkInfo.Portgroup[i].Spec.Name == name {
+			return &s.NetworkInfo.Portgroup[i]
+		}
+	}
+	return nil
+}
+
+// updateVnics persists the VMkernel NICs in both networkInfo and the host's config.network.
+func (s *HostNetworkSystem) updateVnics(ctx *Context, vnics []types.HostVirtualNic) {
+	ctx.Map.Update(s, []types.PropertyChange{
+		{Name: "networkInfo.vnic", Val: vnics},
+	})
+
+	if host := ctx.Map.Get(s.Host.Reference()); host != nil {
+		ctx.Map.Update(host, []types.PropertyChange{
+			{Name: "config.network.vnic", Val: vnics},
+		})
+	}
+}
+
+func (s *HostNetworkSystem) nicManager(ctx *Context) *HostVirtualNicManager {
+	if ref := s.Host.ConfigManager.VirtualNicManager; ref != nil {
+		m, _ := ctx.Map.Get(*ref).(*HostVirtualNicManager)
+		return m
+	}
+	return nil
+}
+
+// vnicMAC generates a MAC address for the given VMkernel NIC device, unique to this host.
+func (s *HostNetworkSystem) vnicMAC(device string) string {
+	sum := sha1.Sum([]byte(s.Host.Summary.Hardware.Uuid + device))
+	return net.HardwareAddr{0x00, 0x50, 0x56, sum[0] & 0x3f, sum[1], sum[2]}.String()
+}
+
+// validateVnicSpec checks the IP config, MTU and netstack of a VMkernel NIC spec.
+func (s *HostNetworkSystem) validateVnicSpec(spec *types.HostVirtualNicSpec) types.BaseMethodFault {
+	if ip := spec.Ip; ip != nil && !ip.Dhcp && ip.IpAddress != "" {
+		addr := net.ParseIP(ip.IpAddress)
+		if addr == nil {
+			return &types.InvalidArgument{InvalidProperty: "nic.ip.ipAddress"}
+		}
+		if addr.To4() != nil {
+			mask := net.ParseIP(ip.SubnetMask)
+			if mask == nil || mask.To4() == nil {
+				return &types.InvalidArgument{InvalidProperty: "nic.ip.subnetMask"}
+			}
+		}
+	}
+
+	if spec.Mtu != 0 && (spec.Mtu < 1280 || spec.Mtu > 9000) {
+		return &types.InvalidArgument{InvalidProperty: "nic.mtu"}
+	}
+
+	if key := spec.NetStackInstanceKey; key != "" {
+		found := false
+		for _, stack := range s.Host.Config.Network.NetStackInstance {
+			if stack.Key == key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return &types.InvalidArgument{InvalidProperty: "nic.netStackInstanceKey"}
+		}
+	}
+
+	return nil
+}
+
+// connectVnic connects a VMkernel NIC to the DVS port referenced by conn.
+func (s *HostNetworkSystem) connectVnic(ctx *Context, device string, conn *types.DistributedVirtualSwitchPortConnection) types.BaseMethodFault {
+	dc := ctx.Map.getEntityDatacenter(s.Host)
+	if dc == nil {
+		return new(types.NotFound)
+	}
+
+	dswitch := findSwitch(ctx, dc, conn.SwitchUuid)
+	if dswitch == nil {
+		return new(types.NotFound)
+	}
+
+	if FindReference(dswitch.Summary.HostMember, s.Host.Self) == nil {
+		return &types.InvalidArgument{InvalidProperty: "nic.distributedVirtualPort.switchUuid"}
+	}
+
+	var err types.BaseMethodFault
+	ctx.WithLock(dswitch, func() {
+		err = dswitch.connectDVPort(ctx, s.vnicConnectee(device), &s.Host.Self, nil, conn)
+	})
+
+	return err
+}
+
+// disconnectVnic releases the DVS port connected to a VMkernel NIC.
+func (s *HostNetworkSystem) disconnectVnic(ctx *Context, device string, conn *types.DistributedVirtualSwitchPortConnection) {
+	dc := ctx.Map.getEntityDatacenter(s.Host)
+	if dc == nil {
+		return
+	}
+
+	dswitch := findSwitch(ctx, dc, conn.SwitchUuid)
+	if dswitch == nil {
+		return
+	}
+
+	ctx.WithLock(dswitch, func() {
+		dswitch.disconnectDVPort(ctx, s.vnicConnectee(device), conn)
+	})
+}
+
+func (s *HostNetworkSystem) vnicConnectee(device string) *types.DistributedVirtualSwitchPortConnectee {
+	return &types.DistributedVirtualSwitchPortConnectee{
+		ConnectedEntity: &s.Host.Self,
+		NicKey:          device,
+		Type:            string(types.DistributedVirtualSwitchPortConnecteeConnecteeTypeHostVmkVnic),
+	}
+}
+
+const vnicPortPrefix = "key-vim.host.PortGroup.Port-"
+
+// vnicPortKey returns the next standard portgroup port key, after the highest key in use by any vnic
+func (s *HostNetworkSystem) vnicPortKey() string {
+	next := 33554436
+	for _, nic := range s.NetworkInfo.Vnic {
+		if n, err := strconv.Atoi(strings.TrimPrefix(nic.Port, vnicPortPrefix)); err == nil && n >= next {
+			next = n + 1
+		}
+	}
+	return vnicPortPrefix + strconv.Itoa(next)
+}
+
+func (s *HostNetworkSystem) AddVirtualNic(ctx *Context, req *types.AddVirtualNic) soap.HasFault {
+	r := &methods.AddVirtualNicBody{}
+
+	spec := req.Nic
+
+	if req.Portgroup != "" {
+		if s.portgroup(req.Portgroup) == nil {
+			r.Fault_ = Fault("", &types.NotFound{})
+			return r
+		}
+		spec.DistributedVirtualPort = nil
+	} else if spec.DistributedVirtualPort == nil {
+		r.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "portgroup"})
+		return r
+	}
+
+	if err := s.validateVnicSpec(&spec); err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	var device string
+	for i := 0; ; i++ {
+		device = fmt.Sprintf("vmk%d", i)
+		if s.vnicIndex(device) == -1 {
+			break
+		}
+	}
+
+	spec.Portgroup = req.Portgroup
+	if spec.Ip == nil {
+		spec.Ip = &types.HostIpConfig{Dhcp: true}
+	}
+	if spec.Mac == "" {
+		spec.Mac = s.vnicMAC(device)
+	}
+	if spec.Mtu == 0 {
+		spec.Mtu = 1500
+	}
+	if spec.NetStackInstanceKey == "" {
+		spec.NetStackInstanceKey = "defaultTcpipStack"
+	}
+
+	nic := types.HostVirtualNic{
+		Device:    device,
+		Key:       "key-vim.host.VirtualNic-" + device,
+		Portgroup: req.Portgroup,
+		Spec:      spec,
+	}
+
+	if conn := spec.DistributedVirtualPort; conn != nil {
+		port := *conn
+		if err := s.connectVnic(ctx, device, &port); err != nil {
+			r.Fault_ = Fault("", err)
+			return r
+		}
+		nic.Spec.DistributedVirtualPort = &port
+	} else {
+		nic.Port = s.vnicPortKey()
+	}
+
+	s.updateVnics(ctx, append(s.NetworkInfo.Vnic, nic))
+
+	if m := s.nicManager(ctx); m != nil {
+		ctx.WithLock(m, func() {
+			m.addVnic(ctx, nic)
+		})
+	}
+
+	r.Res = &types.AddVirtualNicResponse{
+		Returnval: device,
+	}
+
+	return r
+}
+
+func (s *HostNetworkSystem) UpdateVirtualNic(ctx *Context, req *types.UpdateVirtualNic) soap.HasFault {
+	r := &methods.UpdateVirtualNicBody{}
+
+	i := s.vnicIndex(req.Device)
+	if i == -1 {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	spec := req.Nic
+	if err := s.validateVnicSpec(&spec); err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	vnics := append([]types.HostVirtualNic(nil), s.NetworkInfo.Vnic...)
+	nic := &vnics[i]
+
+	if spec.NetStackInstanceKey != "" && spec.NetStackInstanceKey != nic.Spec.NetStackInstanceKey {
+		r.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "nic.netStackInstanceKey"})
+		return r
+	}
+
+	if spec.Portgroup != "" && spec.Portgroup != nic.Portgroup {
+		if s.portgroup(spec.Portgroup) == nil {
+			r.Fault_ = Fault("", &types.NotFound{})
+			return r
+		}
+		if old := nic.Spec.DistributedVirtualPort; old != nil {
+			s.disconnectVnic(ctx, nic.Device, old)
+			nic.Spec.DistributedVirtualPort = nil
+		}
+		nic.Portgroup = spec.Portgroup
+		nic.Spec.Portgroup = spec.Portgroup
+		if nic.Port == "" {
+			nic.Port = s.vnicPortKey()
+		}
+	} else if conn := spec.DistributedVirtualPort; conn != nil {
+		old := nic.Spec.DistributedVirtualPort
+		if old == nil || old.SwitchUuid != conn.SwitchUuid || old.PortgroupKey != conn.PortgroupKey || old.PortKey != conn.PortKey {
+			port := *conn
+			if err := s.connectVnic(ctx, nic.Device, &port); err != nil {
+				r.Fault_ = Fault("", err)
+				return r
+			}
+			if old != nil {
+				s.disconnectVnic(ctx, nic.Device, old)
+			}
+			nic.Portgroup = ""
+			nic.Port = ""
+			nic.Spec.Portgroup = ""
+			nic.Spec.DistributedVirtualPort = &port
+		}
+	}
+
+	if spec.Ip != nil {
+		nic.Spec.Ip = spec.Ip
+	}
+	if spec.Mac != "" {
+		nic.Spec.Mac = spec.Mac
+	}
+	if spec.Mtu != 0 {
+		nic.Spec.Mtu = spec.Mtu
+	}
+	if spec.TsoEnabled != nil {
+		nic.Spec.TsoEnabled = spec.TsoEnabled
+	}
+	if spec.IpRouteSpec != nil {
+		nic.Spec.IpRouteSpec = spec.IpRouteSpec
+	}
+	if spec.PinnedPnic != "" {
+		nic.Spec.PinnedPnic = spec.PinnedPnic
+	}
+
+	s.updateVnics(ctx, vnics)
+
+	if m := s.nicManager(ctx); m != nil {
+		ctx.WithLock(m, func() {
+			m.updateVnic(ctx, *nic)
+		})
+	}
+
+	r.Res = new(types.UpdateVirtualNicResponse)
+
+	return r
+}
+
+func (s *HostNetworkSystem) RemoveVirtualNic(ctx *Context, req *types.RemoveVirtualNic) soap.HasFault {
+	r := &methods.RemoveVirtualNicBody{}
+
+	i := s.vnicIndex(req.Device)
+	if i == -1 {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	nic := s.NetworkInfo.Vnic[i]
+
+	if conn := nic.Spec.DistributedVirtualPort; conn != nil {
+		s.disconnectVnic(ctx, nic.Device, conn)
+	}
+
+	vnics := append([]types.HostVirtualNic(nil), s.NetworkInfo.Vnic[:i]...)
+	s.updateVnics(ctx, append(vnics, s.NetworkInfo.Vnic[i+1:]...))
+
+	if m := s.nicManager(ctx); m != nil {
+		ctx.WithLock(m, func() {
+			m.removeVnic(ctx, nic)
+		})
+	}
+
+	if ref := s.Host.ConfigManager.VsanSystem; ref != nil {
+		if vsan, ok := ctx.Map.Get(*ref).(*HostVsanSystem); ok {
+			ctx.WithLock(vsan, func() {
+				vsan.removeVnic(ctx, nic.Device)
+			})
+		}
+	}
+
+	r.Res = new(types.RemoveVirtualNicResponse)
+
+	return r
+}
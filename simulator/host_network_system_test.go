@@ -23,6 +23,7 @@ import (
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -174,3 +175,246 @@ func TestHostNetworkSystem(t *testing.T) {
 		t.Errorf("len=%d", len(info))
 	}
 }
+
+func TestHostVirtualNic(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, Map.Any("HostSystem").Reference())
+
+		ns, err := host.ConfigManager().NetworkSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vnm, err := host.ConfigManager().VirtualNicManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vnics := func() []types.HostVirtualNic {
+			var mns mo.HostNetworkSystem
+			err := ns.Properties(ctx, ns.Reference(), []string{"networkInfo.vnic"}, &mns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var mhs mo.HostSystem
+			err = host.Properties(ctx, host.Reference(), []string{"config.network.vnic"}, &mhs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(mhs.Config.Network.Vnic) != len(mns.NetworkInfo.Vnic) {
+				t.Fatalf("config.network.vnic=%d, networkInfo.vnic=%d", len(mhs.Config.Network.Vnic), len(mns.NetworkInfo.Vnic))
+			}
+			return mns.NetworkInfo.Vnic
+		}
+
+		selected := func(nicType string) []string {
+			info, err := vnm.Info(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range info.NetConfig {
+				if c.NicType == nicType {
+					return c.SelectedVnic
+				}
+			}
+			t.Fatalf("nicType %s not found", nicType)
+			return nil
+		}
+
+		spec := types.HostVirtualNicSpec{
+			Ip: &types.HostIpConfig{IpAddress: "10.0.0.10", SubnetMask: "255.255.255.0"},
+		}
+
+		if _, err = ns.AddVirtualNic(ctx, "enoent", spec); err == nil {
+			t.Error("expected error") // NotFound
+		}
+
+		if _, err = ns.AddVirtualNic(ctx, "", spec); err == nil {
+			t.Error("expected error") // InvalidArgument "portgroup"
+		}
+
+		tests := []types.HostVirtualNicSpec{
+			{Ip: &types.HostIpConfig{IpAddress: "10.0.0.300", SubnetMask: "255.255.255.0"}},
+			{Ip: &types.HostIpConfig{IpAddress: "10.0.0.10", SubnetMask: "invalid"}},
+			{Mtu: 100},
+			{NetStackInstanceKey: "enoent"},
+		}
+		for _, invalid := range tests {
+			if _, err = ns.AddVirtualNic(ctx, "Management Network", invalid); err == nil {
+				t.Errorf("expected error for %#v", invalid)
+			}
+		}
+
+		n := len(vnics())
+
+		device, err := ns.AddVirtualNic(ctx, "Management Network", spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		nics := vnics()
+		if len(nics) != n+1 {
+			t.Fatalf("vnics=%d", len(nics))
+		}
+		nic := nics[n]
+		if nic.Device != device || nic.Spec.Ip.IpAddress != "10.0.0.10" || nic.Spec.Mtu != 1500 || nic.Spec.Mac == "" {
+			t.Errorf("unexpected vnic: %#v", nic.Spec)
+		}
+		if nic.Spec.NetStackInstanceKey != "defaultTcpipStack" {
+			t.Errorf("netstack=%s", nic.Spec.NetStackInstanceKey)
+		}
+
+		if err = ns.UpdateVirtualNic(ctx, device, types.HostVirtualNicSpec{Mtu: 9000}); err != nil {
+			t.Fatal(err)
+		}
+		if mtu := vnics()[n].Spec.Mtu; mtu != 9000 {
+			t.Errorf("mtu=%d", mtu)
+		}
+
+		if err = ns.UpdateVirtualNic(ctx, device, types.HostVirtualNicSpec{NetStackInstanceKey: "vmotion"}); err == nil {
+			t.Error("expected error") // InvalidArgument
+		}
+
+		if err = ns.UpdateVirtualNic(ctx, "enoent", types.HostVirtualNicSpec{Mtu: 9000}); err == nil {
+			t.Error("expected error") // NotFound
+		}
+
+		// service selection
+		key := "vmotion." + nic.Key
+		if err = vnm.SelectVnic(ctx, "vmotion", device); err != nil {
+			t.Fatal(err)
+		}
+		if s := selected("vmotion"); len(s) != 1 || s[0] != key {
+			t.Errorf("selected=%v", s)
+		}
+		if err = vnm.DeselectVnic(ctx, "vmotion", device); err != nil {
+			t.Fatal(err)
+		}
+		if s := selected("vmotion"); len(s) != 0 {
+			t.Errorf("selected=%v", s)
+		}
+		if err = vnm.SelectVnic(ctx, "vmotion", "enoent"); err == nil {
+			t.Error("expected error") // NotFound
+		}
+
+		// vSAN selection is made via HostVsanSystem
+		if err = vnm.SelectVnic(ctx, "vsan", device); err != nil {
+			t.Fatal(err)
+		}
+		if s := selected("vsan"); len(s) != 1 || s[0] != "vsan."+nic.Key {
+			t.Errorf("selected=%v", s)
+		}
+
+		// vmknic on a dedicated netstack is selected for that service by default
+		dedicated, err := ns.AddVirtualNic(ctx, "Management Network", types.HostVirtualNicSpec{NetStackInstanceKey: "vmotion"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := selected("vmotion"); len(s) != 1 || s[0] != "vmotion.key-vim.host.VirtualNic-"+dedicated {
+			t.Errorf("selected=%v", s)
+		}
+		if err = vnm.SelectVnic(ctx, "management", dedicated); err == nil {
+			t.Error("expected error") // InvalidArgument
+		}
+
+		// port keys in use are not reused after a vmknic is removed
+		if err = ns.RemoveVirtualNic(ctx, device); err != nil {
+			t.Fatal(err)
+		}
+		device, err = ns.AddVirtualNic(ctx, "Management Network", spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ports := make(map[string]string)
+		for _, nic := range vnics() {
+			if other, ok := ports[nic.Port]; ok && nic.Port != "" {
+				t.Errorf("%s and %s share port %s", nic.Device, other, nic.Port)
+			}
+			ports[nic.Port] = nic.Device
+		}
+
+		for _, name := range []string{device, dedicated} {
+			if err = ns.RemoveVirtualNic(ctx, name); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if len(vnics()) != n {
+			t.Errorf("vnics=%d", len(vnics()))
+		}
+		if s := selected("vmotion"); len(s) != 0 {
+			t.Errorf("selected=%v", s)
+		}
+		if s := selected("vsan"); len(s) != 0 {
+			t.Errorf("selected=%v", s)
+		}
+
+		if err = ns.RemoveVirtualNic(ctx, device); err == nil {
+			t.Error("expected error") // NotFound
+		}
+	})
+}
+
+func TestHostVirtualNicDVS(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, Map.Any("HostSystem").Reference())
+
+		ns, err := host.ConfigManager().NetworkSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pg := Map.Any("DistributedVirtualPortgroup").(*DistributedVirtualPortgroup)
+		dvs := Map.Get(*pg.Config.DistributedVirtualSwitch).(*DistributedVirtualSwitch)
+
+		spec := types.HostVirtualNicSpec{
+			DistributedVirtualPort: &types.DistributedVirtualSwitchPortConnection{
+				SwitchUuid:   dvs.Uuid,
+				PortgroupKey: pg.Key,
+			},
+		}
+
+		device, err := ns.AddVirtualNic(ctx, "", spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var mns mo.HostNetworkSystem
+		err = ns.Properties(ctx, ns.Reference(), []string{"networkInfo.vnic"}, &mns)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var port *types.DistributedVirtualSwitchPortConnection
+		for _, nic := range mns.NetworkInfo.Vnic {
+			if nic.Device == device {
+				port = nic.Spec.DistributedVirtualPort
+			}
+		}
+		if port == nil || port.PortKey == "" {
+			t.Fatalf("port=%#v", port)
+		}
+
+		connected := func() *types.DistributedVirtualSwitchPortConnectee {
+			for _, p := range dvs.FetchDVPortsResponse.Returnval {
+				if p.Key == port.PortKey {
+					return p.Connectee
+				}
+			}
+			return nil
+		}
+
+		connectee := connected()
+		if connectee == nil || connectee.NicKey != device || *connectee.ConnectedEntity != host.Reference() {
+			t.Errorf("connectee=%#v", connectee)
+		}
+
+		if err = ns.RemoveVirtualNic(ctx, device); err != nil {
+			t.Fatal(err)
+		}
+
+		if connected() != nil {
+			t.Error("port still connected")
+		}
+	})
+}
@@ -17,47 +17,404 @@ limitations under the License.
 package simulator
 
 import (
-	"github.com/vmware/govmomi/simulator/esx"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/govmomi/units"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
+// lunCapacity is the size of LUNs presented to hosts by Model.Lun and Model.IscsiTarget
+const lunCapacity = 100 * units.GB
+
 type HostStorageSystem struct {
 	mo.HostStorageSystem
 
 	Host *mo.HostSystem
 	HBA  []types.BaseHostHostBusAdapter
+
+	// lun maps adapter device names to the LUNs presented on that adapter,
+	// made visible to the host via RescanAllHba.
+	lun map[string][]types.HostScsiDisk
+
+	// iscsi maps iSCSI target addresses to the number of LUNs presented by the target.
+	iscsi map[string]int
 }
 
 func NewHostStorageSystem(h *mo.HostSystem) *HostStorageSystem {
 	s := &HostStorageSystem{Host: h}
 
-	s.StorageDeviceInfo = &esx.HostStorageDeviceInfo
+	// h.Config is a copy of esx.HostConfigInfo, owned by this host
+	s.StorageDeviceInfo = h.Config.StorageDevice
 
 	s.HBA = fibreChannelHBA
 
 	return s
 }
 
-// RescanAllHba swaps HostStorageSystem.HBA and StorageDeviceInfo.HostBusAdapter.
-// This allows testing HBA with and without Fibre Channel data.
-func (s *HostStorageSystem) RescanAllHba(ctx *Context, _ *types.RescanAllHba) soap.HasFault {
-	hba := s.StorageDeviceInfo.HostBusAdapter
-	s.StorageDeviceInfo.HostBusAdapter = s.HBA
-	s.HBA = hba
+func (s *HostStorageSystem) init(r *Registry) {
+	for _, obj := range r.kinds["HostSystem"] {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.StorageSystem; ref != nil && ref.Value == s.Self.Value {
+				s.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+// newScsiDisk returns a disk with a unique identifier derived from the given id.
+func newScsiDisk(id string, lun int, model string) types.HostScsiDisk {
+	sum := sha1.Sum([]byte(id))
+	naa := "6" + hex.EncodeToString(sum[:])[:31]
+	name := "naa." + naa
+	uuid := fmt.Sprintf("0200%02x0000%s566972747561", lun, naa)
+
+	return types.HostScsiDisk{
+		ScsiLun: types.ScsiLun{
+			HostDevice: types.HostDevice{
+				DeviceName: "/vmfs/devices/disks/" + name,
+				DeviceType: "disk",
+			},
+			Key:              "key-vim.host.ScsiDisk-" + uuid,
+			Uuid:             uuid,
+			Descriptor:       []types.ScsiLunDescriptor{{Quality: "highQuality", Id: name}},
+			CanonicalName:    name,
+			DisplayName:      fmt.Sprintf("VMware %s (%s)", model, name),
+			LunType:          "disk",
+			Vendor:           "VMware",
+			Model:            model,
+			Revision:         "1.0",
+			ScsiLevel:        6,
+			SerialNumber:     "unavailable",
+			QueueDepth:       64,
+			OperationalState: []string{string(types.ScsiLunStateOk)},
+			Capabilities:     &types.ScsiLunCapabilities{},
+			VStorageSupport:  string(types.FileSystemMountInfoVStorageSupportStatusVStorageUnsupported),
+			ProtocolEndpoint: types.NewBool(false),
+		},
+		Capacity: types.HostDiskDimensionsLba{
+			BlockSize: 512,
+			Block:     int64(lunCapacity) / 512,
+		},
+		DevicePath:            "/vmfs/devices/disks/" + name,
+		Ssd:                   types.NewBool(false),
+		LocalDisk:             types.NewBool(false),
+		EmulatedDIXDIFEnabled: types.NewBool(false),
+		ScsiDiskType:          "native512",
+	}
+}
+
+// presentLuns presents count LUNs, shared by all hosts configured with the same id, on the given adapter.
+func (s *HostStorageSystem) presentLuns(id string, device string, count int) {
+	if s.lun == nil {
+		s.lun = make(map[string][]types.HostScsiDisk)
+	}
+
+	for i := 0; i < count; i++ {
+		s.lun[device] = append(s.lun[device], newScsiDisk(fmt.Sprintf("%s-%d", id, i), i, "Fibre Channel Disk"))
+	}
+}
+
+func (s *HostStorageSystem) hba(device string) types.BaseHostHostBusAdapter {
+	for _, hba := range s.StorageDeviceInfo.HostBusAdapter {
+		if hba.GetHostHostBusAdapter().Device == device {
+			return hba
+		}
+	}
+	return nil
+}
+
+func (s *HostStorageSystem) iscsiHba(device string) (*types.HostInternetScsiHba, types.BaseMethodFault) {
+	hba, ok := s.hba(device).(*types.HostInternetScsiHba)
+	if !ok {
+		return nil, &types.NotFound{}
+	}
+	return hba, nil
+}
+
+func (s *HostStorageSystem) scsiDisk(uuid string) *types.HostScsiDisk {
+	for _, lun := range s.StorageDeviceInfo.ScsiLun {
+		if disk, ok := lun.(*types.HostScsiDisk); ok && disk.Uuid == uuid {
+			return disk
+		}
+	}
+	return nil
+}
+
+// vmfsVolume returns the datastore backed by the given disk, if any.
+func vmfsVolume(ctx *Context, diskName string) *Datastore {
+	for _, obj := range ctx.Map.All("Datastore") {
+		ds := obj.(*Datastore)
+		info, ok := ds.Info.(*types.VmfsDatastoreInfo)
+		if !ok || info.Vmfs == nil {
+			continue
+		}
+		for _, extent := range info.Vmfs.Extent {
+			if extent.DiskName == diskName {
+				return ds
+			}
+		}
+	}
+	return nil
+}
+
+// vmfsMounted returns the VMFS datastore mounted on this host and backed by the given disk, if any.
+func (s *HostStorageSystem) vmfsMounted(ctx *Context, diskName string) *Datastore {
+	ds := vmfsVolume(ctx, diskName)
+	if ds == nil {
+		return nil
+	}
+
+	for _, mount := range ds.Host {
+		if mount.Key == s.Host.Self && isTrue(mount.MountInfo.Mounted) {
+			return ds
+		}
+	}
+
+	return nil
+}
+
+// presented returns the LUNs presented to each adapter, including those discovered via iSCSI targets.
+func (s *HostStorageSystem) presented() map[string][]types.HostScsiDisk {
+	luns := make(map[string][]types.HostScsiDisk)
+
+	for _, hba := range s.StorageDeviceInfo.HostBusAdapter {
+		device := hba.GetHostHostBusAdapter().Device
+
+		iscsi, ok := hba.(*types.HostInternetScsiHba)
+		if !ok {
+			luns[device] = s.lun[device]
+			continue
+		}
+
+		for _, target := range iscsi.ConfiguredStaticTarget {
+			for i := 0; i < s.iscsi[target.Address]; i++ {
+				disk := newScsiDisk(fmt.Sprintf("iscsi-%s-%d", target.Address, i), i, "iSCSI Disk")
+				luns[device] = append(luns[device], disk)
+			}
+		}
+	}
+
+	return luns
+}
+
+// discover adds static targets for the configured iSCSI send targets known to the simulator.
+func (s *HostStorageSystem) discover() {
+	for _, hba := range s.StorageDeviceInfo.HostBusAdapter {
+		iscsi, ok := hba.(*types.HostInternetScsiHba)
+		if !ok {
+			continue
+		}
+
+		for _, send := range iscsi.ConfiguredSendTarget {
+			if _, ok := s.iscsi[send.Address]; !ok {
+				continue
+			}
+
+			parent := iscsiTargetParent(send.Address, send.Port)
+			found := false
+			for _, target := range iscsi.ConfiguredStaticTarget {
+				if target.Parent == parent {
+					found = true
+					break
+				}
+			}
 
-	ctx.WithLock(s.Host, func() {
-		s.Host.Config.StorageDevice.HostBusAdapter = s.StorageDeviceInfo.HostBusAdapter
+			if !found {
+				iscsi.ConfiguredStaticTarget = append(iscsi.ConfiguredStaticTarget, types.HostInternetScsiHbaStaticTarget{
+					Address:         send.Address,
+					Port:            send.Port,
+					IScsiName:       "iqn.2021-01.com.vmware.vcsim:" + send.Address,
+					DiscoveryMethod: string(types.HostInternetScsiHbaStaticTargetTargetDiscoveryMethodSendTargetMethod),
+					Parent:          parent,
+				})
+			}
+		}
+	}
+}
+
+func iscsiTargetParent(address string, port int32) string {
+	return fmt.Sprintf("%s:%d", address, port)
+}
+
+// rescan syncs StorageDeviceInfo with the adapters and LUNs presented to the host.
+func (s *HostStorageSystem) rescan(ctx *Context) {
+	info := s.StorageDeviceInfo
+
+	for _, hba := range s.HBA {
+		device := hba.GetHostHostBusAdapter().Device
+		replaced := false
+		for i := range info.HostBusAdapter {
+			if info.HostBusAdapter[i].GetHostHostBusAdapter().Device == device {
+				info.HostBusAdapter[i] = hba
+				replaced = true
+			}
+		}
+		if !replaced {
+			info.HostBusAdapter = append(info.HostBusAdapter, hba)
+		}
+	}
+	s.HBA = nil
+
+	s.discover()
+
+	presented := make(map[string]string) // LUN uuid -> adapter device
+	var luns []types.HostScsiDisk
+	var devices []string
+	for device, disks := range s.presented() {
+		for _, disk := range disks {
+			presented[disk.Uuid] = device
+		}
+		luns = append(luns, disks...)
+		devices = append(devices, device)
+	}
+
+	// remove LUNs that are no longer presented, such as after an iSCSI target is removed
+	var scsiLun []types.BaseScsiLun
+	for _, lun := range info.ScsiLun {
+		disk, ok := lun.(*types.HostScsiDisk)
+		if ok && strings.HasPrefix(disk.CanonicalName, "naa.") {
+			if _, ok = presented[disk.Uuid]; !ok {
+				s.removeMultipath(disk)
+				continue
+			}
+			delete(presented, disk.Uuid)
+		}
+		scsiLun = append(scsiLun, lun)
+	}
+
+	for i := range luns {
+		device, ok := presented[luns[i].Uuid]
+		if !ok {
+			continue // already visible
+		}
+		disk := luns[i]
+		scsiLun = append(scsiLun, &disk)
+		s.addMultipath(device, &disk)
+	}
+
+	info.ScsiLun = scsiLun
+
+	s.update(ctx)
+}
+
+func (s *HostStorageSystem) addMultipath(device string, disk *types.HostScsiDisk) {
+	info := s.StorageDeviceInfo
+	if info.MultipathInfo == nil {
+		info.MultipathInfo = new(types.HostMultipathInfo)
+	}
+
+	hba := s.hba(device).GetHostHostBusAdapter()
+	unit := "key-vim.host.MultipathInfo.LogicalUnit-" + disk.Uuid
+	n := 0
+	for _, lu := range info.MultipathInfo.Lun {
+		if strings.HasPrefix(lu.Path[0].Name, device+":") {
+			n++
+		}
+	}
+	name := fmt.Sprintf("%s:C0:T0:L%d", device, n)
+
+	info.MultipathInfo.Lun = append(info.MultipathInfo.Lun, types.HostMultipathInfoLogicalUnit{
+		Key: unit,
+		Id:  disk.Uuid,
+		Lun: disk.Key,
+		Path: []types.HostMultipathInfoPath{{
+			Key:           "key-vim.host.MultipathInfo.Path-" + name,
+			Name:          name,
+			PathState:     "active",
+			State:         "active",
+			IsWorkingPath: types.NewBool(true),
+			Adapter:       hba.Key,
+			Lun:           unit,
+		}},
+		Policy: &types.HostMultipathInfoLogicalUnitPolicy{
+			Policy: "VMW_PSP_RR",
+		},
+		StorageArrayTypePolicy: &types.HostMultipathInfoLogicalUnitStorageArrayTypePolicy{
+			Policy: "VMW_SATP_DEFAULT_AA",
+		},
 	})
+}
+
+func (s *HostStorageSystem) removeMultipath(disk *types.HostScsiDisk) {
+	info := s.StorageDeviceInfo
+	if info.MultipathInfo == nil {
+		return
+	}
+
+	var luns []types.HostMultipathInfoLogicalUnit
+	for _, lu := range info.MultipathInfo.Lun {
+		if lu.Id != disk.Uuid {
+			luns = append(luns, lu)
+		}
+	}
+	info.MultipathInfo.Lun = luns
+}
+
+func (s *HostStorageSystem) update(ctx *Context) {
+	ctx.Map.Update(s, []types.PropertyChange{
+		{Name: "storageDeviceInfo", Val: s.StorageDeviceInfo},
+	})
+
+	if host := ctx.Map.Get(s.Host.Self); host != nil {
+		ctx.Map.Update(host, []types.PropertyChange{
+			{Name: "config.storageDevice", Val: s.StorageDeviceInfo},
+		})
+	}
+}
+
+// RescanAllHba discovers the adapters in HostStorageSystem.HBA and any LUNs presented to the host.
+// The HBA list allows testing HBA with and without Fibre Channel data.
+func (s *HostStorageSystem) RescanAllHba(ctx *Context, _ *types.RescanAllHba) soap.HasFault {
+	s.rescan(ctx)
 
 	return &methods.RescanAllHbaBody{
 		Res: new(types.RescanAllHbaResponse),
 	}
 }
 
-func (s *HostStorageSystem) RescanVmfs(*Context, *types.RescanVmfs) soap.HasFault {
+func (s *HostStorageSystem) RescanHba(ctx *Context, req *types.RescanHba) soap.HasFault {
+	r := &methods.RescanHbaBody{}
+
+	if s.hba(req.HbaDevice) == nil {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	s.rescan(ctx)
+
+	r.Res = new(types.RescanHbaResponse)
+	return r
+}
+
+// RescanVmfs mounts VMFS volumes found on the host's LUNs, such as those created by another host.
+func (s *HostStorageSystem) RescanVmfs(ctx *Context, _ *types.RescanVmfs) soap.HasFault {
+	ref := s.Host.ConfigManager.DatastoreSystem
+	if ref != nil {
+		dss := ctx.Map.Get(*ref).(*HostDatastoreSystem)
+
+		for _, lun := range s.StorageDeviceInfo.ScsiLun {
+			disk, ok := lun.(*types.HostScsiDisk)
+			if !ok {
+				continue
+			}
+			ds := vmfsVolume(ctx, disk.CanonicalName)
+			if ds == nil || hostMount(ds, s.Host.Self) != nil {
+				continue
+			}
+			ctx.WithLock(dss, func() {
+				ctx.WithLock(ds, func() {
+					dss.mount(ctx, ds)
+				})
+			})
+		}
+	}
+
 	return &methods.RescanVmfsBody{Res: new(types.RescanVmfsResponse)}
 }
 
@@ -65,6 +422,353 @@ func (s *HostStorageSystem) RefreshStorageSystem(*Context, *types.RefreshStorage
 	return &methods.RefreshStorageSystemBody{Res: new(types.RefreshStorageSystemResponse)}
 }
 
+func (s *HostStorageSystem) UpdateSoftwareInternetScsiEnabled(ctx *Context, req *types.UpdateSoftwareInternetScsiEnabled) soap.HasFault {
+	r := &methods.UpdateSoftwareInternetScsiEnabledBody{}
+	info := s.StorageDeviceInfo
+
+	if req.Enabled == info.SoftwareInternetScsiEnabled {
+		r.Res = new(types.UpdateSoftwareInternetScsiEnabledResponse)
+		return r
+	}
+
+	const device = "vmhba65"
+
+	if req.Enabled {
+		info.HostBusAdapter = append(info.HostBusAdapter, &types.HostInternetScsiHba{
+			HostHostBusAdapter: types.HostHostBusAdapter{
+				Key:    "key-vim.host.InternetScsiHba-" + device,
+				Device: device,
+				Status: "online",
+				Model:  "iSCSI Software Adapter",
+				Driver: "iscsi_vmk",
+			},
+			IsSoftwareBased: true,
+			CanBeDisabled:   types.NewBool(true),
+			DiscoveryCapabilities: types.HostInternetScsiHbaDiscoveryCapabilities{
+				StaticTargetDiscoverySettable: true,
+				SendTargetsDiscoverySettable:  true,
+			},
+			DiscoveryProperties: types.HostInternetScsiHbaDiscoveryProperties{
+				StaticTargetDiscoveryEnabled: true,
+				SendTargetsDiscoveryEnabled:  true,
+			},
+			IScsiName: "iqn.1998-01.com.vmware:" + strings.ToLower(s.Host.Name),
+		})
+	} else {
+		hba, err := s.iscsiHba(device)
+		if err != nil {
+			r.Fault_ = Fault("", err)
+			return r
+		}
+		if err := s.iscsiInUse(ctx, hba.Device, hba.ConfiguredStaticTarget); err != nil {
+			r.Fault_ = Fault("", err)
+			return r
+		}
+
+		var adapters []types.BaseHostHostBusAdapter
+		for _, a := range info.HostBusAdapter {
+			if a.GetHostHostBusAdapter().Device != device {
+				adapters = append(adapters, a)
+			}
+		}
+		info.HostBusAdapter = adapters
+	}
+
+	info.SoftwareInternetScsiEnabled = req.Enabled
+	s.rescan(ctx)
+
+	r.Res = new(types.UpdateSoftwareInternetScsiEnabledResponse)
+	return r
+}
+
+// iscsiInUse returns ResourceInUse if any LUN presented by the given targets backs a VMFS volume mounted on this host.
+func (s *HostStorageSystem) iscsiInUse(ctx *Context, device string, targets []types.HostInternetScsiHbaStaticTarget) types.BaseMethodFault {
+	for _, target := range targets {
+		for i := 0; i < s.iscsi[target.Address]; i++ {
+			disk := newScsiDisk(fmt.Sprintf("iscsi-%s-%d", target.Address, i), i, "")
+			if ds := s.vmfsMounted(ctx, disk.CanonicalName); ds != nil {
+				return &types.ResourceInUse{Type: ds.Self.Type, Name: ds.Name}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *HostStorageSystem) AddInternetScsiSendTargets(ctx *Context, req *types.AddInternetScsiSendTargets) soap.HasFault {
+	r := &methods.AddInternetScsiSendTargetsBody{}
+
+	hba, err := s.iscsiHba(req.IScsiHbaDevice)
+	if err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	for _, target := range req.Targets {
+		if target.Port == 0 {
+			target.Port = 3260
+		}
+		found := false
+		for _, t := range hba.ConfiguredSendTarget {
+			if t.Address == target.Address && t.Port == target.Port {
+				found = true
+				break
+			}
+		}
+		if !found {
+			hba.ConfiguredSendTarget = append(hba.ConfiguredSendTarget, target)
+		}
+	}
+
+	s.update(ctx)
+
+	r.Res = new(types.AddInternetScsiSendTargetsResponse)
+	return r
+}
+
+func (s *HostStorageSystem) AddInternetScsiStaticTargets(ctx *Context, req *types.AddInternetScsiStaticTargets) soap.HasFault {
+	r := &methods.AddInternetScsiStaticTargetsBody{}
+
+	hba, err := s.iscsiHba(req.IScsiHbaDevice)
+	if err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	for _, target := range req.Targets {
+		if target.IScsiName == "" {
+			r.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "iScsiName"})
+			return r
+		}
+		if target.Port == 0 {
+			target.Port = 3260
+		}
+		found := false
+		for _, t := range hba.ConfiguredStaticTarget {
+			if t.Address == target.Address && t.Port == target.Port && t.IScsiName == target.IScsiName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			target.DiscoveryMethod = string(types.HostInternetScsiHbaStaticTargetTargetDiscoveryMethodStaticMethod)
+			hba.ConfiguredStaticTarget = append(hba.ConfiguredStaticTarget, target)
+		}
+	}
+
+	s.update(ctx)
+
+	r.Res = new(types.AddInternetScsiStaticTargetsResponse)
+	return r
+}
+
+func (s *HostStorageSystem) RemoveInternetScsiSendTargets(ctx *Context, req *types.RemoveInternetScsiSendTargets) soap.HasFault {
+	r := &methods.RemoveInternetScsiSendTargetsBody{}
+
+	hba, err := s.iscsiHba(req.IScsiHbaDevice)
+	if err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	removed := make(map[string]bool)
+	for _, target := range req.Targets {
+		if target.Port == 0 {
+			target.Port = 3260
+		}
+		removed[iscsiTargetParent(target.Address, target.Port)] = true
+	}
+
+	var send []types.HostInternetScsiHbaSendTarget
+	for _, t := range hba.ConfiguredSendTarget {
+		if !removed[iscsiTargetParent(t.Address, t.Port)] {
+			send = append(send, t)
+		}
+	}
+
+	// static targets discovered via the send targets are removed too
+	var static, discovered []types.HostInternetScsiHbaStaticTarget
+	for _, t := range hba.ConfiguredStaticTarget {
+		if removed[t.Parent] {
+			discovered = append(discovered, t)
+		} else {
+			static = append(static, t)
+		}
+	}
+
+	if !isTrue(req.Force) {
+		if err := s.iscsiInUse(ctx, hba.Device, discovered); err != nil {
+			r.Fault_ = Fault("", err)
+			return r
+		}
+	}
+
+	hba.ConfiguredSendTarget = send
+	hba.ConfiguredStaticTarget = static
+	s.update(ctx)
+
+	r.Res = new(types.RemoveInternetScsiSendTargetsResponse)
+	return r
+}
+
+func (s *HostStorageSystem) RemoveInternetScsiStaticTargets(ctx *Context, req *types.RemoveInternetScsiStaticTargets) soap.HasFault {
+	r := &methods.RemoveInternetScsiStaticTargetsBody{}
+
+	hba, err := s.iscsiHba(req.IScsiHbaDevice)
+	if err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	var static, removed []types.HostInternetScsiHbaStaticTarget
+	for _, t := range hba.ConfiguredStaticTarget {
+		match := false
+		for _, target := range req.Targets {
+			if target.Port == 0 {
+				target.Port = 3260
+			}
+			if t.Address == target.Address && t.Port == target.Port && t.IScsiName == target.IScsiName {
+				match = true
+				break
+			}
+		}
+		if match {
+			removed = append(removed, t)
+		} else {
+			static = append(static, t)
+		}
+	}
+
+	if err := s.iscsiInUse(ctx, hba.Device, removed); err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	hba.ConfiguredStaticTarget = static
+	s.update(ctx)
+
+	r.Res = new(types.RemoveInternetScsiStaticTargetsResponse)
+	return r
+}
+
+func (s *HostStorageSystem) AttachScsiLun(ctx *Context, req *types.AttachScsiLun) soap.HasFault {
+	r := &methods.AttachScsiLunBody{}
+
+	disk := s.scsiDisk(req.LunUuid)
+	if disk == nil {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	disk.OperationalState = []string{string(types.ScsiLunStateOk)}
+	s.update(ctx)
+
+	r.Res = new(types.AttachScsiLunResponse)
+	return r
+}
+
+func (s *HostStorageSystem) DetachScsiLun(ctx *Context, req *types.DetachScsiLun) soap.HasFault {
+	r := &methods.DetachScsiLunBody{}
+
+	disk := s.scsiDisk(req.LunUuid)
+	if disk == nil {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	if ds := s.vmfsMounted(ctx, disk.CanonicalName); ds != nil {
+		r.Fault_ = Fault("", &types.ResourceInUse{Type: ds.Self.Type, Name: ds.Name})
+		return r
+	}
+
+	disk.OperationalState = []string{string(types.ScsiLunStateOff)}
+	s.update(ctx)
+
+	r.Res = new(types.DetachScsiLunResponse)
+	return r
+}
+
+// vmfsMount returns the host's mount of the VMFS volume with the given uuid.
+func (s *HostStorageSystem) vmfsMount(ctx *Context, uuid string) (*Datastore, *types.DatastoreHostMount) {
+	for _, ref := range s.Host.Datastore {
+		ds, ok := ctx.Map.Get(ref).(*Datastore)
+		if !ok {
+			continue
+		}
+		info, ok := ds.Info.(*types.VmfsDatastoreInfo)
+		if !ok || info.Vmfs == nil || info.Vmfs.Uuid != uuid {
+			continue
+		}
+		for i := range ds.Host {
+			if ds.Host[i].Key == s.Host.Self {
+				return ds, &ds.Host[i]
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (s *HostStorageSystem) setVmfsMounted(ctx *Context, uuid string, mounted bool) types.BaseMethodFault {
+	ds, mount := s.vmfsMount(ctx, uuid)
+	if ds == nil {
+		return &types.NotFound{}
+	}
+
+	if !mounted {
+		for _, ref := range ds.Vm {
+			vm := ctx.Map.Get(ref).(*VirtualMachine)
+			if *vm.Runtime.Host == s.Host.Self {
+				return &types.ResourceInUse{Type: ds.Self.Type, Name: ds.Name}
+			}
+		}
+	}
+
+	ctx.WithLock(ds, func() {
+		mount.MountInfo.Mounted = types.NewBool(mounted)
+		mount.MountInfo.Accessible = types.NewBool(mounted)
+		if mounted {
+			mount.MountInfo.InaccessibleReason = ""
+		} else {
+			mount.MountInfo.InaccessibleReason = "NotMounted"
+		}
+
+		accessible := false
+		for _, m := range ds.Host {
+			accessible = accessible || isTrue(m.MountInfo.Accessible)
+		}
+
+		ctx.Map.Update(ds, []types.PropertyChange{
+			{Name: "host", Val: ds.Host},
+			{Name: "summary.accessible", Val: accessible},
+		})
+	})
+
+	return nil
+}
+
+func (s *HostStorageSystem) MountVmfsVolume(ctx *Context, req *types.MountVmfsVolume) soap.HasFault {
+	r := &methods.MountVmfsVolumeBody{}
+
+	if err := s.setVmfsMounted(ctx, req.VmfsUuid, true); err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	r.Res = new(types.MountVmfsVolumeResponse)
+	return r
+}
+
+func (s *HostStorageSystem) UnmountVmfsVolume(ctx *Context, req *types.UnmountVmfsVolume) soap.HasFault {
+	r := &methods.UnmountVmfsVolumeBody{}
+
+	if err := s.setVmfsMounted(ctx, req.VmfsUuid, false); err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	r.Res = new(types.UnmountVmfsVolumeResponse)
+	return r
+}
+
 // HBA with FibreChannel data, see RescanAllHba()
 var fibreChannelHBA = []types.BaseHostHostBusAdapter{
 	&types.HostBlockHba{
@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostStorageSystemIscsi(t *testing.T) {
+	m := ESX()
+	m.IscsiTarget = map[string]int{"10.0.0.1": 2}
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		host, err := find.NewFinder(c).DefaultHostSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hss, err := host.ConfigManager().StorageSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		info := func() *types.HostStorageDeviceInfo {
+			var mhss mo.HostStorageSystem
+			if err := hss.Properties(ctx, hss.Reference(), []string{"storageDeviceInfo"}, &mhss); err != nil {
+				t.Fatal(err)
+			}
+			return mhss.StorageDeviceInfo
+		}
+
+		iscsi := func() *types.HostInternetScsiHba {
+			for _, hba := range info().HostBusAdapter {
+				if a, ok := hba.(*types.HostInternetScsiHba); ok {
+					return a
+				}
+			}
+			return nil
+		}
+
+		luns := func() []string {
+			var names []string
+			for _, lun := range info().ScsiLun {
+				if disk, ok := lun.(*types.HostScsiDisk); ok && disk.Model == "iSCSI Disk" {
+					names = append(names, disk.CanonicalName)
+				}
+			}
+			return names
+		}
+
+		send := []types.HostInternetScsiHbaSendTarget{{Address: "10.0.0.1"}}
+
+		if err = hss.AddInternetScsiSendTargets(ctx, "vmhba65", send); err == nil {
+			t.Error("expected error") // NotFound
+		}
+
+		if err = hss.UpdateSoftwareInternetScsiEnabled(ctx, true); err != nil {
+			t.Fatal(err)
+		}
+
+		hba := iscsi()
+		if hba == nil || !hba.IsSoftwareBased || !info().SoftwareInternetScsiEnabled {
+			t.Fatal("software iSCSI adapter not enabled")
+		}
+
+		if err = hss.AddInternetScsiSendTargets(ctx, hba.Device, send); err != nil {
+			t.Fatal(err)
+		}
+		static := []types.HostInternetScsiHbaStaticTarget{{Address: "10.0.0.2", IScsiName: "iqn.2021-01.com.example:unknown"}}
+		if err = hss.AddInternetScsiStaticTargets(ctx, hba.Device, static); err != nil {
+			t.Fatal(err)
+		}
+
+		// LUNs are not visible until rescan
+		if n := len(luns()); n != 0 {
+			t.Errorf("luns=%d", n)
+		}
+
+		if err = hss.RescanAllHba(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		names := luns()
+		if len(names) != 2 {
+			t.Fatalf("luns=%v", names)
+		}
+		if n := len(iscsi().ConfiguredStaticTarget); n != 2 {
+			t.Errorf("static targets=%d", n) // 1 discovered via send target + 1 static target
+		}
+
+		// iSCSI LUNs can be used for VMFS datastores
+		dss, err := host.ConfigManager().DatastoreSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		disks, err := dss.QueryAvailableDisksForVmfs(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var disk *types.HostScsiDisk
+		for i := range disks {
+			if disks[i].CanonicalName == names[0] {
+				disk = &disks[i]
+			}
+		}
+		if disk == nil {
+			t.Fatalf("%s not available", names[0])
+		}
+
+		options, err := dss.QueryVmfsDatastoreCreateOptions(ctx, disk.DevicePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		spec := *options[0].Spec.(*types.VmfsDatastoreCreateSpec)
+		spec.Vmfs.VolumeName = "iscsi-vmfs"
+		ds, err := dss.CreateVmfsDatastore(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = hss.RemoveInternetScsiSendTargets(ctx, hba.Device, send, false); err == nil {
+			t.Error("expected error") // ResourceInUse
+		}
+		if err = hss.UpdateSoftwareInternetScsiEnabled(ctx, false); err == nil {
+			t.Error("expected error") // ResourceInUse
+		}
+
+		if err = dss.Remove(ctx, ds); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = hss.RemoveInternetScsiSendTargets(ctx, hba.Device, send, false); err != nil {
+			t.Fatal(err)
+		}
+		if err = hss.RemoveInternetScsiStaticTargets(ctx, hba.Device, static); err != nil {
+			t.Fatal(err)
+		}
+		if n := len(iscsi().ConfiguredStaticTarget); n != 0 {
+			t.Errorf("static targets=%d", n)
+		}
+
+		if err = hss.RescanAllHba(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if n := len(luns()); n != 0 {
+			t.Errorf("luns=%d", n)
+		}
+
+		if err = hss.UpdateSoftwareInternetScsiEnabled(ctx, false); err != nil {
+			t.Fatal(err)
+		}
+		if iscsi() != nil {
+			t.Error("software iSCSI adapter not disabled")
+		}
+	}, m)
+}
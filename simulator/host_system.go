@@ -34,6 +34,9 @@ var (
 
 type HostSystem struct {
 	mo.HostSystem
+
+	mme *ReflectManagedMethodExecuter
+	dtm *InternalDynamicTypeManager
 }
 
 func asHostSystemMO(obj mo.Reference) (*mo.HostSystem, bool) {
@@ -41,7 +44,13 @@ func asHostSystemMO(obj mo.Reference) (*mo.HostSystem, bool) {
 	return h, ok
 }
 
+// NewHostSystem returns a HostSystem with its config managers registered in the global Map.
 func NewHostSystem(host mo.HostSystem) *HostSystem {
+	return newHostSystem(SpoofContext(), host)
+}
+
+// newHostSystem returns a HostSystem with its config managers registered in the Context's Registry.
+func newHostSystem(ctx *Context, host mo.HostSystem) *HostSystem {
 	if hostPortUnique { // configure unique port for each host
 		port := &esx.HostSystem.Summary.Config.Port
 		*port++
@@ -81,10 +90,14 @@ func NewHostSystem(host mo.HostSystem) *HostSystem {
 		{&hs.ConfigManager.AdvancedOption, NewOptionManager(nil, esx.Setting)},
 		{&hs.ConfigManager.FirewallSystem, NewHostFirewallSystem(&hs.HostSystem)},
 		{&hs.ConfigManager.StorageSystem, NewHostStorageSystem(&hs.HostSystem)},
+		{&hs.ConfigManager.VirtualNicManager, NewHostVirtualNicManager(&hs.HostSystem)},
+		{&hs.ConfigManager.VsanSystem, NewHostVsanSystem(&hs.HostSystem)},
+		{&hs.ConfigManager.CertificateManager, NewHostCertificateManager(&hs.HostSystem)},
+		{&hs.ConfigManager.HostAccessManager, NewHostAccessManager(&hs.HostSystem)},
 	}
 
 	for _, c := range config {
-		ref := Map.Put(c.obj).Reference()
+		ref := ctx.Map.Put(c.obj).Reference()
 
 		*c.ref = &ref
 	}
@@ -92,7 +105,7 @@ func NewHostSystem(host mo.HostSystem) *HostSystem {
 	return hs
 }
 
-func (h *HostSystem) configure(spec types.HostConnectSpec, connected bool) {
+func (h *HostSystem) configure(ctx *Context, spec types.HostConnectSpec, connected bool) {
 	h.Runtime.ConnectionState = types.HostSystemConnectionStateDisconnected
 	if connected {
 		h.Runtime.ConnectionState = types.HostSystemConnectionStateConnected
@@ -106,13 +119,16 @@ func (h *HostSystem) configure(spec types.HostConnectSpec, connected bool) {
 	id := newUUID(h.Name)
 	h.Summary.Hardware.Uuid = id
 	h.Hardware.SystemInfo.Uuid = id
+
+	// regenerate the default certificate with the configured host name
+	ctx.Map.Get(*h.ConfigManager.CertificateManager).(*HostCertificateManager).generate()
 }
 
-func (h *HostSystem) event() types.HostEvent {
+func (h *HostSystem) event(ctx *Context) types.HostEvent {
 	return types.HostEvent{
 		Event: types.Event{
-			Datacenter:      datacenterEventArgument(h),
-			ComputeResource: h.eventArgumentParent(),
+			Datacenter:      datacenterEventArgument(ctx, h),
+			ComputeResource: h.eventArgumentParent(ctx),
 			Host:            h.eventArgument(),
 		},
 	}
@@ -125,8 +141,8 @@ func (h *HostSystem) eventArgument() *types.HostEventArgument {
 	}
 }
 
-func (h *HostSystem) eventArgumentParent() *types.ComputeResourceEventArgument {
-	parent := hostParent(&h.HostSystem)
+func (h *HostSystem) eventArgumentParent(ctx *Context) *types.ComputeResourceEventArgument {
+	parent := hostParent(ctx, &h.HostSystem)
 
 	return &types.ComputeResourceEventArgument{
 		ComputeResource:     parent.Self,
@@ -134,8 +150,8 @@ func (h *HostSystem) eventArgumentParent() *types.ComputeResourceEventArgument {
 	}
 }
 
-func hostParent(host *mo.HostSystem) *mo.ComputeResource {
-	switch parent := Map.Get(*host.Parent).(type) {
+func hostParent(ctx *Context, host *mo.HostSystem) *mo.ComputeResource {
+	switch parent := ctx.Map.Get(*host.Parent).(type) {
 	case *mo.ComputeResource:
 		return parent
 	case *ClusterComputeResource:
@@ -162,7 +178,7 @@ func addComputeResource(s *types.ComputeResourceSummary, h *HostSystem) {
 func CreateDefaultESX(ctx *Context, f *Folder) {
 	dc := NewDatacenter(ctx, &f.Folder)
 
-	host := NewHostSystem(esx.HostSystem)
+	host := newHostSystem(ctx, esx.HostSystem)
 
 	summary := new(types.ComputeResourceSummary)
 	addComputeResource(summary, host)
@@ -171,14 +187,14 @@ func CreateDefaultESX(ctx *Context, f *Folder) {
 		Summary: summary,
 		Network: esx.Datacenter.Network,
 	}
-	cr.EnvironmentBrowser = newEnvironmentBrowser()
+	cr.EnvironmentBrowser = newEnvironmentBrowser(ctx)
 	cr.Self = *host.Parent
 	cr.Name = host.Name
 	cr.Host = append(cr.Host, host.Reference())
 	host.Network = cr.Network
 	ctx.Map.PutEntity(cr, host)
 
-	pool := NewResourcePool()
+	pool := newResourcePool(ctx.Map)
 	cr.ResourcePool = &pool.Self
 	ctx.Map.PutEntity(cr, pool)
 	pool.Owner = cr.Self
@@ -205,9 +221,9 @@ func CreateStandaloneHost(ctx *Context, f *Folder, spec types.HostConnectSpec) (
 		network = cr.Network
 	}
 
-	pool := NewResourcePool()
-	host := NewHostSystem(template)
-	host.configure(spec, false)
+	pool := newResourcePool(ctx.Map)
+	host := newHostSystem(ctx, template)
+	host.configure(ctx, spec, false)
 
 	summary := new(types.ComputeResourceSummary)
 	addComputeResource(summary, host)
@@ -217,7 +233,7 @@ func CreateStandaloneHost(ctx *Context, f *Folder, spec types.HostConnectSpec) (
 			VmSwapPlacement: string(types.VirtualMachineConfigInfoSwapPlacementTypeVmDirectory),
 		},
 		Summary:            summary,
-		EnvironmentBrowser: newEnvironmentBrowser(),
+		EnvironmentBrowser: newEnvironmentBrowser(ctx),
 	}
 
 	ctx.Map.PutEntity(cr, ctx.Map.NewEntity(host))
@@ -238,12 +254,12 @@ func CreateStandaloneHost(ctx *Context, f *Folder, spec types.HostConnectSpec) (
 }
 
 func (h *HostSystem) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
-	task := CreateTask(h, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, h, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if len(h.Vm) > 0 {
 			return nil, &types.ResourceInUse{}
 		}
 
-		ctx.postEvent(&types.HostRemovedEvent{HostEvent: h.event()})
+		ctx.postEvent(&types.HostRemovedEvent{HostEvent: h.event(ctx)})
 
 		f := ctx.Map.getEntityParent(h, "Folder").(*Folder)
 		folderRemoveChild(ctx, &f.Folder, h.Reference())
@@ -259,7 +275,7 @@ func (h *HostSystem) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.Has
 }
 
 func (h *HostSystem) EnterMaintenanceModeTask(ctx *Context, spec *types.EnterMaintenanceMode_Task) soap.HasFault {
-	task := CreateTask(h, "enterMaintenanceMode", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, h, "enterMaintenanceMode", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		h.Runtime.InMaintenanceMode = true
 		return nil, nil
 	})
@@ -272,7 +288,7 @@ func (h *HostSystem) EnterMaintenanceModeTask(ctx *Context, spec *types.EnterMai
 }
 
 func (h *HostSystem) ExitMaintenanceModeTask(ctx *Context, spec *types.ExitMaintenanceMode_Task) soap.HasFault {
-	task := CreateTask(h, "exitMaintenanceMode", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, h, "exitMaintenanceMode", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		h.Runtime.InMaintenanceMode = false
 		return nil, nil
 	})
@@ -283,3 +299,37 @@ func (h *HostSystem) ExitMaintenanceModeTask(ctx *Context, spec *types.ExitMaint
 		},
 	}
 }
+
+func (h *HostSystem) accessManager(ctx *Context) *HostAccessManager {
+	return ctx.Map.Get(*h.ConfigManager.HostAccessManager).(*HostAccessManager)
+}
+
+func (h *HostSystem) EnterLockdownMode(ctx *Context, req *types.EnterLockdownMode) soap.HasFault {
+	body := new(methods.EnterLockdownModeBody)
+
+	m := h.accessManager(ctx)
+	if m.LockdownMode != types.HostLockdownModeLockdownDisabled {
+		body.Fault_ = Fault("", new(types.AdminDisabled))
+		return body
+	}
+
+	m.lockdown(ctx, types.HostLockdownModeLockdownNormal)
+	body.Res = new(types.EnterLockdownModeResponse)
+
+	return body
+}
+
+func (h *HostSystem) ExitLockdownMode(ctx *Context, req *types.ExitLockdownMode) soap.HasFault {
+	body := new(methods.ExitLockdownModeBody)
+
+	m := h.accessManager(ctx)
+	if m.LockdownMode == types.HostLockdownModeLockdownDisabled {
+		body.Fault_ = Fault("", new(types.AdminNotDisabled))
+		return body
+	}
+
+	m.lockdown(ctx, types.HostLockdownModeLockdownDisabled)
+	body.Res = new(types.ExitLockdownModeResponse)
+
+	return body
+}
@@ -0,0 +1,241 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type HostVirtualNicManager struct {
+	mo.HostVirtualNicManager
+
+	Host *mo.HostSystem
+}
+
+func NewHostVirtualNicManager(host *mo.HostSystem) *HostVirtualNicManager {
+	m := &HostVirtualNicManager{Host: host}
+
+	if info := host.Config.VirtualNicManagerInfo; info != nil {
+		m.Info = *info
+	}
+	// the host's config.virtualNicManagerInfo reflects any changes made via this manager
+	host.Config.VirtualNicManagerInfo = &m.Info
+
+	return m
+}
+
+func (m *HostVirtualNicManager) init(r *Registry) {
+	for _, obj := range r.objects {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.VirtualNicManager; ref != nil && ref.Value == m.Self.Value {
+				m.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+func (m *HostVirtualNicManager) netConfig(nicType string) *types.VirtualNicManagerNetConfig {
+	for i := range m.Info.NetConfig {
+		if m.Info.NetConfig[i].NicType == nicType {
+			return &m.Info.NetConfig[i]
+		}
+	}
+	return nil
+}
+
+func (m *HostVirtualNicManager) vnic(device string) *types.HostVirtualNic {
+	for i := range m.Host.Config.Network.Vnic {
+		if m.Host.Config.Network.Vnic[i].Device == device {
+			return &m.Host.Config.Network.Vnic[i]
+		}
+	}
+	return nil
+}
+
+// candidate reports whether the given nic can be selected for nicType,
+// vnics on a dedicated netstack can only be used for that netstack's service.
+func candidate(nicType string, nic types.HostVirtualNic) bool {
+	if t, ok := netStackNicType[nic.Spec.NetStackInstanceKey]; ok {
+		return t == nicType
+	}
+	_, ok := netStackNicType[nicType]
+	return !ok || nic.Spec.NetStackInstanceKey == "" || nic.Spec.NetStackInstanceKey == "defaultTcpipStack"
+}
+
+func (m *HostVirtualNicManager) update(ctx *Context) {
+	ctx.Map.Update(m, []types.PropertyChange{
+		{Name: "info", Val: m.Info},
+	})
+}
+
+func (m *HostVirtualNicManager) addVnic(ctx *Context, nic types.HostVirtualNic) {
+	for i := range m.Info.NetConfig {
+		c := &m.Info.NetConfig[i]
+		if !candidate(c.NicType, nic) {
+			continue
+		}
+
+		vnic := nic
+		vnic.Key = c.NicType + "." + nic.Key
+		c.CandidateVnic = append(c.CandidateVnic, vnic)
+
+		if netStackNicType[nic.Spec.NetStackInstanceKey] == c.NicType {
+			// services on a dedicated netstack are enabled by default
+			c.SelectedVnic = append(c.SelectedVnic, vnic.Key)
+		}
+	}
+
+	m.update(ctx)
+}
+
+func (m *HostVirtualNicManager) updateVnic(ctx *Context, nic types.HostVirtualNic) {
+	for i := range m.Info.NetConfig {
+		c := &m.Info.NetConfig[i]
+		for j := range c.CandidateVnic {
+			if c.CandidateVnic[j].Device == nic.Device {
+				key := c.CandidateVnic[j].Key
+				c.CandidateVnic[j] = nic
+				c.CandidateVnic[j].Key = key
+			}
+		}
+	}
+
+	m.update(ctx)
+}
+
+func (m *HostVirtualNicManager) removeVnic(ctx *Context, nic types.HostVirtualNic) {
+	for i := range m.Info.NetConfig {
+		c := &m.Info.NetConfig[i]
+		key := c.NicType + "." + nic.Key
+
+		var candidates []types.HostVirtualNic
+		for _, vnic := range c.CandidateVnic {
+			if vnic.Device != nic.Device {
+				candidates = append(candidates, vnic)
+			}
+		}
+		c.CandidateVnic = candidates
+
+		var selected []string
+		for _, k := range c.SelectedVnic {
+			if k != key {
+				selected = append(selected, k)
+			}
+		}
+		c.SelectedVnic = selected
+	}
+
+	m.update(ctx)
+}
+
+// selectVnic validates the nicType and device, returning the selection key.
+func (m *HostVirtualNicManager) selectVnic(nicType, device string) (*types.VirtualNicManagerNetConfig, string, types.BaseMethodFault) {
+	if nicType == string(types.HostVirtualNicManagerNicTypeVsan) {
+		// vSAN traffic is configured via HostVsanSystem.UpdateVsan_Task
+		return nil, "", new(types.NotSupported)
+	}
+
+	c := m.netConfig(nicType)
+	if c == nil {
+		return nil, "", &types.InvalidArgument{InvalidProperty: "nicType"}
+	}
+
+	nic := m.vnic(device)
+	if nic == nil {
+		return nil, "", &types.NotFound{}
+	}
+
+	if !candidate(nicType, *nic) {
+		return nil, "", &types.InvalidArgument{InvalidProperty: "device"}
+	}
+
+	return c, nicType + "." + nic.Key, nil
+}
+
+func (m *HostVirtualNicManager) QueryNetConfig(req *types.QueryNetConfig) soap.HasFault {
+	r := &methods.QueryNetConfigBody{}
+
+	c := m.netConfig(req.NicType)
+	if c == nil {
+		r.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "nicType"})
+		return r
+	}
+
+	r.Res = &types.QueryNetConfigResponse{
+		Returnval: c,
+	}
+
+	return r
+}
+
+func (m *HostVirtualNicManager) SelectVnicForNicType(ctx *Context, req *types.SelectVnicForNicType) soap.HasFault {
+	r := &methods.SelectVnicForNicTypeBody{}
+
+	c, key, err := m.selectVnic(req.NicType, req.Device)
+	if err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	if !c.MultiSelectAllowed {
+		c.SelectedVnic = nil
+	}
+
+	found := false
+	for _, k := range c.SelectedVnic {
+		if k == key {
+			found = true
+			break
+		}
+	}
+	if !found {
+		c.SelectedVnic = append(c.SelectedVnic, key)
+	}
+
+	m.update(ctx)
+
+	r.Res = new(types.SelectVnicForNicTypeResponse)
+
+	return r
+}
+
+func (m *HostVirtualNicManager) DeselectVnicForNicType(ctx *Context, req *types.DeselectVnicForNicType) soap.HasFault {
+	r := &methods.DeselectVnicForNicTypeBody{}
+
+	c, key, err := m.selectVnic(req.NicType, req.Device)
+	if err != nil {
+		r.Fault_ = Fault("", err)
+		return r
+	}
+
+	var selected []string
+	for _, k := range c.SelectedVnic {
+		if k != key {
+			selected = append(selected, k)
+		}
+	}
+	c.SelectedVnic = selected
+
+	m.update(ctx)
+
+	r.Res = new(types.DeselectVnicForNicTypeResponse)
+
+	return r
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type HostVsanSystem struct {
+	mo.HostVsanSystem
+
+	Host *mo.HostSystem
+}
+
+func NewHostVsanSystem(host *mo.HostSystem) *HostVsanSystem {
+	s := &HostVsanSystem{Host: host}
+
+	if config := host.Config.VsanHostConfig; config != nil {
+		deepCopy(config, &s.Config)
+	}
+	if s.Config.NetworkInfo == nil {
+		s.Config.NetworkInfo = new(types.VsanHostConfigInfoNetworkInfo)
+	}
+	host.Config.VsanHostConfig = &s.Config
+
+	return s
+}
+
+func (s *HostVsanSystem) init(r *Registry) {
+	for _, obj := range r.kinds["HostSystem"] {
+		if h, ok := obj.(*HostSystem); ok {
+			if ref := h.ConfigManager.VsanSystem; ref != nil && ref.Value == s.Self.Value {
+				s.Host = &h.HostSystem
+			}
+		}
+	}
+}
+
+// syncNicManager selects the vsan service on the vmknics listed in config.networkInfo.port
+func (s *HostVsanSystem) syncNicManager(ctx *Context) {
+	ref := s.Host.ConfigManager.VirtualNicManager
+	if ref == nil {
+		return
+	}
+	m, ok := ctx.Map.Get(*ref).(*HostVirtualNicManager)
+	if !ok {
+		return
+	}
+
+	ctx.WithLock(m, func() {
+		c := m.netConfig(string(types.HostVirtualNicManagerNicTypeVsan))
+		if c == nil {
+			return
+		}
+
+		c.SelectedVnic = nil
+		for _, port := range s.Config.NetworkInfo.Port {
+			if nic := m.vnic(port.Device); nic != nil {
+				c.SelectedVnic = append(c.SelectedVnic, c.NicType+"."+nic.Key)
+			}
+		}
+
+		m.update(ctx)
+	})
+}
+
+func (s *HostVsanSystem) removeVnic(ctx *Context, device string) {
+	var ports []types.VsanHostConfigInfoNetworkInfoPortConfig
+	for _, port := range s.Config.NetworkInfo.Port {
+		if port.Device != device {
+			ports = append(ports, port)
+		}
+	}
+
+	ctx.Map.Update(s, []types.PropertyChange{
+		{Name: "config.networkInfo.port", Val: ports},
+	})
+}
+
+func (s *HostVsanSystem) UpdateVsanTask(ctx *Context, req *types.UpdateVsan_Task) soap.HasFault {
+	task := CreateTask(ctx, s, "updateVsan", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		config := req.Config
+
+		if info := config.NetworkInfo; info != nil {
+			for _, port := range info.Port {
+				found := false
+				for _, nic := range s.Host.Config.Network.Vnic {
+					if nic.Device == port.Device {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return nil, &types.NotFound{}
+				}
+			}
+		}
+
+		var changes []types.PropertyChange
+
+		if config.Enabled != nil {
+			changes = append(changes, types.PropertyChange{Name: "config.enabled", Val: config.Enabled})
+		}
+		if config.ClusterInfo != nil {
+			changes = append(changes, types.PropertyChange{Name: "config.clusterInfo", Val: config.ClusterInfo})
+		}
+		if config.StorageInfo != nil {
+			changes = append(changes, types.PropertyChange{Name: "config.storageInfo", Val: config.StorageInfo})
+		}
+		if config.NetworkInfo != nil {
+			changes = append(changes, types.PropertyChange{Name: "config.networkInfo", Val: config.NetworkInfo})
+		}
+		if config.FaultDomainInfo != nil {
+			changes = append(changes, types.PropertyChange{Name: "config.faultDomainInfo", Val: config.FaultDomainInfo})
+		}
+
+		ctx.Map.Update(s, changes)
+
+		if config.NetworkInfo != nil {
+			s.syncNicManager(ctx)
+		}
+
+		return nil, nil
+	})
+
+	return &methods.UpdateVsan_TaskBody{
+		Res: &types.UpdateVsan_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
@@ -26,6 +26,7 @@ import (
 // Minimal set of internal types and methods:
 // - Fetch() - used by ovftool to collect various managed object properties
 // - RetrieveInternalContent() - used by ovftool to obtain a reference to NfcService (which it does not use by default)
+// - NotifyAffectedServices() - used by object.HostCertificateManager.InstallServerCertificate
 
 func init() {
 	types.Add("Fetch", reflect.TypeOf((*Fetch)(nil)).Elem())
@@ -71,3 +72,21 @@ type InternalServiceInstanceContent struct {
 
 	NfcService types.ManagedObjectReference `xml:"nfcService"`
 }
+
+func init() {
+	types.Add("NotifyAffectedServices", reflect.TypeOf((*NotifyAffectedServices)(nil)).Elem())
+}
+
+type NotifyAffectedServices struct {
+	This types.ManagedObjectReference `xml:"_this"`
+}
+
+type NotifyAffectedServicesResponse struct {
+}
+
+type NotifyAffectedServicesBody struct {
+	Res    *NotifyAffectedServicesResponse `xml:"NotifyAffectedServicesResponse,omitempty"`
+	Fault_ *soap.Fault                     `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *NotifyAffectedServicesBody) Fault() *soap.Fault { return b.Fault_ }
@@ -17,12 +17,16 @@ limitations under the License.
 package simulator
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"log"
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -57,6 +61,18 @@ type IpPoolManager struct {
 
 	pools      map[int32]*IpPool
 	nextPoolId int32
+
+	// guest maps a VirtualMachine to its guest IP allocations, see assignGuestIP
+	guest map[types.ManagedObjectReference][]guestAllocation
+	// delay before guest IPs are assigned after power on
+	delay time.Duration
+}
+
+type guestAllocation struct {
+	pool   int32
+	id     string
+	device int32  // guest.net DeviceConfigId
+	ip     string // allocated address
 }
 
 func (m *IpPoolManager) init(*Registry) {
@@ -64,11 +80,13 @@ func (m *IpPoolManager) init(*Registry) {
 		1: ipPool,
 	}
 	m.nextPoolId = 2
+	m.guest = make(map[types.ManagedObjectReference][]guestAllocation)
 }
 
 func (m *IpPoolManager) CreateIpPool(req *types.CreateIpPool) soap.HasFault {
 	body := &methods.CreateIpPoolBody{}
 	id := m.nextPoolId
+	req.Pool.Id = id
 
 	var err error
 	m.pools[id], err = NewIpPool(&req.Pool)
@@ -229,6 +247,283 @@ func (m *IpPoolManager) QueryIPAllocations(req *types.QueryIPAllocations) soap.H
 	return body
 }
 
+// networkPool returns the IpPool associated with the given network, if any.
+func (m *IpPoolManager) networkPool(ref types.ManagedObjectReference, name string) (int32, *IpPool) {
+	for i := int32(1); i < m.nextPoolId; i++ {
+		p, ok := m.pools[i]
+		if !ok || p.config.Ipv4Config == nil {
+			continue
+		}
+
+		for _, a := range p.config.NetworkAssociation {
+			if (a.Network != nil && *a.Network == ref) || (name != "" && a.NetworkName == name) {
+				return i, p
+			}
+		}
+	}
+
+	return 0, nil
+}
+
+// guestNetwork returns the network reference and name of the given ethernet card backing.
+func guestNetwork(ctx *Context, card *types.VirtualEthernetCard) (types.ManagedObjectReference, string) {
+	var ref types.ManagedObjectReference
+
+	switch b := card.Backing.(type) {
+	case *types.VirtualEthernetCardNetworkBackingInfo:
+		if b.Network != nil {
+			ref = *b.Network
+		}
+		return ref, b.DeviceName
+	case *types.VirtualEthernetCardDistributedVirtualPortBackingInfo:
+		ref = types.ManagedObjectReference{Type: "DistributedVirtualPortgroup", Value: b.Port.PortgroupKey}
+		if pg, ok := ctx.Map.Get(ref).(*DistributedVirtualPortgroup); ok {
+			return ref, pg.Name
+		}
+	}
+
+	return ref, ""
+}
+
+// assignGuestIP allocates an address for each VM NIC connected to a network with an associated IpPool,
+// populating the guest.net, guest.ipAddress and guest.ipStack properties, as DHCP would in a real guest.
+func (m *IpPoolManager) assignGuestIP(ctx *Context, vm *VirtualMachine) {
+	var allocs []guestAllocation
+	var routes []types.NetIpRouteConfigInfoIpRoute
+	var address string
+
+	nics := make([]types.GuestNicInfo, len(vm.Guest.Net))
+	copy(nics, vm.Guest.Net)
+	devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+
+	ctx.WithLock(m, func() {
+		for i := range nics {
+			nic := &nics[i]
+
+			card, ok := devices.FindByKey(nic.DeviceConfigId).(types.BaseVirtualEthernetCard)
+			if !ok {
+				continue
+			}
+
+			id, pool := m.networkPool(guestNetwork(ctx, card.GetVirtualEthernetCard()))
+			if pool == nil {
+				continue
+			}
+
+			alloc := guestAllocation{pool: id, id: fmt.Sprintf("%s-%d", vm.Self.Value, nic.DeviceConfigId), device: nic.DeviceConfigId}
+			ip, err := pool.AllocateIPv4(alloc.id)
+			if err != nil {
+				log.Printf("%s: %s: %s", vm.Self, pool.config.Name, err)
+				continue
+			}
+			alloc.ip = ip
+			allocs = append(allocs, alloc)
+
+			config := pool.config.Ipv4Config
+			prefix := pool.ipv4PrefixLength()
+			device := strconv.Itoa(i)
+
+			nic.IpAddress = []string{ip}
+			nic.IpConfig = &types.NetIpConfigInfo{
+				IpAddress: []types.NetIpConfigInfoIpAddress{{
+					IpAddress:    ip,
+					PrefixLength: prefix,
+					State:        string(types.NetIpConfigInfoIpAddressStatusPreferred),
+				}},
+			}
+
+			if address == "" {
+				address = ip
+				if config.Gateway != "" {
+					routes = append(routes, types.NetIpRouteConfigInfoIpRoute{
+						Network:      "0.0.0.0",
+						PrefixLength: 0,
+						Gateway:      types.NetIpRouteConfigInfoGateway{IpAddress: config.Gateway, Device: device},
+					})
+				}
+			}
+
+			routes = append(routes, types.NetIpRouteConfigInfoIpRoute{
+				Network:      config.SubnetAddress,
+				PrefixLength: prefix,
+				Gateway:      types.NetIpRouteConfigInfoGateway{Device: device},
+			})
+		}
+
+		if len(allocs) != 0 {
+			m.guest[vm.Self] = allocs
+		}
+	})
+
+	if len(allocs) == 0 {
+		return
+	}
+
+	ctx.Map.Update(vm, []types.PropertyChange{
+		{Name: "guest.net", Val: nics},
+		{Name: "guest.ipAddress", Val: address},
+		{Name: "summary.guest.ipAddress", Val: address},
+		{Name: "guest.ipStack", Val: guestIpStackWith(vm.Guest.IpStack, routes)},
+	})
+}
+
+// guestIpStackWith returns a copy of the given guest.ipStack, with the given routes replacing those of the same NIC devices.
+func guestIpStackWith(stack []types.GuestStackInfo, routes []types.NetIpRouteConfigInfoIpRoute) []types.GuestStackInfo {
+	devices := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		devices[route.Gateway.Device] = true
+	}
+
+	stack = guestIpStackWithout(stack, devices)
+	if len(stack) == 0 {
+		return []types.GuestStackInfo{{IpRouteConfig: &types.NetIpRouteConfigInfo{IpRoute: routes}}}
+	}
+
+	info := &stack[0]
+	var config types.NetIpRouteConfigInfo
+	if info.IpRouteConfig != nil {
+		config.IpRoute = append(config.IpRoute, info.IpRouteConfig.IpRoute...)
+	}
+	config.IpRoute = append(config.IpRoute, routes...)
+	info.IpRouteConfig = &config
+
+	return stack
+}
+
+// releaseGuestIP releases the addresses allocated to the given VM NICs by assignGuestIP, or all NICs if none are given
+func (m *IpPoolManager) releaseGuestIP(ctx *Context, vm *VirtualMachine, devices ...int32) {
+	var allocs []guestAllocation
+
+	ctx.WithLock(m, func() {
+		var keep []guestAllocation
+
+		for _, alloc := range m.guest[vm.Self] {
+			if len(devices) != 0 && !hasDevice(devices, alloc.device) {
+				keep = append(keep, alloc)
+				continue
+			}
+			if pool, ok := m.pools[alloc.pool]; ok {
+				_ = pool.ReleaseIpv4(alloc.id)
+			}
+			allocs = append(allocs, alloc)
+		}
+
+		if len(keep) == 0 {
+			delete(m.guest, vm.Self)
+		} else {
+			m.guest[vm.Self] = keep
+		}
+	})
+
+	if len(allocs) == 0 {
+		return
+	}
+
+	// only NICs with a pool allocation are cleared, addresses from other sources are left as-is
+	released := make(map[int32]bool, len(allocs))
+	address := vm.Guest.IpAddress
+	for _, alloc := range allocs {
+		released[alloc.device] = true
+		if alloc.ip == address {
+			address = ""
+		}
+	}
+
+	routes := make(map[string]bool, len(allocs))
+	nics := make([]types.GuestNicInfo, len(vm.Guest.Net))
+	copy(nics, vm.Guest.Net)
+	for i := range nics {
+		if released[nics[i].DeviceConfigId] {
+			nics[i].IpAddress = nil
+			nics[i].IpConfig = nil
+			routes[strconv.Itoa(i)] = true
+		} else if address == "" && len(nics[i].IpAddress) != 0 {
+			address = nics[i].IpAddress[0]
+		}
+	}
+
+	ctx.Map.Update(vm, []types.PropertyChange{
+		{Name: "guest.net", Val: nics},
+		{Name: "guest.ipAddress", Val: address},
+		{Name: "summary.guest.ipAddress", Val: address},
+		{Name: "guest.ipStack", Val: guestIpStackWithout(vm.Guest.IpStack, routes)},
+	})
+}
+
+func hasDevice(devices []int32, key int32) bool {
+	for _, device := range devices {
+		if device == key {
+			return true
+		}
+	}
+	return false
+}
+
+// guestIpStackWithout returns a copy of the given guest.ipStack, without the routes of the given NIC devices.
+// A stack is dropped if routes were its only config.
+func guestIpStackWithout(stack []types.GuestStackInfo, devices map[string]bool) []types.GuestStackInfo {
+	var res []types.GuestStackInfo
+
+	for _, info := range stack {
+		if info.IpRouteConfig != nil {
+			var routes []types.NetIpRouteConfigInfoIpRoute
+			for _, route := range info.IpRouteConfig.IpRoute {
+				if !devices[route.Gateway.Device] {
+					routes = append(routes, route)
+				}
+			}
+			if len(routes) == 0 && info.DnsConfig == nil && len(info.IpStackConfig) == 0 && info.DhcpConfig == nil {
+				continue
+			}
+			info.IpRouteConfig = &types.NetIpRouteConfigInfo{IpRoute: routes}
+		}
+		res = append(res, info)
+	}
+
+	return res
+}
+
+// newSubnetIpPool returns an IpPool for the given IPv4 subnet in CIDR notation, associated with the given network name.
+// The first address in the subnet is used as the gateway, the remaining host addresses are available for allocation.
+func newSubnetIpPool(network string, cidr string) (*types.IpPool, error) {
+	_, subnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	base := subnet.IP.To4()
+	if base == nil {
+		return nil, fmt.Errorf("%s: only IPv4 subnets are supported", cidr)
+	}
+
+	ones, bits := subnet.Mask.Size()
+	if ones < 16 || ones > 30 {
+		return nil, fmt.Errorf("%s: prefix length must be between 16 and 30", cidr)
+	}
+
+	addr := func(n uint32) string {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(base)+n)
+		return ip.String()
+	}
+
+	// exclude the network, gateway and broadcast addresses
+	size := int32(1<<uint(bits-ones)) - 3
+
+	return &types.IpPool{
+		Name: network,
+		Ipv4Config: &types.IpPoolIpPoolConfigInfo{
+			SubnetAddress: base.String(),
+			Netmask:       net.IP(subnet.Mask).String(),
+			Gateway:       addr(1),
+			Range:         fmt.Sprintf("%s#%d", addr(2), size),
+			IpPoolEnabled: types.NewBool(true),
+		},
+		AvailableIpv4Addresses: size,
+		NetworkAssociation:     []types.IpPoolAssociation{{NetworkName: network}},
+	}, nil
+}
+
 var (
 	errNoIpAvailable     = errors.New("no ip address available")
 	errInvalidAllocation = errors.New("allocation id not recognized")
@@ -281,8 +576,11 @@ func (p *IpPool) init() error {
 				return err
 			}
 
+			start := binary.BigEndian.Uint32(ip)
 			for i := 0; i < length; i++ {
-				p.ipv4Pool = append(p.ipv4Pool, net.IPv4(ip[0], ip[1], ip[2], ip[3]+byte(i)).String())
+				next := make(net.IP, net.IPv4len)
+				binary.BigEndian.PutUint32(next, start+uint32(i))
+				p.ipv4Pool = append(p.ipv4Pool, next.String())
 			}
 		}
 	}
@@ -318,6 +616,16 @@ func (p *IpPool) init() error {
 	return nil
 }
 
+// ipv4PrefixLength returns the prefix length of the pool's IPv4 netmask
+func (p *IpPool) ipv4PrefixLength() int32 {
+	mask := net.IPMask(net.ParseIP(p.config.Ipv4Config.Netmask).To4())
+	ones, bits := mask.Size()
+	if bits == 0 {
+		return 24 // non-canonical netmask, such as the default ip-pool
+	}
+	return int32(ones)
+}
+
 func (p *IpPool) AllocateIPv4(allocation string) (string, error) {
 	if ip, ok := p.ipv4Allocation[allocation]; ok {
 		return ip, nil
@@ -21,10 +21,15 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -360,3 +365,146 @@ func TestIpPoolManagerAllocate(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestIpPoolManagerGuestIP(t *testing.T) {
+	m := VPX()
+	m.GuestSubnet = map[string]string{"DC0_DVPG0": "10.0.0.0/24"}
+	m.GuestIPDelay = 50 * time.Millisecond
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+
+		ip, err := vm.WaitForIP(ctx, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, subnet, _ := net.ParseCIDR(m.GuestSubnet["DC0_DVPG0"])
+		if !subnet.Contains(net.ParseIP(ip)) {
+			t.Errorf("%s not in %s", ip, subnet)
+		}
+
+		var props mo.VirtualMachine
+		err = vm.Properties(ctx, vm.Reference(), []string{"guest"}, &props)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(props.Guest.IpStack) != 1 {
+			t.Errorf("guest.ipStack=%#v", props.Guest.IpStack)
+		}
+
+		si := object.NewSearchIndex(c)
+		ref, err := si.FindByIp(ctx, nil, ip, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ref == nil || ref.Reference() != vm.Reference() {
+			t.Errorf("FindByIp(%s)=%v", ip, ref)
+		}
+
+		// a NIC address that did not come from a pool is not released
+		static := types.GuestNicInfo{DeviceConfigId: -1, IpAddress: []string{"192.168.1.10"}}
+		svm := Map.Get(vm.Reference()).(*VirtualMachine)
+		route := types.NetIpRouteConfigInfoIpRoute{
+			Network:      "192.168.1.0",
+			PrefixLength: 24,
+			Gateway:      types.NetIpRouteConfigInfoGateway{Device: strconv.Itoa(len(svm.Guest.Net))},
+		}
+		stack := svm.Guest.IpStack[0]
+		stack.IpRouteConfig = &types.NetIpRouteConfigInfo{IpRoute: append(stack.IpRouteConfig.IpRoute, route)}
+		Map.Update(svm, []types.PropertyChange{
+			{Name: "guest.net", Val: append(svm.Guest.Net, static)},
+			{Name: "guest.ipStack", Val: []types.GuestStackInfo{stack}},
+		})
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		err = vm.Properties(ctx, vm.Reference(), []string{"guest"}, &props)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if props.Guest.IpAddress != static.IpAddress[0] {
+			t.Errorf("guest.ipAddress=%s", props.Guest.IpAddress)
+		}
+
+		for _, net := range props.Guest.Net {
+			if net.DeviceConfigId == static.DeviceConfigId {
+				if len(net.IpAddress) != 1 || net.IpAddress[0] != static.IpAddress[0] {
+					t.Errorf("guest.net.ipAddress=%s", net.IpAddress)
+				}
+				continue
+			}
+			if len(net.IpAddress) != 0 {
+				t.Errorf("guest.net.ipAddress=%s", net.IpAddress)
+			}
+		}
+
+		if len(props.Guest.IpStack) != 1 {
+			t.Fatalf("guest.ipStack=%#v", props.Guest.IpStack)
+		}
+		routes := props.Guest.IpStack[0].IpRouteConfig.IpRoute
+		if len(routes) != 1 || routes[0] != route {
+			t.Errorf("guest.ipStack routes=%#v", routes)
+		}
+	}, m)
+}
+
+func TestIpPoolManagerGuestIPRelease(t *testing.T) {
+	m := VPX()
+	m.GuestSubnet = map[string]string{"DC0_DVPG0": "10.0.0.0/24"}
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		pm := Map.IpPoolManager()
+		svm := Map.Any("VirtualMachine").(*VirtualMachine)
+		vm := object.NewVirtualMachine(c, svm.Reference())
+
+		allocated := func() int {
+			return len(pm.guest[svm.Self])
+		}
+
+		wait := func(task *object.Task, err error) {
+			t.Helper()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if allocated() != 1 {
+			t.Fatalf("allocated=%d", allocated())
+		}
+
+		wait(vm.Suspend(ctx))
+		if allocated() != 0 {
+			t.Errorf("suspend: allocated=%d", allocated())
+		}
+
+		wait(vm.PowerOn(ctx))
+		if allocated() != 1 {
+			t.Fatalf("power on: allocated=%d", allocated())
+		}
+
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nics := devices.SelectByType((*types.VirtualEthernetCard)(nil))
+		if err = vm.RemoveDevice(ctx, false, nics...); err != nil {
+			t.Fatal(err)
+		}
+		if allocated() != 0 {
+			t.Errorf("remove nic: allocated=%d", allocated())
+		}
+		if svm.Guest.IpAddress != "" {
+			t.Errorf("guest.ipAddress=%s", svm.Guest.IpAddress)
+		}
+	}, m)
+}
@@ -30,66 +30,412 @@ limitations under the License.
 package simulator
 
 import (
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/license"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
+// licenseFeatures maps the license feature keys used by LicenseEditions to their names
+var licenseFeatures = map[string]string{
+	"serialuri:2": "Remote virtual Serial Port Concentrator",
+	"dvs":         "vSphere Distributed Switch",
+	"vmotion":     "vSphere vMotion",
+	"svmotion":    "vSphere Storage vMotion",
+	"drs":         "vSphere DRS",
+	"das":         "vSphere HA",
+}
+
+// licenseFeatureProperties returns a "feature" property for each of the given feature keys
+func licenseFeatureProperties(keys ...string) []types.KeyAnyValue {
+	var props []types.KeyAnyValue
+	for _, key := range keys {
+		props = append(props, types.KeyAnyValue{
+			Key: "feature",
+			Value: types.KeyValue{
+				Key:   key,
+				Value: licenseFeatures[key],
+			},
+		})
+	}
+	return props
+}
+
+var evalFeatures = []string{"serialuri:2", "dvs", "vmotion", "svmotion", "drs", "das"}
+
 // EvalLicense is the default license
 var EvalLicense = types.LicenseManagerLicenseInfo{
 	LicenseKey: "00000-00000-00000-00000-00000",
 	EditionKey: "eval",
 	Name:       "Evaluation Mode",
-	Properties: []types.KeyAnyValue{
-		{
-			Key: "feature",
-			Value: types.KeyValue{
-				Key:   "serialuri:2",
-				Value: "Remote virtual Serial Port Concentrator",
-			},
-		},
-		{
-			Key: "feature",
-			Value: types.KeyValue{
-				Key:   "dvs",
-				Value: "vSphere Distributed Switch",
-			},
-		},
+	Properties: licenseFeatureProperties(evalFeatures...),
+}
+
+const (
+	licenseProductESX = "VMware ESX Server"
+	licenseProductVC  = "VMware VirtualCenter Server"
+)
+
+// LicenseEdition describes the product, capacity unit and features of a license edition
+type LicenseEdition struct {
+	Name     string
+	Product  string        // ProductName property, editions without a Product can be assigned to any entity
+	CostUnit string        // unit of the license capacity, such as "cpuPackage" or "server"
+	Features []string      // feature keys, such as "vmotion" or "drs"
+	Term     time.Duration // licenses expire after Term, from the time they are added; 0 for perpetual licenses
+}
+
+// LicenseEditions maps EditionKey to LicenseEdition
+var LicenseEditions = map[string]LicenseEdition{
+	"eval": {
+		Name:     EvalLicense.Name,
+		Features: evalFeatures,
+		Term:     60 * 24 * time.Hour,
+	},
+	"esx.enterprisePlus.cpuPackage": {
+		Name:     "VMware vSphere 7 Enterprise Plus",
+		Product:  licenseProductESX,
+		CostUnit: "cpuPackage",
+		Features: evalFeatures,
+	},
+	"esx.standard.cpuPackage": {
+		Name:     "VMware vSphere 7 Standard",
+		Product:  licenseProductESX,
+		CostUnit: "cpuPackage",
+		Features: []string{"serialuri:2", "vmotion", "svmotion", "das"},
 	},
+	"esx.hypervisor.cpuPackage": {
+		Name:     "VMware vSphere 7 Hypervisor",
+		Product:  licenseProductESX,
+		CostUnit: "cpuPackage",
+	},
+	"vc.standard.instance": {
+		Name:     "VMware vCenter Server 7 Standard",
+		Product:  licenseProductVC,
+		CostUnit: "server",
+	},
+}
+
+// LicenseKey is the edition and capacity of a license key.
+// Total is in units of the edition's CostUnit, 0 is unlimited.
+type LicenseKey struct {
+	Edition string
+	Total   int32
+}
+
+// LicenseKeys are the license keys decoded by the simulator.
+// Other keys decode with a "diagnostic" property, as invalid keys do on a real server.
+var LicenseKeys = map[string]LicenseKey{
+	EvalLicense.LicenseKey:          {Edition: "eval"},
+	"VSEPL-00000-00000-00000-00016": {Edition: "esx.enterprisePlus.cpuPackage", Total: 16},
+	"VSSTD-00000-00000-00000-00016": {Edition: "esx.standard.cpuPackage", Total: 16},
+	"VSHYP-00000-00000-00000-00000": {Edition: "esx.hypervisor.cpuPackage"},
+	"VCSTD-00000-00000-00000-00001": {Edition: "vc.standard.instance", Total: 1},
 }
 
 type LicenseManager struct {
 	mo.LicenseManager
+
+	mu       sync.Mutex
+	registry *Registry
+	offset   time.Duration     // license clock adjustment, see ControlLicenseClock
+	assigned map[string]string // entity ID to license key, entities not found here are assigned the EvalLicense
+	expired  map[string]bool   // license keys for which a LicenseExpiredEvent has been posted
 }
 
 func (m *LicenseManager) init(r *Registry) {
-	m.Licenses = []types.LicenseManagerLicenseInfo{EvalLicense}
+	m.registry = r
+	m.assigned = make(map[string]string)
+	m.expired = make(map[string]bool)
+	m.Licenses = []types.LicenseManagerLicenseInfo{m.decode(EvalLicense.LicenseKey)}
 
 	if r.IsVPX() {
-		am := Map.Put(&LicenseAssignmentManager{}).Reference()
+		am := r.Put(&LicenseAssignmentManager{}).Reference()
 		m.LicenseAssignmentManager = &am
 	}
+
+	r.AddHandler(m)
+	m.update()
+}
+
+// now returns the current time of the license clock
+func (m *LicenseManager) now() time.Time {
+	return time.Now().Add(m.offset)
+}
+
+// decode returns the LicenseInfo for the given key, as defined by LicenseKeys and LicenseEditions
+func (m *LicenseManager) decode(key string) types.LicenseManagerLicenseInfo {
+	info := types.LicenseManagerLicenseInfo{LicenseKey: key}
+
+	lk, ok := LicenseKeys[key]
+	edition, eok := LicenseEditions[lk.Edition]
+	if !ok || !eok {
+		info.Properties = []types.KeyAnyValue{{Key: "diagnostic", Value: "License is not valid for this product"}}
+		return info
+	}
+
+	info.EditionKey = lk.Edition
+	info.Name = edition.Name
+	info.Total = lk.Total
+	info.CostUnit = edition.CostUnit
+
+	if edition.Product != "" {
+		info.Properties = append(info.Properties,
+			types.KeyAnyValue{Key: "ProductName", Value: edition.Product},
+			types.KeyAnyValue{Key: "ProductVersion", Value: "7.0"},
+		)
+	}
+	info.Properties = append(info.Properties, licenseFeatureProperties(edition.Features...)...)
+
+	if edition.Term != 0 {
+		info.Properties = append(info.Properties, types.KeyAnyValue{Key: "expirationDate", Value: m.now().Add(edition.Term)})
+	}
+
+	return info
+}
+
+func licenseProperty(info *types.LicenseManagerLicenseInfo, key string) interface{} {
+	for _, p := range info.Properties {
+		if p.Key == key {
+			return p.Value
+		}
+	}
+	return nil
+}
+
+func setLicenseProperty(props []types.KeyAnyValue, key string, val interface{}) []types.KeyAnyValue {
+	for i := range props {
+		if props[i].Key == key {
+			props[i].Value = val
+			return props
+		}
+	}
+	return append(props, types.KeyAnyValue{Key: key, Value: val})
+}
+
+// licenseExpiration returns the expiration date of the given license, if any
+func licenseExpiration(info *types.LicenseManagerLicenseInfo) (time.Time, bool) {
+	date, ok := licenseProperty(info, "expirationDate").(time.Time)
+	return date, ok
+}
+
+// licenseEntity is a vCenter instance or HostSystem to which a license can be assigned
+type licenseEntity struct {
+	id, name, product string
+	cost              int32 // license capacity used per cpuPackage
+}
+
+// entities returns the vCenter instance (if any) and each HostSystem
+func (m *LicenseManager) entities() []licenseEntity {
+	var entities []licenseEntity
+
+	if m.registry.IsVPX() {
+		about := m.registry.content().About
+		entities = append(entities, licenseEntity{about.InstanceUuid, about.Name, licenseProductVC, 1})
+	}
+
+	for _, e := range m.registry.All("HostSystem") {
+		host := e.(*HostSystem)
+		entities = append(entities, licenseEntity{host.Self.Value, host.Name, licenseProductESX, hostLicenseCost(host)})
+	}
+
+	return entities
+}
+
+// hostLicenseCost returns the license capacity used by the given host
+func hostLicenseCost(host *HostSystem) int32 {
+	if host.Summary.Hardware != nil && host.Summary.Hardware.NumCpuPkgs > 0 {
+		return int32(host.Summary.Hardware.NumCpuPkgs)
+	}
+	return 1
+}
+
+func (m *LicenseManager) entity(id string) *licenseEntity {
+	for _, e := range m.entities() {
+		if e.id == id {
+			return &e
+		}
+	}
+	return nil
+}
+
+// assignedKey returns the license key assigned to the given entity ID
+func (m *LicenseManager) assignedKey(id string) string {
+	if key, ok := m.assigned[id]; ok {
+		return key
+	}
+	return EvalLicense.LicenseKey
+}
+
+func (m *LicenseManager) license(key string) *types.LicenseManagerLicenseInfo {
+	for i := range m.Licenses {
+		if m.Licenses[i].LicenseKey == key {
+			return &m.Licenses[i]
+		}
+	}
+	return nil
+}
+
+// update recomputes the used capacity and remaining time of each license
+func (m *LicenseManager) update() {
+	now := m.now()
+	used := make(map[string]int32)
+	for _, e := range m.entities() {
+		used[m.assignedKey(e.id)] += e.cost
+	}
+
+	licenses := make([]types.LicenseManagerLicenseInfo, len(m.Licenses))
+	var eval []types.KeyAnyValue
+
+	for i, info := range m.Licenses {
+		info.Used = used[info.LicenseKey]
+		info.Properties = append([]types.KeyAnyValue(nil), info.Properties...)
+
+		if date, ok := licenseExpiration(&info); ok {
+			left := date.Sub(now)
+			if left < 0 {
+				left = 0
+			}
+			info.Properties = setLicenseProperty(info.Properties, "expirationHours", int32(left.Hours()))
+			info.Properties = setLicenseProperty(info.Properties, "expirationMinutes", int32(left.Minutes()))
+
+			if info.LicenseKey == EvalLicense.LicenseKey {
+				for _, key := range []string{"expirationDate", "expirationHours", "expirationMinutes"} {
+					eval = append(eval, types.KeyAnyValue{Key: key, Value: licenseProperty(&info, key)})
+				}
+			}
+		}
+
+		licenses[i] = info
+	}
+
+	m.registry.Update(m, []types.PropertyChange{
+		{Name: "licenses", Val: licenses},
+		{Name: "evaluation", Val: types.LicenseManagerEvaluationInfo{Properties: eval}},
+	})
+}
+
+// use adds delta to the used capacity of the given license,
+// without recomputing the capacity used by every entity as update does.
+func (m *LicenseManager) use(key string, delta int32) {
+	licenses := make([]types.LicenseManagerLicenseInfo, len(m.Licenses))
+	copy(licenses, m.Licenses)
+
+	for i := range licenses {
+		if licenses[i].LicenseKey == key {
+			licenses[i].Used += delta
+		}
+	}
+
+	m.registry.Update(m, []types.PropertyChange{{Name: "licenses", Val: licenses}})
+}
+
+// expire posts a LicenseExpiredEvent for each license that has expired since the last call
+func (m *LicenseManager) expire(ctx *Context) {
+	now := m.now()
+	var events []types.BaseEvent
+
+	for i := range m.Licenses {
+		info := &m.Licenses[i]
+		date, ok := licenseExpiration(info)
+		if !ok || now.Before(date) || m.expired[info.LicenseKey] {
+			continue
+		}
+		m.expired[info.LicenseKey] = true
+
+		events = append(events, &types.LicenseExpiredEvent{
+			Feature: types.LicenseFeatureInfo{
+				Key:         info.EditionKey,
+				FeatureName: info.Name,
+				State:       types.LicenseFeatureInfoStateDisabled,
+				CostUnit:    info.CostUnit,
+				Edition:     types.NewBool(true),
+				ExpiresOn:   &date,
+			},
+		})
+	}
+
+	if len(events) != 0 {
+		m.update()
+		ctx.postEvent(events...)
+	}
+}
+
+// advance moves the license clock by the given duration, expiring licenses as needed
+func (m *LicenseManager) advance(ctx *Context, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.offset += d
+	m.update()
+	m.expire(ctx)
 }
 
+// checkFeature returns a fault if the license assigned to the given host does not include the given feature or has expired
+func (m *LicenseManager) checkFeature(ctx *Context, host types.ManagedObjectReference, feature string) types.BaseMethodFault {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.expire(ctx)
+
+	info := m.license(m.assignedKey(host.Value))
+	if info == nil || !license.HasFeature(*info, feature) {
+		return new(types.LicenseRestricted)
+	}
+
+	if date, ok := licenseExpiration(info); ok && !m.now().Before(date) {
+		return &types.ExpiredFeatureLicense{
+			Feature:        feature,
+			Count:          info.Total,
+			ExpirationDate: date,
+		}
+	}
+
+	return nil
+}
+
+func (m *LicenseManager) PutObject(obj mo.Reference) {
+	if host, ok := obj.(*HostSystem); ok {
+		m.mu.Lock()
+		m.use(m.assignedKey(host.Self.Value), hostLicenseCost(host))
+		m.mu.Unlock()
+	}
+}
+
+func (m *LicenseManager) RemoveObject(_ *Context, ref types.ManagedObjectReference) {
+	if host, ok := m.registry.Get(ref).(*HostSystem); ok {
+		m.mu.Lock()
+		m.use(m.assignedKey(ref.Value), -hostLicenseCost(host))
+		delete(m.assigned, ref.Value)
+		m.mu.Unlock()
+	}
+}
+
+func (*LicenseManager) UpdateObject(mo.Reference, []types.PropertyChange) {}
+
 func (m *LicenseManager) AddLicense(req *types.AddLicense) soap.HasFault {
 	body := &methods.AddLicenseBody{
 		Res: &types.AddLicenseResponse{},
 	}
 
-	for _, license := range m.Licenses {
-		if license.LicenseKey == req.LicenseKey {
-			body.Res.Returnval = licenseInfo(license.LicenseKey, license.Labels)
-			return body
-		}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if info := m.license(req.LicenseKey); info != nil {
+		body.Res.Returnval = *info
+		return body
 	}
 
-	m.Licenses = append(m.Licenses, types.LicenseManagerLicenseInfo{
-		LicenseKey: req.LicenseKey,
-		Labels:     req.Labels,
-	})
+	info := m.decode(req.LicenseKey)
+	info.Labels = req.Labels
+	m.Licenses = append(m.Licenses, info)
+	m.update()
 
-	body.Res.Returnval = licenseInfo(req.LicenseKey, req.Labels)
+	body.Res.Returnval = *m.license(req.LicenseKey)
 
 	return body
 }
@@ -99,15 +445,41 @@ func (m *LicenseManager) RemoveLicense(req *types.RemoveLicense) soap.HasFault {
 		Res: &types.RemoveLicenseResponse{},
 	}
 
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	for i, license := range m.Licenses {
 		if req.LicenseKey == license.LicenseKey {
 			m.Licenses = append(m.Licenses[:i], m.Licenses[i+1:]...)
+			// entities assigned the removed license fall back to evaluation mode
+			for id, key := range m.assigned {
+				if key == req.LicenseKey {
+					delete(m.assigned, id)
+				}
+			}
+			m.update()
 			return body
 		}
 	}
 	return body
 }
 
+func (m *LicenseManager) DecodeLicense(req *types.DecodeLicense) soap.HasFault {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	info := m.decode(req.LicenseKey)
+	if existing := m.license(req.LicenseKey); existing != nil {
+		info = *existing
+	}
+
+	return &methods.DecodeLicenseBody{
+		Res: &types.DecodeLicenseResponse{
+			Returnval: info,
+		},
+	}
+}
+
 func (m *LicenseManager) UpdateLicenseLabel(req *types.UpdateLicenseLabel) soap.HasFault {
 	body := &methods.UpdateLicenseLabelBody{}
 
@@ -149,40 +521,101 @@ type LicenseAssignmentManager struct {
 	mo.LicenseAssignmentManager
 }
 
-func (m *LicenseAssignmentManager) QueryAssignedLicenses(req *types.QueryAssignedLicenses) soap.HasFault {
+func (m *LicenseAssignmentManager) QueryAssignedLicenses(ctx *Context, req *types.QueryAssignedLicenses) soap.HasFault {
 	body := &methods.QueryAssignedLicensesBody{
 		Res: &types.QueryAssignedLicensesResponse{},
 	}
 
-	// EntityId can be a HostSystem or the vCenter InstanceUuid
-	if req.EntityId != "" {
-		if req.EntityId != Map.content().About.InstanceUuid {
-			id := types.ManagedObjectReference{
-				Type:  "HostSystem",
-				Value: req.EntityId,
-			}
+	lm := ctx.Map.LicenseManager()
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
 
-			if Map.Get(id) == nil {
-				return body
-			}
+	// EntityId can be a HostSystem or the vCenter InstanceUuid, all entities are returned if empty
+	for _, e := range lm.entities() {
+		if req.EntityId != "" && req.EntityId != e.id {
+			continue
 		}
+
+		info := lm.license(lm.assignedKey(e.id))
+		if info == nil {
+			continue
+		}
+
+		body.Res.Returnval = append(body.Res.Returnval, types.LicenseAssignmentManagerLicenseAssignment{
+			EntityId:          e.id,
+			Scope:             ctx.Map.content().About.InstanceUuid,
+			EntityDisplayName: e.name,
+			AssignedLicense:   *info,
+		})
 	}
 
-	body.Res.Returnval = []types.LicenseAssignmentManagerLicenseAssignment{
-		{
-			EntityId:        req.EntityId,
-			AssignedLicense: EvalLicense,
-		},
+	return body
+}
+
+func (m *LicenseAssignmentManager) UpdateAssignedLicense(ctx *Context, req *types.UpdateAssignedLicense) soap.HasFault {
+	body := new(methods.UpdateAssignedLicenseBody)
+
+	lm := ctx.Map.LicenseManager()
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	e := lm.entity(req.Entity)
+	if e == nil {
+		body.Fault_ = Fault("", &types.LicenseEntityNotFound{EntityId: req.Entity})
+		return body
+	}
+
+	info := lm.license(req.LicenseKey)
+	if info == nil {
+		// keys are added to the LicenseManager as needed, as vCenter does
+		decoded := lm.decode(req.LicenseKey)
+		info = &decoded
+	}
+
+	if info.EditionKey == "" {
+		body.Fault_ = Fault("", &types.InvalidLicense{LicenseContent: req.LicenseKey})
+		return body
+	}
+
+	if product, ok := licenseProperty(info, "ProductName").(string); ok && product != e.product {
+		body.Fault_ = Fault("", &types.LicenseAssignmentFailed{Reason: "keyEntityMismatch"})
+		return body
+	}
+
+	if lm.assignedKey(e.id) != req.LicenseKey && info.Total != 0 && info.Used+e.cost > info.Total {
+		body.Fault_ = Fault("", new(types.NotEnoughLicenses))
+		return body
+	}
+
+	if lm.license(req.LicenseKey) == nil {
+		lm.Licenses = append(lm.Licenses, *info)
+	}
+	lm.assigned[e.id] = req.LicenseKey
+	lm.update()
+
+	body.Res = &types.UpdateAssignedLicenseResponse{
+		Returnval: *lm.license(req.LicenseKey),
 	}
 
 	return body
 }
 
-func licenseInfo(key string, labels []types.KeyValue) types.LicenseManagerLicenseInfo {
-	info := EvalLicense
+func (m *LicenseAssignmentManager) RemoveAssignedLicense(ctx *Context, req *types.RemoveAssignedLicense) soap.HasFault {
+	body := new(methods.RemoveAssignedLicenseBody)
 
-	info.LicenseKey = key
-	info.Labels = labels
+	lm := ctx.Map.LicenseManager()
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
 
-	return info
+	if lm.entity(req.EntityId) == nil {
+		body.Fault_ = Fault("", &types.LicenseEntityNotFound{EntityId: req.EntityId})
+		return body
+	}
+
+	delete(lm.assigned, req.EntityId)
+	lm.update()
+
+	body.Res = new(types.RemoveAssignedLicenseResponse)
+
+	return body
 }
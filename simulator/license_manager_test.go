@@ -18,12 +18,18 @@ package simulator
 
 import (
 	"context"
-	"reflect"
 	"testing"
+	"time"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/license"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestLicenseManagerVPX(t *testing.T) {
@@ -69,7 +75,7 @@ func TestLicenseManagerVPX(t *testing.T) {
 	host := hosts[0].Reference().Value
 	vcid := c.Client.ServiceContent.About.InstanceUuid
 
-	for _, name := range []string{"", host, vcid} {
+	for _, name := range []string{host, vcid} {
 		la, err = am.QueryAssigned(ctx, name)
 		if err != nil {
 			t.Fatal(err)
@@ -79,10 +85,20 @@ func TestLicenseManagerVPX(t *testing.T) {
 			t.Fatal("no licenses")
 		}
 
-		if !reflect.DeepEqual(la[0].AssignedLicense, EvalLicense) {
+		if la[0].EntityId != name || la[0].AssignedLicense.LicenseKey != EvalLicense.LicenseKey {
 			t.Fatal("invalid license")
 		}
 	}
+
+	// all entities: vCenter and each host
+	la, err = am.QueryAssigned(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(la) != len(hosts)+1 {
+		t.Errorf("%d licenses", len(la))
+	}
 }
 
 func TestLicenseManagerESX(t *testing.T) {
@@ -119,9 +135,14 @@ func TestLicenseManagerESX(t *testing.T) {
 		t.Fatal("no licenses")
 	}
 
-	if !reflect.DeepEqual(la[0], EvalLicense) {
+	if la[0].LicenseKey != EvalLicense.LicenseKey || la[0].EditionKey != EvalLicense.EditionKey {
 		t.Fatal("invalid license")
 	}
+
+	// the ESX host uses 1 unit per cpu package
+	if la[0].Used != 2 {
+		t.Errorf("used=%d", la[0].Used)
+	}
 }
 
 func TestAddRemoveLicense(t *testing.T) {
@@ -204,3 +225,163 @@ func TestAddRemoveLicense(t *testing.T) {
 		t.Fatal("no licenses")
 	}
 }
+
+func TestLicenseEnforcement(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		lm := license.NewManager(c)
+		am, err := lm.AssignmentManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder := find.NewFinder(c)
+
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		hosts, err := cluster.Hosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		standard := "VSSTD-00000-00000-00000-00016"
+		for _, host := range hosts {
+			if _, err = am.Update(ctx, host.Reference().Value, standard, ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// vCenter keys cannot be assigned to a host
+		_, err = am.Update(ctx, hosts[0].Reference().Value, "VCSTD-00000-00000-00000-00001", "")
+		if _, ok := soap.ToSoapFault(err).VimFault().(types.LicenseAssignmentFailed); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		_, err = am.Update(ctx, hosts[0].Reference().Value, "00000-00000-00000-00000-11111", "")
+		if _, ok := soap.ToSoapFault(err).VimFault().(types.InvalidLicense); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		_, err = am.Update(ctx, "enoent", standard, "")
+		if _, ok := soap.ToSoapFault(err).VimFault().(types.LicenseEntityNotFound); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		drs := func(cluster *object.ClusterComputeResource) error {
+			spec := &types.ClusterConfigSpecEx{
+				DrsConfig: &types.ClusterDrsConfigInfo{Enabled: types.NewBool(true)},
+			}
+			task, err := cluster.Reconfigure(ctx, spec, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return task.Wait(ctx)
+		}
+
+		// Standard edition does not include DRS
+		err = drs(cluster)
+		if _, ok := err.(task.Error).Fault().(*types.LicenseRestricted); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		// Hypervisor edition does not include vMotion or DVS
+		vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		src := *Map.Get(vm.Reference()).(*VirtualMachine).Runtime.Host
+		dst := hosts[0].Reference()
+		if dst == src {
+			dst = hosts[1].Reference()
+		}
+
+		if _, err = am.Update(ctx, src.Value, "VSHYP-00000-00000-00000-00000", ""); err != nil {
+			t.Fatal(err)
+		}
+
+		info, err := lm.Decode(ctx, standard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Used != int32(2*(len(hosts)-1)) {
+			t.Errorf("used=%d", info.Used)
+		}
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dtask, err := folders.NetworkFolder.CreateDVS(ctx, types.DVSCreateSpec{
+			ConfigSpec: &types.VMwareDVSConfigSpec{DVSConfigSpec: types.DVSConfigSpec{Name: "DVS1"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := dtask.WaitForResult(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dvs := object.NewDistributedVirtualSwitch(c, res.Result.(types.ManagedObjectReference))
+
+		dtask, err = dvs.Reconfigure(ctx, &types.DVSConfigSpec{
+			Host: []types.DistributedVirtualSwitchHostMemberConfigSpec{{
+				Operation: string(types.ConfigSpecOperationAdd),
+				Host:      src,
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = dtask.Wait(ctx)
+		if _, ok := err.(task.Error).Fault().(*types.LicenseRestricted); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		vtask, err := vm.Relocate(ctx, types.VirtualMachineRelocateSpec{Host: &dst}, types.VirtualMachineMovePriorityDefaultPriority)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = vtask.Wait(ctx)
+		if _, ok := err.(task.Error).Fault().(*types.LicenseRestricted); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		vtask, err = vm.Migrate(ctx, nil, object.NewHostSystem(c, dst), types.VirtualMachineMovePriorityDefaultPriority, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = vtask.Wait(ctx)
+		if _, ok := err.(task.Error).Fault().(*types.LicenseRestricted); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		// the evaluation license expires after 60 days
+		ctl := NewControlClient(c.Client)
+		if err = ctl.AdvanceLicenseClock(ctx, 61*24*time.Hour); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, host := range hosts {
+			if err = am.Remove(ctx, host.Reference().Value); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		err = drs(cluster)
+		if _, ok := err.(task.Error).Fault().(*types.ExpiredFeatureLicense); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		events, err := event.NewManager(c).QueryEvents(ctx, types.EventFilterSpec{EventTypeId: []string{"LicenseExpiredEvent"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 1 {
+			t.Errorf("%d events", len(events))
+		}
+	})
+}
@@ -0,0 +1,255 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// CallLogSize is the number of recent SOAP calls retained for the /debug/calls endpoint
+var CallLogSize = 1000
+
+// latencyBuckets are the upper bounds in seconds of the vcsim_method_duration_seconds histogram
+var latencyBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5}
+
+// Call is a record of a SOAP method call, as served by the /debug/calls endpoint
+type Call struct {
+	Time     time.Time                    `json:"time"`
+	Method   string                       `json:"method"`
+	This     types.ManagedObjectReference `json:"this"`
+	User     string                       `json:"user,omitempty"`
+	OpID     string                       `json:"opID,omitempty"`
+	Duration time.Duration                `json:"duration"`
+	Fault    string                       `json:"fault,omitempty"`
+}
+
+type methodMetrics struct {
+	calls    int64
+	faults   map[string]int64
+	duration float64
+	buckets  []int64
+}
+
+// metrics collects per-method statistics and a ring buffer of recent calls
+type metrics struct {
+	sync.Mutex
+
+	method map[string]*methodMetrics
+	calls  []Call
+	next   int
+}
+
+func newMetrics() *metrics {
+	return &metrics{method: make(map[string]*methodMetrics)}
+}
+
+// faultName returns the fault type name of the given response, if any
+func faultName(res soap.HasFault) string {
+	f := res.Fault()
+	if f == nil {
+		return ""
+	}
+	if f.Detail.Fault == nil {
+		return "SOAPFault"
+	}
+	return reflect.Indirect(reflect.ValueOf(f.Detail.Fault)).Type().Name()
+}
+
+func (m *metrics) record(call Call) {
+	m.Lock()
+	defer m.Unlock()
+
+	mm, ok := m.method[call.Method]
+	if !ok {
+		mm = &methodMetrics{
+			faults:  make(map[string]int64),
+			buckets: make([]int64, len(latencyBuckets)),
+		}
+		m.method[call.Method] = mm
+	}
+
+	seconds := call.Duration.Seconds()
+	mm.calls++
+	mm.duration += seconds
+	for i, le := range latencyBuckets {
+		if seconds <= le {
+			mm.buckets[i]++
+		}
+	}
+	if call.Fault != "" {
+		mm.faults[call.Fault]++
+	}
+
+	if CallLogSize <= 0 {
+		return
+	}
+	if len(m.calls) < CallLogSize {
+		m.calls = append(m.calls, call)
+		return
+	}
+	m.calls[m.next] = call
+	m.next = (m.next + 1) % len(m.calls)
+}
+
+// recent returns the recent calls, oldest first
+func (m *metrics) recent() []Call {
+	m.Lock()
+	defer m.Unlock()
+
+	n := len(m.calls)
+	calls := make([]Call, 0, n)
+	for i := 0; i < n; i++ {
+		calls = append(calls, m.calls[(m.next+i)%n])
+	}
+	return calls
+}
+
+// sessionStats returns the number of active sessions and their PropertyFilter count
+func (m *SessionManager) sessionStats() (int, int) {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+
+	filters := 0
+	for _, s := range m.sessions {
+		filters += len(s.AllReference("PropertyFilter"))
+	}
+
+	return len(m.sessions), filters
+}
+
+// ServeMetrics implements the /metrics endpoint, in the Prometheus text exposition format.
+func (s *Service) ServeMetrics(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+
+	metric := func(name, kind, help string) {
+		fmt.Fprintf(&buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+
+	s.metrics.Lock()
+	names := make([]string, 0, len(s.metrics.method))
+	for name := range s.metrics.method {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	metric("vcsim_method_calls_total", "counter", "Total number of SOAP method calls.")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "vcsim_method_calls_total{method=%q} %d\n", name, s.metrics.method[name].calls)
+	}
+
+	metric("vcsim_method_faults_total", "counter", "Total number of SOAP method calls that returned a fault.")
+	for _, name := range names {
+		mm := s.metrics.method[name]
+		faults := make([]string, 0, len(mm.faults))
+		for fault := range mm.faults {
+			faults = append(faults, fault)
+		}
+		sort.Strings(faults)
+		for _, fault := range faults {
+			fmt.Fprintf(&buf, "vcsim_method_faults_total{method=%q,fault=%q} %d\n", name, fault, mm.faults[fault])
+		}
+	}
+
+	metric("vcsim_method_duration_seconds", "histogram", "SOAP method call latency in seconds.")
+	for _, name := range names {
+		mm := s.metrics.method[name]
+		for i, le := range latencyBuckets {
+			le := strconv.FormatFloat(le, 'g', -1, 64)
+			fmt.Fprintf(&buf, "vcsim_method_duration_seconds_bucket{method=%q,le=%q} %d\n", name, le, mm.buckets[i])
+		}
+		fmt.Fprintf(&buf, "vcsim_method_duration_seconds_bucket{method=%q,le=\"+Inf\"} %d\n", name, mm.calls)
+		fmt.Fprintf(&buf, "vcsim_method_duration_seconds_sum{method=%q} %g\n", name, mm.duration)
+		fmt.Fprintf(&buf, "vcsim_method_duration_seconds_count{method=%q} %d\n", name, mm.calls)
+	}
+	s.metrics.Unlock()
+
+	sessions, filters := s.sm.sessionStats()
+
+	metric("vcsim_sessions_active", "gauge", "Number of active sessions.")
+	fmt.Fprintf(&buf, "vcsim_sessions_active %d\n", sessions)
+
+	metric("vcsim_property_collector_filters", "gauge", "Number of PropertyCollector filters across all sessions.")
+	fmt.Fprintf(&buf, "vcsim_property_collector_filters %d\n", filters)
+
+	inventory := make(map[string]int)
+	for _, e := range s.registry.All("") {
+		inventory[e.Reference().Type]++
+	}
+	kinds := make([]string, 0, len(inventory))
+	for kind := range inventory {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	metric("vcsim_inventory_objects", "gauge", "Number of inventory objects by type.")
+	for _, kind := range kinds {
+		fmt.Fprintf(&buf, "vcsim_inventory_objects{type=%q} %d\n", kind, inventory[kind])
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_, _ = w.Write(buf.Bytes())
+}
+
+// ServeCalls implements the /debug/calls endpoint, returning the recent SOAP calls in JSON format, oldest first.
+// Calls can be filtered using the "method", "user" and "fault" query parameters,
+// and the "limit" parameter returns only the given number of most recent calls.
+func (s *Service) ServeCalls(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	calls := []Call{}
+	for _, call := range s.metrics.recent() {
+		if method := query.Get("method"); method != "" && method != call.Method {
+			continue
+		}
+		if user := query.Get("user"); user != "" && user != call.User {
+			continue
+		}
+		if fault := query.Get("fault"); fault != "" {
+			if fault == "true" && call.Fault == "" || fault != "true" && fault != call.Fault {
+				continue
+			}
+		}
+		calls = append(calls, call)
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		if n < len(calls) {
+			calls = calls[len(calls)-n:]
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(calls)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestServeMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	m := VPX()
+	defer m.Remove()
+
+	err := m.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := m.Service.NewServer()
+	defer s.Close()
+
+	c, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vm := object.NewVirtualMachine(c.Client, types.ManagedObjectReference{Type: "VirtualMachine", Value: "enoent"})
+	if _, err = vm.PowerOn(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+
+	pc := property.DefaultCollector(c.Client)
+	err = pc.CreateFilter(ctx, types.CreateFilter{
+		Spec: types.PropertyFilterSpec{
+			ObjectSet: []types.ObjectSpec{{Obj: c.ServiceContent.RootFolder}},
+			PropSet:   []types.PropertySpec{{Type: "Folder", PathSet: []string{"name"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(path string) string {
+		u := *s.URL
+		u.Path = path
+		u.User = nil
+		if i := strings.Index(path, "?"); i > 0 {
+			u.Path = path[:i]
+			u.RawQuery = path[i+1:]
+		}
+
+		res, err := s.Client().Get(u.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("%s: %s", path, res.Status)
+		}
+
+		b, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	metrics := get("/metrics")
+
+	for _, line := range []string{
+		`vcsim_method_calls_total{method="Login"} 1`,
+		`vcsim_method_faults_total{method="PowerOnVM_Task",fault="ManagedObjectNotFound"} 1`,
+		`vcsim_method_duration_seconds_count{method="PowerOnVM_Task"} 1`,
+		`vcsim_method_duration_seconds_bucket{method="Login",le="+Inf"} 1`,
+		`vcsim_sessions_active 1`,
+		`vcsim_property_collector_filters 1`,
+		`vcsim_inventory_objects{type="Datacenter"} 1`,
+		`vcsim_inventory_objects{type="HostSystem"} 4`,
+	} {
+		if !strings.Contains(metrics, line+"\n") {
+			t.Errorf("missing metric: %s", line)
+		}
+	}
+
+	var calls []Call
+	decode := func(path string) {
+		calls = nil
+		if err := json.Unmarshal([]byte(get(path)), &calls); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	decode("/debug/calls")
+	if len(calls) < 4 {
+		t.Fatalf("calls=%d", len(calls))
+	}
+	if calls[0].Method != "RetrieveServiceContent" {
+		t.Errorf("first call=%s", calls[0].Method)
+	}
+
+	decode("/debug/calls?fault=true")
+	if len(calls) != 1 || calls[0].Fault != "ManagedObjectNotFound" || calls[0].This.Value != "enoent" || calls[0].User != "user" {
+		t.Errorf("calls=%#v", calls)
+	}
+
+	decode("/debug/calls?method=Login")
+	if len(calls) != 1 {
+		t.Errorf("calls=%#v", calls)
+	}
+
+	decode("/debug/calls?limit=1")
+	if len(calls) != 1 || calls[0].Method != "CreateFilter" {
+		t.Errorf("calls=%#v", calls)
+	}
+}
+
+func TestMetricsCallLog(t *testing.T) {
+	size := CallLogSize
+	defer func() { CallLogSize = size }()
+	CallLogSize = 3
+
+	m := newMetrics()
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		m.record(Call{Method: name})
+	}
+
+	var names []string
+	for _, call := range m.recent() {
+		names = append(names, call.Method)
+	}
+
+	if strings.Join(names, "") != "CDE" {
+		t.Errorf("recent=%v", names)
+	}
+
+	if m.method["A"].calls != 1 || len(m.method) != 5 {
+		t.Errorf("method=%v", m.method)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -141,9 +142,52 @@ type Model struct {
 	// Name prefix: POD, vcsim flag: -pod
 	Pod int
 
+	// Lun specifies the number of SCSI LUNs presented to each HostSystem via a Fibre Channel adapter.
+	// The LUNs are shared by all hosts within a Datacenter and are available for use by CreateVmfsDatastore.
+	// vcsim flag: -lun
+	Lun int `json:",omitempty"`
+
+	// IscsiTarget maps iSCSI target addresses to the number of LUNs presented by each target.
+	// Hosts discover the LUNs via RescanAllHba, once the target has been added to the software iSCSI
+	// adapter with AddInternetScsiSendTargets or AddInternetScsiStaticTargets.
+	// vcsim flag: -iscsi-target
+	IscsiTarget map[string]int `json:"-"`
+
+	// GuestSubnet maps network names to an IPv4 subnet in CIDR notation, for example "DC0_DVPG0": "10.0.0.0/24".
+	// An IpPool associated with each network is created, from which an address is assigned to each NIC
+	// of a powered on VM connected to that network. Addresses are released when the VM is powered off.
+	// This option only applies to the vCenter model, vcsim flag: -guest-subnet
+	GuestSubnet map[string]string `json:"-"`
+
+	// GuestIPDelay specifies the delay after power on before guest IPs are assigned
+	// vcsim flag: -guest-ip-delay
+	GuestIPDelay time.Duration `json:"-"`
+
+	// GuestTools installs guest tools in each VM created by the Model, such that tools report running after power on.
+	// Otherwise, tools can be installed via MountToolsInstaller.
+	// vcsim flag: -guest-tools
+	GuestTools bool `json:"-"`
+
+	// GuestToolsDelay specifies the delay after power on before guest tools report running,
+	// for VMs with tools installed via GuestTools or MountToolsInstaller.
+	// vcsim flag: -guest-tools-delay
+	GuestToolsDelay time.Duration `json:"-"`
+
+	// HostListen starts a TLS listener for each HostSystem when the Server is started, serving the certificate
+	// installed by the host's HostCertificateManager. Logins via a host listener are subject to the host's lockdown mode.
+	// Each listener serves an ESX (HostAgent) view of the host, sharing state with the vCenter inventory,
+	// and is reachable via the host's management IP and summary.config.port.
+	// This option only applies to the vCenter model with TLS enabled, vcsim flag: -host-listen
+	HostListen bool `json:"-"`
+
 	// Delay configurations
 	DelayConfig DelayConfig `json:"-"`
 
+	// StrictValidation rejects SOAP requests that real vCenter would reject, such as requests with unknown
+	// properties, missing required properties, invalid enum values or an incompatible xsi:type.
+	// vcsim flag: -strict
+	StrictValidation bool `json:"-"`
+
 	// total number of inventory objects, set by Count()
 	total int
 
@@ -187,11 +231,23 @@ func VPX() *Model {
 	}
 }
 
+// registry returns the Registry of the Model's Service, defaulting to the global Map.
+func (m *Model) registry() *Registry {
+	if m.Service != nil {
+		return m.Service.registry
+	}
+	return Map
+}
+
 // Count returns a Model with total number of each existing type
 func (m *Model) Count() Model {
 	count := Model{}
 
-	for ref, obj := range Map.objects {
+	r := m.registry()
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	for ref, obj := range r.objects {
 		if _, ok := obj.(mo.Entity); !ok {
 			continue
 		}
@@ -233,47 +289,50 @@ func (*Model) fmtName(prefix string, num int) string {
 
 // kinds maps managed object types to their vcsim wrapper types
 var kinds = map[string]reflect.Type{
-	"AuthorizationManager":            reflect.TypeOf((*AuthorizationManager)(nil)).Elem(),
-	"ClusterComputeResource":          reflect.TypeOf((*ClusterComputeResource)(nil)).Elem(),
-	"CustomFieldsManager":             reflect.TypeOf((*CustomFieldsManager)(nil)).Elem(),
-	"CustomizationSpecManager":        reflect.TypeOf((*CustomizationSpecManager)(nil)).Elem(),
-	"Datacenter":                      reflect.TypeOf((*Datacenter)(nil)).Elem(),
-	"Datastore":                       reflect.TypeOf((*Datastore)(nil)).Elem(),
-	"DistributedVirtualPortgroup":     reflect.TypeOf((*DistributedVirtualPortgroup)(nil)).Elem(),
-	"DistributedVirtualSwitch":        reflect.TypeOf((*DistributedVirtualSwitch)(nil)).Elem(),
-	"DistributedVirtualSwitchManager": reflect.TypeOf((*DistributedVirtualSwitchManager)(nil)).Elem(),
-	"EnvironmentBrowser":              reflect.TypeOf((*EnvironmentBrowser)(nil)).Elem(),
-	"EventManager":                    reflect.TypeOf((*EventManager)(nil)).Elem(),
-	"FileManager":                     reflect.TypeOf((*FileManager)(nil)).Elem(),
-	"Folder":                          reflect.TypeOf((*Folder)(nil)).Elem(),
-	"GuestOperationsManager":          reflect.TypeOf((*GuestOperationsManager)(nil)).Elem(),
-	"HostDatastoreBrowser":            reflect.TypeOf((*HostDatastoreBrowser)(nil)).Elem(),
-	"HostLocalAccountManager":         reflect.TypeOf((*HostLocalAccountManager)(nil)).Elem(),
-	"HostNetworkSystem":               reflect.TypeOf((*HostNetworkSystem)(nil)).Elem(),
-	"HostSystem":                      reflect.TypeOf((*HostSystem)(nil)).Elem(),
-	"IpPoolManager":                   reflect.TypeOf((*IpPoolManager)(nil)).Elem(),
-	"LicenseManager":                  reflect.TypeOf((*LicenseManager)(nil)).Elem(),
-	"OptionManager":                   reflect.TypeOf((*OptionManager)(nil)).Elem(),
-	"OvfManager":                      reflect.TypeOf((*OvfManager)(nil)).Elem(),
-	"PerformanceManager":              reflect.TypeOf((*PerformanceManager)(nil)).Elem(),
-	"PropertyCollector":               reflect.TypeOf((*PropertyCollector)(nil)).Elem(),
-	"ResourcePool":                    reflect.TypeOf((*ResourcePool)(nil)).Elem(),
-	"SearchIndex":                     reflect.TypeOf((*SearchIndex)(nil)).Elem(),
-	"SessionManager":                  reflect.TypeOf((*SessionManager)(nil)).Elem(),
-	"StoragePod":                      reflect.TypeOf((*StoragePod)(nil)).Elem(),
-	"StorageResourceManager":          reflect.TypeOf((*StorageResourceManager)(nil)).Elem(),
-	"TaskManager":                     reflect.TypeOf((*TaskManager)(nil)).Elem(),
-	"TenantTenantManager":             reflect.TypeOf((*TenantManager)(nil)).Elem(),
-	"UserDirectory":                   reflect.TypeOf((*UserDirectory)(nil)).Elem(),
-	"VcenterVStorageObjectManager":    reflect.TypeOf((*VcenterVStorageObjectManager)(nil)).Elem(),
-	"ViewManager":                     reflect.TypeOf((*ViewManager)(nil)).Elem(),
-	"VirtualApp":                      reflect.TypeOf((*VirtualApp)(nil)).Elem(),
-	"VirtualDiskManager":              reflect.TypeOf((*VirtualDiskManager)(nil)).Elem(),
-	"VirtualMachine":                  reflect.TypeOf((*VirtualMachine)(nil)).Elem(),
-	"VmwareDistributedVirtualSwitch":  reflect.TypeOf((*DistributedVirtualSwitch)(nil)).Elem(),
+	"AuthorizationManager":               reflect.TypeOf((*AuthorizationManager)(nil)).Elem(),
+	"ClusterComputeResource":             reflect.TypeOf((*ClusterComputeResource)(nil)).Elem(),
+	"CustomFieldsManager":                reflect.TypeOf((*CustomFieldsManager)(nil)).Elem(),
+	"CustomizationSpecManager":           reflect.TypeOf((*CustomizationSpecManager)(nil)).Elem(),
+	"Datacenter":                         reflect.TypeOf((*Datacenter)(nil)).Elem(),
+	"Datastore":                          reflect.TypeOf((*Datastore)(nil)).Elem(),
+	"DiagnosticManager":                  reflect.TypeOf((*DiagnosticManager)(nil)).Elem(),
+	"DistributedVirtualPortgroup":        reflect.TypeOf((*DistributedVirtualPortgroup)(nil)).Elem(),
+	"DistributedVirtualSwitch":           reflect.TypeOf((*DistributedVirtualSwitch)(nil)).Elem(),
+	"DistributedVirtualSwitchManager":    reflect.TypeOf((*DistributedVirtualSwitchManager)(nil)).Elem(),
+	"EnvironmentBrowser":                 reflect.TypeOf((*EnvironmentBrowser)(nil)).Elem(),
+	"EventManager":                       reflect.TypeOf((*EventManager)(nil)).Elem(),
+	"FileManager":                        reflect.TypeOf((*FileManager)(nil)).Elem(),
+	"Folder":                             reflect.TypeOf((*Folder)(nil)).Elem(),
+	"GuestOperationsManager":             reflect.TypeOf((*GuestOperationsManager)(nil)).Elem(),
+	"HostDatastoreBrowser":               reflect.TypeOf((*HostDatastoreBrowser)(nil)).Elem(),
+	"HostLocalAccountManager":            reflect.TypeOf((*HostLocalAccountManager)(nil)).Elem(),
+	"HostNetworkSystem":                  reflect.TypeOf((*HostNetworkSystem)(nil)).Elem(),
+	"HostSystem":                         reflect.TypeOf((*HostSystem)(nil)).Elem(),
+	"IpPoolManager":                      reflect.TypeOf((*IpPoolManager)(nil)).Elem(),
+	"LicenseManager":                     reflect.TypeOf((*LicenseManager)(nil)).Elem(),
+	"OptionManager":                      reflect.TypeOf((*OptionManager)(nil)).Elem(),
+	"OvfManager":                         reflect.TypeOf((*OvfManager)(nil)).Elem(),
+	"PerformanceManager":                 reflect.TypeOf((*PerformanceManager)(nil)).Elem(),
+	"PropertyCollector":                  reflect.TypeOf((*PropertyCollector)(nil)).Elem(),
+	"ResourcePool":                       reflect.TypeOf((*ResourcePool)(nil)).Elem(),
+	"SearchIndex":                        reflect.TypeOf((*SearchIndex)(nil)).Elem(),
+	"SessionManager":                     reflect.TypeOf((*SessionManager)(nil)).Elem(),
+	"StoragePod":                         reflect.TypeOf((*StoragePod)(nil)).Elem(),
+	"StorageResourceManager":             reflect.TypeOf((*StorageResourceManager)(nil)).Elem(),
+	"TaskManager":                        reflect.TypeOf((*TaskManager)(nil)).Elem(),
+	"TenantTenantManager":                reflect.TypeOf((*TenantManager)(nil)).Elem(),
+	"UserDirectory":                      reflect.TypeOf((*UserDirectory)(nil)).Elem(),
+	"VcenterVStorageObjectManager":       reflect.TypeOf((*VcenterVStorageObjectManager)(nil)).Elem(),
+	"ViewManager":                        reflect.TypeOf((*ViewManager)(nil)).Elem(),
+	"VirtualApp":                         reflect.TypeOf((*VirtualApp)(nil)).Elem(),
+	"VirtualDiskManager":                 reflect.TypeOf((*VirtualDiskManager)(nil)).Elem(),
+	"VirtualMachine":                     reflect.TypeOf((*VirtualMachine)(nil)).Elem(),
+	"VirtualMachineCompatibilityChecker": reflect.TypeOf((*VirtualMachineCompatibilityChecker)(nil)).Elem(),
+	"VirtualMachineProvisioningChecker":  reflect.TypeOf((*VirtualMachineProvisioningChecker)(nil)).Elem(),
+	"VmwareDistributedVirtualSwitch":     reflect.TypeOf((*DistributedVirtualSwitch)(nil)).Elem(),
 }
 
-func loadObject(content types.ObjectContent) (mo.Reference, error) {
+func loadObject(r *Registry, content types.ObjectContent) (mo.Reference, error) {
 	var obj mo.Reference
 	id := content.Obj
 
@@ -292,7 +351,7 @@ func loadObject(content types.ObjectContent) (mo.Reference, error) {
 	} else {
 		if len(content.PropSet) == 0 {
 			// via NewServiceInstance()
-			Map.setReference(obj, id)
+			r.setReference(obj, id)
 		} else {
 			// via Model.Load()
 			dst := getManagedObject(obj).Addr().Interface().(mo.Reference)
@@ -303,7 +362,7 @@ func loadObject(content types.ObjectContent) (mo.Reference, error) {
 		}
 
 		if x, ok := obj.(interface{ init(*Registry) }); ok {
-			x.init(Map)
+			x.init(r)
 		}
 	}
 
@@ -338,7 +397,7 @@ func (m *Model) resolveReferences(ctx *Context) error {
 			// object was loaded without its parent, attempt to foster with another parent
 			switch e.Parent.Type {
 			case "Folder":
-				folder := dc.folder(me)
+				folder := dc.folder(ctx, me)
 				e.Parent = &folder.Self
 				log.Printf("%s adopted %s", e.Parent, ref)
 				folderPutChild(ctx, folder, me)
@@ -405,6 +464,7 @@ func SpoofContext() *Context {
 				Key: uuid.New().String(),
 			},
 			Registry: NewRegistry(),
+			Map:      Map,
 		},
 		Map: Map,
 	}
@@ -449,7 +509,7 @@ func (m *Model) Load(dir string) error {
 			ctx.Map = Map
 		}
 
-		obj, err := loadObject(content)
+		obj, err := loadObject(ctx.Map, content)
 		if err != nil {
 			return err
 		}
@@ -468,6 +528,10 @@ func (m *Model) Load(dir string) error {
 	}
 
 	m.Service = New(s)
+	m.Service.strict = m.StrictValidation
+	m.Service.toolsDelay = m.GuestToolsDelay
+	m.Service.hostListen = m.HostListen
+	m.Service.tempDir = m.createTempDir
 
 	return m.resolveReferences(ctx)
 }
@@ -530,12 +594,18 @@ func (m *Model) Create() error {
 	addMachine := func(prefix string, host *object.HostSystem, pool *object.ResourcePool, folders *object.DatacenterFolders) {
 		nic := esx.EthernetCard
 		nic.Backing = vmnet
+		backing := vmnet
 		ds := types.ManagedObjectReference{}
 
 		f := func() error {
 			for i := 0; i < m.Machine; i++ {
 				name := m.fmtName(prefix+"_VM", i)
 
+				if b, ok := backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo); ok {
+					port := *b // each VM is connected to its own DVPort
+					nic.Backing = &port
+				}
+
 				config := types.VirtualMachineConfigSpec{
 					Name:    name,
 					GuestId: string(types.VirtualMachineGuestOsIdentifierOtherGuest),
@@ -573,6 +643,8 @@ func (m *Model) Create() error {
 
 				vm := object.NewVirtualMachine(client, info.Result.(types.ManagedObjectReference))
 
+				m.installTools(ctx, vm.Reference())
+
 				if m.Autostart {
 					task, _ = vm.PowerOn(ctx)
 					_, _ = task.WaitForResult(ctx, nil)
@@ -654,9 +726,10 @@ func (m *Model) Create() error {
 		for npg := 0; npg < m.Portgroup; npg++ {
 			name := m.fmtName(dcName+"_DVPG", npg)
 			spec := types.DVPortgroupConfigSpec{
-				Name:     name,
-				Type:     string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
-				NumPorts: 1,
+				Name:       name,
+				Type:       string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
+				NumPorts:   1,
+				AutoExpand: types.NewBool(true),
 			}
 
 			task, err := dvs.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{spec})
@@ -683,6 +756,7 @@ func (m *Model) Create() error {
 				Name:        name,
 				Type:        string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
 				BackingType: string(types.DistributedVirtualPortgroupBackingTypeNsx),
+				AutoExpand:  types.NewBool(true),
 			}
 
 			task, err := dvs.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{spec})
@@ -805,6 +879,21 @@ func (m *Model) Create() error {
 		}
 	}
 
+	return m.finish(ctx, hostMap, vms)
+}
+
+// finish completes Model.Create and Model.CreateFromSpec once the hosts and datastores are created,
+// presenting Model.Lun and Model.IscsiTarget storage to the hosts of each datacenter, creating guest subnets
+// and VMs, then applying the Service options.
+func (m *Model) finish(ctx *Context, hostMap map[string][]*object.HostSystem, vms []func() error) error {
+	for dc, dchosts := range hostMap {
+		m.createStorage(ctx, dc, dchosts)
+	}
+
+	if err := m.createGuestSubnets(ctx); err != nil {
+		return err
+	}
+
 	for _, createVM := range vms {
 		err := createVM()
 		if err != nil {
@@ -814,10 +903,27 @@ func (m *Model) Create() error {
 
 	// Turn on delay AFTER we're done building the service content
 	m.Service.delay = &m.DelayConfig
+	m.Service.strict = m.StrictValidation
+	m.Service.toolsDelay = m.GuestToolsDelay
+	m.Service.hostListen = m.HostListen
+	m.Service.tempDir = m.createTempDir
 
 	return nil
 }
 
+// installTools installs guest tools in the given VM if Model.GuestTools is enabled
+func (m *Model) installTools(ctx *Context, ref types.ManagedObjectReference) {
+	if !m.GuestTools {
+		return
+	}
+
+	vm := ctx.Map.Get(ref).(*VirtualMachine)
+
+	ctx.WithLock(vm, func() {
+		vm.toolsInstall(ctx)
+	})
+}
+
 func (m *Model) createTempDir(dc string, name string) (string, error) {
 	dir, err := ioutil.TempDir("", fmt.Sprintf("govcsim-%s-%s-", dc, name))
 	if err == nil {
@@ -848,16 +954,62 @@ func (m *Model) createLocalDatastore(dc string, name string, hosts []*object.Hos
 	return nil
 }
 
+// createStorage presents the Model.Lun and Model.IscsiTarget LUNs to the given hosts
+func (m *Model) createStorage(ctx *Context, dc string, hosts []*object.HostSystem) {
+	for _, host := range hosts {
+		h := ctx.Map.Get(host.Reference()).(*HostSystem)
+		s := ctx.Map.Get(*h.ConfigManager.StorageSystem).(*HostStorageSystem)
+
+		s.iscsi = m.IscsiTarget
+
+		if m.Lun > 0 {
+			s.presentLuns(dc, "vmhba2", m.Lun)
+			s.rescan(ctx)
+		}
+	}
+}
+
+// createGuestSubnets creates an IpPool for each Model.GuestSubnet
+func (m *Model) createGuestSubnets(ctx *Context) error {
+	ipm := ctx.Map.IpPoolManager()
+	if ipm == nil {
+		return nil
+	}
+
+	ipm.delay = m.GuestIPDelay
+
+	names := make([]string, 0, len(m.GuestSubnet))
+	for name := range m.GuestSubnet {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		pool, err := newSubnetIpPool(name, m.GuestSubnet[name])
+		if err != nil {
+			return err
+		}
+
+		res := ipm.CreateIpPool(&types.CreateIpPool{This: ipm.Self, Pool: *pool})
+		if f := res.Fault(); f != nil {
+			return fmt.Errorf("guest subnet %q: %s", name, f.String)
+		}
+	}
+
+	return nil
+}
+
 // Remove cleans up items created by the Model, such as local datastore directories
 func (m *Model) Remove() {
 	// Remove associated vm containers, if any
-	Map.m.Lock()
-	for _, obj := range Map.objects {
+	r := m.registry()
+	r.m.Lock()
+	for _, obj := range r.objects {
 		if vm, ok := obj.(*VirtualMachine); ok {
 			vm.run.remove(vm)
 		}
 	}
-	Map.m.Unlock()
+	r.m.Unlock()
 
 	for _, dir := range m.dirs {
 		_ = os.RemoveAll(dir)
@@ -0,0 +1,757 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/units"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// InventorySpec is a declarative inventory definition, an alternative to the Model counts
+// for tests that want inventory names and shapes resembling a production environment.
+// See Model.CreateFromSpec and LoadInventorySpec.
+type InventorySpec struct {
+	Datacenter []DatacenterSpec `json:"datacenters"`
+}
+
+// DatacenterSpec defines a Datacenter and the inventory it contains.
+type DatacenterSpec struct {
+	// Name of the Datacenter
+	Name string `json:"name"`
+	// Folder path within the root folder to place the Datacenter, for example "Regions/East"
+	Folder string `json:"folder,omitempty"`
+	// Network defines standard portgroups, added to vSwitch0 of every host in the Datacenter
+	Network []NetworkSpec `json:"networks,omitempty"`
+	// Switch defines distributed switches, all hosts in the Datacenter are added as members
+	Switch []SwitchSpec `json:"switches,omitempty"`
+	// Cluster defines clusters and their hosts
+	Cluster []ClusterSpec `json:"clusters,omitempty"`
+	// Host defines standalone hosts
+	Host []HostSpec `json:"hosts,omitempty"`
+	// Datastore defines local datastores, each backed by a temporary directory
+	Datastore []DatastoreSpec `json:"datastores,omitempty"`
+	// VM defines virtual machines
+	VM []VMSpec `json:"vms,omitempty"`
+}
+
+// NetworkSpec defines a standard portgroup.
+type NetworkSpec struct {
+	Name string `json:"name"`
+	VLAN int32  `json:"vlan,omitempty"`
+}
+
+// SwitchSpec defines a distributed virtual switch.
+type SwitchSpec struct {
+	Name      string          `json:"name"`
+	Portgroup []PortgroupSpec `json:"portgroups,omitempty"`
+}
+
+// PortgroupSpec defines a distributed virtual portgroup.
+type PortgroupSpec struct {
+	Name string `json:"name"`
+	VLAN int32  `json:"vlan,omitempty"`
+	// Ports is the initial number of ports, the portgroup auto expands as needed
+	Ports int32 `json:"ports,omitempty"`
+}
+
+// ClusterSpec defines a ClusterComputeResource.
+type ClusterSpec struct {
+	Name string `json:"name"`
+	// Folder path within the Datacenter host folder
+	Folder string `json:"folder,omitempty"`
+	// DRS enables DRS when true, defaults to true
+	DRS *bool `json:"drs,omitempty"`
+	// HA enables vSphere HA when true
+	HA bool `json:"ha,omitempty"`
+	// Pool names child ResourcePools of the cluster's root pool
+	Pool []string   `json:"pools,omitempty"`
+	Host []HostSpec `json:"hosts,omitempty"`
+}
+
+// HostSpec defines a HostSystem, fields left empty keep the esx.HostSystem template values.
+type HostSpec struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Build   string `json:"build,omitempty"`
+	Vendor  string `json:"vendor,omitempty"`
+	Model   string `json:"model,omitempty"`
+	// CPU is the number of CPU cores
+	CPU int16 `json:"cpu,omitempty"`
+	// Memory size in MB
+	Memory int64 `json:"memory,omitempty"`
+}
+
+// DatastoreSpec defines a local Datastore.
+type DatastoreSpec struct {
+	Name string `json:"name"`
+	// Capacity of the Datastore, for example "2TB", defaults to 10TB
+	Capacity string `json:"capacity,omitempty"`
+	// Free space of the Datastore, defaults to Capacity.
+	// Disks of the VMs created on this Datastore are subtracted from this value.
+	Free string `json:"free,omitempty"`
+	// Host names to mount the Datastore on, defaults to all hosts in the Datacenter
+	Host []string `json:"hosts,omitempty"`
+}
+
+// VMSpec defines a VirtualMachine.
+type VMSpec struct {
+	Name string `json:"name"`
+	// Folder path within the Datacenter vm folder
+	Folder string `json:"folder,omitempty"`
+	// Cluster name to place the VM, defaults to the first cluster or standalone host
+	Cluster string `json:"cluster,omitempty"`
+	// Host name to place the VM, a cluster or standalone host
+	Host string `json:"host,omitempty"`
+	// Pool name of a ClusterSpec.Pool, defaults to the cluster's root pool
+	Pool string `json:"pool,omitempty"`
+	// Datastore name, defaults to the first Datastore in the Datacenter
+	Datastore string `json:"datastore,omitempty"`
+	// Version is the virtual hardware version, for example "vmx-13"
+	Version string `json:"version,omitempty"`
+	GuestID string `json:"guestId,omitempty"`
+	CPU     int32  `json:"cpu,omitempty"`
+	// Memory size in MB
+	Memory int64 `json:"memory,omitempty"`
+	// Disk capacities, for example "40GB"
+	Disk []string `json:"disks,omitempty"`
+	// Network names for each NIC, a standard or distributed portgroup
+	Network []string `json:"networks,omitempty"`
+	// Power state: poweredOn, poweredOff or suspended, defaults to poweredOff
+	Power string `json:"power,omitempty"`
+	// Tags in "category:tag" format, attached by the vAPI simulator once registered
+	Tag []string `json:"tags,omitempty"`
+	// CustomValue maps custom field names to values
+	CustomValue map[string]string `json:"customValues,omitempty"`
+	Annotation  string            `json:"annotation,omitempty"`
+}
+
+// LoadInventorySpec decodes the JSON InventorySpec in the given file, see DecodeInventorySpec.
+func LoadInventorySpec(name string) (*InventorySpec, error) {
+	f, err := os.Open(filepath.Clean(name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	spec, err := DecodeInventorySpec(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+
+	return spec, nil
+}
+
+// DecodeInventorySpec decodes a JSON InventorySpec, failing on unknown fields.
+// Other formats, such as the YAML files accepted by vcsim, can be converted to JSON before decoding.
+func DecodeInventorySpec(r io.Reader) (*InventorySpec, error) {
+	spec := new(InventorySpec)
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(spec); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// specDatacenter tracks the inventory created for a DatacenterSpec
+type specDatacenter struct {
+	*DatacenterSpec
+	dc      *object.Datacenter
+	folders *object.DatacenterFolders
+	hosts   []*object.HostSystem
+	host    map[string]*object.HostSystem
+	cluster map[string]*object.ClusterComputeResource
+	pool    map[string]*object.ResourcePool
+}
+
+// CreateFromSpec populates the Model with the inventory defined by the given spec.
+// The Model counts and Autostart are ignored, options such as GuestSubnet, Lun and DelayConfig
+// are applied as they are by Model.Create.
+// VM tags are attached when the vAPI simulator is registered, see Service.RegisterEndpoints.
+func (m *Model) CreateFromSpec(spec *InventorySpec) error {
+	if m.ServiceContent.RootFolder == esx.RootFolder.Reference() {
+		return errors.New("inventory spec requires the vCenter model")
+	}
+
+	ctx := SpoofContext()
+	m.Service = New(NewServiceInstance(ctx, m.ServiceContent, m.RootFolder))
+	ctx.Map = Map
+
+	hostMap := make(map[string][]*object.HostSystem)
+	var vms []func() error
+
+	for i := range spec.Datacenter {
+		dc, err := m.createSpecDatacenter(ctx, &spec.Datacenter[i])
+		if err != nil {
+			return fmt.Errorf("datacenter %q: %s", spec.Datacenter[i].Name, err)
+		}
+
+		hostMap[dc.Name] = dc.hosts
+
+		for j := range dc.VM {
+			vm := &dc.VM[j]
+			vms = append(vms, func() error {
+				if err := m.createSpecVM(ctx, dc, vm); err != nil {
+					return fmt.Errorf("datacenter %q: vm %q: %s", dc.Name, vm.Name, err)
+				}
+				return nil
+			})
+		}
+	}
+
+	return m.finish(ctx, hostMap, vms)
+}
+
+// specFolder returns the folder at path p relative to f, creating any missing folders.
+func (m *Model) specFolder(ctx *Context, f *object.Folder, p string) (*object.Folder, error) {
+	for _, name := range strings.Split(p, "/") {
+		if name == "" {
+			continue
+		}
+
+		folder := ctx.Map.Get(f.Reference()).(*Folder)
+		if child := ctx.Map.FindByName(name, folder.ChildEntity); child != nil {
+			if _, ok := child.(*Folder); !ok {
+				return nil, fmt.Errorf("folder %q: %s exists", p, child.Reference())
+			}
+			f = object.NewFolder(m.Service.client, child.Reference())
+			continue
+		}
+
+		child, err := f.CreateFolder(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		f = child
+	}
+
+	return f, nil
+}
+
+func (m *Model) createSpecDatacenter(ctx *Context, spec *DatacenterSpec) (*specDatacenter, error) {
+	client := m.Service.client
+
+	folder, err := m.specFolder(ctx, object.NewRootFolder(client), spec.Folder)
+	if err != nil {
+		return nil, err
+	}
+
+	dc := &specDatacenter{
+		DatacenterSpec: spec,
+		host:           make(map[string]*object.HostSystem),
+		cluster:        make(map[string]*object.ClusterComputeResource),
+		pool:           make(map[string]*object.ResourcePool),
+	}
+
+	dc.dc, err = folder.CreateDatacenter(ctx, spec.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	dc.folders, err = dc.dc.Folders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range spec.Host {
+		_, err = m.addSpecHost(ctx, dc, &spec.Host[i], func(c types.HostConnectSpec) (*object.Task, error) {
+			return dc.folders.HostFolder.AddStandaloneHost(ctx, c, true, nil, nil)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range spec.Cluster {
+		if err = m.createSpecCluster(ctx, dc, &spec.Cluster[i]); err != nil {
+			return nil, fmt.Errorf("cluster %q: %s", spec.Cluster[i].Name, err)
+		}
+	}
+
+	for _, net := range spec.Network {
+		for _, host := range dc.hosts {
+			ns, err := host.ConfigManager().NetworkSystem(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			err = ns.AddPortGroup(ctx, types.HostPortGroupSpec{
+				Name:        net.Name,
+				VlanId:      net.VLAN,
+				VswitchName: "vSwitch0",
+				Policy:      types.HostNetworkPolicy{},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("network %q: %s", net.Name, err)
+			}
+		}
+	}
+
+	for i := range spec.Switch {
+		if err = m.createSpecSwitch(ctx, dc, &spec.Switch[i]); err != nil {
+			return nil, fmt.Errorf("switch %q: %s", spec.Switch[i].Name, err)
+		}
+	}
+
+	for i := range spec.Datastore {
+		if err = m.createSpecDatastore(ctx, dc, &spec.Datastore[i]); err != nil {
+			return nil, fmt.Errorf("datastore %q: %s", spec.Datastore[i].Name, err)
+		}
+	}
+
+	return dc, nil
+}
+
+func (m *Model) createSpecCluster(ctx *Context, dc *specDatacenter, spec *ClusterSpec) error {
+	folder, err := m.specFolder(ctx, dc.folders.HostFolder, spec.Folder)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := folder.CreateCluster(ctx, spec.Name, types.ClusterConfigSpecEx{})
+	if err != nil {
+		return err
+	}
+	dc.cluster[spec.Name] = cluster
+
+	ccr := ctx.Map.Get(cluster.Reference()).(*ClusterComputeResource)
+	config := ccr.ConfigurationEx.(*types.ClusterConfigInfoEx)
+	if spec.DRS != nil {
+		config.DrsConfig.Enabled = spec.DRS
+	}
+	config.DasConfig.Enabled = types.NewBool(spec.HA)
+
+	for i := range spec.Host {
+		_, err = m.addSpecHost(ctx, dc, &spec.Host[i], func(c types.HostConnectSpec) (*object.Task, error) {
+			return cluster.AddHost(ctx, c, true, nil, nil)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	root, err := cluster.ResourcePool(ctx)
+	if err != nil {
+		return err
+	}
+	dc.pool[spec.Name] = root
+
+	for _, name := range spec.Pool {
+		pool, err := root.Create(ctx, name, types.DefaultResourceConfigSpec())
+		if err != nil {
+			return fmt.Errorf("pool %q: %s", name, err)
+		}
+		dc.pool[path.Join(spec.Name, name)] = pool
+	}
+
+	return nil
+}
+
+func (m *Model) addSpecHost(ctx *Context, dc *specDatacenter, spec *HostSpec, add func(types.HostConnectSpec) (*object.Task, error)) (*object.HostSystem, error) {
+	task, err := add(types.HostConnectSpec{HostName: spec.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := task.WaitForResult(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("host %q: %s", spec.Name, err)
+	}
+
+	host := object.NewHostSystem(m.Service.client, info.Result.(types.ManagedObjectReference))
+	dc.hosts = append(dc.hosts, host)
+	dc.host[spec.Name] = host
+
+	h := ctx.Map.Get(host.Reference()).(*HostSystem)
+	spec.apply(ctx, h)
+
+	return host, nil
+}
+
+// apply the HostSpec hardware and product versions to the given host,
+// adjusting the parent compute resource summary to match.
+func (spec *HostSpec) apply(ctx *Context, h *HostSystem) {
+	summary := hostParent(ctx, &h.HostSystem).Summary.GetComputeResourceSummary()
+	hw := h.Summary.Hardware
+
+	summary.TotalMemory -= hw.MemorySize
+	summary.EffectiveMemory -= hw.MemorySize
+	summary.NumCpuCores -= hw.NumCpuCores
+	summary.NumCpuThreads -= hw.NumCpuThreads
+
+	if spec.CPU != 0 {
+		hw.NumCpuCores = spec.CPU
+		hw.NumCpuThreads = spec.CPU
+		h.Hardware.CpuInfo.NumCpuCores = spec.CPU
+		h.Hardware.CpuInfo.NumCpuThreads = spec.CPU
+	}
+	if spec.Memory != 0 {
+		hw.MemorySize = spec.Memory * units.MB
+		h.Hardware.MemorySize = hw.MemorySize
+	}
+	if spec.Vendor != "" {
+		hw.Vendor = spec.Vendor
+		h.Hardware.SystemInfo.Vendor = spec.Vendor
+	}
+	if spec.Model != "" {
+		hw.Model = spec.Model
+		h.Hardware.SystemInfo.Model = spec.Model
+	}
+
+	summary.TotalMemory += hw.MemorySize
+	summary.EffectiveMemory += hw.MemorySize
+	summary.NumCpuCores += hw.NumCpuCores
+	summary.NumCpuThreads += hw.NumCpuThreads
+
+	if spec.Version != "" || spec.Build != "" {
+		product := &h.Config.Product
+		if spec.Version != "" {
+			product.FullName = strings.Replace(product.FullName, product.Version, spec.Version, 1)
+			product.Version = spec.Version
+		}
+		if spec.Build != "" {
+			product.FullName = strings.Replace(product.FullName, product.Build, spec.Build, 1)
+			product.Build = spec.Build
+		}
+		h.Summary.Config.Product = product
+	}
+}
+
+func (m *Model) createSpecSwitch(ctx *Context, dc *specDatacenter, spec *SwitchSpec) error {
+	var create types.DVSCreateSpec
+	create.ConfigSpec = &types.VMwareDVSConfigSpec{}
+	create.ConfigSpec.GetDVSConfigSpec().Name = spec.Name
+
+	task, err := dc.folders.NetworkFolder.CreateDVS(ctx, create)
+	if err != nil {
+		return err
+	}
+
+	info, err := task.WaitForResult(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	dvs := object.NewDistributedVirtualSwitch(m.Service.client, info.Result.(types.ManagedObjectReference))
+
+	members := &types.DVSConfigSpec{}
+	for _, host := range dc.hosts {
+		members.Host = append(members.Host, types.DistributedVirtualSwitchHostMemberConfigSpec{
+			Operation: string(types.ConfigSpecOperationAdd),
+			Host:      host.Reference(),
+		})
+	}
+
+	task, err = dvs.Reconfigure(ctx, members)
+	if err != nil {
+		return err
+	}
+	if err = task.Wait(ctx); err != nil {
+		return err
+	}
+
+	for _, pg := range spec.Portgroup {
+		config := types.DVPortgroupConfigSpec{
+			Name:       pg.Name,
+			Type:       string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
+			NumPorts:   pg.Ports,
+			AutoExpand: types.NewBool(true),
+			DefaultPortConfig: &types.VMwareDVSPortSetting{
+				Vlan: &types.VmwareDistributedVirtualSwitchVlanIdSpec{
+					VlanId: pg.VLAN,
+				},
+			},
+		}
+
+		task, err := dvs.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{config})
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return fmt.Errorf("portgroup %q: %s", pg.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Model) createSpecDatastore(ctx *Context, dc *specDatacenter, spec *DatastoreSpec) error {
+	hosts := dc.hosts
+	if len(spec.Host) != 0 {
+		hosts = nil
+		for _, name := range spec.Host {
+			host, ok := dc.host[name]
+			if !ok {
+				return fmt.Errorf("host %q not found", name)
+			}
+			hosts = append(hosts, host)
+		}
+	}
+
+	if len(hosts) == 0 {
+		return errors.New("no hosts to mount datastore")
+	}
+
+	var capacity, free units.ByteSize
+	capacity = units.ByteSize(units.TB * 10)
+	if spec.Capacity != "" {
+		if err := capacity.Set(spec.Capacity); err != nil {
+			return fmt.Errorf("capacity %q: %s", spec.Capacity, err)
+		}
+	}
+	free = capacity
+	if spec.Free != "" {
+		if err := free.Set(spec.Free); err != nil {
+			return fmt.Errorf("free %q: %s", spec.Free, err)
+		}
+	}
+
+	if err := m.createLocalDatastore(dc.Name, spec.Name, hosts); err != nil {
+		return err
+	}
+
+	ds := ctx.Map.FindByName(spec.Name, ctx.Map.Get(dc.dc.Reference()).(*Datacenter).Datastore).(*Datastore)
+	info := ds.Info.GetDatastoreInfo()
+	ds.Summary.Capacity = int64(capacity)
+	ds.Summary.FreeSpace = int64(free)
+	info.FreeSpace = ds.Summary.FreeSpace
+	info.MaxFileSize = ds.Summary.Capacity
+	info.MaxMemoryFileSize = ds.Summary.Capacity
+
+	return nil
+}
+
+// placement returns the ResourcePool and optional HostSystem for the given VMSpec
+func (dc *specDatacenter) placement(spec *VMSpec) (*object.ResourcePool, *object.HostSystem, error) {
+	ctx := SpoofContext()
+	var host *object.HostSystem
+	cluster := spec.Cluster
+
+	if spec.Host != "" {
+		var ok bool
+		if host, ok = dc.host[spec.Host]; !ok {
+			return nil, nil, fmt.Errorf("host %q not found", spec.Host)
+		}
+		h := ctx.Map.Get(host.Reference()).(*HostSystem)
+		if c, ok := ctx.Map.Get(*h.Parent).(*ClusterComputeResource); ok && cluster == "" {
+			cluster = c.Name
+		}
+	}
+
+	if cluster == "" && host == nil {
+		switch {
+		case len(dc.DatacenterSpec.Cluster) != 0:
+			cluster = dc.DatacenterSpec.Cluster[0].Name
+		case len(dc.DatacenterSpec.Host) != 0:
+			host = dc.host[dc.DatacenterSpec.Host[0].Name]
+		default:
+			return nil, nil, errors.New("no cluster or host for placement")
+		}
+	}
+
+	if cluster == "" {
+		pool, err := host.ResourcePool(ctx)
+		return pool, host, err
+	}
+
+	name := cluster
+	if spec.Pool != "" {
+		name = path.Join(cluster, spec.Pool)
+	}
+	pool, ok := dc.pool[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("pool %q not found", name)
+	}
+
+	return pool, host, nil
+}
+
+func (m *Model) createSpecVM(ctx *Context, dc *specDatacenter, spec *VMSpec) error {
+	client := m.Service.client
+	datacenter := ctx.Map.Get(dc.dc.Reference()).(*Datacenter)
+
+	pool, host, err := dc.placement(spec)
+	if err != nil {
+		return err
+	}
+
+	folder, err := m.specFolder(ctx, dc.folders.VmFolder, spec.Folder)
+	if err != nil {
+		return err
+	}
+
+	var ds mo.Entity
+	switch {
+	case spec.Datastore != "":
+		ds = ctx.Map.FindByName(spec.Datastore, datacenter.Datastore)
+	case len(datacenter.Datastore) != 0:
+		ds = ctx.Map.Get(datacenter.Datastore[0]).(mo.Entity)
+	}
+	if ds == nil {
+		return fmt.Errorf("datastore %q not found", spec.Datastore)
+	}
+	dsPath := fmt.Sprintf("[%s]", ds.Entity().Name)
+
+	config := types.VirtualMachineConfigSpec{
+		Name:       spec.Name,
+		Version:    spec.Version,
+		GuestId:    spec.GuestID,
+		NumCPUs:    spec.CPU,
+		MemoryMB:   spec.Memory,
+		Annotation: spec.Annotation,
+		Files: &types.VirtualMachineFileInfo{
+			VmPathName: dsPath,
+		},
+	}
+	if config.GuestId == "" {
+		config.GuestId = string(types.VirtualMachineGuestOsIdentifierOtherGuest)
+	}
+
+	var devices object.VirtualDeviceList
+
+	scsi, _ := devices.CreateSCSIController("pvscsi")
+	devices = append(devices, scsi)
+
+	for i, size := range spec.Disk {
+		var capacity units.ByteSize
+		if err = capacity.Set(size); err != nil {
+			return fmt.Errorf("disk %q: %s", size, err)
+		}
+
+		name := "disk1.vmdk"
+		if i != 0 {
+			name = fmt.Sprintf("disk1_%d.vmdk", i)
+		}
+
+		disk := devices.CreateDisk(scsi.(types.BaseVirtualController), ds.Reference(),
+			dsPath+" "+path.Join(spec.Name, name))
+		disk.CapacityInKB = int64(capacity) / units.KB
+		devices = append(devices, disk)
+	}
+
+	for _, name := range spec.Network {
+		ref := ctx.Map.FindByName(name, datacenter.Network)
+		if ref == nil {
+			return fmt.Errorf("network %q not found", name)
+		}
+
+		net, ok := object.NewReference(client, ref.Reference()).(object.NetworkReference)
+		if !ok {
+			return fmt.Errorf("network %q: %s is not a network", name, ref.Reference())
+		}
+
+		backing, err := net.EthernetCardBackingInfo(ctx)
+		if err != nil {
+			return err
+		}
+
+		nic, err := devices.CreateEthernetCard("vmxnet3", backing)
+		if err != nil {
+			return err
+		}
+		devices = append(devices, nic)
+	}
+
+	config.DeviceChange, _ = devices.ConfigSpec(types.VirtualDeviceConfigSpecOperationAdd)
+
+	task, err := folder.CreateVM(ctx, config, pool, host)
+	if err != nil {
+		return err
+	}
+
+	info, err := task.WaitForResult(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	vm := object.NewVirtualMachine(client, info.Result.(types.ManagedObjectReference))
+
+	m.installTools(ctx, vm.Reference())
+
+	switch types.VirtualMachinePowerState(spec.Power) {
+	case "", types.VirtualMachinePowerStatePoweredOff:
+	case types.VirtualMachinePowerStatePoweredOn, types.VirtualMachinePowerStateSuspended:
+		task, err = vm.PowerOn(ctx)
+		if err == nil {
+			err = task.Wait(ctx)
+		}
+		if err == nil && spec.Power == string(types.VirtualMachinePowerStateSuspended) {
+			task, err = vm.Suspend(ctx)
+			if err == nil {
+				err = task.Wait(ctx)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid power state %q", spec.Power)
+	}
+
+	if len(spec.CustomValue) != 0 {
+		fields, err := object.GetCustomFieldsManager(client)
+		if err != nil {
+			return err
+		}
+
+		for name, val := range spec.CustomValue {
+			key, err := fields.FindKey(ctx, name)
+			if err != nil {
+				def, err := fields.Add(ctx, name, "", nil, nil)
+				if err != nil {
+					return err
+				}
+				key = def.Key
+			}
+
+			if err = fields.Set(ctx, vm.Reference(), key, val); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, tag := range spec.Tag {
+		parts := strings.SplitN(tag, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("tag %q: expected format is category:tag", tag)
+		}
+
+		if ctx.Map.tags == nil {
+			ctx.Map.tags = make(map[types.ManagedObjectReference][]types.VslmTagEntry)
+		}
+		ctx.Map.tags[vm.Reference()] = append(ctx.Map.tags[vm.Reference()], types.VslmTagEntry{
+			ParentCategoryName: parts[0],
+			TagName:            parts[1],
+		})
+	}
+
+	return nil
+}
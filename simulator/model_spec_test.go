@@ -0,0 +1,324 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/units"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const testInventorySpec = `{
+  "datacenters": [{
+    "name": "Boston",
+    "folder": "Regions/East",
+    "networks": [{"name": "Management", "vlan": 10}],
+    "switches": [{"name": "DSwitch", "portgroups": [{"name": "Web", "vlan": 100}]}],
+    "clusters": [{
+      "name": "Compute",
+      "ha": true,
+      "pools": ["Production"],
+      "hosts": [
+        {"name": "esx-01", "version": "7.0.3", "build": "19193900", "cpu": 32, "memory": 262144},
+        {"name": "esx-02", "version": "7.0.3", "build": "19193900", "cpu": 32, "memory": 262144}
+      ]
+    }],
+    "hosts": [{"name": "esx-edge", "vendor": "Dell Inc.", "model": "PowerEdge R640"}],
+    "datastores": [
+      {"name": "shared", "capacity": "2TB", "free": "1TB"},
+      {"name": "edge-local", "hosts": ["esx-edge"]}
+    ],
+    "vms": [
+      {
+        "name": "web-01", "folder": "Web", "cluster": "Compute", "pool": "Production",
+        "datastore": "shared", "cpu": 2, "memory": 4096, "disks": ["40GB", "10GB"],
+        "networks": ["Web", "Management"], "power": "poweredOn", "tags": ["env:production"],
+        "customValues": {"owner": "web-team"}, "annotation": "frontend"
+      },
+      {"name": "edge-01", "host": "esx-edge", "datastore": "edge-local", "power": "suspended"}
+    ]
+  }]
+}`
+
+func TestModelCreateFromSpec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vcsim-inventory-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "inventory.json")
+	if err = ioutil.WriteFile(name, []byte(testInventorySpec), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	spec, err := LoadInventorySpec(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := VPX()
+	m.Lun = 2
+	defer m.Remove()
+
+	if err = m.CreateFromSpec(spec); err != nil {
+		t.Fatal(err)
+	}
+
+	count := m.Count()
+	if count.Host != 3 || count.Machine != 2 || count.Datastore != 2 || count.Cluster != 1 {
+		t.Errorf("count=%#v", count)
+	}
+
+	// attached once the vapi simulator is registered
+	if len(Map.tags) != 1 {
+		t.Errorf("tags=%v", Map.tags)
+	}
+
+	err = m.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+
+		vm, err := finder.VirtualMachine(ctx, "/Regions/East/Boston/vm/Web/web-01")
+		if err != nil {
+			return err
+		}
+
+		var mvm mo.VirtualMachine
+		err = vm.Properties(ctx, vm.Reference(), []string{"config", "runtime", "customValue", "network"}, &mvm)
+		if err != nil {
+			return err
+		}
+
+		if mvm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			t.Errorf("power state=%s", mvm.Runtime.PowerState)
+		}
+		if mvm.Config.Hardware.NumCPU != 2 || mvm.Config.Hardware.MemoryMB != 4096 || mvm.Config.Annotation != "frontend" {
+			t.Errorf("hardware=%#v", mvm.Config.Hardware)
+		}
+		if len(mvm.Network) != 2 {
+			t.Errorf("network=%v", mvm.Network)
+		}
+		if len(mvm.CustomValue) != 1 || mvm.CustomValue[0].(*types.CustomFieldStringValue).Value != "web-team" {
+			t.Errorf("customValue=%#v", mvm.CustomValue)
+		}
+		if tags := Map.tags[vm.Reference()]; len(tags) != 1 || tags[0].TagName != "production" {
+			t.Errorf("tags=%v", tags)
+		}
+
+		edge, err := finder.VirtualMachine(ctx, "/Regions/East/Boston/vm/edge-01")
+		if err != nil {
+			return err
+		}
+
+		state, err := edge.PowerState(ctx)
+		if err != nil {
+			return err
+		}
+		if state != types.VirtualMachinePowerStateSuspended {
+			t.Errorf("power state=%s", state)
+		}
+
+		host, err := finder.HostSystem(ctx, "/Regions/East/Boston/host/Compute/esx-01")
+		if err != nil {
+			return err
+		}
+
+		var mhost mo.HostSystem
+		err = host.Properties(ctx, host.Reference(), []string{"summary"}, &mhost)
+		if err != nil {
+			return err
+		}
+		if mhost.Summary.Config.Product.Build != "19193900" || mhost.Summary.Hardware.NumCpuCores != 32 {
+			t.Errorf("summary=%#v", mhost.Summary.Config.Product)
+		}
+
+		// Model.Lun disks are presented to each host
+		dss, err := host.ConfigManager().DatastoreSystem(ctx)
+		if err != nil {
+			return err
+		}
+		disks, err := dss.QueryAvailableDisksForVmfs(ctx)
+		if err != nil {
+			return err
+		}
+		luns := 0
+		for _, disk := range disks {
+			if strings.HasPrefix(disk.CanonicalName, "naa.") {
+				luns++
+			}
+		}
+		if luns != m.Lun {
+			t.Errorf("luns=%d", luns)
+		}
+
+		cluster, err := finder.ClusterComputeResource(ctx, "/Regions/East/Boston/host/Compute")
+		if err != nil {
+			return err
+		}
+
+		var mcluster mo.ClusterComputeResource
+		err = cluster.Properties(ctx, cluster.Reference(), []string{"summary", "configurationEx"}, &mcluster)
+		if err != nil {
+			return err
+		}
+		if total := mcluster.Summary.GetComputeResourceSummary().TotalMemory; total != 2*262144*units.MB {
+			t.Errorf("total memory=%d", total)
+		}
+		if !*mcluster.ConfigurationEx.(*types.ClusterConfigInfoEx).DasConfig.Enabled {
+			t.Error("HA not enabled")
+		}
+
+		ds, err := finder.Datastore(ctx, "/Regions/East/Boston/datastore/shared")
+		if err != nil {
+			return err
+		}
+
+		var mds mo.Datastore
+		err = ds.Properties(ctx, ds.Reference(), []string{"summary", "host"}, &mds)
+		if err != nil {
+			return err
+		}
+		if mds.Summary.Capacity != 2*units.TB || mds.Summary.FreeSpace != units.TB-50*units.GB {
+			t.Errorf("capacity=%d free=%d", mds.Summary.Capacity, mds.Summary.FreeSpace)
+		}
+		if len(mds.Host) != 3 {
+			t.Errorf("hosts=%d", len(mds.Host))
+		}
+
+		// standard portgroups of the same name share a Network entity
+		net, err := finder.Network(ctx, "/Regions/East/Boston/network/Management")
+		if err != nil {
+			return err
+		}
+
+		var mnet mo.Network
+		err = property.DefaultCollector(c).RetrieveOne(ctx, net.Reference(), []string{"host"}, &mnet)
+		if err != nil {
+			return err
+		}
+		if len(mnet.Host) != 3 {
+			t.Errorf("network hosts=%v", mnet.Host)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestModelCreateFromSpecInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec DatacenterSpec
+	}{
+		{"network", DatacenterSpec{
+			Name: "DC",
+			Host: []HostSpec{{Name: "h1"}},
+			VM:   []VMSpec{{Name: "vm1", Network: []string{"enoent"}}},
+		}},
+		{"datastore", DatacenterSpec{
+			Name: "DC",
+			Host: []HostSpec{{Name: "h1"}},
+			VM:   []VMSpec{{Name: "vm1"}},
+		}},
+		{"placement", DatacenterSpec{
+			Name: "DC",
+			VM:   []VMSpec{{Name: "vm1"}},
+		}},
+		{"power", DatacenterSpec{
+			Name:      "DC",
+			Host:      []HostSpec{{Name: "h1"}},
+			Datastore: []DatastoreSpec{{Name: "ds1"}},
+			VM:        []VMSpec{{Name: "vm1", Power: "on"}},
+		}},
+		{"tag", DatacenterSpec{
+			Name:      "DC",
+			Host:      []HostSpec{{Name: "h1"}},
+			Datastore: []DatastoreSpec{{Name: "ds1"}},
+			VM:        []VMSpec{{Name: "vm1", Tag: []string{"production"}}},
+		}},
+		{"capacity", DatacenterSpec{
+			Name:      "DC",
+			Host:      []HostSpec{{Name: "h1"}},
+			Datastore: []DatastoreSpec{{Name: "ds1", Capacity: "lots"}},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := VPX()
+			defer m.Remove()
+
+			err := m.CreateFromSpec(&InventorySpec{Datacenter: []DatacenterSpec{test.spec}})
+			if err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	m := ESX()
+	if err := m.CreateFromSpec(new(InventorySpec)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestDecodeInventorySpec(t *testing.T) {
+	expect, err := DecodeInventorySpec(strings.NewReader(testInventorySpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expect.Datacenter) == 0 {
+		t.Fatalf("spec=%#v", expect)
+	}
+
+	tests := []struct {
+		json string
+		err  bool
+	}{
+		{`{"datacenters": [{"name": "DC", "hosts": [{"name": "h1", "cpu": 8}], "datastores": [{"name": "ds1", "capacity": "1TB"}]}]}`, false},
+		{`{"datacenters": [{"name": "DC", "enoent": true}]}`, true},
+		{`{"datacenters": [`, true},
+	}
+
+	for _, test := range tests {
+		spec, err := DecodeInventorySpec(strings.NewReader(test.json))
+		if test.err {
+			if err == nil {
+				t.Errorf("expected error: %q", test.json)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		dc := spec.Datacenter[0]
+		if dc.Name != "DC" || dc.Host[0].CPU != 8 || dc.Datastore[0].Capacity != "1TB" {
+			t.Errorf("spec=%#v", dc)
+		}
+	}
+}
@@ -138,13 +138,13 @@ func (p *PerformanceManager) buildAvailablePerfMetricsQueryResponse(ids []types.
 	return r
 }
 
-func (p *PerformanceManager) queryAvailablePerfMetric(entity types.ManagedObjectReference, interval int32) *types.QueryAvailablePerfMetricResponse {
+func (p *PerformanceManager) queryAvailablePerfMetric(ctx *Context, entity types.ManagedObjectReference, interval int32) *types.QueryAvailablePerfMetricResponse {
 	switch entity.Type {
 	case "VirtualMachine":
-		vm := Map.Get(entity).(*VirtualMachine)
+		vm := ctx.Map.Get(entity).(*VirtualMachine)
 		return p.buildAvailablePerfMetricsQueryResponse(p.vmMetrics, int(vm.Summary.Config.NumCpu), vm.Datastore[0].Value)
 	case "HostSystem":
-		host := Map.Get(entity).(*HostSystem)
+		host := ctx.Map.Get(entity).(*HostSystem)
 		return p.buildAvailablePerfMetricsQueryResponse(p.hostMetrics, int(host.Hardware.CpuInfo.NumCpuThreads), host.Datastore[0].Value)
 	case "ResourcePool":
 		return p.buildAvailablePerfMetricsQueryResponse(p.rpMetrics, 0, "")
@@ -168,7 +168,7 @@ func (p *PerformanceManager) queryAvailablePerfMetric(entity types.ManagedObject
 
 func (p *PerformanceManager) QueryAvailablePerfMetric(ctx *Context, req *types.QueryAvailablePerfMetric) soap.HasFault {
 	body := new(methods.QueryAvailablePerfMetricBody)
-	body.Res = p.queryAvailablePerfMetric(req.Entity, req.IntervalId)
+	body.Res = p.queryAvailablePerfMetric(ctx, req.Entity, req.IntervalId)
 
 	return body
 }
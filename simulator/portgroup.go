@@ -34,7 +34,11 @@ func (s *DistributedVirtualPortgroup) RenameTask(ctx *Context, req *types.Rename
 }
 
 func (s *DistributedVirtualPortgroup) ReconfigureDVPortgroupTask(ctx *Context, req *types.ReconfigureDVPortgroup_Task) soap.HasFault {
-	task := CreateTask(s, "reconfigureDvPortgroup", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, s, "reconfigureDvPortgroup", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if err := validateDVPortSetting(req.Spec.DefaultPortConfig); err != nil {
+			return nil, err
+		}
+
 		s.Config.DefaultPortConfig = req.Spec.DefaultPortConfig
 		s.Config.NumPorts = req.Spec.NumPorts
 		s.Config.AutoExpand = req.Spec.AutoExpand
@@ -48,6 +52,15 @@ func (s *DistributedVirtualPortgroup) ReconfigureDVPortgroupTask(ctx *Context, r
 		s.Config.LogicalSwitchUuid = req.Spec.LogicalSwitchUuid
 		s.Config.BackingType = req.Spec.BackingType
 
+		if s.Config.Type == "" {
+			s.Config.Type = string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding)
+		}
+
+		vswitch := ctx.Map.Get(*s.Config.DistributedVirtualSwitch).(*DistributedVirtualSwitch)
+		ctx.WithLock(vswitch, func() {
+			vswitch.syncDVPorts(ctx, s)
+		})
+
 		return nil, nil
 	})
 
@@ -59,11 +72,35 @@ func (s *DistributedVirtualPortgroup) ReconfigureDVPortgroupTask(ctx *Context, r
 }
 
 func (s *DistributedVirtualPortgroup) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
-	task := CreateTask(s, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, s, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		vswitch := ctx.Map.Get(*s.Config.DistributedVirtualSwitch).(*DistributedVirtualSwitch)
+
+		// ports connected to a VM or host vmknic must be disconnected first
+		var inUse bool
+		ctx.WithLock(vswitch, func() {
+			for _, port := range vswitch.FetchDVPortsResponse.Returnval {
+				if port.PortgroupKey == s.Key && port.Connectee != nil {
+					inUse = true
+				}
+			}
+		})
+		if inUse {
+			return nil, &types.ResourceInUse{Type: s.Self.Type, Name: s.Name}
+		}
+
 		ctx.Map.RemoveReference(ctx, vswitch, &vswitch.Portgroup, s.Reference())
 		ctx.Map.removeString(ctx, vswitch, &vswitch.Summary.PortgroupName, s.Name)
 
+		ctx.WithLock(vswitch, func() {
+			ports := vswitch.FetchDVPortsResponse.Returnval
+			for i := len(ports) - 1; i >= 0; i-- {
+				if ports[i].PortgroupKey == s.Key {
+					vswitch.removeDVPort(ctx, i)
+				}
+			}
+			vswitch.updateDVPortCount(ctx)
+		})
+
 		f := ctx.Map.getEntityParent(vswitch, "Folder").(*Folder)
 		folderRemoveChild(ctx, &f.Folder, s.Reference())
 
@@ -23,7 +23,9 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/task"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -158,3 +160,61 @@ func TestPortgroupBackingWithNSX(t *testing.T) {
 		}
 	}, model)
 }
+
+func TestPortgroupDestroyInUse(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		// a portgroup with a port connected to a VM
+		connected := make(map[string]bool)
+		for _, obj := range Map.All("DistributedVirtualSwitch") {
+			for _, port := range obj.(*DistributedVirtualSwitch).FetchDVPortsResponse.Returnval {
+				if port.Connectee != nil {
+					connected[port.PortgroupKey] = true
+				}
+			}
+		}
+		var pg *DistributedVirtualPortgroup
+		for _, obj := range Map.All("DistributedVirtualPortgroup") {
+			if p := obj.(*DistributedVirtualPortgroup); connected[p.Key] {
+				pg = p
+			}
+		}
+		if pg == nil {
+			t.Fatal("no portgroup with connected ports")
+		}
+
+		ptask, err := object.NewDistributedVirtualPortgroup(c, pg.Reference()).Destroy(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ptask.Wait(ctx)
+		if terr, ok := err.(task.Error); !ok {
+			t.Fatalf("err=%v", err)
+		} else if _, ok = terr.Fault().(*types.ResourceInUse); !ok {
+			t.Fatalf("err=%v", err)
+		}
+		if Map.Get(pg.Reference()) == nil {
+			t.Fatal("portgroup was destroyed")
+		}
+
+		dvs := object.NewDistributedVirtualSwitch(c, *pg.Config.DistributedVirtualSwitch)
+		ptask, err = dvs.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{{Name: "unused", Type: string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding), NumPorts: 4}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ptask.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		unused, err := find.NewFinder(c).Network(ctx, "unused")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ptask, err = unused.(*object.DistributedVirtualPortgroup).Destroy(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ptask.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
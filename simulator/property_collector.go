@@ -22,6 +22,7 @@ import (
 	"log"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -40,8 +41,11 @@ type PropertyCollector struct {
 
 	nopLocker
 	updates []types.ObjectUpdate
+	notify  chan struct{} // signaled when updates are queued
 	mu      sync.Mutex
 	cancel  context.CancelFunc
+	version int
+	pending []types.PropertyFilterUpdate // remainder of a truncated UpdateSet
 }
 
 func NewPropertyCollector(ref types.ManagedObjectReference) object.Reference {
@@ -55,6 +59,14 @@ var errEmptyField = errors.New("empty field")
 
 func getObject(ctx *Context, ref types.ManagedObjectReference) (reflect.Value, bool) {
 	var obj mo.Reference
+	if v := ctx.hostView(); v != nil {
+		obj = v.get(ref) // host agent view of a vCenter object, if any
+	}
+
+	if obj != nil {
+		return getManagedObject(obj), true
+	}
+
 	if ctx.Session == nil {
 		// Even without permissions to access an object or specific fields, RetrieveProperties
 		// returns an ObjectContent response as long as the object exists.  See retrieveResult.add()
@@ -158,9 +170,46 @@ func fieldValueInterface(f reflect.StructField, rval reflect.Value) interface{}
 	return wrapValue(rval, f.Type)
 }
 
+type fieldKey struct {
+	kind reflect.Type
+	name string
+}
+
+// fieldCache maps a struct type and property name to the index sequence of its field, or nil if not found.
+// Property paths are resolved by reflection for every object collected, so the field lookup is only done once per type.
+var fieldCache sync.Map
+
+// fieldByName is a cached equivalent of reflect.Type.FieldByName, where name is the lowerCamel property name.
+func fieldByName(rtype reflect.Type, name string) (reflect.StructField, bool) {
+	key := fieldKey{rtype, name}
+	if f, ok := fieldCache.Load(key); ok {
+		field := f.(*reflect.StructField)
+		return *field, field.Index != nil
+	}
+
+	field, ok := rtype.FieldByName(ucFirst(name))
+	if !ok {
+		field = reflect.StructField{}
+	}
+	fieldCache.Store(key, &field)
+	return field, ok
+}
+
+// fieldPaths caches the result of splitting property paths, to avoid allocations on every object collected.
+var fieldPaths sync.Map
+
+func splitPath(p string) []string {
+	if fields, ok := fieldPaths.Load(p); ok {
+		return fields.([]string)
+	}
+	fields := strings.Split(p, ".")
+	fieldPaths.Store(p, fields)
+	return fields
+}
+
 func fieldValue(rval reflect.Value, p string) (interface{}, error) {
 	var value interface{}
-	fields := strings.Split(p, ".")
+	fields := splitPath(p)
 
 	for i, name := range fields {
 		kind := rval.Type().Kind()
@@ -180,18 +229,21 @@ func fieldValue(rval reflect.Value, p string) (interface{}, error) {
 			rval = rval.Elem()
 		}
 
-		x := ucFirst(name)
-		val := rval.FieldByName(x)
-		if !val.IsValid() {
+		if rval.Kind() != reflect.Struct {
+			return nil, errMissingField
+		}
+
+		ftype, ok := fieldByName(rval.Type(), name)
+		if !ok {
 			return nil, errMissingField
 		}
+		val := rval.FieldByIndex(ftype.Index)
 
 		if isEmpty(val) {
 			return nil, errEmptyField
 		}
 
 		if i == len(fields)-1 {
-			ftype, _ := rval.Type().FieldByName(x)
 			value = fieldValueInterface(ftype, val)
 			break
 		}
@@ -250,10 +302,14 @@ type retrieveResult struct {
 }
 
 func (rr *retrieveResult) add(ctx *Context, name string, val types.AnyType, content *types.ObjectContent) {
+	if ctx.unset(content.Obj.Type, name) {
+		return // property was introduced after the client's API version
+	}
+
 	if ctx.Session != nil {
 		content.PropSet = append(content.PropSet, types.DynamicProperty{
 			Name: name,
-			Val:  val,
+			Val:  ctx.versioned(val),
 		})
 		return
 	}
@@ -448,8 +504,9 @@ func (pc *PropertyCollector) CreateFilter(ctx *Context, c *types.CreateFilter) s
 	body := &methods.CreateFilterBody{}
 
 	filter := &PropertyFilter{
-		pc:   pc,
-		refs: make(map[types.ManagedObjectReference]struct{}),
+		pc:    pc,
+		refs:  make(map[types.ManagedObjectReference]struct{}),
+		paths: traversalPaths(c.Spec),
 	}
 	filter.PartialUpdates = c.PartialUpdates
 	filter.Spec = c.Spec
@@ -557,9 +614,21 @@ func (pc *PropertyCollector) CancelWaitForUpdates(r *types.CancelWaitForUpdates)
 	return &methods.CancelWaitForUpdatesBody{Res: new(types.CancelWaitForUpdatesResponse)}
 }
 
+// wakeup returns the channel signaled when updates are queued, the caller must hold pc.mu.
+func (pc *PropertyCollector) wakeup() chan struct{} {
+	if pc.notify == nil {
+		pc.notify = make(chan struct{}, 1)
+	}
+	return pc.notify
+}
+
 func (pc *PropertyCollector) update(u types.ObjectUpdate) {
 	pc.mu.Lock()
 	pc.updates = append(pc.updates, u)
+	select {
+	case pc.wakeup() <- struct{}{}:
+	default: // WaitForUpdatesEx has yet to consume a previous signal
+	}
 	pc.mu.Unlock()
 }
 
@@ -587,14 +656,39 @@ func (pc *PropertyCollector) RemoveObject(_ *Context, ref types.ManagedObjectRef
 	})
 }
 
+// apply collects the objects selected by each filter that have not yet been reported,
+// adding an ObjectUpdate of kind Enter to the given UpdateSet for each.
 func (pc *PropertyCollector) apply(ctx *Context, update *types.UpdateSet) types.BaseMethodFault {
 	for _, ref := range pc.Filter {
 		filter := ctx.Session.Get(ref).(*PropertyFilter)
 
-		r := &types.RetrievePropertiesEx{}
-		r.SpecSet = append(r.SpecSet, filter.Spec)
+		// Traverse the filter spec without collecting properties to find any new objects
+		spec := filter.Spec
+		spec.PropSet = make([]types.PropertySpec, len(filter.Spec.PropSet))
+		for i, p := range filter.Spec.PropSet {
+			spec.PropSet[i] = types.PropertySpec{Type: p.Type}
+		}
+
+		res, fault := pc.collect(ctx, &types.RetrievePropertiesEx{SpecSet: []types.PropertyFilterSpec{spec}})
+		if fault != nil {
+			return fault
+		}
+
+		spec = types.PropertyFilterSpec{
+			PropSet:                       filter.Spec.PropSet,
+			ReportMissingObjectsInResults: types.NewBool(true),
+		}
+		for _, o := range res.Objects {
+			if _, ok := filter.refs[o.Obj]; !ok {
+				spec.ObjectSet = append(spec.ObjectSet, types.ObjectSpec{Obj: o.Obj})
+			}
+		}
+		if len(spec.ObjectSet) == 0 {
+			continue
+		}
 
-		res, fault := pc.collect(ctx, r)
+		// Collect properties of the new objects only
+		res, fault = pc.collect(ctx, &types.RetrievePropertiesEx{SpecSet: []types.PropertyFilterSpec{spec}})
 		if fault != nil {
 			return fault
 		}
@@ -631,10 +725,72 @@ func (pc *PropertyCollector) apply(ctx *Context, update *types.UpdateSet) types.
 	return nil
 }
 
+// mergeObjectUpdate adds the given update to the set, combining changes with a pending Modify update of the same object.
+func mergeObjectUpdate(set []types.ObjectUpdate, update types.ObjectUpdate) []types.ObjectUpdate {
+	for i := range set {
+		if set[i].Obj != update.Obj || set[i].Kind != types.ObjectUpdateKindModify {
+			continue
+		}
+
+		for _, change := range update.ChangeSet {
+			found := false
+			for j := range set[i].ChangeSet {
+				if set[i].ChangeSet[j].Name == change.Name {
+					set[i].ChangeSet[j] = change
+					found = true
+					break
+				}
+			}
+			if !found {
+				set[i].ChangeSet = append(set[i].ChangeSet, change)
+			}
+		}
+
+		return set
+	}
+
+	return append(set, update)
+}
+
+// truncate limits the given UpdateSet to max ObjectUpdates, saving the remainder for the next WaitForUpdatesEx call.
+func (pc *PropertyCollector) truncate(set *types.UpdateSet, max int) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	pc.version++
+	set.Version = strconv.Itoa(pc.version)
+
+	if max <= 0 {
+		return
+	}
+
+	n := 0
+	for i := range set.FilterSet {
+		fu := &set.FilterSet[i]
+		if n+len(fu.ObjectSet) <= max {
+			n += len(fu.ObjectSet)
+			continue
+		}
+
+		pending := append([]types.PropertyFilterUpdate{}, set.FilterSet[i:]...)
+		pending[0].ObjectSet = fu.ObjectSet[max-n:]
+		fu.ObjectSet = fu.ObjectSet[:max-n]
+		set.FilterSet = set.FilterSet[:i]
+		if len(fu.ObjectSet) != 0 {
+			set.FilterSet = append(set.FilterSet, *fu)
+		}
+		pc.pending = append(pending, pc.pending...)
+		set.Truncated = types.NewBool(true)
+		return
+	}
+}
+
 func (pc *PropertyCollector) WaitForUpdatesEx(ctx *Context, r *types.WaitForUpdatesEx) soap.HasFault {
 	wait, cancel := context.WithCancel(context.Background())
 	oneUpdate := false
+	maxObjectUpdates := 0
 	if r.Options != nil {
+		maxObjectUpdates = int(r.Options.MaxObjectUpdates)
 		if max := r.Options.MaxWaitSeconds; max != nil {
 			// A value of 0 causes WaitForUpdatesEx to do one update calculation and return any results.
 			oneUpdate = (*max == 0)
@@ -666,15 +822,35 @@ func (pc *PropertyCollector) WaitForUpdatesEx(ctx *Context, r *types.WaitForUpda
 		return true
 	}
 
+	// done truncates the UpdateSet when MaxObjectUpdates is exceeded and assigns the next version.
+	done := func() soap.HasFault {
+		pc.truncate(set, maxObjectUpdates)
+		return body
+	}
+
 	if r.Version == "" {
+		pc.mu.Lock()
+		pc.pending = nil // discard any truncated updates from a previous sequence
+		pc.mu.Unlock()
 		ctx.Map.AddHandler(pc) // Listen for create, update, delete of managed objects
-		apply()                // Collect current state
-		set.Version = "-"      // Next request with Version set will wait via loop below
-		return body
+		if !apply() {          // Collect current state
+			return body
+		}
+		return done() // Next request with Version set will wait via loop below
+	}
+
+	pc.mu.Lock()
+	set.FilterSet = pc.pending
+	pc.pending = nil
+	pc.mu.Unlock()
+	if len(set.FilterSet) != 0 {
+		return done() // remainder of a truncated UpdateSet is returned without waiting
 	}
 
-	ticker := time.NewTicker(20 * time.Millisecond) // allow for updates to accumulate
-	defer ticker.Stop()
+	pc.mu.Lock()
+	notify := pc.wakeup()
+	pc.mu.Unlock()
+
 	// Start the wait loop, returning on one of:
 	// - Client calls CancelWaitForUpdates
 	// - MaxWaitSeconds was specified and has been exceeded
@@ -693,7 +869,7 @@ func (pc *PropertyCollector) WaitForUpdatesEx(ctx *Context, r *types.WaitForUpda
 			}
 
 			return body
-		case <-ticker.C:
+		default:
 			pc.mu.Lock()
 			updates := pc.updates
 			pc.updates = nil // clear updates collected by the managed object CRUD listeners
@@ -703,32 +879,52 @@ func (pc *PropertyCollector) WaitForUpdatesEx(ctx *Context, r *types.WaitForUpda
 					body.Res.Returnval = nil
 					return body
 				}
+				select {
+				case <-wait.Done():
+				case <-notify: // wait for updates to be queued by the managed object CRUD listeners
+				}
 				continue
 			}
 
 			tracef("%s: applying %d updates to %d filters", pc.Self, len(updates), len(pc.Filter))
 
+			// New objects and changes to traversed properties may add objects to the filter set,
+			// which are collected once for the batch of updates rather than per update.
+			traverse := false
+			for _, update := range updates {
+				switch update.Kind {
+				case types.ObjectUpdateKindEnter:
+					traverse = true
+				case types.ObjectUpdateKindModify:
+					for _, f := range pc.Filter {
+						if ctx.Session.Get(f).(*PropertyFilter).traverses(update.ChangeSet) {
+							traverse = true
+							break
+						}
+					}
+				}
+				if traverse {
+					break
+				}
+			}
+			if traverse && !apply() {
+				return body
+			}
+
 			for _, f := range pc.Filter {
 				filter := ctx.Session.Get(f).(*PropertyFilter)
 				fu := types.PropertyFilterUpdate{Filter: f}
 
 				for _, update := range updates {
 					switch update.Kind {
-					case types.ObjectUpdateKindEnter: // Create
-						if !apply() {
-							return body
-						}
 					case types.ObjectUpdateKindModify: // Update
 						tracef("%s has %d changes", update.Obj, len(update.ChangeSet))
-						if !apply() { // An update may apply to collector traversal specs
-							return body
-						}
 						if _, ok := filter.refs[update.Obj]; ok {
 							// This object has already been applied by the filter,
 							// now check if the property spec applies for this update.
 							update = filter.apply(ctx, update)
 							if len(update.ChangeSet) != 0 {
-								fu.ObjectSet = append(fu.ObjectSet, update)
+								fu.ObjectSet = mergeObjectUpdate(fu.ObjectSet, update)
 							}
 						}
 					case types.ObjectUpdateKindLeave: // Delete
@@ -745,7 +941,7 @@ func (pc *PropertyCollector) WaitForUpdatesEx(ctx *Context, r *types.WaitForUpda
 				}
 			}
 			if len(set.FilterSet) != 0 {
-				return body
+				return done()
 			}
 			if oneUpdate {
 				body.Res.Returnval = nil
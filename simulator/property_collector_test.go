@@ -18,6 +18,7 @@ package simulator
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"reflect"
 	"sync"
@@ -31,6 +32,7 @@ import (
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/simulator/vpx"
 	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -1661,3 +1663,304 @@ func TestPropertyCollectorNoPathSet(t *testing.T) {
 		t.Fatalf("len(content)=%d", len(content))
 	}
 }
+
+func TestWaitForUpdatesTruncated(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		pc, err := property.DefaultCollector(c).Create(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		v, err := view.NewManager(c).CreateContainerView(ctx, c.ServiceContent.RootFolder, []string{"VirtualMachine"}, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		filter := new(property.WaitFilter).Add(v.Reference(), "VirtualMachine", []string{"name"}, v.TraversalSpec())
+		if err = pc.CreateFilter(ctx, filter.CreateFilter); err != nil {
+			t.Fatal(err)
+		}
+
+		vms := Map.All("VirtualMachine")
+		max := int32(len(vms)/2 - 1) // at least 3 UpdateSets required
+
+		req := types.WaitForUpdatesEx{
+			This:    pc.Reference(),
+			Options: &types.WaitOptions{MaxWaitSeconds: types.NewInt32(1), MaxObjectUpdates: max},
+		}
+
+		// collect returns the objects updated across a sequence of truncated UpdateSets
+		collect := func(kind types.ObjectUpdateKind) map[types.ManagedObjectReference]int {
+			seen := make(map[types.ManagedObjectReference]int)
+			versions := make(map[string]bool)
+
+			for i := 0; ; i++ {
+				res, err := methods.WaitForUpdatesEx(ctx, c, &req)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				set := res.Returnval
+				if set == nil {
+					t.Fatalf("%s: no updates", kind)
+				}
+				if versions[set.Version] {
+					t.Errorf("%s: duplicate version %q", kind, set.Version)
+				}
+				versions[set.Version] = true
+				req.Version = set.Version
+
+				n := 0
+				for _, fs := range set.FilterSet {
+					for _, update := range fs.ObjectSet {
+						if update.Kind != kind {
+							t.Errorf("%s: unexpected update kind %s", kind, update.Kind)
+						}
+						seen[update.Obj]++
+						n++
+					}
+				}
+				if n > int(max) {
+					t.Errorf("%s: %d updates exceeds max %d", kind, n, max)
+				}
+
+				if !isTrue(set.Truncated) {
+					if i < 2 {
+						t.Errorf("%s: expected truncation, %d UpdateSets", kind, i+1)
+					}
+					return seen
+				}
+			}
+		}
+
+		seen := collect(types.ObjectUpdateKindEnter)
+		if len(seen) != len(vms) {
+			t.Errorf("enter: %d of %d objects", len(seen), len(vms))
+		}
+
+		// multiple changes to the same object are merged into 1 ObjectUpdate
+		for _, vm := range vms {
+			for i := 0; i < 2; i++ {
+				Map.Update(vm, []types.PropertyChange{{Name: "name", Val: vm.Entity().Name + "-x"}})
+			}
+		}
+
+		seen = collect(types.ObjectUpdateKindModify)
+		if len(seen) != len(vms) {
+			t.Errorf("modify: %d of %d objects", len(seen), len(vms))
+		}
+		for ref, n := range seen {
+			if n != 1 {
+				t.Errorf("modify: %s updated %d times", ref, n)
+			}
+		}
+
+		// property.WaitForUpdates continues with the next version until the UpdateSet is no longer truncated
+		filter.Options = &types.WaitOptions{MaxObjectUpdates: max}
+		truncated := 0
+		seen = make(map[types.ManagedObjectReference]int)
+		err = property.WaitForUpdates(ctx, property.DefaultCollector(c), filter, func(updates []types.ObjectUpdate) bool {
+			for _, update := range updates {
+				seen[update.Obj]++
+			}
+			if filter.Truncated {
+				truncated++
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if truncated == 0 || len(seen) != len(vms) {
+			t.Errorf("truncated=%d, %d of %d objects", truncated, len(seen), len(vms))
+		}
+	})
+}
+
+func TestWaitForUpdatesPartialUpdates(t *testing.T) {
+	tests := []struct {
+		partial bool
+		path    string
+		change  string
+		expect  []string
+	}{
+		{false, "runtime", "runtime.powerState", []string{"runtime"}},
+		{true, "runtime", "runtime.powerState", []string{"runtime.powerState"}},
+		{false, "config", "config.hardware.device", []string{"config"}},
+		{true, "config", "config.hardware.device", []string{"config.hardware.device"}},
+		{false, "config.hardware.device", "config", []string{"config.hardware.device"}},
+		{true, "config.hardware.device", "config", []string{"config.hardware.device"}},
+		{false, "config", "configStatus", nil},                 // not a nested field
+		{false, "", "runtime.powerState", []string{"runtime"}}, // all properties
+		{true, "", "runtime.powerState", []string{"runtime.powerState"}},
+	}
+
+	for _, test := range tests {
+		Test(func(ctx context.Context, c *vim25.Client) {
+			pc, err := property.DefaultCollector(c).Create(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			vm := Map.Any("VirtualMachine").(*VirtualMachine)
+
+			var paths []string
+			if test.path != "" {
+				paths = append(paths, test.path)
+			}
+			filter := new(property.WaitFilter).Add(vm.Self, vm.Self.Type, paths)
+			filter.PartialUpdates = test.partial
+			if err = pc.CreateFilter(ctx, filter.CreateFilter); err != nil {
+				t.Fatal(err)
+			}
+
+			req := types.WaitForUpdatesEx{
+				This:    pc.Reference(),
+				Options: &types.WaitOptions{MaxWaitSeconds: types.NewInt32(1)},
+			}
+
+			res, err := methods.WaitForUpdatesEx(ctx, c, &req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Version = res.Returnval.Version
+
+			val, err := fieldValue(reflect.ValueOf(vm), test.change)
+			if err != nil {
+				t.Fatal(err)
+			}
+			Map.Update(vm, []types.PropertyChange{{Name: test.change, Val: val}})
+
+			res, err = methods.WaitForUpdatesEx(ctx, c, &req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			if set := res.Returnval; set != nil {
+				for _, fs := range set.FilterSet {
+					for _, update := range fs.ObjectSet {
+						for _, change := range update.ChangeSet {
+							names = append(names, change.Name)
+							if change.Val == nil {
+								t.Errorf("%s: nil value", change.Name)
+							}
+						}
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(names, test.expect) {
+				t.Errorf("partial=%t path=%q change=%s: names=%v, expected=%v", test.partial, test.path, test.change, names, test.expect)
+			}
+		})
+	}
+}
+
+// benchmarkModel creates a VPX model with the given number of VirtualMachines and returns a client connected to it.
+func benchmarkModel(b *testing.B, vms int) (*vim25.Client, func()) {
+	ctx := context.Background()
+
+	m := VPX()
+	m.Machine = vms / 2 // 1 standalone host + 1 cluster
+
+	if err := m.Create(); err != nil {
+		b.Fatal(err)
+	}
+
+	s := m.Service.NewServer()
+
+	c, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return c.Client, func() {
+		s.Close()
+		m.Remove()
+	}
+}
+
+func BenchmarkRetrievePropertiesContainerView(b *testing.B) {
+	for _, vms := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("%d", vms), func(b *testing.B) {
+			ctx := context.Background()
+			c, done := benchmarkModel(b, vms)
+			defer done()
+
+			v, err := view.NewManager(c).CreateContainerView(ctx, c.ServiceContent.RootFolder, []string{"VirtualMachine"}, true)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var content []mo.VirtualMachine
+				err = v.Retrieve(ctx, []string{"VirtualMachine"}, []string{"name", "runtime.powerState"}, &content)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(content) != vms {
+					b.Fatalf("%d VMs", len(content))
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkWaitForUpdatesConcurrent(b *testing.B) {
+	for _, clients := range []int{10, 100} {
+		b.Run(fmt.Sprintf("%d", clients), func(b *testing.B) {
+			ctx := context.Background()
+			c, done := benchmarkModel(b, 1000)
+			defer done()
+
+			v, err := view.NewManager(c).CreateContainerView(ctx, c.ServiceContent.RootFolder, []string{"VirtualMachine"}, true)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			vm := Map.Any("VirtualMachine")
+			updates := make(chan struct{}, clients)
+			wctx, cancel := context.WithCancel(ctx)
+			var wg sync.WaitGroup
+
+			for i := 0; i < clients; i++ {
+				entered := make(chan struct{})
+				var once sync.Once
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					filter := new(property.WaitFilter).Add(v.Reference(), "VirtualMachine", []string{"name"}, v.TraversalSpec())
+					_ = property.WaitForUpdates(wctx, property.DefaultCollector(c), filter, func(set []types.ObjectUpdate) bool {
+						for _, update := range set {
+							switch update.Kind {
+							case types.ObjectUpdateKindEnter:
+								once.Do(func() { close(entered) })
+							case types.ObjectUpdateKindModify:
+								if update.Obj == vm.Reference() {
+									updates <- struct{}{}
+								}
+							}
+						}
+						return false
+					})
+				}()
+				<-entered
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				Map.Update(vm, []types.PropertyChange{{Name: "name", Val: fmt.Sprintf("vm-%d", i)}})
+				for j := 0; j < clients; j++ {
+					<-updates
+				}
+			}
+			b.StopTimer()
+
+			cancel()
+			wg.Wait()
+		})
+	}
+}
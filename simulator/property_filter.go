@@ -31,6 +31,42 @@ type PropertyFilter struct {
 
 	pc   *PropertyCollector
 	refs map[types.ManagedObjectReference]struct{}
+
+	// paths of the Spec TraversalSpecs, changes to these properties may change the set of objects to collect
+	paths map[string]bool
+}
+
+// traversalPaths returns the Path of each TraversalSpec in the given filter spec.
+func traversalPaths(spec types.PropertyFilterSpec) map[string]bool {
+	paths := make(map[string]bool)
+
+	var add func([]types.BaseSelectionSpec)
+	add = func(set []types.BaseSelectionSpec) {
+		for _, s := range set {
+			if ts, ok := s.(*types.TraversalSpec); ok && !paths[ts.Path] {
+				paths[ts.Path] = true
+				add(ts.SelectSet)
+			}
+		}
+	}
+
+	for _, o := range spec.ObjectSet {
+		add(o.SelectSet)
+	}
+
+	return paths
+}
+
+// traverses returns true if the given changes may affect the set of objects selected by the filter.
+func (f *PropertyFilter) traverses(changes []types.PropertyChange) bool {
+	for _, change := range changes {
+		for path := range f.paths {
+			if change.Name == path || strings.HasPrefix(path, change.Name+".") || strings.HasPrefix(change.Name, path+".") {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (f *PropertyFilter) DestroyPropertyFilter(ctx *Context, c *types.DestroyPropertyFilter) soap.HasFault {
@@ -45,9 +81,20 @@ func (f *PropertyFilter) DestroyPropertyFilter(ctx *Context, c *types.DestroyPro
 	return body
 }
 
-// matches returns true if the change matches one of the filter Spec.PropSet
-func (f *PropertyFilter) matches(ctx *Context, ref types.ManagedObjectReference, change *types.PropertyChange) bool {
+// matches returns the changes derived from the given change that match one of the filter Spec.PropSet
+func (f *PropertyFilter) matches(ctx *Context, ref types.ManagedObjectReference, change types.PropertyChange) []types.PropertyChange {
 	var kind reflect.Type
+	var changes []types.PropertyChange
+
+	// property returns the current value of the given property path as a change
+	property := func(name string) types.PropertyChange {
+		c := change
+		c.Name = name
+		if obj := ctx.Map.Get(ref); obj != nil { // object may have since been deleted
+			c.Val, _ = fieldValue(reflect.ValueOf(obj), name)
+		}
+		return c
+	}
 
 	for _, p := range f.Spec.PropSet {
 		if p.Type != ref.Type {
@@ -62,43 +109,47 @@ func (f *PropertyFilter) matches(ctx *Context, ref types.ManagedObjectReference,
 		}
 
 		if isTrue(p.All) {
-			return true
+			// all properties are top-level, e.g. "runtime.powerState" -> "runtime"
+			if i := strings.Index(change.Name, "."); i > 0 && !f.PartialUpdates {
+				return append(changes, property(change.Name[:i]))
+			}
+			return append(changes, change)
 		}
 
 		for _, name := range p.PathSet {
-			if name == change.Name {
-				return true
-			}
-
-			// strings.HasPrefix("runtime.powerState", "runtime") == parent field matches
-			if strings.HasPrefix(change.Name, name) {
-				if obj := ctx.Map.Get(ref); obj != nil { // object may have since been deleted
-					change.Name = name
-					change.Val, _ = fieldValue(reflect.ValueOf(obj), name)
+			switch {
+			case name == change.Name:
+				return append(changes, change)
+			case strings.HasPrefix(change.Name, name+"."):
+				// a nested field of the spec path changed, e.g. "runtime.powerState" within "runtime"
+				if f.PartialUpdates {
+					return append(changes, change) // report only the nested change
 				}
-
-				return true
+				return append(changes, property(name)) // report the enclosing property named in the spec
+			case strings.HasPrefix(name, change.Name+"."):
+				// a parent of the spec path changed, e.g. "config" for "config.hardware.device"
+				changes = append(changes, property(name))
 			}
 		}
 	}
 
-	return false
+	return changes
 }
 
 // apply the PropertyFilter.Spec to the given ObjectUpdate
 func (f *PropertyFilter) apply(ctx *Context, change types.ObjectUpdate) types.ObjectUpdate {
-	parents := make(map[string]bool)
+	seen := make(map[string]bool)
 	set := change.ChangeSet
 	change.ChangeSet = nil
 
-	for i, p := range set {
-		if f.matches(ctx, change.Obj, &p) {
+	for i := range set {
+		for _, p := range f.matches(ctx, change.Obj, set[i]) {
 			if p.Name != set[i].Name {
-				// update matches a parent field from the spec.
-				if parents[p.Name] {
-					continue // only return 1 instance of the parent
+				// update derived from a parent or child field of the spec.
+				if seen[p.Name] {
+					continue // only return 1 instance of the property
 				}
-				parents[p.Name] = true
+				seen[p.Name] = true
 			}
 			change.ChangeSet = append(change.ChangeSet, p)
 		}
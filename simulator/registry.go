@@ -68,8 +68,11 @@ type RegisterObject interface {
 // Registry manages a map of mo.Reference objects
 type Registry struct {
 	counter  int64 // Keep first to ensure 64-bit alignment
-	m        sync.Mutex
+	m        sync.RWMutex
 	objects  map[types.ManagedObjectReference]mo.Reference
+	kinds    map[string]map[types.ManagedObjectReference]mo.Reference                       // objects indexed by type
+	contents map[types.ManagedObjectReference]map[types.ManagedObjectReference]mo.Reference // entities indexed by container
+	parents  map[types.ManagedObjectReference][]types.ManagedObjectReference                // containers of each indexed entity
 	handlers map[types.ManagedObjectReference]RegisterObject
 	locks    map[types.ManagedObjectReference]*internal.ObjectLock
 
@@ -78,6 +81,9 @@ type Registry struct {
 	Handler   func(*Context, *Method) (mo.Reference, types.BaseMethodFault)
 
 	tagManager tagManager
+
+	// tags are attached once the vapi tag manager simulator is registered, see Model.CreateFromSpec
+	tags map[types.ManagedObjectReference][]types.VslmTagEntry
 }
 
 // tagManager is an interface to simplify internal interaction with the vapi tag manager simulator.
@@ -86,12 +92,54 @@ type tagManager interface {
 	AttachedTags(id types.ManagedObjectReference) ([]types.VslmTagEntry, types.BaseMethodFault)
 	AttachTag(types.ManagedObjectReference, types.VslmTagEntry) types.BaseMethodFault
 	DetachTag(types.ManagedObjectReference, types.VslmTagEntry) types.BaseMethodFault
+	CreateTag(types.VslmTagEntry) types.BaseMethodFault
+}
+
+// attachTags creates and attaches tags recorded before the tag manager was registered.
+func (r *Registry) attachTags() {
+	for ref, tags := range r.tags {
+		for _, tag := range tags {
+			if err := r.tagManager.CreateTag(tag); err != nil {
+				log.Printf("create tag %s:%s: %s", tag.ParentCategoryName, tag.TagName, faultMessage(err))
+				continue
+			}
+			if err := r.tagManager.AttachTag(ref, tag); err != nil {
+				log.Printf("attach tag %s:%s to %s: %s", tag.ParentCategoryName, tag.TagName, ref, faultMessage(err))
+			}
+		}
+	}
+	r.tags = nil
+}
+
+// faultMessage returns the messages of the given fault, or the fault name if it has no messages.
+func faultMessage(f types.BaseMethodFault) string {
+	var msgs []string
+	for _, msg := range f.GetMethodFault().FaultMessage {
+		msgs = append(msgs, msg.Message)
+	}
+	if len(msgs) == 0 {
+		return soap.WrapVimFault(f).Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AttachedTags returns the vapi tags attached to the given object,
+// or nil if the vapi tag manager simulator is not registered.
+func (r *Registry) AttachedTags(ref types.ManagedObjectReference) []types.VslmTagEntry {
+	if r.tagManager == nil {
+		return nil
+	}
+	tags, _ := r.tagManager.AttachedTags(ref)
+	return tags
 }
 
 // NewRegistry creates a new instances of Registry
 func NewRegistry() *Registry {
 	r := &Registry{
 		objects:  make(map[types.ManagedObjectReference]mo.Reference),
+		kinds:    make(map[string]map[types.ManagedObjectReference]mo.Reference),
+		contents: make(map[types.ManagedObjectReference]map[types.ManagedObjectReference]mo.Reference),
+		parents:  make(map[types.ManagedObjectReference][]types.ManagedObjectReference),
 		handlers: make(map[types.ManagedObjectReference]RegisterObject),
 		locks:    make(map[types.ManagedObjectReference]*internal.ObjectLock),
 
@@ -190,21 +238,28 @@ func (r *Registry) PutEntity(parent mo.Entity, item mo.Entity) mo.Entity {
 
 // Get returns the object for the given reference.
 func (r *Registry) Get(ref types.ManagedObjectReference) mo.Reference {
-	r.m.Lock()
-	defer r.m.Unlock()
+	r.m.RLock()
+	defer r.m.RUnlock()
 
 	return r.objects[ref]
 }
 
+// objectsOfKind returns the objects of the given type, or all objects if kind is empty.
+// The caller must hold r.m.
+func (r *Registry) objectsOfKind(kind string) map[types.ManagedObjectReference]mo.Reference {
+	if kind == "" {
+		return r.objects
+	}
+	return r.kinds[kind]
+}
+
 // Any returns the first instance of entity type specified by kind.
 func (r *Registry) Any(kind string) mo.Entity {
-	r.m.Lock()
-	defer r.m.Unlock()
+	r.m.RLock()
+	defer r.m.RUnlock()
 
-	for ref, val := range r.objects {
-		if ref.Type == kind {
-			return val.(mo.Entity)
-		}
+	for _, val := range r.kinds[kind] {
+		return val.(mo.Entity)
 	}
 
 	return nil
@@ -213,15 +268,14 @@ func (r *Registry) Any(kind string) mo.Entity {
 // All returns all entities of type specified by kind.
 // If kind is empty - all entities will be returned.
 func (r *Registry) All(kind string) []mo.Entity {
-	r.m.Lock()
-	defer r.m.Unlock()
-
-	var entities []mo.Entity
-	for ref, val := range r.objects {
-		if kind == "" || ref.Type == kind {
-			if e, ok := val.(mo.Entity); ok {
-				entities = append(entities, e)
-			}
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	objs := r.objectsOfKind(kind)
+	entities := make([]mo.Entity, 0, len(objs))
+	for _, val := range objs {
+		if e, ok := val.(mo.Entity); ok {
+			entities = append(entities, e)
 		}
 	}
 
@@ -231,27 +285,26 @@ func (r *Registry) All(kind string) []mo.Entity {
 // AllReference returns all mo.Reference objects of type specified by kind.
 // If kind is empty - all objects will be returned.
 func (r *Registry) AllReference(kind string) []mo.Reference {
-	r.m.Lock()
-	defer r.m.Unlock()
+	r.m.RLock()
+	defer r.m.RUnlock()
 
-	var objs []mo.Reference
-	for ref, val := range r.objects {
-		if kind == "" || ref.Type == kind {
-			objs = append(objs, val)
-		}
+	objs := r.objectsOfKind(kind)
+	refs := make([]mo.Reference, 0, len(objs))
+	for _, val := range objs {
+		refs = append(refs, val)
 	}
 
-	return objs
+	return refs
 }
 
 // applyHandlers calls the given func for each r.handlers
 func (r *Registry) applyHandlers(f func(o RegisterObject)) {
-	r.m.Lock()
+	r.m.RLock()
 	handlers := make([]RegisterObject, 0, len(r.handlers))
 	for _, handler := range r.handlers {
 		handlers = append(handlers, handler)
 	}
-	r.m.Unlock()
+	r.m.RUnlock()
 
 	for i := range handlers {
 		f(handlers[i])
@@ -267,6 +320,104 @@ func (r *Registry) reference(item mo.Reference) types.ManagedObjectReference {
 	return ref
 }
 
+// add the given object to the objects map, type index and container index.
+// The caller must hold r.m.
+func (r *Registry) add(ref types.ManagedObjectReference, item mo.Reference) {
+	r.objects[ref] = item
+	kind, ok := r.kinds[ref.Type]
+	if !ok {
+		kind = make(map[types.ManagedObjectReference]mo.Reference)
+		r.kinds[ref.Type] = kind
+	}
+	kind[ref] = item
+
+	r.unindex(ref) // the object may have been moved to another container
+	parents := containers(item)
+	for _, parent := range parents {
+		contents, ok := r.contents[parent]
+		if !ok {
+			contents = make(map[types.ManagedObjectReference]mo.Reference)
+			r.contents[parent] = contents
+		}
+		contents[ref] = item
+	}
+	if len(parents) != 0 {
+		r.parents[ref] = parents
+	}
+}
+
+// reindex updates the container index, after the given object has been moved to another container.
+func (r *Registry) reindex(item mo.Reference) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	ref := item.Reference()
+	if r.objects[ref] == item {
+		r.add(ref, item)
+	}
+}
+
+// unindex removes the given object from the container index.
+// The caller must hold r.m.
+func (r *Registry) unindex(ref types.ManagedObjectReference) {
+	for _, parent := range r.parents[ref] {
+		delete(r.contents[parent], ref)
+		if len(r.contents[parent]) == 0 {
+			delete(r.contents, parent)
+		}
+	}
+	delete(r.parents, ref)
+}
+
+// containers returns the inventory containers of the given object, as walked by a ContainerView:
+// the entity Parent, along with the ResourcePool and HostSystem of a VirtualMachine.
+func containers(item mo.Reference) []types.ManagedObjectReference {
+	me, ok := item.(mo.Entity)
+	if !ok {
+		return nil
+	}
+
+	var refs []types.ManagedObjectReference
+	if parent := me.Entity().Parent; parent != nil {
+		refs = append(refs, *parent)
+	}
+
+	var vm *mo.VirtualMachine
+	switch e := item.(type) {
+	case *VirtualMachine:
+		vm = &e.VirtualMachine
+	case *mo.VirtualMachine:
+		vm = e
+	}
+	if vm != nil {
+		if vm.ResourcePool != nil {
+			refs = append(refs, *vm.ResourcePool)
+		}
+		if vm.Runtime.Host != nil {
+			refs = append(refs, *vm.Runtime.Host)
+		}
+	}
+
+	return refs
+}
+
+// contains returns true if the given object is a direct child of the given container.
+func (r *Registry) contains(container, ref types.ManagedObjectReference) bool {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	_, ok := r.contents[container][ref]
+	return ok
+}
+
+// containersOf returns the inventory containers of the given object, see containers.
+func (r *Registry) containersOf(ref types.ManagedObjectReference) []types.ManagedObjectReference {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	return r.parents[ref]
+}
+
 // Put adds a new object to Registry, generating a ManagedObjectReference if not already set.
 func (r *Registry) Put(item mo.Reference) mo.Reference {
 	r.m.Lock()
@@ -277,7 +428,7 @@ func (r *Registry) Put(item mo.Reference) mo.Reference {
 		me.Entity().EffectiveRole = []int32{-1} // Admin
 	}
 
-	r.objects[r.reference(item)] = item
+	r.add(r.reference(item), item)
 
 	r.m.Unlock()
 
@@ -296,6 +447,8 @@ func (r *Registry) Remove(ctx *Context, item types.ManagedObjectReference) {
 
 	r.m.Lock()
 	delete(r.objects, item)
+	delete(r.kinds[item.Type], item)
+	r.unindex(item)
 	delete(r.handlers, item)
 	delete(r.locks, item)
 	r.m.Unlock()
@@ -320,6 +473,13 @@ func (r *Registry) Update(obj mo.Reference, changes []types.PropertyChange) {
 
 	mo.ApplyPropertyChange(val, changes)
 
+	for _, change := range changes {
+		switch change.Name {
+		case "parent", "resourcePool", "runtime.host":
+			r.reindex(obj)
+		}
+	}
+
 	r.applyHandlers(func(o RegisterObject) {
 		o.UpdateObject(val, changes)
 	})
@@ -567,14 +727,39 @@ func (r *Registry) CustomFieldsManager() *CustomFieldsManager {
 	return r.Get(r.content().CustomFieldsManager.Reference()).(*CustomFieldsManager)
 }
 
+// IpPoolManager returns the IpPoolManager singleton, or nil if the model does not have one (ESX)
+func (r *Registry) IpPoolManager() *IpPoolManager {
+	ref := r.content().IpPoolManager
+	if ref == nil {
+		return nil
+	}
+	m, _ := r.Get(*ref).(*IpPoolManager)
+	return m
+}
+
+// VStorageObjectManager returns the VcenterVStorageObjectManager singleton, or nil if the model does not have one (ESX)
+func (r *Registry) VStorageObjectManager() *VcenterVStorageObjectManager {
+	ref := r.content().VStorageObjectManager
+	if ref == nil {
+		return nil
+	}
+	m, _ := r.Get(*ref).(*VcenterVStorageObjectManager)
+	return m
+}
+
+// LicenseManager returns the LicenseManager singleton
+func (r *Registry) LicenseManager() *LicenseManager {
+	return r.Get(r.content().LicenseManager.Reference()).(*LicenseManager)
+}
+
 // TenantManager returns TenantManager singleton
 func (r *Registry) TenantManager() *TenantManager {
 	return r.Get(r.content().TenantManager.Reference()).(*TenantManager)
 }
 
 func (r *Registry) MarshalJSON() ([]byte, error) {
-	r.m.Lock()
-	defer r.m.Unlock()
+	r.m.RLock()
+	defer r.m.RUnlock()
 
 	vars := struct {
 		Objects int
@@ -615,8 +800,15 @@ func (r *Registry) locker(obj mo.Reference) *internal.ObjectLock {
 		return internal.NewObjectLock(mu)
 	}
 
-	r.m.Lock()
+	r.m.RLock()
 	mu, ok := r.locks[ref]
+	r.m.RUnlock()
+	if ok {
+		return mu
+	}
+
+	r.m.Lock()
+	mu, ok = r.locks[ref]
 	if !ok {
 		mu = internal.NewObjectLock(new(sync.Mutex))
 		r.locks[ref] = mu
@@ -38,12 +38,20 @@ func TestRegistry(t *testing.T) {
 		t.Fail()
 	}
 
+	if len(r.All("Test")) != 1 || r.Any("Test") != e {
+		t.Error("type index")
+	}
+
 	r.Remove(SpoofContext(), ref)
 
 	if r.Get(ref) != nil {
 		t.Fail()
 	}
 
+	if len(r.All("Test")) != 0 || r.Any("Test") != nil {
+		t.Error("type index")
+	}
+
 	r.Put(e)
 	e = r.Get(ref)
 
@@ -67,3 +75,126 @@ func TestRemoveReference(t *testing.T) {
 		t.Errorf("%d", len(refs))
 	}
 }
+
+func TestRegistryContainers(t *testing.T) {
+	r := NewRegistry()
+
+	folder := r.Put(&mo.Folder{}).(*mo.Folder)
+	pool := r.Put(&mo.ResourcePool{}).(*mo.ResourcePool)
+	host := r.Put(&mo.HostSystem{}).(*mo.HostSystem)
+
+	vm := &mo.VirtualMachine{ResourcePool: &pool.Self}
+	vm.Runtime.Host = &host.Self
+	r.PutEntity(folder, vm)
+
+	for _, parent := range []types.ManagedObjectReference{folder.Self, pool.Self, host.Self} {
+		if !r.contains(parent, vm.Self) {
+			t.Errorf("%s does not contain %s", parent, vm.Self)
+		}
+	}
+
+	other := r.Put(&mo.Folder{}).(*mo.Folder)
+	r.Update(vm, []types.PropertyChange{{Name: "parent", Val: other.Self}})
+
+	if r.contains(folder.Self, vm.Self) || !r.contains(other.Self, vm.Self) {
+		t.Error("parent was not reindexed")
+	}
+
+	r.Remove(SpoofContext(), vm.Self)
+
+	if len(r.containersOf(vm.Self)) != 0 || r.contains(pool.Self, vm.Self) {
+		t.Error("removed object is still indexed")
+	}
+}
+
+func benchmarkRegistry(b *testing.B, n int) *Registry {
+	r := NewRegistry()
+
+	for i := 0; i < n; i++ {
+		r.Put(&mo.VirtualMachine{})
+		r.Put(&mo.HostSystem{})
+	}
+
+	b.ResetTimer()
+	return r
+}
+
+func BenchmarkRegistryGet(b *testing.B) {
+	r := benchmarkRegistry(b, 100000)
+	refs := r.AllReference("VirtualMachine")
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if r.Get(refs[i%len(refs)].Reference()) == nil {
+				b.Fatal("not found")
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkRegistryAll(b *testing.B) {
+	r := benchmarkRegistry(b, 100000)
+
+	for i := 0; i < b.N; i++ {
+		if len(r.All("HostSystem")) != 100000 {
+			b.Fatal("HostSystem count")
+		}
+	}
+}
+
+func BenchmarkContainerViewPutObject(b *testing.B) {
+	r := NewRegistry()
+	root := r.Put(&mo.Folder{}).(*mo.Folder)
+
+	// 100 folders of 1000 VMs each
+	for i := 0; i < 100; i++ {
+		folder := r.PutEntity(root, &mo.Folder{}).(*mo.Folder)
+		root.ChildEntity = append(root.ChildEntity, folder.Self)
+		for j := 0; j < 1000; j++ {
+			vm := r.PutEntity(folder, &mo.VirtualMachine{})
+			folder.ChildEntity = append(folder.ChildEntity, vm.Reference())
+		}
+	}
+
+	v := &ContainerView{
+		ContainerView: mo.ContainerView{Container: root.Self, Recursive: true},
+		registry:      r,
+		root:          root,
+		types:         map[string]bool{"VirtualMachine": true},
+		tree:          map[types.ManagedObjectReference]bool{root.Self: true},
+	}
+	v.add(root, make(map[types.ManagedObjectReference]bool))
+	if len(v.View) != 100000 {
+		b.Fatalf("%d VMs", len(v.View))
+	}
+
+	parent := r.Get(root.ChildEntity[len(root.ChildEntity)-1]).(*mo.Folder)
+	vm := &mo.VirtualMachine{}
+	r.PutEntity(parent, vm)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !v.contains(vm) {
+			b.Fatal("not found")
+		}
+	}
+}
+
+func TestFaultMessage(t *testing.T) {
+	if msg := faultMessage(new(types.NotFound)); msg != "NotFound" {
+		t.Errorf("msg=%q", msg)
+	}
+
+	fault := &types.NotFound{
+		VimFault: types.VimFault{
+			MethodFault: types.MethodFault{
+				FaultMessage: []types.LocalizableMessage{{Message: "tag not found"}},
+			},
+		},
+	}
+	if msg := faultMessage(fault); msg != "tag not found" {
+		t.Errorf("msg=%q", msg)
+	}
+}
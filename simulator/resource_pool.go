@@ -40,11 +40,16 @@ func asResourcePoolMO(obj mo.Reference) (*mo.ResourcePool, bool) {
 }
 
 func NewResourcePool() *ResourcePool {
+	return newResourcePool(Map)
+}
+
+// newResourcePool returns a ResourcePool with VApp methods enabled if the given Registry is a vCenter
+func newResourcePool(r *Registry) *ResourcePool {
 	pool := &ResourcePool{
 		ResourcePool: esx.ResourcePool,
 	}
 
-	if Map.IsVPX() {
+	if r.IsVPX() {
 		pool.DisabledMethod = nil // Enable VApp methods for VC
 	}
 
@@ -86,8 +91,8 @@ func allResourceFieldsValid(info *types.ResourceAllocationInfo) bool {
 	return true
 }
 
-func (p *ResourcePool) createChild(name string, spec types.ResourceConfigSpec) (*ResourcePool, *soap.Fault) {
-	if e := Map.FindByName(name, p.ResourcePool.ResourcePool); e != nil {
+func (p *ResourcePool) createChild(ctx *Context, name string, spec types.ResourceConfigSpec) (*ResourcePool, *soap.Fault) {
+	if e := ctx.Map.FindByName(name, p.ResourcePool.ResourcePool); e != nil {
 		return nil, Fault("", &types.DuplicateName{
 			Name:   e.Entity().Name,
 			Object: e.Reference(),
@@ -106,7 +111,7 @@ func (p *ResourcePool) createChild(name string, spec types.ResourceConfigSpec) (
 		})
 	}
 
-	child := NewResourcePool()
+	child := newResourcePool(ctx.Map)
 
 	child.Name = name
 	child.Owner = p.Owner
@@ -118,16 +123,16 @@ func (p *ResourcePool) createChild(name string, spec types.ResourceConfigSpec) (
 	return child, nil
 }
 
-func (p *ResourcePool) CreateResourcePool(c *types.CreateResourcePool) soap.HasFault {
+func (p *ResourcePool) CreateResourcePool(ctx *Context, c *types.CreateResourcePool) soap.HasFault {
 	body := &methods.CreateResourcePoolBody{}
 
-	child, err := p.createChild(c.Name, c.Spec)
+	child, err := p.createChild(ctx, c.Name, c.Spec)
 	if err != nil {
 		body.Fault_ = err
 		return body
 	}
 
-	Map.PutEntity(p, Map.NewEntity(child))
+	ctx.Map.PutEntity(p, ctx.Map.NewEntity(child))
 
 	p.ResourcePool.ResourcePool = append(p.ResourcePool.ResourcePool, child.Reference())
 
@@ -160,11 +165,11 @@ func updateResourceAllocation(kind string, src, dst *types.ResourceAllocationInf
 	return nil
 }
 
-func (p *ResourcePool) UpdateConfig(c *types.UpdateConfig) soap.HasFault {
+func (p *ResourcePool) UpdateConfig(ctx *Context, c *types.UpdateConfig) soap.HasFault {
 	body := &methods.UpdateConfigBody{}
 
 	if c.Name != "" {
-		if e := Map.FindByName(c.Name, p.ResourcePool.ResourcePool); e != nil {
+		if e := ctx.Map.FindByName(c.Name, p.ResourcePool.ResourcePool); e != nil {
 			body.Fault_ = Fault("", &types.DuplicateName{
 				Name:   e.Entity().Name,
 				Object: e.Reference(),
@@ -247,7 +252,7 @@ func (p *ResourcePool) ImportVApp(ctx *Context, req *types.ImportVApp) soap.HasF
 		var file object.DatastorePath
 		file.FromString(info.GetVirtualDeviceFileBackingInfo().FileName)
 		name := path.Base(file.Path)
-		ds := vm.findDatastore(file.Datastore)
+		ds := vm.findDatastore(ctx, file.Datastore)
 		lease.files[name] = path.Join(ds.Info.GetDatastoreInfo().Url, file.Path)
 
 		_, disk := d.(*types.VirtualDisk)
@@ -305,10 +310,10 @@ func NewVAppConfigSpec() types.VAppConfigSpec {
 	return spec
 }
 
-func (p *ResourcePool) CreateVApp(req *types.CreateVApp) soap.HasFault {
+func (p *ResourcePool) CreateVApp(ctx *Context, req *types.CreateVApp) soap.HasFault {
 	body := &methods.CreateVAppBody{}
 
-	pool, err := p.createChild(req.Name, req.ResSpec)
+	pool, err := p.createChild(ctx, req.Name, req.ResSpec)
 	if err != nil {
 		body.Fault_ = err
 		return body
@@ -320,7 +325,7 @@ func (p *ResourcePool) CreateVApp(req *types.CreateVApp) soap.HasFault {
 	child.ParentFolder = req.VmFolder
 
 	if child.ParentFolder == nil {
-		folder := Map.getEntityDatacenter(p).VmFolder
+		folder := ctx.Map.getEntityDatacenter(p).VmFolder
 		child.ParentFolder = &folder
 	}
 
@@ -333,7 +338,7 @@ func (p *ResourcePool) CreateVApp(req *types.CreateVApp) soap.HasFault {
 		child.VAppConfig.Product = append(child.VAppConfig.Product, *product.Info)
 	}
 
-	Map.PutEntity(p, Map.NewEntity(child))
+	ctx.Map.PutEntity(p, ctx.Map.NewEntity(child))
 
 	p.ResourcePool.ResourcePool = append(p.ResourcePool.ResourcePool, child.Reference())
 
@@ -364,7 +369,7 @@ func (a *VirtualApp) CreateChildVMTask(ctx *Context, req *types.CreateChildVM_Ta
 }
 
 func (a *VirtualApp) CloneVAppTask(ctx *Context, req *types.CloneVApp_Task) soap.HasFault {
-	task := CreateTask(a, "cloneVapp", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, a, "cloneVapp", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		folder := req.Spec.VmFolder
 		if folder == nil {
 			folder = a.ParentFolder
@@ -376,7 +381,7 @@ func (a *VirtualApp) CloneVAppTask(ctx *Context, req *types.CloneVApp_Task) soap
 			rspec = &s
 		}
 
-		res := a.CreateVApp(&types.CreateVApp{
+		res := a.CreateVApp(ctx, &types.CreateVApp{
 			This:       a.Self,
 			Name:       req.Name,
 			ResSpec:    *rspec,
@@ -422,8 +427,8 @@ func (a *VirtualApp) CloneVAppTask(ctx *Context, req *types.CloneVApp_Task) soap
 	}
 }
 
-func (a *VirtualApp) CreateVApp(req *types.CreateVApp) soap.HasFault {
-	return (&ResourcePool{ResourcePool: a.ResourcePool}).CreateVApp(req)
+func (a *VirtualApp) CreateVApp(ctx *Context, req *types.CreateVApp) soap.HasFault {
+	return (&ResourcePool{ResourcePool: a.ResourcePool}).CreateVApp(ctx, req)
 }
 
 func (a *VirtualApp) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
@@ -431,7 +436,7 @@ func (a *VirtualApp) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.Has
 }
 
 func (p *ResourcePool) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
-	task := CreateTask(p, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, p, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if strings.HasSuffix(p.Parent.Type, "ComputeResource") {
 			// Can't destroy the root pool
 			return nil, &types.InvalidArgument{}
@@ -452,6 +457,7 @@ func (p *ResourcePool) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.H
 		for _, ref := range vms {
 			vm := ctx.Map.Get(ref).(*VirtualMachine)
 			ctx.WithLock(vm, func() { vm.ResourcePool = &parent.Self })
+			ctx.Map.reindex(vm)
 		}
 
 		ctx.WithLock(parent, func() {
@@ -29,13 +29,13 @@ type SearchIndex struct {
 	mo.SearchIndex
 }
 
-func (s *SearchIndex) FindByDatastorePath(r *types.FindByDatastorePath) soap.HasFault {
+func (s *SearchIndex) FindByDatastorePath(ctx *Context, r *types.FindByDatastorePath) soap.HasFault {
 	res := &methods.FindByDatastorePathBody{Res: new(types.FindByDatastorePathResponse)}
 
-	Map.m.Lock()
-	defer Map.m.Unlock()
+	ctx.Map.m.RLock()
+	defer ctx.Map.m.RUnlock()
 
-	for ref, obj := range Map.objects {
+	for ref, obj := range ctx.Map.kinds["VirtualMachine"] {
 		vm, ok := asVirtualMachineMO(obj)
 		if !ok {
 			continue
@@ -50,7 +50,7 @@ func (s *SearchIndex) FindByDatastorePath(r *types.FindByDatastorePath) soap.Has
 	return res
 }
 
-func (s *SearchIndex) FindByInventoryPath(req *types.FindByInventoryPath) soap.HasFault {
+func (s *SearchIndex) FindByInventoryPath(ctx *Context, req *types.FindByInventoryPath) soap.HasFault {
 	body := &methods.FindByInventoryPathBody{Res: new(types.FindByInventoryPathResponse)}
 
 	split := func(c rune) bool {
@@ -61,11 +61,11 @@ func (s *SearchIndex) FindByInventoryPath(req *types.FindByInventoryPath) soap.H
 		return body
 	}
 
-	root := Map.content().RootFolder
+	root := ctx.Map.content().RootFolder
 	o := &root
 
 	for _, name := range path {
-		f := s.FindChild(&types.FindChild{Entity: *o, Name: name})
+		f := s.FindChild(ctx, &types.FindChild{Entity: *o, Name: name})
 
 		o = f.(*methods.FindChildBody).Res.Returnval
 		if o == nil {
@@ -78,10 +78,10 @@ func (s *SearchIndex) FindByInventoryPath(req *types.FindByInventoryPath) soap.H
 	return body
 }
 
-func (s *SearchIndex) FindChild(req *types.FindChild) soap.HasFault {
+func (s *SearchIndex) FindChild(ctx *Context, req *types.FindChild) soap.HasFault {
 	body := &methods.FindChildBody{}
 
-	obj := Map.Get(req.Entity)
+	obj := ctx.Map.Get(req.Entity)
 
 	if obj == nil {
 		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Entity})
@@ -111,7 +111,7 @@ func (s *SearchIndex) FindChild(req *types.FindChild) soap.HasFault {
 		children = append(children, e.Vm...)
 	}
 
-	match := Map.FindByName(req.Name, children)
+	match := ctx.Map.FindByName(req.Name, children)
 
 	if match != nil {
 		ref := match.Reference()
@@ -121,15 +121,15 @@ func (s *SearchIndex) FindChild(req *types.FindChild) soap.HasFault {
 	return body
 }
 
-func (s *SearchIndex) FindByUuid(req *types.FindByUuid) soap.HasFault {
+func (s *SearchIndex) FindByUuid(ctx *Context, req *types.FindByUuid) soap.HasFault {
 	body := &methods.FindByUuidBody{Res: new(types.FindByUuidResponse)}
 
-	Map.m.Lock()
-	defer Map.m.Unlock()
+	ctx.Map.m.RLock()
+	defer ctx.Map.m.RUnlock()
 
 	if req.VmSearch {
 		// Find Virtual Machine using UUID
-		for ref, obj := range Map.objects {
+		for ref, obj := range ctx.Map.kinds["VirtualMachine"] {
 			vm, ok := asVirtualMachineMO(obj)
 			if !ok {
 				continue
@@ -148,7 +148,7 @@ func (s *SearchIndex) FindByUuid(req *types.FindByUuid) soap.HasFault {
 		}
 	} else {
 		// Find Host System using UUID
-		for ref, obj := range Map.objects {
+		for ref, obj := range ctx.Map.kinds["HostSystem"] {
 			host, ok := asHostSystemMO(obj)
 			if !ok {
 				continue
@@ -163,12 +163,12 @@ func (s *SearchIndex) FindByUuid(req *types.FindByUuid) soap.HasFault {
 	return body
 }
 
-func (s *SearchIndex) FindByDnsName(req *types.FindByDnsName) soap.HasFault {
+func (s *SearchIndex) FindByDnsName(ctx *Context, req *types.FindByDnsName) soap.HasFault {
 	body := &methods.FindByDnsNameBody{Res: new(types.FindByDnsNameResponse)}
 
 	all := types.FindAllByDnsName(*req)
 
-	switch r := s.FindAllByDnsName(&all).(type) {
+	switch r := s.FindAllByDnsName(ctx, &all).(type) {
 	case *methods.FindAllByDnsNameBody:
 		if len(r.Res.Returnval) > 0 {
 			body.Res.Returnval = &r.Res.Returnval[0]
@@ -180,15 +180,15 @@ func (s *SearchIndex) FindByDnsName(req *types.FindByDnsName) soap.HasFault {
 	return body
 }
 
-func (s *SearchIndex) FindAllByDnsName(req *types.FindAllByDnsName) soap.HasFault {
+func (s *SearchIndex) FindAllByDnsName(ctx *Context, req *types.FindAllByDnsName) soap.HasFault {
 	body := &methods.FindAllByDnsNameBody{Res: new(types.FindAllByDnsNameResponse)}
 
-	Map.m.Lock()
-	defer Map.m.Unlock()
+	ctx.Map.m.RLock()
+	defer ctx.Map.m.RUnlock()
 
 	if req.VmSearch {
 		// Find Virtual Machine using DNS name
-		for ref, obj := range Map.objects {
+		for ref, obj := range ctx.Map.kinds["VirtualMachine"] {
 			vm, ok := asVirtualMachineMO(obj)
 			if !ok {
 				continue
@@ -199,7 +199,7 @@ func (s *SearchIndex) FindAllByDnsName(req *types.FindAllByDnsName) soap.HasFaul
 		}
 	} else {
 		// Find Host System using DNS name
-		for ref, obj := range Map.objects {
+		for ref, obj := range ctx.Map.kinds["HostSystem"] {
 			host, ok := asHostSystemMO(obj)
 			if !ok {
 				continue
@@ -215,12 +215,12 @@ func (s *SearchIndex) FindAllByDnsName(req *types.FindAllByDnsName) soap.HasFaul
 	return body
 }
 
-func (s *SearchIndex) FindByIp(req *types.FindByIp) soap.HasFault {
+func (s *SearchIndex) FindByIp(ctx *Context, req *types.FindByIp) soap.HasFault {
 	body := &methods.FindByIpBody{Res: new(types.FindByIpResponse)}
 
 	all := types.FindAllByIp(*req)
 
-	switch r := s.FindAllByIp(&all).(type) {
+	switch r := s.FindAllByIp(ctx, &all).(type) {
 	case *methods.FindAllByIpBody:
 		if len(r.Res.Returnval) > 0 {
 			body.Res.Returnval = &r.Res.Returnval[0]
@@ -232,26 +232,26 @@ func (s *SearchIndex) FindByIp(req *types.FindByIp) soap.HasFault {
 	return body
 }
 
-func (s *SearchIndex) FindAllByIp(req *types.FindAllByIp) soap.HasFault {
+func (s *SearchIndex) FindAllByIp(ctx *Context, req *types.FindAllByIp) soap.HasFault {
 	body := &methods.FindAllByIpBody{Res: new(types.FindAllByIpResponse)}
 
-	Map.m.Lock()
-	defer Map.m.Unlock()
+	ctx.Map.m.RLock()
+	defer ctx.Map.m.RUnlock()
 
 	if req.VmSearch {
 		// Find Virtual Machine using IP
-		for ref, obj := range Map.objects {
+		for ref, obj := range ctx.Map.kinds["VirtualMachine"] {
 			vm, ok := asVirtualMachineMO(obj)
 			if !ok {
 				continue
 			}
-			if vm.Guest.IpAddress == req.Ip {
+			if vm.Guest.IpAddress == req.Ip || guestNetHasIP(vm.Guest.Net, req.Ip) {
 				body.Res.Returnval = append(body.Res.Returnval, ref)
 			}
 		}
 	} else {
 		// Find Host System using IP
-		for ref, obj := range Map.objects {
+		for ref, obj := range ctx.Map.kinds["HostSystem"] {
 			host, ok := asHostSystemMO(obj)
 			if !ok {
 				continue
@@ -266,3 +266,15 @@ func (s *SearchIndex) FindAllByIp(req *types.FindAllByIp) soap.HasFault {
 
 	return body
 }
+
+// guestNetHasIP returns true if any of the given guest NICs has the given ip address
+func guestNetHasIP(nics []types.GuestNicInfo, ip string) bool {
+	for _, nic := range nics {
+		for _, addr := range nic.IpAddress {
+			if addr == ip {
+				return true
+			}
+		}
+	}
+	return false
+}
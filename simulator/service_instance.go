@@ -34,8 +34,8 @@ type ServiceInstance struct {
 }
 
 func NewServiceInstance(ctx *Context, content types.ServiceContent, folder mo.Folder) *ServiceInstance {
-	// TODO: This function ignores the passed in Map and operates on the
-	// global Map.
+	// The ServiceInstance becomes the global Map, use Model.Fork for
+	// additional instances that do not share the global Map.
 	Map = NewRegistry()
 	ctx.Map = Map
 
@@ -44,10 +44,10 @@ func NewServiceInstance(ctx *Context, content types.ServiceContent, folder mo.Fo
 	s.Self = vim25.ServiceInstance
 	s.Content = content
 
-	Map.Put(s)
+	ctx.Map.Put(s)
 
 	f := &Folder{Folder: folder}
-	Map.Put(f)
+	ctx.Map.Put(f)
 
 	if content.About.ApiType == "HostAgent" {
 		CreateDefaultESX(ctx, f)
@@ -58,24 +58,29 @@ func NewServiceInstance(ctx *Context, content types.ServiceContent, folder mo.Fo
 	refs := mo.References(content)
 
 	for i := range refs {
-		if Map.Get(refs[i]) != nil {
+		if ctx.Map.Get(refs[i]) != nil {
 			continue
 		}
 		content := types.ObjectContent{Obj: refs[i]}
-		o, err := loadObject(content)
+		o, err := loadObject(ctx.Map, content)
 		if err != nil {
 			panic(err)
 		}
-		Map.Put(o)
+		ctx.Map.Put(o)
 	}
 
 	return s
 }
 
-func (s *ServiceInstance) RetrieveServiceContent(*types.RetrieveServiceContent) soap.HasFault {
+func (s *ServiceInstance) RetrieveServiceContent(ctx *Context, _ *types.RetrieveServiceContent) soap.HasFault {
+	content := s.Content
+	if v := ctx.hostView(); v != nil {
+		content = v.content
+	}
+
 	return &methods.RetrieveServiceContentBody{
 		Res: &types.RetrieveServiceContentResponse{
-			Returnval: s.Content,
+			Returnval: ctx.versioned(content).(types.ServiceContent),
 		},
 	}
 }
@@ -80,6 +80,7 @@ func createSession(ctx *Context, name string, locale string) types.UserSession {
 			ExtensionSession: types.NewBool(false),
 		},
 		Registry: NewRegistry(),
+		Map:      ctx.VimMap(),
 	}
 
 	ctx.SetSession(session, true)
@@ -122,6 +123,10 @@ func (s *SessionManager) Login(ctx *Context, req *types.Login) soap.HasFault {
 	body := new(methods.LoginBody)
 
 	if s.validLogin(ctx, req) {
+		if fault := ctx.lockdown(req.UserName); fault != nil {
+			body.Fault_ = fault
+			return body
+		}
 		body.Res = &types.LoginResponse{
 			Returnval: createSession(ctx, req.UserName, req.Locale),
 		}
@@ -370,6 +375,15 @@ func (c *Context) SetSession(session Session, login bool) {
 	}
 }
 
+// VimMap returns the vim25 Registry of the Service handling this Context.
+// This differs from Context.Map when serving another SDK endpoint, such as cns or pbm.
+func (c *Context) VimMap() *Registry {
+	if c.svc != nil {
+		return c.svc.registry
+	}
+	return c.Map
+}
+
 // WithLock holds a lock for the given object while the given function is run.
 // It will skip locking if this context already holds the given object's lock.
 func (c *Context) WithLock(obj mo.Reference, f func()) {
@@ -396,6 +410,18 @@ func (c *Context) postEvent(events ...types.BaseEvent) {
 type Session struct {
 	types.UserSession
 	*Registry
+
+	// Map is the service Registry this Session was created for,
+	// used to resolve objects that are not session-scoped.
+	Map *Registry
+}
+
+// vim returns the service Registry of this Session, defaulting to the global Map.
+func (s *Session) vim() *Registry {
+	if s.Map != nil {
+		return s.Map
+	}
+	return Map
 }
 
 func (s *Session) setReference(item mo.Reference) {
@@ -426,7 +452,7 @@ func (s *Session) Get(ref types.ManagedObjectReference) mo.Reference {
 	switch ref.Type {
 	case "SessionManager":
 		// Clone SessionManager so the PropertyCollector can properly report CurrentSession
-		m := *Map.SessionManager()
+		m := *s.vim().SessionManager()
 		m.CurrentSession = &s.UserSession
 
 		// TODO: we could maintain SessionList as part of the SessionManager singleton
@@ -438,10 +464,10 @@ func (s *Session) Get(ref types.ManagedObjectReference) mo.Reference {
 
 		return &m
 	case "PropertyCollector":
-		if ref == Map.content().PropertyCollector {
+		if ref == s.vim().content().PropertyCollector {
 			// Per-session instance of the PropertyCollector singleton.
 			// Using reflection here as PropertyCollector might be wrapped with a custom type.
-			obj = Map.Get(ref)
+			obj = s.vim().Get(ref)
 			pc := reflect.New(reflect.TypeOf(obj).Elem())
 			obj = pc.Interface().(mo.Reference)
 			s.Registry.setReference(obj, ref)
@@ -449,5 +475,5 @@ func (s *Session) Get(ref types.ManagedObjectReference) mo.Reference {
 		}
 	}
 
-	return Map.Get(ref)
+	return s.vim().Get(ref)
 }
@@ -37,6 +37,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -71,11 +73,24 @@ type Method struct {
 
 // Service decodes incoming requests and dispatches to a Handler
 type Service struct {
-	client *vim25.Client
-	sm     *SessionManager
-	sdk    map[string]*Registry
-	funcs  []handleFunc
-	delay  *DelayConfig
+	client   *vim25.Client
+	registry *Registry
+	session  *Session
+	sm       *SessionManager
+	sdk      map[string]*Registry
+	funcs    []handleFunc
+	delay    *DelayConfig
+	strict   bool
+
+	toolsDelay time.Duration
+	hostListen bool
+
+	// tempDir creates a directory that is removed by Model.Remove
+	tempDir func(dc string, name string) (string, error)
+
+	bundles sync.Map // log bundles pending download, see ServeLogBundle
+
+	metrics *metrics
 
 	readAll func(io.Reader) ([]byte, error)
 
@@ -93,14 +108,30 @@ type Server struct {
 	Tunnel int
 
 	caFile string
+	hosts  map[types.ManagedObjectReference]*internal.Server
 }
 
 // New returns an initialized simulator Service instance
 func New(instance *ServiceInstance) *Service {
+	return newService(Map)
+}
+
+// newService returns a Service bound to the given vim25 Registry
+func newService(r *Registry) *Service {
 	s := &Service{
-		readAll: ioutil.ReadAll,
-		sm:      Map.SessionManager(),
-		sdk:     make(map[string]*Registry),
+		readAll:  ioutil.ReadAll,
+		registry: r,
+		sm:       r.SessionManager(),
+		sdk:      make(map[string]*Registry),
+		metrics:  newMetrics(),
+	}
+
+	s.session = &Session{
+		UserSession: types.UserSession{
+			Key: uuid.New().String(),
+		},
+		Registry: NewRegistry(),
+		Map:      r,
 	}
 
 	s.client, _ = vim25.NewClient(context.Background(), s)
@@ -139,6 +170,11 @@ func tracef(format string, v ...interface{}) {
 func (s *Service) call(ctx *Context, method *Method) soap.HasFault {
 	handler := ctx.Map.Get(method.This)
 	session := ctx.Session
+	if v := ctx.hostView(); v != nil {
+		if ref, ok := v.backing(method.This); ok {
+			handler = ctx.Map.Get(ref)
+		}
+	}
 	ctx.Caller = &method.This
 
 	if ctx.Map.Handler != nil {
@@ -187,7 +223,7 @@ func (s *Service) call(ctx *Context, method *Method) soap.HasFault {
 	}
 
 	m := reflect.ValueOf(handler).MethodByName(name)
-	if !m.IsValid() {
+	if !m.IsValid() || !ctx.supports(method.Name) {
 		msg := fmt.Sprintf("%s does not implement: %s", method.This, method.Name)
 		log.Print(msg)
 		fault := &types.MethodNotFound{Receiver: method.This, Method: method.Name}
@@ -221,14 +257,6 @@ func (s *Service) call(ctx *Context, method *Method) soap.HasFault {
 	return res[0].Interface().(soap.HasFault)
 }
 
-// internalSession is the session for use by the in-memory client (Service.RoundTrip)
-var internalSession = &Session{
-	UserSession: types.UserSession{
-		Key: uuid.New().String(),
-	},
-	Registry: NewRegistry(),
-}
-
 // RoundTrip implements the soap.RoundTripper interface in process.
 // Rather than encode/decode SOAP over HTTP, this implementation uses reflection.
 func (s *Service) RoundTrip(ctx context.Context, request, response soap.HasFault) error {
@@ -248,10 +276,11 @@ func (s *Service) RoundTrip(ctx context.Context, request, response soap.HasFault
 		Body: req.Interface(),
 	}
 
+	// s.session is the session for use by the in-memory client
 	res := s.call(&Context{
-		Map:     Map,
+		Map:     s.registry,
 		Context: ctx,
-		Session: internalSession,
+		Session: s.session,
 	}, method)
 
 	if err := res.Fault(); err != nil {
@@ -400,7 +429,9 @@ func (s *Service) Handle(pattern string, handler http.Handler) {
 	// Not ideal, but avoids having to add yet another registration mechanism
 	// so we can optionally use vapi/simulator internally.
 	if m, ok := handler.(tagManager); ok {
-		s.sdk[vim25.Path].tagManager = m
+		r := s.sdk[vim25.Path]
+		r.tagManager = m
+		r.attachTags()
 	}
 }
 
@@ -423,9 +454,11 @@ func (s *Service) HandleFunc(pattern string, handler func(http.ResponseWriter, *
 // If r.Path is already registered, r's objects are added to the existing Registry.
 func (s *Service) RegisterSDK(r *Registry) {
 	if existing, ok := s.sdk[r.Path]; ok {
+		existing.m.Lock()
 		for id, obj := range r.objects {
-			existing.objects[id] = obj
+			existing.add(id, obj)
 		}
+		existing.m.Unlock()
 		return
 	}
 
@@ -480,16 +513,34 @@ func (s *Service) ServeSDK(w http.ResponseWriter, r *http.Request) {
 	var res soap.HasFault
 	var soapBody interface{}
 
-	method, err := UnmarshalBody(ctx.Map.typeFunc, body)
+	method, err := s.unmarshalBody(ctx.Map.typeFunc, body)
 	if err != nil {
-		res = serverFault(err.Error())
+		if verr, ok := err.(*validationError); ok {
+			res = verr.soapFault()
+		} else {
+			res = serverFault(err.Error())
+		}
 	} else {
 		ctx.Header = method.Header
 		if method.Name == "Fetch" {
 			// Redirect any Fetch method calls to the PropertyCollector singleton
 			method.This = ctx.Map.content().PropertyCollector
 		}
+		start := time.Now()
 		res = s.call(ctx, method)
+
+		call := Call{
+			Time:     start,
+			Method:   method.Name,
+			This:     method.This,
+			OpID:     method.Header.ID,
+			Duration: time.Since(start),
+			Fault:    faultName(res),
+		}
+		if ctx.Session != nil {
+			call.User = ctx.Session.UserName
+		}
+		s.metrics.record(call)
 	}
 
 	if f := res.Fault(); f != nil {
@@ -557,7 +608,7 @@ func (s *Service) findDatastore(query url.Values) (*Datastore, error) {
 		return nil, err
 	}
 
-	return Map.Get(ds.Reference()).(*Datastore), nil
+	return s.registry.Get(ds.Reference()).(*Datastore), nil
 }
 
 const folderPrefix = "/folder/"
@@ -612,13 +663,17 @@ func (s *Service) ServiceVersions(w http.ResponseWriter, r *http.Request) {
   <name>urn:vim25</name>
   <version>%s</version>
   <priorVersions>
-   <version>6.0</version>
-   <version>5.5</version>
-  </priorVersions>
+%s  </priorVersions>
  </namespace>
 </namespaces>
 `
-	fmt.Fprintf(w, versions, s.client.ServiceContent.About.ApiVersion)
+	version := s.client.ServiceContent.About.ApiVersion
+	var prior strings.Builder
+	for _, v := range priorVersions(version) {
+		fmt.Fprintf(&prior, "   <version>%s</version>\n", v)
+	}
+
+	fmt.Fprintf(w, versions, version, prior.String())
 }
 
 // defaultIP returns addr.IP if specified, otherwise attempts to find a non-loopback ipv4 IP
@@ -654,17 +709,21 @@ func defaultIP(addr *net.TCPAddr) string {
 
 // NewServer returns an http Server instance for the given service
 func (s *Service) NewServer() *Server {
-	s.RegisterSDK(Map)
+	s.RegisterSDK(s.registry)
 
 	mux := s.ServeMux
-	vim := Map.Path + "/vimService"
+	vim := s.registry.Path + "/vimService"
 	s.sdk[vim] = s.sdk[vim25.Path]
 	mux.HandleFunc(vim, s.ServeSDK)
-	mux.HandleFunc(Map.Path+"/vimServiceVersions.xml", s.ServiceVersions)
+	mux.HandleFunc(s.registry.Path+"/vimServiceVersions.xml", s.ServiceVersions)
 	mux.HandleFunc(folderPrefix, s.ServeDatastore)
 	mux.HandleFunc(guestPrefix, ServeGuest)
 	mux.HandleFunc(nfcPrefix, ServeNFC)
+	mux.HandleFunc(logBundlePrefix, s.ServeLogBundle)
 	mux.HandleFunc("/about", s.About)
+	mux.HandleFunc("/metrics", s.ServeMetrics)
+	mux.HandleFunc("/debug/calls", s.ServeCalls)
+	mux.HandleFunc(controlPath, s.ServeControl)
 
 	if s.Listen == nil {
 		s.Listen = new(url.URL)
@@ -675,17 +734,17 @@ func (s *Service) NewServer() *Server {
 	u := &url.URL{
 		Scheme: "http",
 		Host:   net.JoinHostPort(defaultIP(addr), port),
-		Path:   Map.Path,
+		Path:   s.registry.Path,
 	}
 	if s.TLS != nil {
 		u.Scheme += "s"
 	}
 
 	// Redirect clients to this http server, rather than HostSystem.Name
-	Map.SessionManager().ServiceHostName = u.Host
+	s.sm.ServiceHostName = u.Host
 
 	// Add vcsim config to OptionManager for use by SDK handlers (see lookup/simulator for example)
-	m := Map.OptionManager()
+	m := s.registry.OptionManager()
 	for i := range m.Setting {
 		setting := m.Setting[i].GetOptionValue()
 
@@ -714,10 +773,14 @@ func (s *Service) NewServer() *Server {
 
 	if s.RegisterEndpoints {
 		for i := range endpoints {
-			endpoints[i](s, Map)
+			endpoints[i](s, s.registry)
 		}
 	}
 
+	if n := len(s.registry.tags); n != 0 && s.registry.tagManager == nil {
+		log.Printf("vapi simulator is not registered, tags of %d objects are not attached", n)
+	}
+
 	for _, f := range s.funcs {
 		pattern := &url.URL{Path: f.pattern}
 		endpoint, _ := s.ServeMux.Handler(&http.Request{URL: pattern})
@@ -732,7 +795,7 @@ func (s *Service) NewServer() *Server {
 	if s.TLS != nil {
 		ts.TLS = s.TLS
 		ts.TLS.ClientAuth = tls.RequestClientCert // Used by SessionManager.LoginExtensionByCertificate
-		Map.SessionManager().TLSCert = func() string {
+		s.sm.TLSCert = func() string {
 			return base64.StdEncoding.EncodeToString(ts.TLS.Certificates[0].Certificate[0])
 		}
 		ts.StartTLS()
@@ -740,10 +803,16 @@ func (s *Service) NewServer() *Server {
 		ts.Start()
 	}
 
-	return &Server{
+	server := &Server{
 		Server: ts,
 		URL:    u,
 	}
+
+	if s.TLS != nil && s.hostListen && !s.registry.IsESX() {
+		server.hosts = s.startHostListeners(u)
+	}
+
+	return server
 }
 
 // Certificate returns the TLS certificate for the Server if started with TLS enabled.
@@ -837,6 +906,9 @@ func (s *Server) StartTunnel() error {
 // requests on this server have completed.
 func (s *Server) Close() {
 	s.Server.Close()
+	for _, ts := range s.hosts {
+		ts.Close()
+	}
 	if s.caFile != "" {
 		_ = os.Remove(s.caFile)
 	}
@@ -884,6 +956,16 @@ func (e *Element) Decode(val interface{}) error {
 	return e.decoder().DecodeElement(val, &e.start)
 }
 
+// unmarshalBody validates the request when strict validation is enabled, before calling UnmarshalBody
+func (s *Service) unmarshalBody(typeFunc func(string) (reflect.Type, bool), data []byte) (*Method, error) {
+	if s.strict {
+		if err := validateRequest(typeFunc, data); err != nil {
+			return nil, err
+		}
+	}
+	return UnmarshalBody(typeFunc, data)
+}
+
 // UnmarshalBody extracts the Body from a soap.Envelope and unmarshals to the corresponding govmomi type
 func UnmarshalBody(typeFunc func(string) (reflect.Type, bool), data []byte) (*Method, error) {
 	body := &Element{typeFunc: typeFunc}
@@ -32,8 +32,8 @@ type VirtualMachineSnapshot struct {
 	mo.VirtualMachineSnapshot
 }
 
-func (v *VirtualMachineSnapshot) createSnapshotFiles() types.BaseMethodFault {
-	vm := Map.Get(v.Vm).(*VirtualMachine)
+func (v *VirtualMachineSnapshot) createSnapshotFiles(ctx *Context) types.BaseMethodFault {
+	vm := ctx.Map.Get(v.Vm).(*VirtualMachine)
 
 	snapshotDirectory := vm.Config.Files.SnapshotDirectory
 	if snapshotDirectory == "" {
@@ -43,7 +43,7 @@ func (v *VirtualMachineSnapshot) createSnapshotFiles() types.BaseMethodFault {
 	index := 1
 	for {
 		fileName := fmt.Sprintf("%s-Snapshot%d.vmsn", vm.Name, index)
-		f, err := vm.createFile(snapshotDirectory, fileName, false)
+		f, err := vm.createFile(ctx, snapshotDirectory, fileName, false)
 		if err != nil {
 			switch err.(type) {
 			case *types.FileAlreadyExists:
@@ -57,15 +57,15 @@ func (v *VirtualMachineSnapshot) createSnapshotFiles() types.BaseMethodFault {
 		_ = f.Close()
 
 		p, _ := parseDatastorePath(snapshotDirectory)
-		vm.useDatastore(p.Datastore)
+		vm.useDatastore(ctx, p.Datastore)
 		datastorePath := object.DatastorePath{
 			Datastore: p.Datastore,
 			Path:      path.Join(p.Path, fileName),
 		}
 
-		dataLayoutKey := vm.addFileLayoutEx(datastorePath, 0)
-		vm.addSnapshotLayout(v.Self, dataLayoutKey)
-		vm.addSnapshotLayoutEx(v.Self, dataLayoutKey, -1)
+		dataLayoutKey := vm.addFileLayoutEx(ctx, datastorePath, 0)
+		vm.addSnapshotLayout(ctx, v.Self, dataLayoutKey)
+		vm.addSnapshotLayoutEx(ctx, v.Self, dataLayoutKey, -1)
 
 		return nil
 	}
@@ -110,7 +110,7 @@ func (v *VirtualMachineSnapshot) removeSnapshotFiles(ctx *Context) types.BaseMet
 }
 
 func (v *VirtualMachineSnapshot) RemoveSnapshotTask(ctx *Context, req *types.RemoveSnapshot_Task) soap.HasFault {
-	task := CreateTask(v, "removeSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, v, "removeSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		var changes []types.PropertyChange
 
 		vm := ctx.Map.Get(v.Vm).(*VirtualMachine)
@@ -147,7 +147,7 @@ func (v *VirtualMachineSnapshot) RemoveSnapshotTask(ctx *Context, req *types.Rem
 }
 
 func (v *VirtualMachineSnapshot) RevertToSnapshotTask(ctx *Context, req *types.RevertToSnapshot_Task) soap.HasFault {
-	task := CreateTask(v.Vm, "revertToSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, v.Vm, "revertToSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		vm := ctx.Map.Get(v.Vm).(*VirtualMachine)
 
 		ctx.WithLock(vm, func() {
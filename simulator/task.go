@@ -42,14 +42,15 @@ type Task struct {
 	Execute func(*Task) (types.AnyType, types.BaseMethodFault)
 }
 
-func NewTask(runner TaskRunner) *Task {
+func NewTask(ctx *Context, runner TaskRunner) *Task {
 	ref := runner.Reference()
 	name := reflect.TypeOf(runner).Elem().Name()
 	name = strings.Replace(name, "VM", "Vm", 1) // "VM" for the type to make go-lint happy, but "Vm" for the vmodl ID
-	return CreateTask(ref, name, runner.Run)
+	return CreateTask(ctx, ref, name, runner.Run)
 }
 
-func CreateTask(e mo.Reference, name string, run func(*Task) (types.AnyType, types.BaseMethodFault)) *Task {
+// CreateTask returns a queued Task, registered with the vim25 Registry of the given Context.
+func CreateTask(ctx *Context, e mo.Reference, name string, run func(*Task) (types.AnyType, types.BaseMethodFault)) *Task {
 	ref := e.Reference()
 	id := name
 
@@ -62,7 +63,9 @@ func CreateTask(e mo.Reference, name string, run func(*Task) (types.AnyType, typ
 		Execute: run,
 	}
 
-	task.Self = Map.newReference(task)
+	vimMap := ctx.VimMap()
+
+	task.Self = vimMap.newReference(task)
 	task.Info.Key = task.Self.Value
 	task.Info.Task = task.Self
 	task.Info.Name = ucFirst(name)
@@ -73,7 +76,7 @@ func CreateTask(e mo.Reference, name string, run func(*Task) (types.AnyType, typ
 	task.Info.QueueTime = time.Now()
 	task.Info.State = types.TaskInfoStateQueued
 
-	Map.Put(task)
+	vimMap.Put(task)
 
 	return task
 }
@@ -95,9 +98,7 @@ func (tr *taskReference) Reference() types.ManagedObjectReference {
 
 func (t *Task) Run(ctx *Context) types.ManagedObjectReference {
 	t.ctx = ctx
-	// alias the global Map to reduce data races in tests that reset the
-	// global Map variable.
-	vimMap := Map
+	vimMap := ctx.VimMap()
 
 	vimMap.AtomicUpdate(t.ctx, t, []types.PropertyChange{
 		{Name: "info.startTime", Val: time.Now()},
@@ -155,7 +156,7 @@ func (t *Task) Wait() {
 
 	isRunning := func() bool {
 		var running bool
-		Map.WithLock(isolatedLockingContext, t, func() {
+		t.ctx.VimMap().WithLock(isolatedLockingContext, t, func() {
 			switch t.Info.State {
 			case types.TaskInfoStateSuccess, types.TaskInfoStateError:
 				running = false
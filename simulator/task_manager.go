@@ -30,6 +30,8 @@ var recentTaskMax = 200 // the VC limit
 type TaskManager struct {
 	mo.TaskManager
 	sync.Mutex
+
+	registry *Registry
 }
 
 func (m *TaskManager) init(r *Registry) {
@@ -40,6 +42,7 @@ func (m *TaskManager) init(r *Registry) {
 			m.Description = esx.Description
 		}
 	}
+	m.registry = r
 	r.AddHandler(m)
 }
 
@@ -55,7 +58,7 @@ func (m *TaskManager) PutObject(obj mo.Reference) {
 		recent = recent[1:]
 	}
 
-	Map.Update(m, []types.PropertyChange{{Name: "recentTask", Val: recent}})
+	m.registry.Update(m, []types.PropertyChange{{Name: "recentTask", Val: recent}})
 	m.Unlock()
 }
 
@@ -37,8 +37,10 @@ func TestTaskManagerRecent(t *testing.T) {
 	tm := Map.Get(*esx.ServiceContent.TaskManager).(*TaskManager)
 	tm.RecentTask = nil
 
+	ctx := SpoofContext()
+
 	for i := 0; i < recentTaskMax+2; i++ {
-		CreateTask(esx.RootFolder, "noop", nil)
+		CreateTask(ctx, esx.RootFolder, "noop", nil)
 
 		if len(tm.RecentTask) > recentTaskMax {
 			t.Errorf("too many tasks %d > %d", len(tm.RecentTask), recentTaskMax)
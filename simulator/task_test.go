@@ -38,7 +38,7 @@ func TestNewTask(t *testing.T) {
 	Map.NewEntity(f)
 
 	add := &addWaterTask{f, nil}
-	task := NewTask(add)
+	task := NewTask(SpoofContext(), add)
 	info := &task.Info
 
 	if info.Name != "AddWater_Task" {
@@ -47,9 +47,6 @@ func TestTenantManagerForOldClients(t *testing.T) {
 	// ServiceContent TenantManager field is not present in older (<6.9.1) vmodl
 	// (e.g. response to RetrieveServiceConent() API or propery collector on
 	// ServiceInstance object), this field should be set only if the client is newer.
-	// Currently TenantManager is not set in vpx simulator's ServiceContent, and
-	// would be added once simulator supports client vmodl versioning properly.
-	t.Skip("needs vmodl versioning")
 
 	ctx := context.Background()
 	m := VPX()
@@ -102,9 +99,6 @@ func TestTenantManagerVPX(t *testing.T) {
 	// ServiceContent TenantManager field is not present in older (<6.9.1) vmodl
 	// (e.g. response to RetrieveServiceConent() API or propery collector on
 	// ServiceInstance object), this field should be set only if the client is newer.
-	// Currently TenantManager is not set in vpx simulator's ServiceContent, and
-	// would be added once simulator supports client vmodl versioning properly.
-	t.Skip("needs vmodl versioning")
 
 	ctx := context.Background()
 	m := VPX()
@@ -0,0 +1,377 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vim25/xml"
+)
+
+// validationError is returned by validateRequest when a request does not conform to the WSDL
+type validationError struct {
+	msg   string
+	fault types.BaseMethodFault
+}
+
+func (e *validationError) Error() string {
+	return e.msg
+}
+
+// soapFault returns the validation error as a SOAP fault response body
+func (e *validationError) soapFault() soap.HasFault {
+	return &serverFaultBody{Reason: Fault(e.msg, e.fault)}
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	anyType       = reflect.TypeOf((*interface{})(nil)).Elem()
+	referenceType = reflect.TypeOf(types.ManagedObjectReference{})
+)
+
+// validator walks the XML of a request body, checking it against the generated types
+type validator struct {
+	typeFunc func(string) (reflect.Type, bool)
+	decoder  *xml.Decoder
+}
+
+// validateRequest checks that the SOAP request body only contains known properties, that all required
+// properties are present, that enum properties have a known value and that the xsi:type of a property
+// is compatible with its declared type. The Body is not decoded, UnmarshalBody reports any such errors.
+func validateRequest(typeFunc func(string) (reflect.Type, bool), data []byte) error {
+	body := &Element{typeFunc: typeFunc}
+	req := soap.Envelope{
+		Header: &soap.Header{
+			Security: new(Element),
+		},
+		Body: body,
+	}
+
+	if err := xml.Unmarshal(data, &req); err != nil {
+		return nil
+	}
+
+	v := &validator{typeFunc: typeFunc, decoder: body.decoder()}
+
+	for {
+		tok, err := v.decoder.Token()
+		if err != nil {
+			return nil
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			kind, ok := typeFunc(start.Name.Local)
+			if !ok {
+				return nil
+			}
+			if err, ok := v.element(start, kind, "").(*validationError); ok {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// xsiType returns the value of the element's xsi:type attribute, if any
+func xsiType(start xml.StartElement) string {
+	for _, a := range start.Attr {
+		if a.Name.Local == "type" && (a.Name.Space == "xsi" || strings.HasSuffix(a.Name.Space, "XMLSchema-instance")) {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// lookup resolves a type name as used by xsi:type, such as "VirtualDisk" or "vim25:VirtualDisk"
+func (v *validator) lookup(name string) (reflect.Type, bool) {
+	if kind, ok := v.typeFunc(name); ok {
+		return kind, ok
+	}
+	if i := strings.Index(name, ":"); i > 0 {
+		return v.typeFunc(name[i+1:])
+	}
+	return nil, false
+}
+
+// isSubtype returns true if kind is the base type or embeds the base type, as generated for WSDL type extension
+func isSubtype(kind, base reflect.Type) bool {
+	for {
+		if kind == base {
+			return true
+		}
+		if kind.Kind() != reflect.Struct || kind.NumField() == 0 || !kind.Field(0).Anonymous {
+			return false
+		}
+		kind = kind.Field(0).Type
+	}
+}
+
+// resolve returns the concrete type used to decode the element, given the declared type of the property
+func (v *validator) resolve(start xml.StartElement, kind reflect.Type, path string) (reflect.Type, error) {
+	name := xsiType(start)
+
+	if name == "" {
+		if kind.Kind() != reflect.Interface {
+			return kind, nil
+		}
+		if kind != anyType {
+			if base, ok := v.typeFunc(kind.Name()); ok {
+				return base, nil
+			}
+		}
+		return nil, &validationError{
+			msg:   fmt.Sprintf("Missing xsi:type for property %s of type %s", path, kind.Name()),
+			fault: &types.InvalidRequest{},
+		}
+	}
+
+	if strings.HasPrefix(name, "xsd:") {
+		return nil, nil // primitive value, not validated
+	}
+
+	xtype, ok := v.lookup(name)
+	if !ok {
+		return nil, &validationError{
+			msg:   fmt.Sprintf("Unknown type %q for property %s", name, path),
+			fault: &types.InvalidType{Argument: path},
+		}
+	}
+
+	switch kind.Kind() {
+	case reflect.Interface:
+		if xtype.Implements(kind) || reflect.PtrTo(xtype).Implements(kind) {
+			return xtype, nil
+		}
+	case reflect.Struct:
+		if isSubtype(xtype, kind) {
+			return xtype, nil
+		}
+	default:
+		return kind, nil
+	}
+
+	return nil, &validationError{
+		msg:   fmt.Sprintf("Type %s is not compatible with type %s of property %s", name, strings.TrimPrefix(kind.Name(), "Base"), path),
+		fault: &types.InvalidType{Argument: path},
+	}
+}
+
+// stringEnums maps properties declared as xsd:string in the WSDL to the enum type of their values,
+// where the enum type name is not the declaring type name followed by the property name.
+var stringEnums = map[string]string{
+	"BaseConfigInfoRawDiskMappingBackingInfo.compatibilityMode":  "VirtualDiskCompatibilityMode",
+	"VirtualDiskFlatVer1BackingInfo.diskMode":                    "VirtualDiskMode",
+	"VirtualDiskFlatVer2BackingInfo.diskMode":                    "VirtualDiskMode",
+	"VirtualDiskLocalPMemBackingInfo.diskMode":                   "VirtualDiskMode",
+	"VirtualDiskRawDiskMappingVer1BackingInfo.compatibilityMode": "VirtualDiskCompatibilityMode",
+	"VirtualDiskRawDiskMappingVer1BackingInfo.diskMode":          "VirtualDiskMode",
+	"VirtualDiskSeSparseBackingInfo.diskMode":                    "VirtualDiskMode",
+	"VirtualDiskSparseVer1BackingInfo.diskMode":                  "VirtualDiskMode",
+	"VirtualDiskSparseVer2BackingInfo.diskMode":                  "VirtualDiskMode",
+	"VirtualEthernetCard.addressType":                            "VirtualEthernetCardMacType",
+	"VirtualMachineConfigSpec.npivWorldWideNameOp":               "VirtualMachineConfigSpecNpivWwnOp",
+	"VirtualMachineConfigSpec.swapPlacement":                     "VirtualMachineConfigInfoSwapPlacementType",
+	"VirtualMachineConfigSpec.firmware":                          "GuestOsDescriptorFirmwareType",
+	"VirtualMachineConfigSpec.migrateEncryption":                 "VirtualMachineConfigSpecEncryptedVMotionModes",
+	"VirtualMachineConfigSpec.ftEncryptionMode":                  "VirtualMachineConfigSpecEncryptedFtModes",
+	"VirtualMachineDefaultPowerOpInfo.powerOffType":              "VirtualMachinePowerOpType",
+	"VirtualMachineDefaultPowerOpInfo.suspendType":               "VirtualMachinePowerOpType",
+	"VirtualMachineDefaultPowerOpInfo.resetType":                 "VirtualMachinePowerOpType",
+	"VirtualMachineDefaultPowerOpInfo.standbyAction":             "VirtualMachineStandbyActionType",
+	"VirtualMachineFlagInfo.snapshotPowerOffBehavior":            "VirtualMachinePowerOffBehavior",
+}
+
+// field describes a property of a generated type
+type field struct {
+	kind     reflect.Type
+	required bool
+	enum     reflect.Type // enum type of an xsd:string property, if any
+}
+
+// stringEnum returns the enum type of the given string property declared by kind, if any.
+// The generated enum type name is typically the declaring type name followed by the property name,
+// such as VirtualDeviceConnectInfoStatus for VirtualDeviceConnectInfo.status.
+func (v *validator) stringEnum(kind reflect.Type, f reflect.StructField, name string) reflect.Type {
+	elem := f.Type
+	if elem.Kind() == reflect.Slice {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.String || elem.PkgPath() != "" {
+		return nil
+	}
+
+	enum, ok := stringEnums[kind.Name()+"."+name]
+	if !ok {
+		enum = kind.Name() + f.Name
+	}
+
+	if etype, ok := v.typeFunc(enum); ok && etype.Kind() == reflect.String {
+		return etype
+	}
+
+	return nil
+}
+
+// fields returns the properties of the given struct type, including properties of embedded base types
+func (v *validator) fields(kind reflect.Type, props map[string]field) {
+	for i := 0; i < kind.NumField(); i++ {
+		f := kind.Field(i)
+		tag := f.Tag.Get("xml")
+
+		if f.Anonymous && tag == "" {
+			v.fields(f.Type, props)
+			continue
+		}
+
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+		if name == "" || name == "-" || strings.Contains(name, " ") {
+			continue // attr, chardata, innerxml and the XMLName field
+		}
+
+		required := f.Type.Kind() != reflect.Slice && f.Type.Kind() != reflect.Ptr
+		for _, opt := range opts[1:] {
+			if opt == "omitempty" || opt == "attr" {
+				required = false
+			}
+		}
+
+		props[name] = field{kind: f.Type, required: required, enum: v.stringEnum(kind, f, name)}
+	}
+}
+
+// element validates the element start, given the declared type of the property
+func (v *validator) element(start xml.StartElement, kind reflect.Type, path string) error {
+	for kind.Kind() == reflect.Ptr || (kind.Kind() == reflect.Slice && kind.Elem().Kind() != reflect.Uint8) {
+		kind = kind.Elem()
+	}
+
+	kind, err := v.resolve(start, kind, path)
+	if err != nil {
+		return err
+	}
+	if kind == nil {
+		return v.decoder.Skip()
+	}
+
+	switch {
+	case kind == timeType || kind == referenceType:
+		return v.decoder.Skip()
+	case kind.Kind() == reflect.Struct:
+		return v.object(kind, path)
+	case kind.Kind() == reflect.String && kind.PkgPath() != "":
+		return v.enum(kind, path, false)
+	default:
+		return v.decoder.Skip()
+	}
+}
+
+// object validates the properties of a data object
+func (v *validator) object(kind reflect.Type, path string) error {
+	props := make(map[string]field)
+	v.fields(kind, props)
+	seen := make(map[string]bool)
+
+	for {
+		tok, err := v.decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			prop := name
+			if path != "" {
+				prop = path + "." + name
+			}
+
+			f, ok := props[name]
+			if !ok {
+				return &validationError{
+					msg:   fmt.Sprintf("Unexpected element tag %q seen in data object of type %s", name, kind.Name()),
+					fault: &types.InvalidRequest{},
+				}
+			}
+			seen[name] = true
+
+			if f.enum != nil {
+				err = v.enum(f.enum, prop, true)
+			} else {
+				err = v.element(t, f.kind, prop)
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			for name, f := range props {
+				if f.required && !seen[name] {
+					return &validationError{
+						msg:   fmt.Sprintf("Required property %s is missing from data object of type %s", name, kind.Name()),
+						fault: &types.InvalidRequest{},
+					}
+				}
+			}
+			return nil
+		}
+	}
+}
+
+// enum validates that the element value is a known value of the given enum type.
+// An empty value is valid for xsd:string properties.
+func (v *validator) enum(kind reflect.Type, path string, xsdString bool) error {
+	var val string
+
+	for done := false; !done; {
+		tok, err := v.decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.CharData:
+			val += string(t)
+		case xml.StartElement:
+			if err = v.decoder.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			done = true
+		}
+	}
+
+	values := reflect.Zero(kind).MethodByName("Values")
+	if !values.IsValid() || (xsdString && val == "") {
+		return nil
+	}
+
+	known := values.Call(nil)[0]
+	for i := 0; i < known.Len(); i++ {
+		if known.Index(i).String() == val {
+			return nil
+		}
+	}
+
+	return &validationError{
+		msg:   fmt.Sprintf("A specified parameter was not correct: %s", path),
+		fault: &types.InvalidArgument{InvalidProperty: path},
+	}
+}
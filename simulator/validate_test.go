@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestStrictValidation(t *testing.T) {
+	envelope := func(body string) string {
+		return `<?xml version="1.0" encoding="UTF-8"?>
+<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"
+ xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns="urn:vim25">
+<soapenv:Body>` + body + `</soapenv:Body></soapenv:Envelope>`
+	}
+
+	tests := []struct {
+		name  string
+		body  string
+		fault string
+	}{
+		{
+			"valid",
+			`<RetrieveServiceContent><_this type="ServiceInstance">ServiceInstance</_this></RetrieveServiceContent>`,
+			"",
+		},
+		{
+			"unknown property",
+			`<RetrieveServiceContent><_this type="ServiceInstance">ServiceInstance</_this><foo>bar</foo></RetrieveServiceContent>`,
+			"Unexpected element tag",
+		},
+		{
+			"missing required property",
+			`<RetrieveServiceContent></RetrieveServiceContent>`,
+			"Required property _this is missing",
+		},
+		{
+			"invalid enum",
+			`<MigrateVM_Task><_this type="VirtualMachine">vm-1</_this><priority>urgent</priority></MigrateVM_Task>`,
+			"InvalidArgument",
+		},
+		{
+			"incompatible type",
+			`<ReconfigVM_Task><_this type="VirtualMachine">vm-1</_this><spec><deviceChange>
+<operation>add</operation><device xsi:type="VirtualMachineConfigSpec"></device>
+</deviceChange></spec></ReconfigVM_Task>`,
+			"InvalidType",
+		},
+		{
+			"invalid string enum",
+			`<ReconfigVM_Task><_this type="VirtualMachine">vm-1</_this><spec><deviceChange>
+<operation>add</operation><device xsi:type="VirtualDisk"><key>-1</key><capacityInKB>1024</capacityInKB>
+<backing xsi:type="VirtualDiskFlatVer2BackingInfo"><fileName></fileName><diskMode>bogus</diskMode></backing>
+</device></deviceChange></spec></ReconfigVM_Task>`,
+			"spec.deviceChange.device.backing.diskMode",
+		},
+		{
+			"unknown type",
+			`<ReconfigVM_Task><_this type="VirtualMachine">vm-1</_this><spec><deviceChange>
+<operation>add</operation><device xsi:type="VirtualFloppyDisk"></device>
+</deviceChange></spec></ReconfigVM_Task>`,
+			"InvalidType",
+		},
+	}
+
+	m := VPX()
+	m.StrictValidation = true
+
+	err := m.Run(func(ctx context.Context, c *vim25.Client) error {
+		u := c.URL().String()
+
+		for _, test := range tests {
+			test := test
+			t.Run(test.name, func(t *testing.T) {
+				res, err := c.Client.Post(u, "text/xml", strings.NewReader(envelope(test.body)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				body, _ := ioutil.ReadAll(res.Body)
+				_ = res.Body.Close()
+
+				if test.fault == "" {
+					if res.StatusCode != http.StatusOK {
+						t.Errorf("status=%d: %s", res.StatusCode, body)
+					}
+					return
+				}
+
+				if !strings.Contains(string(body), test.fault) {
+					t.Errorf("expected %s fault: %s", test.fault, body)
+				}
+			})
+		}
+
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		// required enum property with an empty value
+		spec := types.VirtualMachineConfigSpec{
+			LatencySensitivity: &types.LatencySensitivity{Sensitivity: 1},
+		}
+
+		_, err = vm.Reconfigure(ctx, spec)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		fault, ok := soap.ToSoapFault(err).VimFault().(types.InvalidArgument)
+		if !ok {
+			t.Fatalf("fault=%#v", soap.ToSoapFault(err).VimFault())
+		}
+		if fault.InvalidProperty != "spec.latencySensitivity.level" {
+			t.Errorf("invalidProperty=%s", fault.InvalidProperty)
+		}
+
+		// the same request is accepted when strict validation is disabled
+		m.Service.strict = false
+
+		task, err := vm.Reconfigure(ctx, spec)
+		if err != nil {
+			return err
+		}
+		return task.Wait(ctx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+type validateBase struct {
+	Name string `xml:"name"`
+}
+
+type validateSubtype struct {
+	validateBase
+
+	Extra string `xml:"extra"`
+}
+
+type validateRequestType struct {
+	This types.ManagedObjectReference `xml:"_this"`
+	Base validateBase                 `xml:"base"`
+}
+
+func TestValidateSubtype(t *testing.T) {
+	kinds := map[string]reflect.Type{
+		"ValidateRequest": reflect.TypeOf(validateRequestType{}),
+		"ValidateBase":    reflect.TypeOf(validateBase{}),
+		"ValidateSubtype": reflect.TypeOf(validateSubtype{}),
+		"OptionValue":     reflect.TypeOf(types.OptionValue{}),
+	}
+	typeFunc := func(name string) (reflect.Type, bool) {
+		kind, ok := kinds[name]
+		return kind, ok
+	}
+
+	envelope := func(base string) []byte {
+		return []byte(`<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"
+ xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><Body>
+<ValidateRequest xmlns="urn:vim25"><_this type="Folder">group-d1</_this>` + base + `</ValidateRequest></Body></Envelope>`)
+	}
+
+	// fields of the xsi:type subtype are valid
+	err := validateRequest(typeFunc, envelope(`<base xsi:type="ValidateSubtype"><name>a</name><extra>b</extra></base>`))
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = validateRequest(typeFunc, envelope(`<base><name>a</name><extra>b</extra></base>`))
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	err = validateRequest(typeFunc, envelope(`<base xsi:type="OptionValue"><name>a</name></base>`))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
@@ -18,6 +18,7 @@ package simulator
 
 import (
 	"reflect"
+	"sync"
 
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
@@ -69,7 +70,14 @@ func destroyView(ref types.ManagedObjectReference) soap.HasFault {
 func (m *ViewManager) CreateContainerView(ctx *Context, req *types.CreateContainerView) soap.HasFault {
 	body := &methods.CreateContainerViewBody{}
 
-	root := ctx.Map.Get(req.Container)
+	var root mo.Reference
+	view := ctx.hostView()
+	if view != nil {
+		root = view.get(req.Container)
+	}
+	if root == nil {
+		root = ctx.Map.Get(req.Container)
+	}
 	if root == nil {
 		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Container})
 		return body
@@ -81,13 +89,16 @@ func (m *ViewManager) CreateContainerView(ctx *Context, req *types.CreateContain
 	}
 
 	container := &ContainerView{
-		mo.ContainerView{
+		ContainerView: mo.ContainerView{
 			Container: root.Reference(),
 			Recursive: req.Recursive,
 			Type:      req.Type,
 		},
-		root,
-		make(map[string]bool),
+		registry: ctx.Map,
+		view:     view,
+		root:     root,
+		types:    make(map[string]bool),
+		tree:     map[types.ManagedObjectReference]bool{root.Reference(): true},
 	}
 
 	for _, ctype := range container.Type {
@@ -124,8 +135,23 @@ func (m *ViewManager) CreateContainerView(ctx *Context, req *types.CreateContain
 type ContainerView struct {
 	mo.ContainerView
 
-	root  mo.Reference
-	types map[string]bool
+	registry *Registry
+	view     *hostView
+	root     mo.Reference
+	types    map[string]bool
+
+	m    sync.Mutex
+	tree map[types.ManagedObjectReference]bool // the container and the objects within, if Recursive
+}
+
+// get returns the given object, using the host agent view if the container was created via a host listener
+func (v *ContainerView) get(ref types.ManagedObjectReference) mo.Reference {
+	if v.view != nil {
+		if obj := v.view.get(ref); obj != nil {
+			return obj
+		}
+	}
+	return v.registry.Get(ref)
 }
 
 func (v *ContainerView) DestroyView(ctx *Context, c *types.DestroyView) soap.HasFault {
@@ -177,6 +203,10 @@ func walk(root mo.Reference, f func(child types.ManagedObjectReference)) {
 
 func (v *ContainerView) add(root mo.Reference, seen map[types.ManagedObjectReference]bool) {
 	walk(root, func(child types.ManagedObjectReference) {
+		if v.Recursive {
+			v.tree[child] = true
+		}
+
 		if v.include(child) {
 			if !seen[child] {
 				seen[child] = true
@@ -185,7 +215,7 @@ func (v *ContainerView) add(root mo.Reference, seen map[types.ManagedObjectRefer
 		}
 
 		if v.Recursive {
-			v.add(Map.Get(child), seen)
+			v.add(v.get(child), seen)
 		}
 	})
 }
@@ -200,32 +230,131 @@ func (v *ContainerView) find(root mo.Reference, ref types.ManagedObjectReference
 			return
 		}
 		if v.Recursive {
-			*found = v.find(Map.Get(child), ref, found)
+			*found = v.find(v.get(child), ref, found)
 		}
 	})
 
 	return *found
 }
 
+// contains returns true if the given object is within the view's container.
+// Direct children of the container are found via the Registry container index.
+// For a recursive view, the object is contained if any of its containers is within the view's tree,
+// which avoids walking the container tree or the parent chain for each new object.
+// Views created via a host listener are walked from the root.
+func (v *ContainerView) contains(obj mo.Reference) bool {
+	ref := obj.Reference()
+
+	if v.view != nil {
+		return v.find(v.root, ref, types.NewBool(false))
+	}
+
+	if !v.Recursive {
+		return v.registry.contains(v.Container, ref)
+	}
+
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	if v.within(ref) {
+		v.tree[ref] = true // children of this object are also within the view
+		return true
+	}
+
+	return false
+}
+
 func (v *ContainerView) PutObject(obj mo.Reference) {
 	ref := obj.Reference()
 
-	if v.include(ref) && v.find(v.root, ref, types.NewBool(false)) {
-		Map.Update(v, []types.PropertyChange{{Name: "view", Val: append(v.View, ref)}})
+	if v.contains(obj) {
+		if v.include(ref) {
+			v.registry.Update(v, []types.PropertyChange{{Name: "view", Val: append(v.View, ref)}})
+		}
+		return
+	}
+
+	v.reindex(obj) // a Folder moved out of the view is removed and put in its new parent
+}
+
+// reindex removes the given object and its children from a recursive view,
+// if the object has been moved out of the view's container.
+func (v *ContainerView) reindex(obj mo.Reference) {
+	if !v.Recursive || v.view != nil {
+		return
+	}
+
+	removed := make(map[types.ManagedObjectReference]bool)
+
+	v.m.Lock()
+	if v.tree[obj.Reference()] && !v.within(obj.Reference()) {
+		delete(v.tree, obj.Reference())
+		removed[obj.Reference()] = true
+	}
+	v.prune(obj, removed)
+	v.m.Unlock()
+
+	var view []types.ManagedObjectReference
+	for _, ref := range v.View {
+		if !removed[ref] {
+			view = append(view, ref)
+		}
+	}
+
+	if len(view) != len(v.View) {
+		v.registry.Update(v, []types.PropertyChange{{Name: "view", Val: view}})
+	}
+}
+
+// within returns true if any container of the given object is within the view's tree.
+// The caller must hold v.m.
+func (v *ContainerView) within(ref types.ManagedObjectReference) bool {
+	for _, parent := range v.registry.containersOf(ref) {
+		if v.tree[parent] {
+			return true
+		}
 	}
+	return false
+}
+
+// prune removes the children of the given object from the view's tree, unless a child is
+// still within the view via another container, such as the HostSystem of a VirtualMachine.
+// The caller must hold v.m.
+func (v *ContainerView) prune(obj mo.Reference, removed map[types.ManagedObjectReference]bool) {
+	walk(obj, func(child types.ManagedObjectReference) {
+		if !v.tree[child] || v.within(child) {
+			return
+		}
+		delete(v.tree, child)
+		removed[child] = true
+		v.prune(v.get(child), removed)
+	})
 }
 
 func (v *ContainerView) RemoveObject(ctx *Context, obj types.ManagedObjectReference) {
+	v.m.Lock()
+	delete(v.tree, obj)
+	v.m.Unlock()
+
 	ctx.Map.RemoveReference(ctx, v, &v.View, obj)
 }
 
-func (*ContainerView) UpdateObject(mo.Reference, []types.PropertyChange) {}
+func (v *ContainerView) UpdateObject(obj mo.Reference, changes []types.PropertyChange) {
+	for _, change := range changes {
+		switch change.Name {
+		case "parent", "resourcePool", "runtime.host":
+			// see Registry.Update, which has reindexed the object's containers
+			v.reindex(obj)
+			return
+		}
+	}
+}
 
 func (m *ViewManager) CreateListView(ctx *Context, req *types.CreateListView) soap.HasFault {
 	body := new(methods.CreateListViewBody)
 	list := new(ListView)
 
-	if err := list.add(req.Obj); err != nil {
+	if err := list.add(ctx, req.Obj); err != nil {
 		body.Fault_ = Fault("", err)
 		return body
 	}
@@ -243,13 +372,13 @@ type ListView struct {
 	mo.ListView
 }
 
-func (v *ListView) update() {
-	Map.Update(v, []types.PropertyChange{{Name: "view", Val: v.View}})
+func (v *ListView) update(ctx *Context) {
+	ctx.Map.Update(v, []types.PropertyChange{{Name: "view", Val: v.View}})
 }
 
-func (v *ListView) add(refs []types.ManagedObjectReference) *types.ManagedObjectNotFound {
+func (v *ListView) add(ctx *Context, refs []types.ManagedObjectReference) *types.ManagedObjectNotFound {
 	for _, ref := range refs {
-		obj := Map.Get(ref)
+		obj := ctx.Map.Get(ref)
 		if obj == nil {
 			return &types.ManagedObjectNotFound{Obj: ref}
 		}
@@ -263,14 +392,14 @@ func (v *ListView) DestroyView(ctx *Context, c *types.DestroyView) soap.HasFault
 	return destroyView(c.This)
 }
 
-func (v *ListView) ModifyListView(req *types.ModifyListView) soap.HasFault {
+func (v *ListView) ModifyListView(ctx *Context, req *types.ModifyListView) soap.HasFault {
 	body := new(methods.ModifyListViewBody)
 
 	for _, ref := range req.Remove {
 		RemoveReference(&v.View, ref)
 	}
 
-	if err := v.add(req.Add); err != nil {
+	if err := v.add(ctx, req.Add); err != nil {
 		body.Fault_ = Fault("", err)
 		return body
 	}
@@ -278,25 +407,25 @@ func (v *ListView) ModifyListView(req *types.ModifyListView) soap.HasFault {
 	body.Res = new(types.ModifyListViewResponse)
 
 	if len(req.Remove) != 0 || len(req.Add) != 0 {
-		v.update()
+		v.update(ctx)
 	}
 
 	return body
 }
 
-func (v *ListView) ResetListView(req *types.ResetListView) soap.HasFault {
+func (v *ListView) ResetListView(ctx *Context, req *types.ResetListView) soap.HasFault {
 	body := new(methods.ResetListViewBody)
 
 	v.View = nil
 
-	if err := v.add(req.Obj); err != nil {
+	if err := v.add(ctx, req.Obj); err != nil {
 		body.Fault_ = Fault("", err)
 		return body
 	}
 
 	body.Res = new(types.ResetListViewResponse)
 
-	v.update()
+	v.update(ctx)
 
 	return body
 }
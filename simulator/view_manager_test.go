@@ -24,6 +24,7 @@ import (
 	"github.com/vmware/govmomi/vim25"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/view"
@@ -198,3 +199,150 @@ func TestViewManager_CreateContainerView(t *testing.T) {
 		t.Errorf("Failed to run simulation: %s", err.Error())
 	}
 }
+
+func TestContainerViewPutObject(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.Datacenter(ctx, "DC0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := view.NewManager(c)
+		recursive, err := m.CreateContainerView(ctx, c.ServiceContent.RootFolder, []string{"VirtualMachine"}, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		direct, err := m.CreateContainerView(ctx, folders.VmFolder.Reference(), []string{"VirtualMachine"}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// a VM within a Folder created after the views
+		folder, err := folders.VmFolder.CreateFolder(ctx, "new")
+		if err != nil {
+			t.Fatal(err)
+		}
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		task, err := folder.MoveInto(ctx, []types.ManagedObjectReference{vm.Reference()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		contains := func(v *view.ContainerView) bool {
+			var content mo.ContainerView
+			if err := v.Properties(ctx, v.Reference(), []string{"view"}, &content); err != nil {
+				t.Fatal(err)
+			}
+			for _, ref := range content.View {
+				if ref == vm.Reference() {
+					return true
+				}
+			}
+			return false
+		}
+
+		if !contains(recursive) {
+			t.Error("recursive view does not contain the moved VM")
+		}
+		if contains(direct) {
+			t.Error("non-recursive view contains the moved VM")
+		}
+	})
+}
+
+func TestContainerViewMoveOut(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.Datacenter(ctx, "DC0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		root, err := folders.VmFolder.CreateFolder(ctx, "root")
+		if err != nil {
+			t.Fatal(err)
+		}
+		folder, err := root.CreateFolder(ctx, "child")
+		if err != nil {
+			t.Fatal(err)
+		}
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		move := func(f *object.Folder, ref types.ManagedObjectReference) {
+			task, err := f.MoveInto(ctx, []types.ManagedObjectReference{ref})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		move(folder, vm.Reference())
+
+		m := view.NewManager(c)
+		v, err := m.CreateContainerView(ctx, root.Reference(), nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		contains := func(ref types.ManagedObjectReference) bool {
+			var content mo.ContainerView
+			if err := v.Properties(ctx, v.Reference(), []string{"view"}, &content); err != nil {
+				t.Fatal(err)
+			}
+			for _, item := range content.View {
+				if item == ref {
+					return true
+				}
+			}
+			return false
+		}
+
+		if !contains(folder.Reference()) || !contains(vm.Reference()) {
+			t.Fatal("view does not contain the folder and its VM")
+		}
+
+		move(folders.VmFolder, folder.Reference())
+
+		if contains(folder.Reference()) {
+			t.Error("view contains the folder moved out of its container")
+		}
+		if contains(vm.Reference()) {
+			t.Error("view contains the VM of the folder moved out of its container")
+		}
+
+		// a Folder created within the moved folder is not within the view either
+		_, err = folder.CreateFolder(ctx, "grandchild")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var content mo.ContainerView
+		if err = v.Properties(ctx, v.Reference(), []string{"view"}, &content); err != nil {
+			t.Fatal(err)
+		}
+		if len(content.View) != 0 {
+			t.Errorf("view=%v", content.View)
+		}
+	})
+}
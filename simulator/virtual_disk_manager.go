@@ -44,10 +44,10 @@ func vdmNames(name string) []string {
 	}
 }
 
-func vdmCreateVirtualDisk(op types.VirtualDeviceConfigSpecFileOperation, req *types.CreateVirtualDisk_Task) types.BaseMethodFault {
-	fm := Map.FileManager()
+func vdmCreateVirtualDisk(ctx *Context, op types.VirtualDeviceConfigSpecFileOperation, req *types.CreateVirtualDisk_Task) types.BaseMethodFault {
+	fm := ctx.Map.FileManager()
 
-	file, fault := fm.resolve(req.Datacenter, req.Name)
+	file, fault := fm.resolve(ctx, req.Datacenter, req.Name)
 	if fault != nil {
 		return fault
 	}
@@ -83,8 +83,8 @@ func vdmCreateVirtualDisk(op types.VirtualDeviceConfigSpecFileOperation, req *ty
 }
 
 func (m *VirtualDiskManager) CreateVirtualDiskTask(ctx *Context, req *types.CreateVirtualDisk_Task) soap.HasFault {
-	task := CreateTask(m, "createVirtualDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
-		if err := vdmCreateVirtualDisk(types.VirtualDeviceConfigSpecFileOperationCreate, req); err != nil {
+	task := CreateTask(ctx, m, "createVirtualDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		if err := vdmCreateVirtualDisk(ctx, types.VirtualDeviceConfigSpecFileOperationCreate, req); err != nil {
 			return "", err
 		}
 		return req.Name, nil
@@ -98,11 +98,11 @@ func (m *VirtualDiskManager) CreateVirtualDiskTask(ctx *Context, req *types.Crea
 }
 
 func (m *VirtualDiskManager) DeleteVirtualDiskTask(ctx *Context, req *types.DeleteVirtualDisk_Task) soap.HasFault {
-	task := CreateTask(m, "deleteVirtualDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
-		fm := Map.FileManager()
+	task := CreateTask(ctx, m, "deleteVirtualDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		fm := ctx.Map.FileManager()
 
 		for _, name := range vdmNames(req.Name) {
-			err := fm.deleteDatastoreFile(&types.DeleteDatastoreFile_Task{
+			err := fm.deleteDatastoreFile(ctx, &types.DeleteDatastoreFile_Task{
 				Name:       name,
 				Datacenter: req.Datacenter,
 			})
@@ -123,13 +123,13 @@ func (m *VirtualDiskManager) DeleteVirtualDiskTask(ctx *Context, req *types.Dele
 }
 
 func (m *VirtualDiskManager) MoveVirtualDiskTask(ctx *Context, req *types.MoveVirtualDisk_Task) soap.HasFault {
-	task := CreateTask(m, "moveVirtualDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, m, "moveVirtualDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		fm := ctx.Map.FileManager()
 
 		dest := vdmNames(req.DestName)
 
 		for i, name := range vdmNames(req.SourceName) {
-			err := fm.moveDatastoreFile(&types.MoveDatastoreFile_Task{
+			err := fm.moveDatastoreFile(ctx, &types.MoveDatastoreFile_Task{
 				SourceName:            name,
 				SourceDatacenter:      req.SourceDatacenter,
 				DestinationName:       dest[i],
@@ -153,7 +153,7 @@ func (m *VirtualDiskManager) MoveVirtualDiskTask(ctx *Context, req *types.MoveVi
 }
 
 func (m *VirtualDiskManager) CopyVirtualDiskTask(ctx *Context, req *types.CopyVirtualDisk_Task) soap.HasFault {
-	task := CreateTask(m, "copyVirtualDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, m, "copyVirtualDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		if req.DestSpec != nil {
 			if ctx.Map.IsVPX() {
 				return nil, new(types.NotImplemented)
@@ -165,7 +165,7 @@ func (m *VirtualDiskManager) CopyVirtualDiskTask(ctx *Context, req *types.CopyVi
 		dest := vdmNames(req.DestName)
 
 		for i, name := range vdmNames(req.SourceName) {
-			err := fm.copyDatastoreFile(&types.CopyDatastoreFile_Task{
+			err := fm.copyDatastoreFile(ctx, &types.CopyDatastoreFile_Task{
 				SourceName:            name,
 				SourceDatacenter:      req.SourceDatacenter,
 				DestinationName:       dest[i],
@@ -200,7 +200,7 @@ func (m *VirtualDiskManager) QueryVirtualDiskUuid(ctx *Context, req *types.Query
 
 	fm := ctx.Map.FileManager()
 
-	file, fault := fm.resolve(req.Datacenter, req.Name)
+	file, fault := fm.resolve(ctx, req.Datacenter, req.Name)
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
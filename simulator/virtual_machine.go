@@ -18,6 +18,7 @@ package simulator
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -49,6 +50,11 @@ type VirtualMachine struct {
 	run container
 	uid uuid.UUID
 	imc *types.CustomizationSpec
+	ipt *time.Timer // pending guest IP assignment
+	tt  *time.Timer // pending guest tools start
+
+	profile     string           // storage policy associated with the VM home
+	diskProfile map[int32]string // storage policies associated with virtual disks, by device key
 }
 
 func asVirtualMachineMO(obj mo.Reference) (*mo.VirtualMachine, bool) {
@@ -163,6 +169,7 @@ func NewVirtualMachine(ctx *Context, parent types.ManagedObjectReference, spec *
 	vm.Capability.ChangeTrackingSupported = types.NewBool(changeTrackingSupported(spec))
 
 	vm.Summary.QuickStats.GuestHeartbeatStatus = types.ManagedEntityStatusGray
+	vm.GuestHeartbeatStatus = types.ManagedEntityStatusGray
 	vm.Summary.OverallStatus = types.ManagedEntityStatusGreen
 	vm.ConfigStatus = types.ManagedEntityStatusGreen
 
@@ -180,15 +187,15 @@ func (*VirtualMachine) Reload(*types.Reload) soap.HasFault {
 	return &methods.ReloadBody{Res: new(types.ReloadResponse)}
 }
 
-func (vm *VirtualMachine) event() types.VmEvent {
-	host := Map.Get(*vm.Runtime.Host).(*HostSystem)
+func (vm *VirtualMachine) event(ctx *Context) types.VmEvent {
+	host := ctx.Map.Get(*vm.Runtime.Host).(*HostSystem)
 
 	return types.VmEvent{
 		Event: types.Event{
-			Datacenter:      datacenterEventArgument(host),
-			ComputeResource: host.eventArgumentParent(),
+			Datacenter:      datacenterEventArgument(ctx, host),
+			ComputeResource: host.eventArgumentParent(ctx),
 			Host:            host.eventArgument(),
-			Ds:              Map.Get(vm.Datastore[0]).(*Datastore).eventArgument(),
+			Ds:              ctx.Map.Get(vm.Datastore[0]).(*Datastore).eventArgument(),
 			Vm: &types.VmEventArgument{
 				EntityEventArgument: types.EntityEventArgument{Name: vm.Name},
 				Vm:                  vm.Self,
@@ -330,9 +337,52 @@ func (vm *VirtualMachine) apply(spec *types.VirtualMachineConfigSpec) {
 		vm.Guest.GuestFamily = guestFamily(spec.GuestId)
 	}
 
+	vm.profile = profileID(spec.VmProfile, vm.profile)
+
 	vm.Config.Modified = time.Now()
 }
 
+// profileID returns the storage policy ID defined by the given spec,
+// or current if the spec does not change the association.
+func profileID(spec []types.BaseVirtualMachineProfileSpec, current string) string {
+	for _, p := range spec {
+		switch p := p.(type) {
+		case *types.VirtualMachineDefinedProfileSpec:
+			return p.ProfileId
+		case *types.VirtualMachineEmptyProfileSpec, *types.VirtualMachineDefaultProfileSpec:
+			return ""
+		}
+	}
+	return current
+}
+
+// StorageProfile returns the ID of the storage policy associated with the VM home,
+// or with the virtual disk of the given key if specified.
+func (vm *VirtualMachine) StorageProfile(key ...int32) string {
+	if len(key) == 0 {
+		return vm.profile
+	}
+	return vm.diskProfile[key[0]]
+}
+
+// setDiskProfile associates the storage policy of an added or edited disk spec with the disk,
+// clearing any previous association if the spec has no profile.
+func (vm *VirtualMachine) setDiskProfile(dspec *types.VirtualDeviceConfigSpec) {
+	if _, ok := dspec.Device.(*types.VirtualDisk); !ok {
+		return
+	}
+	key := dspec.Device.GetVirtualDevice().Key
+	id := profileID(dspec.Profile, "")
+	if id == "" {
+		delete(vm.diskProfile, key)
+		return
+	}
+	if vm.diskProfile == nil {
+		vm.diskProfile = make(map[int32]string)
+	}
+	vm.diskProfile[key] = id
+}
+
 // updateVAppProperty updates the simulator VM with the specified VApp properties.
 func (vm *VirtualMachine) updateVAppProperty(spec *types.VmConfigSpec) types.BaseMethodFault {
 	ps := make([]types.VAppPropertyInfo, 0)
@@ -393,7 +443,7 @@ func extraConfigKey(key string) string {
 	return key
 }
 
-func (vm *VirtualMachine) applyExtraConfig(spec *types.VirtualMachineConfigSpec) {
+func (vm *VirtualMachine) applyExtraConfig(ctx *Context, spec *types.VirtualMachineConfigSpec) {
 	var changes []types.PropertyChange
 	for _, c := range spec.ExtraConfig {
 		val := c.GetOptionValue()
@@ -421,7 +471,7 @@ func (vm *VirtualMachine) applyExtraConfig(spec *types.VirtualMachineConfigSpec)
 		}
 	}
 	if len(changes) != 0 {
-		Map.Update(vm, changes)
+		ctx.Map.Update(vm, changes)
 	}
 }
 
@@ -541,7 +591,7 @@ func getVMFileType(fileName string) types.VirtualMachineFileLayoutExFileType {
 	return fileType
 }
 
-func (vm *VirtualMachine) addFileLayoutEx(datastorePath object.DatastorePath, fileSize int64) int32 {
+func (vm *VirtualMachine) addFileLayoutEx(ctx *Context, datastorePath object.DatastorePath, fileSize int64) int32 {
 	var newKey int32
 	for _, layoutFile := range vm.LayoutEx.File {
 		if layoutFile.Name == datastorePath.String() {
@@ -557,11 +607,11 @@ func (vm *VirtualMachine) addFileLayoutEx(datastorePath object.DatastorePath, fi
 
 	switch fileType {
 	case types.VirtualMachineFileLayoutExFileTypeNvram, types.VirtualMachineFileLayoutExFileTypeSnapshotList:
-		vm.addConfigLayout(datastorePath.Path)
+		vm.addConfigLayout(ctx, datastorePath.Path)
 	case types.VirtualMachineFileLayoutExFileTypeLog:
-		vm.addLogLayout(datastorePath.Path)
+		vm.addLogLayout(ctx, datastorePath.Path)
 	case types.VirtualMachineFileLayoutExFileTypeSwap:
-		vm.addSwapLayout(datastorePath.String())
+		vm.addSwapLayout(ctx, datastorePath.String())
 	}
 
 	vm.LayoutEx.File = append(vm.LayoutEx.File, types.VirtualMachineFileLayoutExFileInfo{
@@ -576,12 +626,12 @@ func (vm *VirtualMachine) addFileLayoutEx(datastorePath object.DatastorePath, fi
 
 	vm.LayoutEx.Timestamp = time.Now()
 
-	vm.updateStorage()
+	vm.updateStorage(ctx)
 
 	return newKey
 }
 
-func (vm *VirtualMachine) addConfigLayout(name string) {
+func (vm *VirtualMachine) addConfigLayout(ctx *Context, name string) {
 	for _, config := range vm.Layout.ConfigFile {
 		if config == name {
 			return
@@ -590,10 +640,10 @@ func (vm *VirtualMachine) addConfigLayout(name string) {
 
 	vm.Layout.ConfigFile = append(vm.Layout.ConfigFile, name)
 
-	vm.updateStorage()
+	vm.updateStorage(ctx)
 }
 
-func (vm *VirtualMachine) addLogLayout(name string) {
+func (vm *VirtualMachine) addLogLayout(ctx *Context, name string) {
 	for _, log := range vm.Layout.LogFile {
 		if log == name {
 			return
@@ -602,16 +652,16 @@ func (vm *VirtualMachine) addLogLayout(name string) {
 
 	vm.Layout.LogFile = append(vm.Layout.LogFile, name)
 
-	vm.updateStorage()
+	vm.updateStorage(ctx)
 }
 
-func (vm *VirtualMachine) addSwapLayout(name string) {
+func (vm *VirtualMachine) addSwapLayout(ctx *Context, name string) {
 	vm.Layout.SwapFile = name
 
-	vm.updateStorage()
+	vm.updateStorage(ctx)
 }
 
-func (vm *VirtualMachine) addSnapshotLayout(snapshot types.ManagedObjectReference, dataKey int32) {
+func (vm *VirtualMachine) addSnapshotLayout(ctx *Context, snapshot types.ManagedObjectReference, dataKey int32) {
 	for _, snapshotLayout := range vm.Layout.Snapshot {
 		if snapshotLayout.Key == snapshot {
 			return
@@ -630,10 +680,10 @@ func (vm *VirtualMachine) addSnapshotLayout(snapshot types.ManagedObjectReferenc
 		SnapshotFile: snapshotFiles,
 	})
 
-	vm.updateStorage()
+	vm.updateStorage(ctx)
 }
 
-func (vm *VirtualMachine) addSnapshotLayoutEx(snapshot types.ManagedObjectReference, dataKey int32, memoryKey int32) {
+func (vm *VirtualMachine) addSnapshotLayoutEx(ctx *Context, snapshot types.ManagedObjectReference, dataKey int32, memoryKey int32) {
 	for _, snapshotLayoutEx := range vm.LayoutEx.Snapshot {
 		if snapshotLayoutEx.Key == snapshot {
 			return
@@ -649,11 +699,11 @@ func (vm *VirtualMachine) addSnapshotLayoutEx(snapshot types.ManagedObjectRefere
 
 	vm.LayoutEx.Timestamp = time.Now()
 
-	vm.updateStorage()
+	vm.updateStorage(ctx)
 }
 
 // Updates both vm.Layout.Disk and vm.LayoutEx.Disk
-func (vm *VirtualMachine) updateDiskLayouts() types.BaseMethodFault {
+func (vm *VirtualMachine) updateDiskLayouts(ctx *Context) types.BaseMethodFault {
 	var disksLayout []types.VirtualMachineFileLayoutDiskLayout
 	var disksLayoutEx []types.VirtualMachineFileLayoutExDiskLayout
 
@@ -679,7 +729,7 @@ func (vm *VirtualMachine) updateDiskLayouts() types.BaseMethodFault {
 					return fault
 				}
 
-				datastore := vm.useDatastore(p.Datastore)
+				datastore := vm.useDatastore(ctx, p.Datastore)
 				dFilePath := path.Join(datastore.Info.GetDatastoreInfo().Url, p.Path)
 
 				var fileSize int64
@@ -688,7 +738,7 @@ func (vm *VirtualMachine) updateDiskLayouts() types.BaseMethodFault {
 					fileSize = dFileInfo.Size()
 				}
 
-				diskKey := vm.addFileLayoutEx(*p, fileSize)
+				diskKey := vm.addFileLayoutEx(ctx, *p, fileSize)
 				fileKeys = append(fileKeys, diskKey)
 			}
 
@@ -713,12 +763,12 @@ func (vm *VirtualMachine) updateDiskLayouts() types.BaseMethodFault {
 	vm.LayoutEx.Disk = disksLayoutEx
 	vm.LayoutEx.Timestamp = time.Now()
 
-	vm.updateStorage()
+	vm.updateStorage(ctx)
 
 	return nil
 }
 
-func (vm *VirtualMachine) updateStorage() types.BaseMethodFault {
+func (vm *VirtualMachine) updateStorage(ctx *Context) types.BaseMethodFault {
 	// Committed - sum of Size for each file in vm.LayoutEx.File
 	// Unshared  - sum of Size for each disk (.vmdk) in vm.LayoutEx.File
 	// Uncommitted - disk capacity minus disk usage (only currently used disk)
@@ -732,7 +782,7 @@ func (vm *VirtualMachine) updateStorage() types.BaseMethodFault {
 			return fault
 		}
 
-		datastore := vm.useDatastore(p.Datastore)
+		datastore := vm.useDatastore(ctx, p.Datastore)
 		dsUsage := &types.VirtualMachineUsageOnDatastore{
 			Datastore: datastore.Self,
 		}
@@ -825,7 +875,7 @@ func (vm *VirtualMachine) RefreshStorageInfo(ctx *Context, req *types.RefreshSto
 			return body
 		}
 
-		datastore := vm.useDatastore(p.Datastore)
+		datastore := vm.useDatastore(ctx, p.Datastore)
 		directory := path.Join(datastore.Info.GetDatastoreInfo().Url, p.Path)
 
 		if path.Ext(p.Path) == ".vmx" {
@@ -849,11 +899,11 @@ func (vm *VirtualMachine) RefreshStorageInfo(ctx *Context, req *types.RefreshSto
 				Path:      strings.TrimPrefix(file.Name(), datastore.Info.GetDatastoreInfo().Url),
 			}
 
-			vm.addFileLayoutEx(datastorePath, file.Size())
+			vm.addFileLayoutEx(ctx, datastorePath, file.Size())
 		}
 	}
 
-	fault := vm.updateDiskLayouts()
+	fault := vm.updateDiskLayouts(ctx)
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -866,14 +916,14 @@ func (vm *VirtualMachine) RefreshStorageInfo(ctx *Context, req *types.RefreshSto
 	return body
 }
 
-func (vm *VirtualMachine) findDatastore(name string) *Datastore {
-	host := Map.Get(*vm.Runtime.Host).(*HostSystem)
+func (vm *VirtualMachine) findDatastore(ctx *Context, name string) *Datastore {
+	host := ctx.Map.Get(*vm.Runtime.Host).(*HostSystem)
 
-	return Map.FindByName(name, host.Datastore).(*Datastore)
+	return ctx.Map.FindByName(name, host.Datastore).(*Datastore)
 }
 
-func (vm *VirtualMachine) useDatastore(name string) *Datastore {
-	ds := vm.findDatastore(name)
+func (vm *VirtualMachine) useDatastore(ctx *Context, name string) *Datastore {
+	ds := vm.findDatastore(ctx, name)
 	if FindReference(vm.Datastore, ds.Self) == nil {
 		vm.Datastore = append(vm.Datastore, ds.Self)
 	}
@@ -891,13 +941,13 @@ func (vm *VirtualMachine) vmx(spec *types.VirtualMachineConfigSpec) object.Datas
 	return p
 }
 
-func (vm *VirtualMachine) createFile(spec string, name string, register bool) (*os.File, types.BaseMethodFault) {
+func (vm *VirtualMachine) createFile(ctx *Context, spec string, name string, register bool) (*os.File, types.BaseMethodFault) {
 	p, fault := parseDatastorePath(spec)
 	if fault != nil {
 		return nil, fault
 	}
 
-	ds := vm.useDatastore(p.Datastore)
+	ds := vm.useDatastore(ctx, p.Datastore)
 
 	nhost := len(ds.Host)
 	if ds.Name == "vsanDatastore" && nhost < 3 {
@@ -1002,7 +1052,7 @@ func (vm *VirtualMachine) create(ctx *Context, spec *types.VirtualMachineConfigS
 	}
 
 	for _, file := range files {
-		f, err := vm.createFile(file.spec, file.name, register)
+		f, err := vm.createFile(ctx, file.spec, file.name, register)
 		if err != nil {
 			return err
 		}
@@ -1064,28 +1114,20 @@ func getDiskSize(disk *types.VirtualDisk) int64 {
 	return disk.CapacityInBytes
 }
 
-func (vm *VirtualMachine) validateSwitchMembers(id string) types.BaseMethodFault {
-	var dswitch *DistributedVirtualSwitch
-
-	var find func(types.ManagedObjectReference)
-	find = func(child types.ManagedObjectReference) {
-		s, ok := Map.Get(child).(*DistributedVirtualSwitch)
-		if ok && s.Uuid == id {
-			dswitch = s
-			return
-		}
-		walk(Map.Get(child), find)
-	}
-	f := Map.getEntityDatacenter(vm).NetworkFolder
-	walk(Map.Get(f), find) // search in NetworkFolder and any sub folders
+// findSwitch returns the DistributedVirtualSwitch with the given uuid in the VM's datacenter, or nil if not found.
+func (vm *VirtualMachine) findSwitch(ctx *Context, id string) *DistributedVirtualSwitch {
+	return findSwitch(ctx, ctx.Map.getEntityDatacenter(vm), id)
+}
 
+func (vm *VirtualMachine) validateSwitchMembers(ctx *Context, id string) types.BaseMethodFault {
+	dswitch := vm.findSwitch(ctx, id)
 	if dswitch == nil {
 		log.Printf("DVS %s cannot be found", id)
 		return new(types.NotFound)
 	}
 
-	h := Map.Get(*vm.Runtime.Host).(*HostSystem)
-	c := hostParent(&h.HostSystem)
+	h := ctx.Map.Get(*vm.Runtime.Host).(*HostSystem)
+	c := hostParent(ctx, &h.HostSystem)
 	isMember := func(val types.ManagedObjectReference) bool {
 		for _, mem := range dswitch.Summary.HostMember {
 			if mem == val {
@@ -1105,6 +1147,71 @@ func (vm *VirtualMachine) validateSwitchMembers(id string) types.BaseMethodFault
 	return nil
 }
 
+// switchPortConnectee returns the DVS port connectee for the given NIC.
+func (vm *VirtualMachine) switchPortConnectee(nic *types.VirtualEthernetCard) *types.DistributedVirtualSwitchPortConnectee {
+	return &types.DistributedVirtualSwitchPortConnectee{
+		ConnectedEntity: &vm.Self,
+		NicKey:          strconv.Itoa(int(nic.Key)),
+		Type:            string(types.DistributedVirtualSwitchPortConnecteeConnecteeTypeVmVnic),
+	}
+}
+
+// connectSwitchPort connects the given NIC to a port of the DVS referenced by conn.
+func (vm *VirtualMachine) connectSwitchPort(ctx *Context, nic *types.VirtualEthernetCard, conn *types.DistributedVirtualSwitchPortConnection) types.BaseMethodFault {
+	dswitch := vm.findSwitch(ctx, conn.SwitchUuid)
+	if dswitch == nil {
+		return new(types.NotFound)
+	}
+
+	var err types.BaseMethodFault
+	ctx.WithLock(dswitch, func() {
+		err = dswitch.connectDVPort(ctx, vm.switchPortConnectee(nic), vm.Runtime.Host, nic, conn)
+	})
+
+	return err
+}
+
+// disconnectSwitchPort releases the DVS port connected to the given NIC.
+func (vm *VirtualMachine) disconnectSwitchPort(ctx *Context, nic *types.VirtualEthernetCard, conn *types.DistributedVirtualSwitchPortConnection) {
+	dc := ctx.Map.getEntityDatacenter(vm)
+	if dc == nil {
+		return // parent was destroyed
+	}
+
+	dswitch := vm.findSwitch(ctx, conn.SwitchUuid)
+	if dswitch == nil {
+		return
+	}
+
+	ctx.WithLock(dswitch, func() {
+		dswitch.disconnectDVPort(ctx, vm.switchPortConnectee(nic), conn)
+	})
+}
+
+// refreshSwitchPorts refreshes the state of the DVS ports connected to the VM's NICs, such as linkUp on power state changes.
+func (vm *VirtualMachine) refreshSwitchPorts(ctx *Context) {
+	for _, device := range vm.Config.Hardware.Device {
+		nic, ok := device.(types.BaseVirtualEthernetCard)
+		if !ok {
+			continue
+		}
+		card := nic.GetVirtualEthernetCard()
+		b, ok := card.Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo)
+		if !ok {
+			continue
+		}
+
+		dswitch := vm.findSwitch(ctx, b.Port.SwitchUuid)
+		if dswitch == nil {
+			continue
+		}
+
+		ctx.WithLock(dswitch, func() {
+			dswitch.refreshConnectedDVPort(ctx, vm.switchPortConnectee(card), card, b.Port.PortKey)
+		})
+	}
+}
+
 func (vm *VirtualMachine) configureDevice(ctx *Context, devices object.VirtualDeviceList, spec *types.VirtualDeviceConfigSpec) types.BaseMethodFault {
 	device := spec.Device
 	d := device.GetVirtualDevice()
@@ -1144,16 +1251,11 @@ func (vm *VirtualMachine) configureDevice(ctx *Context, devices object.VirtualDe
 			summary = fmt.Sprintf("DVSwitch: %s", b.Port.SwitchUuid)
 			net.Type = "DistributedVirtualPortgroup"
 			net.Value = b.Port.PortgroupKey
-			if err := vm.validateSwitchMembers(b.Port.SwitchUuid); err != nil {
+			if err := vm.validateSwitchMembers(ctx, b.Port.SwitchUuid); err != nil {
 				return err
 			}
 		}
 
-		ctx.Map.Update(vm, []types.PropertyChange{
-			{Name: "summary.config.numEthernetCards", Val: vm.Summary.Config.NumEthernetCards + 1},
-			{Name: "network", Val: append(vm.Network, net)},
-		})
-
 		c := x.GetVirtualEthernetCard()
 		if c.MacAddress == "" {
 			if c.UnitNumber == nil {
@@ -1162,6 +1264,17 @@ func (vm *VirtualMachine) configureDevice(ctx *Context, devices object.VirtualDe
 			c.MacAddress = vm.generateMAC(*c.UnitNumber - 7) // Note 7 == PCI offset
 		}
 
+		if b, ok := d.Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo); ok {
+			if err := vm.connectSwitchPort(ctx, c, &b.Port); err != nil {
+				return err
+			}
+		}
+
+		ctx.Map.Update(vm, []types.PropertyChange{
+			{Name: "summary.config.numEthernetCards", Val: vm.Summary.Config.NumEthernetCards + 1},
+			{Name: "network", Val: append(vm.Network, net)},
+		})
+
 		if spec.Operation == types.VirtualDeviceConfigSpecOperationAdd {
 			vm.Guest.Net = append(vm.Guest.Net, types.GuestNicInfo{
 				Network:        name,
@@ -1191,7 +1304,7 @@ func (vm *VirtualMachine) configureDevice(ctx *Context, devices object.VirtualDe
 			path.FromString(info.FileName)
 
 			if path.Path == "" {
-				filename, err := vm.genVmdkPath(path)
+				filename, err := vm.genVmdkPath(ctx, path)
 				if err != nil {
 					return err
 				}
@@ -1199,7 +1312,7 @@ func (vm *VirtualMachine) configureDevice(ctx *Context, devices object.VirtualDe
 				info.FileName = filename
 			}
 
-			err := vdmCreateVirtualDisk(spec.FileOperation, &types.CreateVirtualDisk_Task{
+			err := vdmCreateVirtualDisk(ctx, spec.FileOperation, &types.CreateVirtualDisk_Task{
 				Datacenter: &dc.Self,
 				Name:       info.FileName,
 			})
@@ -1212,7 +1325,7 @@ func (vm *VirtualMachine) configureDevice(ctx *Context, devices object.VirtualDe
 			})
 
 			p, _ := parseDatastorePath(info.FileName)
-			ds := vm.findDatastore(p.Datastore)
+			ds := vm.findDatastore(ctx, p.Datastore)
 			info.Datastore = &ds.Self
 
 			// XXX: compare disk size and free space until windows stat is supported
@@ -1221,7 +1334,7 @@ func (vm *VirtualMachine) configureDevice(ctx *Context, devices object.VirtualDe
 				ds.Info.GetDatastoreInfo().FreeSpace = ds.Summary.FreeSpace
 			})
 
-			vm.updateDiskLayouts()
+			vm.updateDiskLayouts(ctx)
 
 			if disk, ok := b.(*types.VirtualDiskFlatVer2BackingInfo); ok {
 				// These properties default to false
@@ -1306,7 +1419,7 @@ func (vm *VirtualMachine) removeDevice(ctx *Context, devices object.VirtualDevic
 					file = b.GetVirtualDeviceFileBackingInfo().FileName
 
 					p, _ := parseDatastorePath(file)
-					ds := vm.findDatastore(p.Datastore)
+					ds := vm.findDatastore(ctx, p.Datastore)
 
 					ctx.WithLock(ds, func() {
 						ds.Summary.FreeSpace += getDiskSize(device)
@@ -1332,7 +1445,7 @@ func (vm *VirtualMachine) removeDevice(ctx *Context, devices object.VirtualDevic
 				{Name: "summary.config.numVirtualDisks", Val: vm.Summary.Config.NumVirtualDisks - 1},
 			})
 
-			vm.updateDiskLayouts()
+			vm.updateDiskLayouts(ctx)
 		case types.BaseVirtualEthernetCard:
 			var net types.ManagedObjectReference
 
@@ -1342,6 +1455,11 @@ func (vm *VirtualMachine) removeDevice(ctx *Context, devices object.VirtualDevic
 			case *types.VirtualEthernetCardDistributedVirtualPortBackingInfo:
 				net.Type = "DistributedVirtualPortgroup"
 				net.Value = b.Port.PortgroupKey
+				vm.disconnectSwitchPort(ctx, device.GetVirtualEthernetCard(), &b.Port)
+			}
+
+			if m := ctx.Map.IpPoolManager(); m != nil {
+				m.releaseGuestIP(ctx, vm, key)
 			}
 
 			networks := vm.Network
@@ -1358,7 +1476,7 @@ func (vm *VirtualMachine) removeDevice(ctx *Context, devices object.VirtualDevic
 	return devices
 }
 
-func (vm *VirtualMachine) genVmdkPath(p object.DatastorePath) (string, types.BaseMethodFault) {
+func (vm *VirtualMachine) genVmdkPath(ctx *Context, p object.DatastorePath) (string, types.BaseMethodFault) {
 	if p.Datastore == "" {
 		p.FromString(vm.Config.Files.VmPathName)
 	}
@@ -1377,7 +1495,7 @@ func (vm *VirtualMachine) genVmdkPath(p object.DatastorePath) (string, types.Bas
 			filename = fmt.Sprintf("%s_%d.vmdk", vm.Config.Name, index)
 		}
 
-		f, err := vm.createFile(vmdir, filename, false)
+		f, err := vm.createFile(ctx, vmdir, filename, false)
 		if err != nil {
 			switch err.(type) {
 			case *types.FileAlreadyExists:
@@ -1440,6 +1558,7 @@ func (vm *VirtualMachine) configureDevices(ctx *Context, spec *types.VirtualMach
 			}
 
 			devices = append(devices, dspec.Device)
+			vm.setDiskProfile(dspec)
 			if key != device.Key {
 				// Update ControllerKey refs
 				for i := range spec.DeviceChange {
@@ -1466,8 +1585,10 @@ func (vm *VirtualMachine) configureDevices(ctx *Context, spec *types.VirtualMach
 			}
 
 			devices = append(devices, dspec.Device)
+			vm.setDiskProfile(dspec)
 		case types.VirtualDeviceConfigSpecOperationRemove:
 			devices = vm.removeDevice(ctx, devices, dspec)
+			delete(vm.diskProfile, device.Key)
 		}
 	}
 
@@ -1475,9 +1596,9 @@ func (vm *VirtualMachine) configureDevices(ctx *Context, spec *types.VirtualMach
 		{Name: "config.hardware.device", Val: []types.BaseVirtualDevice(devices)},
 	})
 
-	vm.updateDiskLayouts()
+	vm.updateDiskLayouts(ctx)
 
-	vm.applyExtraConfig(spec) // Do this after device config, as some may apply to the devices themselves (e.g. ethernet -> guest.net)
+	vm.applyExtraConfig(ctx, spec) // Do this after device config, as some may apply to the devices themselves (e.g. ethernet -> guest.net)
 
 	return nil
 }
@@ -1505,7 +1626,7 @@ func (c *powerVMTask) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		boot = time.Now()
 	}
 
-	event := c.event()
+	event := c.event(c.ctx)
 	switch c.state {
 	case types.VirtualMachinePowerStatePoweredOn:
 		if c.VirtualMachine.hostInMM(c.ctx) {
@@ -1518,8 +1639,10 @@ func (c *powerVMTask) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 			&types.VmPoweredOnEvent{VmEvent: event},
 		)
 		c.customize(c.ctx)
+		c.assignGuestIP(c.ctx)
 	case types.VirtualMachinePowerStatePoweredOff:
 		c.run.stop(c.ctx, c.VirtualMachine)
+		c.releaseGuestIP(c.ctx)
 		c.ctx.postEvent(
 			&types.VmStoppingEvent{VmEvent: event},
 			&types.VmPoweredOffEvent{VmEvent: event},
@@ -1533,6 +1656,7 @@ func (c *powerVMTask) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		}
 
 		c.run.pause(c.ctx, c.VirtualMachine)
+		c.releaseGuestIP(c.ctx)
 		c.ctx.postEvent(
 			&types.VmSuspendingEvent{VmEvent: event},
 			&types.VmSuspendedEvent{VmEvent: event},
@@ -1545,6 +1669,14 @@ func (c *powerVMTask) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		{Name: "summary.runtime.bootTime", Val: boot},
 	})
 
+	c.refreshSwitchPorts(c.ctx)
+
+	if c.state == types.VirtualMachinePowerStatePoweredOn {
+		c.toolsStart(c.ctx)
+	} else {
+		c.toolsStop(c.ctx)
+	}
+
 	return nil, nil
 }
 
@@ -1556,7 +1688,7 @@ func (vm *VirtualMachine) PowerOnVMTask(ctx *Context, c *types.PowerOnVM_Task) s
 	}
 
 	runner := &powerVMTask{vm, types.VirtualMachinePowerStatePoweredOn, ctx}
-	task := CreateTask(runner.Reference(), "powerOn", runner.Run)
+	task := CreateTask(ctx, runner.Reference(), "powerOn", runner.Run)
 
 	return &methods.PowerOnVM_TaskBody{
 		Res: &types.PowerOnVM_TaskResponse{
@@ -1567,7 +1699,7 @@ func (vm *VirtualMachine) PowerOnVMTask(ctx *Context, c *types.PowerOnVM_Task) s
 
 func (vm *VirtualMachine) PowerOffVMTask(ctx *Context, c *types.PowerOffVM_Task) soap.HasFault {
 	runner := &powerVMTask{vm, types.VirtualMachinePowerStatePoweredOff, ctx}
-	task := CreateTask(runner.Reference(), "powerOff", runner.Run)
+	task := CreateTask(ctx, runner.Reference(), "powerOff", runner.Run)
 
 	return &methods.PowerOffVM_TaskBody{
 		Res: &types.PowerOffVM_TaskResponse{
@@ -1578,7 +1710,7 @@ func (vm *VirtualMachine) PowerOffVMTask(ctx *Context, c *types.PowerOffVM_Task)
 
 func (vm *VirtualMachine) SuspendVMTask(ctx *Context, req *types.SuspendVM_Task) soap.HasFault {
 	runner := &powerVMTask{vm, types.VirtualMachinePowerStateSuspended, ctx}
-	task := CreateTask(runner.Reference(), "suspend", runner.Run)
+	task := CreateTask(ctx, runner.Reference(), "suspend", runner.Run)
 
 	return &methods.SuspendVM_TaskBody{
 		Res: &types.SuspendVM_TaskResponse{
@@ -1588,7 +1720,7 @@ func (vm *VirtualMachine) SuspendVMTask(ctx *Context, req *types.SuspendVM_Task)
 }
 
 func (vm *VirtualMachine) ResetVMTask(ctx *Context, req *types.ResetVM_Task) soap.HasFault {
-	task := CreateTask(vm, "reset", func(task *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "reset", func(task *Task) (types.AnyType, types.BaseMethodFault) {
 		res := vm.PowerOffVMTask(ctx, &types.PowerOffVM_Task{This: vm.Self})
 		ctask := ctx.Map.Get(res.(*methods.PowerOffVM_TaskBody).Res.Returnval).(*Task)
 		ctask.Wait()
@@ -1623,6 +1755,10 @@ func (vm *VirtualMachine) RebootGuest(ctx *Context, req *types.RebootGuest) soap
 
 	if vm.Guest.ToolsRunningStatus == string(types.VirtualMachineToolsRunningStatusGuestToolsRunning) {
 		vm.run.restart(ctx, vm)
+		if vm.run.id == "" {
+			vm.toolsStop(ctx)
+			vm.toolsStart(ctx)
+		}
 		body.Res = new(types.RebootGuestResponse)
 	} else {
 		body.Fault_ = Fault("", new(types.ToolsUnavailable))
@@ -1632,9 +1768,9 @@ func (vm *VirtualMachine) RebootGuest(ctx *Context, req *types.RebootGuest) soap
 }
 
 func (vm *VirtualMachine) ReconfigVMTask(ctx *Context, req *types.ReconfigVM_Task) soap.HasFault {
-	task := CreateTask(vm, "reconfigVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "reconfigVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		ctx.postEvent(&types.VmReconfiguredEvent{
-			VmEvent:    vm.event(),
+			VmEvent:    vm.event(ctx),
 			ConfigSpec: req.Spec,
 		})
 
@@ -1661,10 +1797,75 @@ func (vm *VirtualMachine) ReconfigVMTask(ctx *Context, req *types.ReconfigVM_Tas
 	}
 }
 
+func (vm *VirtualMachine) AttachDiskTask(ctx *Context, req *types.AttachDisk_Task) soap.HasFault {
+	task := CreateTask(ctx, vm, "attachDisk", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		m := ctx.Map.VStorageObjectManager()
+		if m == nil {
+			return nil, new(types.NotSupported)
+		}
+
+		obj := m.object(req.Datastore, req.DiskId)
+		if obj == nil {
+			return nil, &types.InvalidArgument{InvalidProperty: "diskId"}
+		}
+
+		backing := obj.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
+		disk := &types.VirtualDisk{
+			VirtualDevice: types.VirtualDevice{
+				Backing: &types.VirtualDiskFlatVer2BackingInfo{
+					VirtualDeviceFileBackingInfo: types.VirtualDeviceFileBackingInfo{
+						FileName:  backing.FilePath,
+						Datastore: &req.Datastore,
+					},
+					DiskMode:        string(types.VirtualDiskModePersistent),
+					ThinProvisioned: types.NewBool(backing.ProvisioningType == string(types.BaseConfigInfoDiskFileBackingInfoProvisioningTypeThin)),
+				},
+				ControllerKey: req.ControllerKey,
+				UnitNumber:    req.UnitNumber,
+			},
+			CapacityInKB:    obj.Config.CapacityInMB * 1024,
+			CapacityInBytes: obj.Config.CapacityInMB * 1024 * 1024,
+			VDiskId:         &obj.Config.Id,
+		}
+
+		devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+		if disk.ControllerKey == 0 {
+			c, err := devices.FindSCSIController("")
+			if err != nil {
+				return nil, &types.MissingController{}
+			}
+			devices.AssignController(disk, c)
+		} else if disk.UnitNumber == nil {
+			c, ok := devices.FindByKey(disk.ControllerKey).(types.BaseVirtualController)
+			if !ok {
+				return nil, &types.InvalidArgument{InvalidProperty: "controllerKey"}
+			}
+			devices.AssignController(disk, c)
+		}
+
+		spec := &types.VirtualMachineConfigSpec{
+			DeviceChange: []types.BaseVirtualDeviceConfigSpec{
+				&types.VirtualDeviceConfigSpec{
+					Operation: types.VirtualDeviceConfigSpecOperationAdd,
+					Device:    disk,
+				},
+			},
+		}
+
+		return nil, vm.configureDevices(ctx, spec)
+	})
+
+	return &methods.AttachDisk_TaskBody{
+		Res: &types.AttachDisk_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (vm *VirtualMachine) UpgradeVMTask(ctx *Context, req *types.UpgradeVM_Task) soap.HasFault {
 	body := &methods.UpgradeVM_TaskBody{}
 
-	task := CreateTask(vm, "upgradeVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "upgradeVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if vm.Config.Version != esx.HardwareVersion {
 			ctx.Map.Update(vm, []types.PropertyChange{{
 				Name: "config.version", Val: esx.HardwareVersion,
@@ -1683,7 +1884,7 @@ func (vm *VirtualMachine) UpgradeVMTask(ctx *Context, req *types.UpgradeVM_Task)
 func (vm *VirtualMachine) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
 	dc := ctx.Map.getEntityDatacenter(vm)
 
-	task := CreateTask(vm, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if dc == nil {
 			return nil, &types.ManagedObjectNotFound{Obj: vm.Self} // If our Parent was destroyed, so were we.
 		}
@@ -1696,6 +1897,8 @@ func (vm *VirtualMachine) DestroyTask(ctx *Context, req *types.Destroy_Task) soa
 			return nil, r.Fault().VimFault().(types.BaseMethodFault)
 		}
 
+		vm.releaseGuestIP(ctx)
+
 		// Remove all devices
 		devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
 		spec, _ := devices.ConfigSpec(types.VirtualDeviceConfigSpecOperationRemove)
@@ -1755,7 +1958,7 @@ func (vm *VirtualMachine) UnregisterVM(ctx *Context, c *types.UnregisterVM) soap
 		ctx.Map.RemoveReference(ctx, ds, &ds.Vm, vm.Self)
 	}
 
-	ctx.postEvent(&types.VmRemovedEvent{VmEvent: vm.event()})
+	ctx.postEvent(&types.VmRemovedEvent{VmEvent: vm.event(ctx)})
 	if f, ok := asFolderMO(ctx.Map.getEntityParent(vm, "Folder")); ok {
 		folderRemoveChild(ctx, f, c.This)
 	}
@@ -1794,7 +1997,7 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 
 	folder, _ := asFolderMO(ctx.Map.Get(req.Folder))
 	host := ctx.Map.Get(*destHost).(*HostSystem)
-	event := vm.event()
+	event := vm.event(ctx)
 
 	ctx.postEvent(&types.VmBeingClonedEvent{
 		VmCloneEvent: types.VmCloneEvent{
@@ -1812,7 +2015,7 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 		vmx.Datastore = ds
 	}
 
-	task := CreateTask(vm, "cloneVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "cloneVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if pool == nil {
 			return nil, &types.InvalidArgument{InvalidProperty: "spec.location.pool"}
 		}
@@ -1854,6 +2057,12 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 				// Leave FileName empty so CreateVM will just create a new one under VmPathName
 				disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo).FileName = ""
 				disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo).Parent = nil
+			case types.BaseVirtualEthernetCard:
+				if b, ok := disk.GetVirtualEthernetCard().Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo); ok {
+					// Leave PortKey empty so CreateVM will connect the clone to a new port
+					b.Port.PortKey = ""
+					b.Port.ConnectionCookie = 0
+				}
 			}
 
 			config.DeviceChange = append(config.DeviceChange, &types.VirtualDeviceConfigSpec{
@@ -1884,11 +2093,11 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 		}
 
 		if req.Spec.Template {
-			_ = clone.MarkAsTemplate(&types.MarkAsTemplate{This: clone.Self})
+			_ = clone.MarkAsTemplate(ctx, &types.MarkAsTemplate{This: clone.Self})
 		}
 
 		ctx.postEvent(&types.VmClonedEvent{
-			VmCloneEvent: types.VmCloneEvent{VmEvent: clone.event()},
+			VmCloneEvent: types.VmCloneEvent{VmEvent: clone.event(ctx)},
 			SourceVm:     *event.Vm,
 		})
 
@@ -1902,51 +2111,86 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 	}
 }
 
-func (vm *VirtualMachine) RelocateVMTask(ctx *Context, req *types.RelocateVM_Task) soap.HasFault {
-	task := CreateTask(vm, "relocateVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
-		var changes []types.PropertyChange
+// checkMigrateLicense returns a fault if migrating a powered on VM is not permitted by the licenses assigned to the source and destination hosts
+func (vm *VirtualMachine) checkMigrateLicense(ctx *Context, spec *types.VirtualMachineRelocateSpec) types.BaseMethodFault {
+	if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+		return nil // cold migration
+	}
 
-		if ref := req.Spec.Datastore; ref != nil {
-			ds := ctx.Map.Get(*ref).(*Datastore)
-			ctx.Map.RemoveReference(ctx, ds, &ds.Vm, *ref)
+	lm := ctx.Map.LicenseManager()
+	src := *vm.Runtime.Host
 
-			// TODO: migrate vm.Config.Files, vm.Summary.Config.VmPathName, vm.Layout and vm.LayoutEx
+	if spec.Host != nil && *spec.Host != src {
+		for _, host := range []types.ManagedObjectReference{src, *spec.Host} {
+			if err := lm.checkFeature(ctx, host, "vmotion"); err != nil {
+				return err
+			}
+		}
+	}
 
-			changes = append(changes, types.PropertyChange{Name: "datastore", Val: []types.ManagedObjectReference{*ref}})
+	if spec.Datastore != nil && (len(vm.Datastore) == 0 || *spec.Datastore != vm.Datastore[0]) {
+		if err := lm.checkFeature(ctx, src, "svmotion"); err != nil {
+			return err
 		}
+	}
 
-		if ref := req.Spec.Pool; ref != nil {
-			pool := ctx.Map.Get(*ref).(*ResourcePool)
-			ctx.Map.RemoveReference(ctx, pool, &pool.Vm, *ref)
+	return nil
+}
 
-			changes = append(changes, types.PropertyChange{Name: "resourcePool", Val: ref})
-		}
+// relocate moves the VM as specified, once checkMigrateLicense has passed
+func (vm *VirtualMachine) relocate(ctx *Context, spec *types.VirtualMachineRelocateSpec) {
+	var changes []types.PropertyChange
 
-		if ref := req.Spec.Host; ref != nil {
-			host := ctx.Map.Get(*ref).(*HostSystem)
-			ctx.Map.RemoveReference(ctx, host, &host.Vm, *ref)
+	if ref := spec.Datastore; ref != nil {
+		ds := ctx.Map.Get(*ref).(*Datastore)
+		ctx.Map.RemoveReference(ctx, ds, &ds.Vm, *ref)
 
-			changes = append(changes,
-				types.PropertyChange{Name: "runtime.host", Val: ref},
-				types.PropertyChange{Name: "summary.runtime.host", Val: ref},
-			)
-		}
+		// TODO: migrate vm.Config.Files, vm.Summary.Config.VmPathName, vm.Layout and vm.LayoutEx
 
-		if ref := req.Spec.Folder; ref != nil {
-			folder := ctx.Map.Get(*ref).(*Folder)
-			folder.MoveIntoFolderTask(ctx, &types.MoveIntoFolder_Task{
-				List: []types.ManagedObjectReference{vm.Self},
-			})
-		}
+		changes = append(changes, types.PropertyChange{Name: "datastore", Val: []types.ManagedObjectReference{*ref}})
+	}
+
+	if ref := spec.Pool; ref != nil {
+		pool := ctx.Map.Get(*ref).(*ResourcePool)
+		ctx.Map.RemoveReference(ctx, pool, &pool.Vm, *ref)
+
+		changes = append(changes, types.PropertyChange{Name: "resourcePool", Val: ref})
+	}
 
-		ctx.postEvent(&types.VmMigratedEvent{
-			VmEvent:          vm.event(),
-			SourceHost:       *ctx.Map.Get(*vm.Runtime.Host).(*HostSystem).eventArgument(),
-			SourceDatacenter: datacenterEventArgument(vm),
-			SourceDatastore:  ctx.Map.Get(vm.Datastore[0]).(*Datastore).eventArgument(),
+	if ref := spec.Host; ref != nil {
+		host := ctx.Map.Get(*ref).(*HostSystem)
+		ctx.Map.RemoveReference(ctx, host, &host.Vm, *ref)
+
+		changes = append(changes,
+			types.PropertyChange{Name: "runtime.host", Val: ref},
+			types.PropertyChange{Name: "summary.runtime.host", Val: ref},
+		)
+	}
+
+	if ref := spec.Folder; ref != nil {
+		folder := ctx.Map.Get(*ref).(*Folder)
+		folder.MoveIntoFolderTask(ctx, &types.MoveIntoFolder_Task{
+			List: []types.ManagedObjectReference{vm.Self},
 		})
+	}
 
-		ctx.Map.Update(vm, changes)
+	ctx.postEvent(&types.VmMigratedEvent{
+		VmEvent:          vm.event(ctx),
+		SourceHost:       *ctx.Map.Get(*vm.Runtime.Host).(*HostSystem).eventArgument(),
+		SourceDatacenter: datacenterEventArgument(ctx, vm),
+		SourceDatastore:  ctx.Map.Get(vm.Datastore[0]).(*Datastore).eventArgument(),
+	})
+
+	ctx.Map.Update(vm, changes)
+}
+
+func (vm *VirtualMachine) RelocateVMTask(ctx *Context, req *types.RelocateVM_Task) soap.HasFault {
+	task := CreateTask(ctx, vm, "relocateVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if err := vm.checkMigrateLicense(ctx, &req.Spec); err != nil {
+			return nil, err
+		}
+
+		vm.relocate(ctx, &req.Spec)
 
 		return nil, nil
 	})
@@ -1958,12 +2202,42 @@ func (vm *VirtualMachine) RelocateVMTask(ctx *Context, req *types.RelocateVM_Tas
 	}
 }
 
+func (vm *VirtualMachine) MigrateVMTask(ctx *Context, req *types.MigrateVM_Task) soap.HasFault {
+	task := CreateTask(ctx, vm, "migrateVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if req.State != "" && req.State != vm.Runtime.PowerState {
+			return nil, &types.InvalidPowerState{
+				RequestedState: req.State,
+				ExistingState:  vm.Runtime.PowerState,
+			}
+		}
+
+		spec := &types.VirtualMachineRelocateSpec{
+			Pool: req.Pool,
+			Host: req.Host,
+		}
+
+		if err := vm.checkMigrateLicense(ctx, spec); err != nil {
+			return nil, err
+		}
+
+		vm.relocate(ctx, spec)
+
+		return nil, nil
+	})
+
+	return &methods.MigrateVM_TaskBody{
+		Res: &types.MigrateVM_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (vm *VirtualMachine) customize(ctx *Context) {
 	if vm.imc == nil {
 		return
 	}
 
-	event := types.CustomizationEvent{VmEvent: vm.event()}
+	event := types.CustomizationEvent{VmEvent: vm.event(ctx)}
 	ctx.postEvent(&types.CustomizationStartedEvent{CustomizationEvent: event})
 
 	changes := []types.PropertyChange{
@@ -2041,8 +2315,44 @@ func (vm *VirtualMachine) customize(ctx *Context) {
 	ctx.postEvent(&types.CustomizationSucceeded{CustomizationEvent: event})
 }
 
+// assignGuestIP assigns guest IPs from the IpPoolManager, after the configured delay if any.
+func (vm *VirtualMachine) assignGuestIP(ctx *Context) {
+	m := ctx.Map.IpPoolManager()
+	if m == nil {
+		return
+	}
+
+	if m.delay == 0 {
+		m.assignGuestIP(ctx, vm)
+		return
+	}
+
+	// the request ctx may be done by the time the timer fires, use our own for locking
+	tctx := &Context{Context: context.Background(), Session: ctx.Session, Map: ctx.Map}
+
+	vm.ipt = time.AfterFunc(m.delay, func() {
+		tctx.WithLock(vm, func() {
+			if vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn {
+				m.assignGuestIP(tctx, vm)
+			}
+		})
+	})
+}
+
+// releaseGuestIP cancels any pending guest IP assignment and releases those assigned.
+func (vm *VirtualMachine) releaseGuestIP(ctx *Context) {
+	if vm.ipt != nil {
+		vm.ipt.Stop()
+		vm.ipt = nil
+	}
+
+	if m := ctx.Map.IpPoolManager(); m != nil {
+		m.releaseGuestIP(ctx, vm)
+	}
+}
+
 func (vm *VirtualMachine) CustomizeVMTask(ctx *Context, req *types.CustomizeVM_Task) soap.HasFault {
-	task := CreateTask(vm, "customizeVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "customizeVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if vm.hostInMM(ctx) {
 			return nil, new(types.InvalidState)
 		}
@@ -2077,7 +2387,7 @@ func (vm *VirtualMachine) CustomizeVMTask(ctx *Context, req *types.CustomizeVM_T
 }
 
 func (vm *VirtualMachine) CreateSnapshotTask(ctx *Context, req *types.CreateSnapshot_Task) soap.HasFault {
-	task := CreateTask(vm, "createSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "createSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		var changes []types.PropertyChange
 
 		if vm.Snapshot == nil {
@@ -2117,7 +2427,7 @@ func (vm *VirtualMachine) CreateSnapshotTask(ctx *Context, req *types.CreateSnap
 			})
 		}
 
-		snapshot.createSnapshotFiles()
+		snapshot.createSnapshotFiles(ctx)
 
 		changes = append(changes, types.PropertyChange{Name: "snapshot.currentSnapshot", Val: snapshot.Self})
 		ctx.Map.Update(vm, changes)
@@ -2141,7 +2451,7 @@ func (vm *VirtualMachine) RevertToCurrentSnapshotTask(ctx *Context, req *types.R
 		return body
 	}
 
-	task := CreateTask(vm, "revertSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "revertSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		return nil, nil
 	})
 
@@ -2153,7 +2463,7 @@ func (vm *VirtualMachine) RevertToCurrentSnapshotTask(ctx *Context, req *types.R
 }
 
 func (vm *VirtualMachine) RemoveAllSnapshotsTask(ctx *Context, req *types.RemoveAllSnapshots_Task) soap.HasFault {
-	task := CreateTask(vm, "RemoveAllSnapshots", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, vm, "RemoveAllSnapshots", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		if vm.Snapshot == nil {
 			return nil, nil
 		}
@@ -2194,24 +2504,27 @@ func (vm *VirtualMachine) ShutdownGuest(ctx *Context, c *types.ShutdownGuest) so
 	vm.Runtime.PowerState = types.VirtualMachinePowerStatePoweredOff
 	vm.Summary.Runtime.PowerState = types.VirtualMachinePowerStatePoweredOff
 
-	event := vm.event()
+	event := vm.event(ctx)
 	ctx.postEvent(
 		&types.VmGuestShutdownEvent{VmEvent: event},
 		&types.VmPoweredOffEvent{VmEvent: event},
 	)
 	vm.run.stop(ctx, vm)
+	vm.releaseGuestIP(ctx)
 
 	ctx.Map.Update(vm, []types.PropertyChange{
 		{Name: "runtime.powerState", Val: types.VirtualMachinePowerStatePoweredOff},
 		{Name: "summary.runtime.powerState", Val: types.VirtualMachinePowerStatePoweredOff},
 	})
+	vm.refreshSwitchPorts(ctx)
+	vm.toolsStop(ctx)
 
 	r.Res = new(types.ShutdownGuestResponse)
 
 	return r
 }
 
-func (vm *VirtualMachine) MarkAsTemplate(req *types.MarkAsTemplate) soap.HasFault {
+func (vm *VirtualMachine) MarkAsTemplate(ctx *Context, req *types.MarkAsTemplate) soap.HasFault {
 	r := &methods.MarkAsTemplateBody{}
 
 	if vm.Config.Template {
@@ -2230,13 +2543,14 @@ func (vm *VirtualMachine) MarkAsTemplate(req *types.MarkAsTemplate) soap.HasFaul
 	vm.Config.Template = true
 	vm.Summary.Config.Template = true
 	vm.ResourcePool = nil
+	ctx.Map.reindex(vm)
 
 	r.Res = new(types.MarkAsTemplateResponse)
 
 	return r
 }
 
-func (vm *VirtualMachine) MarkAsVirtualMachine(req *types.MarkAsVirtualMachine) soap.HasFault {
+func (vm *VirtualMachine) MarkAsVirtualMachine(ctx *Context, req *types.MarkAsVirtualMachine) soap.HasFault {
 	r := &methods.MarkAsVirtualMachineBody{}
 
 	if !vm.Config.Template {
@@ -2258,6 +2572,7 @@ func (vm *VirtualMachine) MarkAsVirtualMachine(req *types.MarkAsVirtualMachine)
 	if req.Host != nil {
 		vm.Runtime.Host = req.Host
 	}
+	ctx.Map.reindex(vm)
 
 	r.Res = new(types.MarkAsVirtualMachineResponse)
 
@@ -30,6 +30,7 @@ import (
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -1254,6 +1255,45 @@ func TestVmMarkAsTemplate(t *testing.T) {
 	}
 }
 
+func TestVmMigrate(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		host, err := finder.HostSystem(ctx, "DC0_C0_H1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		task, err := vm.Migrate(ctx, nil, host, types.VirtualMachineMovePriorityDefaultPriority, types.VirtualMachinePowerStatePoweredOff)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err == nil {
+			t.Error("expected error") // InvalidPowerState
+		}
+
+		task, err = vm.Migrate(ctx, nil, host, types.VirtualMachineMovePriorityDefaultPriority, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		ref, err := vm.HostSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ref.Reference() != host.Reference() {
+			t.Errorf("host=%s", ref.Reference())
+		}
+	})
+}
+
 func TestVmRefreshStorageInfo(t *testing.T) {
 	ctx := context.Background()
 
@@ -1469,7 +1509,7 @@ func TestVmRefreshStorageInfo(t *testing.T) {
 		t.Fatalf("could not parse datastore path: %s", vmm.Config.Files.LogDirectory)
 	}
 
-	f, fault := vmm.createFile(p.String(), "test.log", false)
+	f, fault := vmm.createFile(SpoofContext(), p.String(), "test.log", false)
 	if fault != nil {
 		t.Fatal("could not create log file")
 	}
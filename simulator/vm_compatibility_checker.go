@@ -0,0 +1,520 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type VirtualMachineCompatibilityChecker struct {
+	mo.VirtualMachineCompatibilityChecker
+}
+
+// vmCheck validates a VM, or a VM config spec, against destination hosts.
+// The same checks are used by the VirtualMachineCompatibilityChecker and VirtualMachineProvisioningChecker.
+type vmCheck struct {
+	ctx      *Context
+	testType []string
+
+	vm    *VirtualMachine                 // the VM to check, if any
+	spec  *types.VirtualMachineConfigSpec // config overrides or the VM config to check, if any
+	pool  *types.ManagedObjectReference   // destination pool, if any
+	state types.VirtualMachinePowerState  // desired power state, if any
+
+	datastore []types.ManagedObjectReference // destination datastores, defaults to the VM's datastores
+	space     int64                          // storage required on each destination datastore
+}
+
+// hosts returns the destination hosts: the given host, else the hosts of the pool's compute resource, else the VM's host.
+func (c *vmCheck) hosts(host *types.ManagedObjectReference) ([]*HostSystem, types.BaseMethodFault) {
+	var refs []types.ManagedObjectReference
+
+	switch {
+	case host != nil:
+		refs = append(refs, *host)
+	case c.pool != nil:
+		refs = computeResourceHosts(c.ctx, *c.pool)
+	case c.vm != nil:
+		refs = append(refs, *c.vm.Runtime.Host)
+	default:
+		return nil, &types.InvalidArgument{InvalidProperty: "host"}
+	}
+
+	var hosts []*HostSystem
+	for _, ref := range refs {
+		h, ok := c.ctx.Map.Get(ref).(*HostSystem)
+		if !ok {
+			return nil, &types.ManagedObjectNotFound{Obj: ref}
+		}
+		hosts = append(hosts, h)
+	}
+
+	if c.pool != nil {
+		if _, ok := asResourcePoolMO(c.ctx.Map.Get(*c.pool)); !ok {
+			return nil, &types.ManagedObjectNotFound{Obj: *c.pool}
+		}
+	}
+
+	return hosts, nil
+}
+
+// computeResourceHosts returns the hosts of the compute resource that owns the given pool
+func computeResourceHosts(ctx *Context, pool types.ManagedObjectReference) []types.ManagedObjectReference {
+	entity, ok := ctx.Map.Get(pool).(mo.Entity)
+	if !ok {
+		return nil
+	}
+
+	switch cr := ctx.Map.getEntityComputeResource(entity).(type) {
+	case *mo.ComputeResource:
+		return cr.Host
+	case *ClusterComputeResource:
+		return cr.Host
+	}
+
+	return nil
+}
+
+// results runs the checks against each destination host
+func (c *vmCheck) results(host *types.ManagedObjectReference) (types.AnyType, types.BaseMethodFault) {
+	hosts, fault := c.hosts(host)
+	if fault != nil {
+		return nil, fault
+	}
+
+	var res types.ArrayOfCheckResult
+	for _, h := range hosts {
+		res.CheckResult = append(res.CheckResult, c.check(h))
+	}
+
+	return res, nil
+}
+
+func (c *vmCheck) enabled(kind types.CheckTestType) bool {
+	if len(c.testType) == 0 {
+		return true
+	}
+	for _, t := range c.testType {
+		if t == string(kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *vmCheck) check(host *HostSystem) types.CheckResult {
+	res := types.CheckResult{Host: &host.Self}
+	if c.vm != nil {
+		res.Vm = &c.vm.Self
+	}
+
+	add := func(list *[]types.LocalizedMethodFault, faults ...types.BaseMethodFault) {
+		for _, f := range faults {
+			*list = append(*list, types.LocalizedMethodFault{
+				Fault:            f,
+				LocalizedMessage: checkFaultMessage(f),
+			})
+		}
+	}
+
+	if c.enabled(types.CheckTestTypeSourceTests) {
+		errs, warns := c.sourceTests(host)
+		add(&res.Error, errs...)
+		add(&res.Warning, warns...)
+	}
+	if c.enabled(types.CheckTestTypeHostTests) {
+		add(&res.Error, c.hostTests(host)...)
+	}
+	if c.enabled(types.CheckTestTypeResourcePoolTests) {
+		add(&res.Error, c.resourcePoolTests(host)...)
+	}
+	if c.enabled(types.CheckTestTypeDatastoreTests) {
+		add(&res.Error, c.datastoreTests(host)...)
+	}
+	if c.enabled(types.CheckTestTypeNetworkTests) {
+		errs, warns := c.networkTests(host)
+		add(&res.Error, errs...)
+		add(&res.Warning, warns...)
+	}
+
+	return res
+}
+
+// checkFaultMessage returns a readable message for the given check fault
+func checkFaultMessage(f types.BaseMethodFault) string {
+	switch f := f.(type) {
+	case *types.HostNotConnected:
+		return "The host is not connected."
+	case *types.InvalidHostState:
+		return "The host is in maintenance mode."
+	case *types.InvalidPowerState:
+		return fmt.Sprintf("The virtual machine is %s, expected %s.", f.ExistingState, f.RequestedState)
+	case *types.NoGuestHeartbeat:
+		return "The virtual machine has no guest heartbeat."
+	case *types.VirtualHardwareVersionNotSupported:
+		return fmt.Sprintf("The virtual hardware version is not supported by host %s.", f.HostName)
+	case *types.UnsupportedGuest:
+		return fmt.Sprintf("The guest operating system %q is not supported.", f.UnsupportedGuestOS)
+	case *types.NotEnoughLogicalCpus:
+		return fmt.Sprintf("The virtual machine requires %d CPUs, the host has %d.", f.NumCpuVm, f.NumCpuDest)
+	case *types.InsufficientHostMemoryCapacityFault:
+		return fmt.Sprintf("The virtual machine requires %d bytes of memory, the host has %d.", f.Requested, f.Unreserved)
+	case *types.InsufficientMemoryResourcesFault:
+		return fmt.Sprintf("The virtual machine requires %d bytes of memory, the pool has %d.", f.Requested, f.Unreserved)
+	case *types.InvalidArgument:
+		return fmt.Sprintf("A specified parameter was not correct: %s", f.InvalidProperty)
+	case *types.InvalidDatastore:
+		return fmt.Sprintf("The datastore %q is not valid.", f.Name)
+	case *types.DatastoreNotWritableOnHost:
+		return fmt.Sprintf("The datastore %q is not accessible from the host.", f.Name)
+	case *types.InaccessibleDatastore:
+		return fmt.Sprintf("The datastore %q is not accessible.", f.Name)
+	case *types.InsufficientStorageSpace:
+		return "Insufficient disk space on datastore."
+	case *types.CannotAccessNetwork:
+		return fmt.Sprintf("Network adapter %q cannot access network %q.", f.Device, f.Backing)
+	}
+	return reflect.TypeOf(f).Elem().Name()
+}
+
+// sourceTests check the VM and its current host
+func (c *vmCheck) sourceTests(dst *HostSystem) ([]types.BaseMethodFault, []types.BaseMethodFault) {
+	var errs, warns []types.BaseMethodFault
+	if c.vm == nil {
+		return nil, nil
+	}
+
+	src := c.ctx.Map.Get(*c.vm.Runtime.Host).(*HostSystem)
+	if src.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+		errs = append(errs, &types.HostNotConnected{})
+	}
+
+	state := c.vm.Runtime.PowerState
+	if c.state != "" && c.state != state {
+		errs = append(errs, &types.InvalidPowerState{
+			RequestedState: c.state,
+			ExistingState:  state,
+		})
+	}
+
+	if state == types.VirtualMachinePowerStatePoweredOn && src.Self != dst.Self {
+		if c.vm.GuestHeartbeatStatus != types.ManagedEntityStatusGreen {
+			warns = append(warns, new(types.NoGuestHeartbeat))
+		}
+	}
+
+	return errs, warns
+}
+
+func (c *vmCheck) hardware() (string, int32, int64, string) {
+	var version, guest string
+	var cpus int32
+	var memory int64
+
+	if c.vm != nil {
+		version = c.vm.Config.Version
+		guest = c.vm.Config.GuestId
+		cpus = c.vm.Config.Hardware.NumCPU
+		memory = int64(c.vm.Config.Hardware.MemoryMB)
+	}
+
+	if spec := c.spec; spec != nil {
+		if spec.Version != "" {
+			version = spec.Version
+		}
+		if spec.GuestId != "" {
+			guest = spec.GuestId
+		}
+		if spec.NumCPUs != 0 {
+			cpus = spec.NumCPUs
+		}
+		if spec.MemoryMB != 0 {
+			memory = spec.MemoryMB
+		}
+	}
+
+	return version, cpus, memory, guest
+}
+
+// hostTests check the VM against the destination host
+func (c *vmCheck) hostTests(host *HostSystem) []types.BaseMethodFault {
+	var errs []types.BaseMethodFault
+
+	if host.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+		errs = append(errs, &types.HostNotConnected{})
+	}
+	if host.Runtime.InMaintenanceMode {
+		errs = append(errs, &types.InvalidHostState{Host: &host.Self})
+	}
+
+	version, cpus, _, guest := c.hardware()
+
+	if v := findHardwareVersion(version); v != nil && !v.supports(host) {
+		errs = append(errs, &types.VirtualHardwareVersionNotSupported{
+			HostName: host.Name,
+			Host:     host.Self,
+		})
+	}
+
+	if guest != "" {
+		if err := validateGuestID(guest); err != nil {
+			errs = append(errs, &types.UnsupportedGuest{UnsupportedGuestOS: guest})
+		}
+	}
+
+	if n := int32(host.Summary.Hardware.NumCpuThreads); cpus > n {
+		errs = append(errs, &types.NotEnoughLogicalCpus{
+			NotEnoughCpus: types.NotEnoughCpus{NumCpuDest: n, NumCpuVm: cpus},
+			Host:          &host.Self,
+		})
+	}
+
+	return errs
+}
+
+// resourcePoolTests check the VM's resource requirements against the destination host and pool
+func (c *vmCheck) resourcePoolTests(host *HostSystem) []types.BaseMethodFault {
+	var errs []types.BaseMethodFault
+	pool := c.pool
+	if pool == nil && c.vm != nil {
+		pool = c.vm.ResourcePool
+	}
+
+	_, _, memory, _ := c.hardware()
+	memory *= 1024 * 1024
+
+	if size := host.Summary.Hardware.MemorySize; memory > size {
+		errs = append(errs, &types.InsufficientHostMemoryCapacityFault{
+			InsufficientHostCapacityFault: types.InsufficientHostCapacityFault{Host: &host.Self},
+			Unreserved:                    size,
+			Requested:                     memory,
+		})
+	}
+
+	if pool == nil {
+		return errs
+	}
+
+	member := false
+	for _, ref := range computeResourceHosts(c.ctx, *pool) {
+		if ref == host.Self {
+			member = true
+		}
+	}
+	if !member {
+		errs = append(errs, &types.InvalidArgument{InvalidProperty: "pool"})
+	}
+
+	if rp, ok := asResourcePoolMO(c.ctx.Map.Get(*pool)); ok {
+		if limit := rp.Config.MemoryAllocation.Limit; limit != nil && *limit >= 0 && memory > *limit*1024*1024 {
+			errs = append(errs, &types.InsufficientMemoryResourcesFault{
+				Unreserved: *limit * 1024 * 1024,
+				Requested:  memory,
+			})
+		}
+	}
+
+	return errs
+}
+
+// datastores returns the destination datastores
+func (c *vmCheck) datastores(host *HostSystem) []types.ManagedObjectReference {
+	if len(c.datastore) != 0 {
+		return c.datastore
+	}
+	if c.vm != nil {
+		return c.vm.Datastore
+	}
+	if c.spec != nil && c.spec.Files != nil {
+		var p object.DatastorePath
+		if p.FromString(c.spec.Files.VmPathName) {
+			if ds := c.ctx.Map.FindByName(p.Datastore, host.Datastore); ds != nil {
+				return []types.ManagedObjectReference{ds.Reference()}
+			}
+			return []types.ManagedObjectReference{{Type: "Datastore", Value: p.Datastore}}
+		}
+	}
+	return nil
+}
+
+// datastoreTests check the destination datastores are accessible from the destination host
+func (c *vmCheck) datastoreTests(host *HostSystem) []types.BaseMethodFault {
+	var errs []types.BaseMethodFault
+
+	for _, ref := range c.datastores(host) {
+		ds, ok := c.ctx.Map.Get(ref).(*Datastore)
+		if !ok {
+			// datastore name from a spec path that doesn't exist
+			errs = append(errs, &types.InvalidDatastore{Name: ref.Value})
+			continue
+		}
+
+		invalid := types.InvalidDatastore{Datastore: &ds.Self, Name: ds.Name}
+
+		mounted := false
+		for _, m := range host.Datastore {
+			if m == ds.Self {
+				mounted = true
+			}
+		}
+		if !mounted {
+			errs = append(errs, &types.DatastoreNotWritableOnHost{InvalidDatastore: invalid, Host: host.Self})
+			continue
+		}
+
+		if !ds.Summary.Accessible {
+			errs = append(errs, &types.InaccessibleDatastore{InvalidDatastore: invalid})
+			continue
+		}
+
+		if c.space > ds.Summary.FreeSpace {
+			errs = append(errs, new(types.InsufficientStorageSpace))
+		}
+	}
+
+	return errs
+}
+
+// devices returns the VM's devices, including those added by the spec
+func (c *vmCheck) devices() object.VirtualDeviceList {
+	var devices object.VirtualDeviceList
+	if c.vm != nil {
+		devices = c.vm.Config.Hardware.Device
+	}
+	if c.spec != nil {
+		for _, change := range c.spec.DeviceChange {
+			spec := change.GetVirtualDeviceConfigSpec()
+			if spec.Operation == types.VirtualDeviceConfigSpecOperationAdd {
+				devices = append(devices, spec.Device)
+			}
+		}
+	}
+	return devices
+}
+
+// networkTests check the networks of the VM's ethernet cards are available on the destination host.
+// Networks of disconnected cards are reported as warnings.
+func (c *vmCheck) networkTests(host *HostSystem) ([]types.BaseMethodFault, []types.BaseMethodFault) {
+	var errs, warns []types.BaseMethodFault
+
+	for _, device := range c.devices().SelectByType((*types.VirtualEthernetCard)(nil)) {
+		dev := device.GetVirtualDevice()
+
+		var net *types.ManagedObjectReference
+		switch b := dev.Backing.(type) {
+		case *types.VirtualEthernetCardNetworkBackingInfo:
+			net = b.Network
+		case *types.VirtualEthernetCardDistributedVirtualPortBackingInfo:
+			net = &types.ManagedObjectReference{Type: "DistributedVirtualPortgroup", Value: b.Port.PortgroupKey}
+		}
+		if net == nil {
+			continue
+		}
+
+		found := false
+		for _, ref := range host.Network {
+			if ref == *net {
+				found = true
+			}
+		}
+		if found {
+			continue
+		}
+
+		connected := dev.Connectable != nil && dev.Connectable.Connected
+		name := net.Value
+		if e, ok := c.ctx.Map.Get(*net).(mo.Entity); ok {
+			name = entityName(e)
+		}
+
+		label := fmt.Sprintf("%d", dev.Key)
+		if dev.DeviceInfo != nil {
+			label = dev.DeviceInfo.GetDescription().Label
+		}
+
+		fault := &types.CannotAccessNetwork{
+			CannotAccessVmDevice: types.CannotAccessVmDevice{
+				Device:    label,
+				Backing:   name,
+				Connected: connected,
+			},
+			Network: net,
+		}
+
+		if connected || (dev.Connectable != nil && dev.Connectable.StartConnected) {
+			errs = append(errs, fault)
+		} else {
+			warns = append(warns, fault)
+		}
+	}
+
+	return errs, warns
+}
+
+func (c *VirtualMachineCompatibilityChecker) CheckCompatibilityTask(ctx *Context, req *types.CheckCompatibility_Task) soap.HasFault {
+	body := new(methods.CheckCompatibility_TaskBody)
+
+	vm, ok := ctx.Map.Get(req.Vm).(*VirtualMachine)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Vm})
+		return body
+	}
+
+	check := &vmCheck{ctx: ctx, testType: req.TestType, vm: vm, pool: req.Pool}
+
+	task := CreateTask(ctx, c, "checkCompatibility", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		return check.results(req.Host)
+	})
+
+	body.Res = &types.CheckCompatibility_TaskResponse{
+		Returnval: task.Run(ctx),
+	}
+
+	return body
+}
+
+func (c *VirtualMachineCompatibilityChecker) CheckVmConfigTask(ctx *Context, req *types.CheckVmConfig_Task) soap.HasFault {
+	body := new(methods.CheckVmConfig_TaskBody)
+
+	check := &vmCheck{ctx: ctx, testType: req.TestType, spec: &req.Spec, pool: req.Pool}
+
+	if req.Vm != nil {
+		vm, ok := ctx.Map.Get(*req.Vm).(*VirtualMachine)
+		if !ok {
+			body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: *req.Vm})
+			return body
+		}
+		check.vm = vm
+	}
+
+	task := CreateTask(ctx, c, "checkVmConfig", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		return check.results(req.Host)
+	})
+
+	body.Res = &types.CheckVmConfig_TaskResponse{
+		Returnval: task.Run(ctx),
+	}
+
+	return body
+}
@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// checkResults waits for a Check*_Task and returns its results
+func checkResults(ctx context.Context, c *vim25.Client, ref types.ManagedObjectReference) ([]types.CheckResult, error) {
+	info, err := object.NewTask(c, ref).WaitForResult(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return info.Result.(types.ArrayOfCheckResult).CheckResult, nil
+}
+
+// checkFaults returns the fault type names of the given LocalizedMethodFaults
+func checkFaults(faults []types.LocalizedMethodFault) []string {
+	var names []string
+	for _, f := range faults {
+		names = append(names, reflect.TypeOf(f.Fault).Elem().Name())
+	}
+	return names
+}
+
+func TestVirtualMachineCompatibilityChecker(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		checker := *c.ServiceContent.VmCompatibilityChecker
+		finder := find.NewFinder(c)
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool, err := finder.ResourcePool(ctx, "DC0_C0/Resources")
+		if err != nil {
+			t.Fatal(err)
+		}
+		host := Map.Get(*Map.Get(vm.Reference()).(*VirtualMachine).Runtime.Host).(*HostSystem)
+
+		check := func(req *types.CheckCompatibility_Task) ([]types.CheckResult, error) {
+			req.This = checker
+			req.Vm = vm.Reference()
+			res, err := methods.CheckCompatibility_Task(ctx, c, req)
+			if err != nil {
+				return nil, err
+			}
+			return checkResults(ctx, c, res.Returnval)
+		}
+
+		res, err := check(&types.CheckCompatibility_Task{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) != 1 || *res[0].Host != host.Self || len(res[0].Error) != 0 {
+			t.Errorf("res=%#v", res)
+		}
+
+		// a pool without a host checks each host of the pool's compute resource
+		res, err = check(&types.CheckCompatibility_Task{Pool: types.NewReference(pool.Reference())})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) != 3 {
+			t.Fatalf("%d results", len(res))
+		}
+		for _, r := range res {
+			// DC0_H0_VM0's DVPG and datastore are available on the cluster hosts
+			if fmt.Sprint(checkFaults(r.Error)) != "[]" {
+				t.Errorf("errors=%v", checkFaults(r.Error))
+			}
+		}
+
+		// the pool must be owned by the destination host's compute resource
+		res, err = check(&types.CheckCompatibility_Task{Host: &host.Self, Pool: types.NewReference(pool.Reference())})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fmt.Sprint(checkFaults(res[0].Error)) != "[InvalidArgument]" {
+			t.Errorf("errors=%v", checkFaults(res[0].Error))
+		}
+
+		host.Runtime.InMaintenanceMode = true
+		host.Runtime.ConnectionState = types.HostSystemConnectionStateNotResponding
+		net := host.Network
+		host.Network = nil
+
+		res, err = check(&types.CheckCompatibility_Task{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := "[HostNotConnected HostNotConnected InvalidHostState CannotAccessNetwork]"
+		if names := fmt.Sprint(checkFaults(res[0].Error)); names != expect {
+			t.Errorf("errors=%s", names)
+		}
+
+		res, err = check(&types.CheckCompatibility_Task{TestType: []string{string(types.CheckTestTypeHostTests)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := fmt.Sprint(checkFaults(res[0].Error)); names != "[HostNotConnected InvalidHostState]" {
+			t.Errorf("errors=%s", names)
+		}
+
+		host.Runtime.InMaintenanceMode = false
+		host.Runtime.ConnectionState = types.HostSystemConnectionStateConnected
+		host.Network = net
+
+		tests := []struct {
+			spec   types.VirtualMachineConfigSpec
+			expect string
+		}{
+			{types.VirtualMachineConfigSpec{NumCPUs: 1, MemoryMB: 64}, "[]"},
+			{types.VirtualMachineConfigSpec{NumCPUs: 64}, "[NotEnoughLogicalCpus]"},
+			{types.VirtualMachineConfigSpec{MemoryMB: 64 * 1024}, "[InsufficientHostMemoryCapacityFault]"},
+			{types.VirtualMachineConfigSpec{GuestId: "enoent"}, "[UnsupportedGuest]"},
+			{types.VirtualMachineConfigSpec{Version: "vmx-19"}, "[VirtualHardwareVersionNotSupported]"},
+			{types.VirtualMachineConfigSpec{Files: &types.VirtualMachineFileInfo{VmPathName: "[enoent] vm/vm.vmx"}}, "[InvalidDatastore]"},
+		}
+
+		for _, test := range tests {
+			req := &types.CheckVmConfig_Task{
+				This: checker,
+				Spec: test.spec,
+				Host: &host.Self,
+			}
+			res, err := methods.CheckVmConfig_Task(ctx, c, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			results, err := checkResults(ctx, c, res.Returnval)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if names := fmt.Sprint(checkFaults(results[0].Error)); names != test.expect {
+				t.Errorf("%#v: errors=%s", test.spec, names)
+			}
+		}
+
+		// a NIC created without DeviceInfo, on a network the host doesn't have
+		nic, err := object.VirtualDeviceList{}.CreateEthernetCard("e1000", &types.VirtualEthernetCardNetworkBackingInfo{
+			Network: &types.ManagedObjectReference{Type: "Network", Value: "enoent"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		nic.GetVirtualDevice().Connectable = &types.VirtualDeviceConnectInfo{StartConnected: true}
+
+		cres, err := methods.CheckVmConfig_Task(ctx, c, &types.CheckVmConfig_Task{
+			This: checker,
+			Host: &host.Self,
+			Spec: types.VirtualMachineConfigSpec{
+				DeviceChange: []types.BaseVirtualDeviceConfigSpec{
+					&types.VirtualDeviceConfigSpec{Operation: types.VirtualDeviceConfigSpecOperationAdd, Device: nic},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		results, err := checkResults(ctx, c, cres.Returnval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := fmt.Sprint(checkFaults(results[0].Error)); names != "[CannotAccessNetwork]" {
+			t.Errorf("errors=%s", names)
+		}
+		if msg := results[0].Error[0].LocalizedMessage; !strings.Contains(msg, "enoent") {
+			t.Errorf("message=%s", msg)
+		}
+
+		// one of host or pool is required when checking a spec without a VM
+		cres, err = methods.CheckVmConfig_Task(ctx, c, &types.CheckVmConfig_Task{This: checker})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = checkResults(ctx, c, cres.Returnval)
+		if _, ok := err.(task.Error).Fault().(*types.InvalidArgument); !ok {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type VirtualMachineProvisioningChecker struct {
+	mo.VirtualMachineProvisioningChecker
+}
+
+// provisioned returns the storage required by a copy of the VM's files: disk capacity plus other files
+func provisioned(vm *VirtualMachine) int64 {
+	var size int64
+	if s := vm.Summary.Storage; s != nil {
+		size = s.Committed - s.Unshared
+	}
+
+	disks := object.VirtualDeviceList(vm.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil))
+	for _, disk := range disks {
+		size += getDiskSize(disk.(*types.VirtualDisk))
+	}
+
+	return size
+}
+
+// relocateCheck returns a vmCheck for the given RelocateSpec
+func relocateCheck(ctx *Context, vm *VirtualMachine, spec *types.VirtualMachineRelocateSpec, testType []string) *vmCheck {
+	check := &vmCheck{ctx: ctx, testType: testType, vm: vm, pool: spec.Pool}
+
+	if spec.Datastore != nil {
+		check.datastore = append(check.datastore, *spec.Datastore)
+	}
+	for _, disk := range spec.Disk {
+		check.datastore = append(check.datastore, disk.Datastore)
+	}
+
+	// storage is only required when moving the VM's files to another datastore
+	for _, ref := range check.datastore {
+		moved := true
+		for _, ds := range vm.Datastore {
+			if ds == ref {
+				moved = false
+			}
+		}
+		if moved {
+			check.space = provisioned(vm)
+		}
+	}
+
+	return check
+}
+
+func (c *VirtualMachineProvisioningChecker) CheckMigrateTask(ctx *Context, req *types.CheckMigrate_Task) soap.HasFault {
+	body := new(methods.CheckMigrate_TaskBody)
+
+	vm, ok := ctx.Map.Get(req.Vm).(*VirtualMachine)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Vm})
+		return body
+	}
+
+	check := &vmCheck{ctx: ctx, testType: req.TestType, vm: vm, pool: req.Pool, state: req.State}
+
+	task := CreateTask(ctx, c, "checkMigrate", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		return check.results(req.Host)
+	})
+
+	body.Res = &types.CheckMigrate_TaskResponse{
+		Returnval: task.Run(ctx),
+	}
+
+	return body
+}
+
+func (c *VirtualMachineProvisioningChecker) CheckRelocateTask(ctx *Context, req *types.CheckRelocate_Task) soap.HasFault {
+	body := new(methods.CheckRelocate_TaskBody)
+
+	vm, ok := ctx.Map.Get(req.Vm).(*VirtualMachine)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Vm})
+		return body
+	}
+
+	check := relocateCheck(ctx, vm, &req.Spec, req.TestType)
+
+	task := CreateTask(ctx, c, "checkRelocate", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		return check.results(req.Spec.Host)
+	})
+
+	body.Res = &types.CheckRelocate_TaskResponse{
+		Returnval: task.Run(ctx),
+	}
+
+	return body
+}
+
+func (c *VirtualMachineProvisioningChecker) CheckCloneTask(ctx *Context, req *types.CheckClone_Task) soap.HasFault {
+	body := new(methods.CheckClone_TaskBody)
+
+	vm, ok := ctx.Map.Get(req.Vm).(*VirtualMachine)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Vm})
+		return body
+	}
+
+	folder, ok := asFolderMO(ctx.Map.Get(req.Folder))
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Folder})
+		return body
+	}
+
+	check := relocateCheck(ctx, vm, &req.Spec.Location, req.TestType)
+	check.spec = req.Spec.Config
+	check.space = provisioned(vm) // clones always require storage
+
+	task := CreateTask(ctx, c, "checkClone", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		// same validation as CloneVM_Task
+		if check.pool == nil && vm.Config.Template {
+			return nil, &types.InvalidArgument{InvalidProperty: "spec.location.pool"}
+		}
+		if e := ctx.Map.FindByName(req.Name, folder.ChildEntity); e != nil {
+			return nil, &types.DuplicateName{Name: req.Name, Object: e.Reference()}
+		}
+
+		return check.results(req.Spec.Location.Host)
+	})
+
+	body.Res = &types.CheckClone_TaskResponse{
+		Returnval: task.Run(ctx),
+	}
+
+	return body
+}
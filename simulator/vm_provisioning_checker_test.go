@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineProvisioningChecker(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		checker := *c.ServiceContent.VmProvisioningChecker
+		finder := find.NewFinder(c)
+
+		obj, err := finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		vm := Map.Get(obj.Reference()).(*VirtualMachine)
+
+		var dst *HostSystem
+		for _, ref := range computeResourceHosts(SpoofContext(), *vm.ResourcePool) {
+			if ref != *vm.Runtime.Host {
+				dst = Map.Get(ref).(*HostSystem)
+				break
+			}
+		}
+
+		// migrate a powered on VM without a guest heartbeat
+		mres, err := methods.CheckMigrate_Task(ctx, c, &types.CheckMigrate_Task{
+			This:  checker,
+			Vm:    vm.Self,
+			Host:  &dst.Self,
+			State: types.VirtualMachinePowerStatePoweredOff,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := checkResults(ctx, c, mres.Returnval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := fmt.Sprint(checkFaults(res[0].Error)); names != "[InvalidPowerState]" {
+			t.Errorf("errors=%s", names)
+		}
+		if names := fmt.Sprint(checkFaults(res[0].Warning)); names != "[NoGuestHeartbeat]" {
+			t.Errorf("warnings=%s", names)
+		}
+
+		ds := Map.Get(vm.Datastore[0]).(*Datastore)
+
+		relocate := func(spec types.VirtualMachineRelocateSpec) string {
+			rres, err := methods.CheckRelocate_Task(ctx, c, &types.CheckRelocate_Task{
+				This:     checker,
+				Vm:       vm.Self,
+				Spec:     spec,
+				TestType: []string{string(types.CheckTestTypeDatastoreTests)},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := checkResults(ctx, c, rres.Returnval)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return fmt.Sprint(checkFaults(res[0].Error))
+		}
+
+		spec := types.VirtualMachineRelocateSpec{Datastore: &ds.Self, Host: &dst.Self}
+		if names := relocate(spec); names != "[]" {
+			t.Errorf("errors=%s", names)
+		}
+
+		ds.Summary.Accessible = false
+		if names := relocate(spec); names != "[InaccessibleDatastore]" {
+			t.Errorf("errors=%s", names)
+		}
+		ds.Summary.Accessible = true
+
+		mounts := dst.Datastore
+		dst.Datastore = nil
+		if names := relocate(spec); names != "[DatastoreNotWritableOnHost]" {
+			t.Errorf("errors=%s", names)
+		}
+		dst.Datastore = mounts
+
+		clone := func(name string, spec types.VirtualMachineCloneSpec) ([]types.CheckResult, error) {
+			cres, err := methods.CheckClone_Task(ctx, c, &types.CheckClone_Task{
+				This:   checker,
+				Vm:     vm.Self,
+				Folder: *vm.Parent,
+				Name:   name,
+				Spec:   spec,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return checkResults(ctx, c, cres.Returnval)
+		}
+
+		_, err = clone(vm.Name, types.VirtualMachineCloneSpec{})
+		if _, ok := err.(task.Error).Fault().(*types.DuplicateName); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		res, err = clone("clone", types.VirtualMachineCloneSpec{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res[0].Error) != 0 {
+			t.Errorf("errors=%v", checkFaults(res[0].Error))
+		}
+
+		// clones require storage on the destination datastore
+		free := ds.Summary.FreeSpace
+		ds.Summary.FreeSpace = 0
+		res, err = clone("clone", types.VirtualMachineCloneSpec{
+			Config: &types.VirtualMachineConfigSpec{NumCPUs: 8},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := fmt.Sprint(checkFaults(res[0].Error)); names != "[NotEnoughLogicalCpus InsufficientStorageSpace]" {
+			t.Errorf("errors=%s", names)
+		}
+		ds.Summary.FreeSpace = free
+	})
+}
@@ -33,6 +33,8 @@ import (
 	"github.com/vmware/govmomi/vim25/types"
 )
 
+const fcdDir = "fcd"
+
 type VStorageObject struct {
 	types.VStorageObject
 	types.VStorageObjectSnapshotInfo
@@ -105,7 +107,7 @@ func (m *VcenterVStorageObjectManager) statDatastoreBacking(ctx *Context, ref ty
 
 	for _, obj := range objs {
 		backing := obj.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
-		file, _ := fm.resolve(&dc.Self, backing.FilePath)
+		file, _ := fm.resolve(ctx, &dc.Self, backing.FilePath)
 		_, res[obj.Config.Id] = os.Stat(file)
 	}
 
@@ -113,7 +115,7 @@ func (m *VcenterVStorageObjectManager) statDatastoreBacking(ctx *Context, ref ty
 }
 
 func (m *VcenterVStorageObjectManager) ReconcileDatastoreInventoryTask(ctx *Context, req *types.ReconcileDatastoreInventory_Task) soap.HasFault {
-	task := CreateTask(m, "reconcileDatastoreInventory", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, m, "reconcileDatastoreInventory", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		objs := m.objects[req.Datastore]
 		stat := m.statDatastoreBacking(ctx, req.Datastore, nil)
 
@@ -183,7 +185,7 @@ func (m *VcenterVStorageObjectManager) RegisterDisk(ctx *Context, req *types.Reg
 		},
 	}
 
-	obj, fault := m.createObject(creq, true)
+	obj, fault := m.createObject(ctx, creq, true)
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -196,18 +198,37 @@ func (m *VcenterVStorageObjectManager) RegisterDisk(ctx *Context, req *types.Reg
 	return body
 }
 
-func (m *VcenterVStorageObjectManager) createObject(req *types.CreateDisk_Task, register bool) (*types.VStorageObject, types.BaseMethodFault) {
-	dir := "fcd"
-	ref := req.Spec.BackingSpec.GetVslmCreateSpecBackingSpec().Datastore
-	ds := Map.Get(ref).(*Datastore)
-	dc := Map.getEntityDatacenter(ds)
+// Catalog returns all objects, across all datastores, keyed by ID.
+func (m *VcenterVStorageObjectManager) Catalog() map[types.ID]*VStorageObject {
+	catalog := make(map[types.ID]*VStorageObject)
+	for _, objects := range m.objects {
+		for id, obj := range objects {
+			catalog[id] = obj
+		}
+	}
+	return catalog
+}
 
+// datastoreObjects returns the objects of the given datastore, creating the fcd directory if needed.
+func (m *VcenterVStorageObjectManager) datastoreObjects(ds *Datastore) map[types.ID]*VStorageObject {
 	objects, ok := m.objects[ds.Self]
 	if !ok {
 		objects = make(map[types.ID]*VStorageObject)
 		m.objects[ds.Self] = objects
-		_ = os.Mkdir(filepath.Join(ds.Info.GetDatastoreInfo().Url, dir), 0750)
+		_ = os.Mkdir(filepath.Join(ds.Info.GetDatastoreInfo().Url, fcdDir), 0750)
 	}
+	return objects
+}
+
+func (m *VcenterVStorageObjectManager) createObject(ctx *Context, req *types.CreateDisk_Task, register bool) (*types.VStorageObject, types.BaseMethodFault) {
+	ref := req.Spec.BackingSpec.GetVslmCreateSpecBackingSpec().Datastore
+	ds, ok := ctx.Map.Get(ref).(*Datastore)
+	if !ok {
+		return nil, &types.ManagedObjectNotFound{Obj: ref}
+	}
+	dc := ctx.Map.getEntityDatacenter(ds)
+
+	objects := m.datastoreObjects(ds)
 
 	id := uuid.New().String()
 	obj := types.VStorageObject{
@@ -223,6 +244,7 @@ func (m *VcenterVStorageObjectManager) createObject(req *types.CreateDisk_Task,
 				NativeSnapshotSupported:     types.NewBool(false),
 				ChangedBlockTrackingEnabled: types.NewBool(false),
 				Iofilter:                    nil,
+				Metadata:                    req.Spec.Metadata,
 			},
 			CapacityInMB:    req.Spec.CapacityInMB,
 			ConsumptionType: []string{"disk"},
@@ -236,11 +258,11 @@ func (m *VcenterVStorageObjectManager) createObject(req *types.CreateDisk_Task,
 		Path:      backing.Path,
 	}
 	if path.Path == "" {
-		path.Path = dir + "/" + id + ".vmdk"
+		path.Path = fcdDir + "/" + id + ".vmdk"
 	}
 
 	if !register {
-		err := vdmCreateVirtualDisk(types.VirtualDeviceConfigSpecFileOperationCreate, &types.CreateVirtualDisk_Task{
+		err := vdmCreateVirtualDisk(ctx, types.VirtualDeviceConfigSpecFileOperationCreate, &types.CreateVirtualDisk_Task{
 			Datacenter: &dc.Self,
 			Name:       path.String(),
 		})
@@ -269,8 +291,8 @@ func (m *VcenterVStorageObjectManager) createObject(req *types.CreateDisk_Task,
 }
 
 func (m *VcenterVStorageObjectManager) CreateDiskTask(ctx *Context, req *types.CreateDisk_Task) soap.HasFault {
-	task := CreateTask(m, "createDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
-		return m.createObject(req, false)
+	task := CreateTask(ctx, m, "createDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		return m.createObject(ctx, req, false)
 	})
 
 	return &methods.CreateDisk_TaskBody{
@@ -281,7 +303,7 @@ func (m *VcenterVStorageObjectManager) CreateDiskTask(ctx *Context, req *types.C
 }
 
 func (m *VcenterVStorageObjectManager) DeleteVStorageObjectTask(ctx *Context, req *types.DeleteVStorageObject_Task) soap.HasFault {
-	task := CreateTask(m, "deleteDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, m, "deleteDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		obj := m.object(req.Datastore, req.Id)
 		if obj == nil {
 			return nil, &types.InvalidArgument{}
@@ -324,7 +346,7 @@ func (m *VcenterVStorageObjectManager) RetrieveSnapshotInfo(req *types.RetrieveS
 }
 
 func (m *VcenterVStorageObjectManager) VStorageObjectCreateSnapshotTask(ctx *Context, req *types.VStorageObjectCreateSnapshot_Task) soap.HasFault {
-	task := CreateTask(m, "createSnapshot", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, m, "createSnapshot", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		obj := m.object(req.Datastore, req.Id)
 		if obj == nil {
 			return nil, new(types.InvalidArgument)
@@ -351,7 +373,7 @@ func (m *VcenterVStorageObjectManager) VStorageObjectCreateSnapshotTask(ctx *Con
 }
 
 func (m *VcenterVStorageObjectManager) ExtendDiskTask(ctx *Context, req *types.ExtendDisk_Task) soap.HasFault {
-	task := CreateTask(m, "extendDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, m, "extendDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		obj := m.object(req.Datastore, req.Id)
 		if obj == nil {
 			return nil, new(types.InvalidArgument)
@@ -368,7 +390,7 @@ func (m *VcenterVStorageObjectManager) ExtendDiskTask(ctx *Context, req *types.E
 }
 
 func (m *VcenterVStorageObjectManager) DeleteSnapshotTask(ctx *Context, req *types.DeleteSnapshot_Task) soap.HasFault {
-	task := CreateTask(m, "deleteSnapshot", func(*Task) (types.AnyType, types.BaseMethodFault) {
+	task := CreateTask(ctx, m, "deleteSnapshot", func(*Task) (types.AnyType, types.BaseMethodFault) {
 		obj := m.object(req.Datastore, req.Id)
 		if obj != nil {
 			for i := range obj.Snapshots {
@@ -467,3 +489,349 @@ func (m *VcenterVStorageObjectManager) ListTagsAttachedToVStorageObject(ctx *Con
 
 	return body
 }
+
+func (m *VcenterVStorageObjectManager) RenameVStorageObject(req *types.RenameVStorageObject) soap.HasFault {
+	body := new(methods.RenameVStorageObjectBody)
+
+	obj := m.object(req.Datastore, req.Id)
+	if obj == nil {
+		body.Fault_ = Fault("", new(types.NotFound))
+		return body
+	}
+
+	obj.Config.Name = req.Name
+	body.Res = new(types.RenameVStorageObjectResponse)
+
+	return body
+}
+
+func (m *VcenterVStorageObjectManager) RetrieveVStorageObjectState(req *types.RetrieveVStorageObjectState) soap.HasFault {
+	body := new(methods.RetrieveVStorageObjectStateBody)
+
+	if m.object(req.Datastore, req.Id) == nil {
+		body.Fault_ = Fault("", new(types.NotFound))
+		return body
+	}
+
+	body.Res = &types.RetrieveVStorageObjectStateResponse{
+		Returnval: types.VStorageObjectStateInfo{Tentative: types.NewBool(false)},
+	}
+
+	return body
+}
+
+// controlFlag returns the config field for the given control flag name
+func (obj *VStorageObject) controlFlag(name string) **bool {
+	switch types.VslmVStorageObjectControlFlag(name) {
+	case types.VslmVStorageObjectControlFlagKeepAfterDeleteVm:
+		return &obj.Config.KeepAfterDeleteVm
+	case types.VslmVStorageObjectControlFlagDisableRelocation:
+		return &obj.Config.RelocationDisabled
+	case types.VslmVStorageObjectControlFlagEnableChangedBlockTracking:
+		return &obj.Config.ChangedBlockTrackingEnabled
+	}
+	return nil
+}
+
+func (m *VcenterVStorageObjectManager) setControlFlags(ds types.ManagedObjectReference, id types.ID, flags []string, val bool) types.BaseMethodFault {
+	obj := m.object(ds, id)
+	if obj == nil {
+		return new(types.NotFound)
+	}
+
+	for _, name := range flags {
+		if obj.controlFlag(name) == nil {
+			return &types.InvalidArgument{InvalidProperty: "controlFlags"}
+		}
+	}
+
+	for _, name := range flags {
+		*obj.controlFlag(name) = types.NewBool(val)
+	}
+
+	return nil
+}
+
+func (m *VcenterVStorageObjectManager) SetVStorageObjectControlFlags(req *types.SetVStorageObjectControlFlags) soap.HasFault {
+	body := new(methods.SetVStorageObjectControlFlagsBody)
+
+	if err := m.setControlFlags(req.Datastore, req.Id, req.ControlFlags, true); err != nil {
+		body.Fault_ = Fault("", err)
+	} else {
+		body.Res = new(types.SetVStorageObjectControlFlagsResponse)
+	}
+
+	return body
+}
+
+func (m *VcenterVStorageObjectManager) ClearVStorageObjectControlFlags(req *types.ClearVStorageObjectControlFlags) soap.HasFault {
+	body := new(methods.ClearVStorageObjectControlFlagsBody)
+
+	if err := m.setControlFlags(req.Datastore, req.Id, req.ControlFlags, false); err != nil {
+		body.Fault_ = Fault("", err)
+	} else {
+		body.Res = new(types.ClearVStorageObjectControlFlagsResponse)
+	}
+
+	return body
+}
+
+func (m *VcenterVStorageObjectManager) InflateDiskTask(ctx *Context, req *types.InflateDisk_Task) soap.HasFault {
+	task := CreateTask(ctx, m, "inflateDisk", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		obj := m.object(req.Datastore, req.Id)
+		if obj == nil {
+			return nil, new(types.InvalidArgument)
+		}
+
+		backing := obj.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
+		backing.ProvisioningType = string(types.BaseConfigInfoDiskFileBackingInfoProvisioningTypeEagerZeroedThick)
+		return nil, nil
+	})
+
+	return &methods.InflateDisk_TaskBody{
+		Res: &types.InflateDisk_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// snapshot returns the index of the given snapshot, or -1 if not found
+func (obj *VStorageObject) snapshot(id types.ID) int {
+	for i := range obj.Snapshots {
+		if *obj.Snapshots[i].Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *VcenterVStorageObjectManager) RetrieveSnapshotDetails(req *types.RetrieveSnapshotDetails) soap.HasFault {
+	body := new(methods.RetrieveSnapshotDetailsBody)
+
+	obj := m.object(req.Datastore, req.Id)
+	if obj == nil || obj.snapshot(req.SnapshotId) == -1 {
+		body.Fault_ = Fault("", new(types.NotFound))
+		return body
+	}
+
+	details := types.VStorageObjectSnapshotDetails{
+		Path: obj.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo).FilePath,
+	}
+	if isTrue(obj.Config.ChangedBlockTrackingEnabled) {
+		details.ChangedBlockTrackingId = req.SnapshotId.Id
+	}
+
+	body.Res = &types.RetrieveSnapshotDetailsResponse{
+		Returnval: details,
+	}
+
+	return body
+}
+
+func (m *VcenterVStorageObjectManager) RevertVStorageObjectTask(ctx *Context, req *types.RevertVStorageObject_Task) soap.HasFault {
+	task := CreateTask(ctx, m, "revertVStorageObject", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		obj := m.object(req.Datastore, req.Id)
+		if obj == nil {
+			return nil, new(types.InvalidArgument)
+		}
+
+		i := obj.snapshot(req.SnapshotId)
+		if i == -1 {
+			return nil, new(types.NotFound)
+		}
+
+		// snapshots taken after the given snapshot are discarded
+		obj.Snapshots = obj.Snapshots[:i+1]
+		return nil, nil
+	})
+
+	return &methods.RevertVStorageObject_TaskBody{
+		Res: &types.RevertVStorageObject_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// copyObject creates a new object on the given datastore with the config of src
+func (m *VcenterVStorageObjectManager) copyObject(ctx *Context, src *VStorageObject, spec types.VslmCreateSpec, ds types.ManagedObjectReference) (*types.VStorageObject, types.BaseMethodFault) {
+	backing := src.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
+
+	if spec.CapacityInMB == 0 {
+		spec.CapacityInMB = src.Config.CapacityInMB
+	}
+
+	if spec.BackingSpec == nil {
+		spec.BackingSpec = new(types.VslmCreateSpecDiskFileBackingSpec)
+	}
+	file, ok := spec.BackingSpec.(*types.VslmCreateSpecDiskFileBackingSpec)
+	if !ok {
+		return nil, &types.InvalidArgument{InvalidProperty: "backingSpec"}
+	}
+	if file.Datastore.Value == "" {
+		file.Datastore = ds
+	}
+	if file.ProvisioningType == "" {
+		file.ProvisioningType = backing.ProvisioningType
+	}
+
+	return m.createObject(ctx, &types.CreateDisk_Task{Spec: spec}, false)
+}
+
+func (m *VcenterVStorageObjectManager) CloneVStorageObjectTask(ctx *Context, req *types.CloneVStorageObject_Task) soap.HasFault {
+	task := CreateTask(ctx, m, "cloneVStorageObject", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		obj := m.object(req.Datastore, req.Id)
+		if obj == nil {
+			return nil, new(types.InvalidArgument)
+		}
+
+		return m.copyObject(ctx, obj, types.VslmCreateSpec{
+			Name:              req.Spec.Name,
+			KeepAfterDeleteVm: req.Spec.KeepAfterDeleteVm,
+			BackingSpec:       req.Spec.BackingSpec,
+			Metadata:          req.Spec.Metadata,
+		}, req.Datastore)
+	})
+
+	return &methods.CloneVStorageObject_TaskBody{
+		Res: &types.CloneVStorageObject_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *VcenterVStorageObjectManager) CreateDiskFromSnapshotTask(ctx *Context, req *types.CreateDiskFromSnapshot_Task) soap.HasFault {
+	task := CreateTask(ctx, m, "createDiskFromSnapshot", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		obj := m.object(req.Datastore, req.Id)
+		if obj == nil {
+			return nil, new(types.InvalidArgument)
+		}
+
+		if obj.snapshot(req.SnapshotId) == -1 {
+			return nil, new(types.NotFound)
+		}
+
+		spec := types.VslmCreateSpec{
+			Name: req.Name,
+			BackingSpec: &types.VslmCreateSpecDiskFileBackingSpec{
+				VslmCreateSpecBackingSpec: types.VslmCreateSpecBackingSpec{
+					Datastore: req.Datastore,
+					Path:      req.Path,
+				},
+			},
+		}
+
+		return m.copyObject(ctx, obj, spec, req.Datastore)
+	})
+
+	return &methods.CreateDiskFromSnapshot_TaskBody{
+		Res: &types.CreateDiskFromSnapshot_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (m *VcenterVStorageObjectManager) RelocateVStorageObjectTask(ctx *Context, req *types.RelocateVStorageObject_Task) soap.HasFault {
+	task := CreateTask(ctx, m, "relocateVStorageObject", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		obj := m.object(req.Datastore, req.Id)
+		if obj == nil {
+			return nil, new(types.InvalidArgument)
+		}
+
+		if isTrue(obj.Config.RelocationDisabled) {
+			return nil, new(types.InvalidState)
+		}
+
+		if req.Spec.BackingSpec == nil {
+			return nil, &types.InvalidArgument{InvalidProperty: "backingSpec"}
+		}
+		spec := req.Spec.BackingSpec.GetVslmCreateSpecBackingSpec()
+		if spec.Datastore == req.Datastore {
+			return obj.VStorageObject, nil
+		}
+
+		ds, ok := ctx.Map.Get(spec.Datastore).(*Datastore)
+		if !ok {
+			return nil, &types.ManagedObjectNotFound{Obj: spec.Datastore}
+		}
+
+		backing := obj.Config.Backing.(*types.BaseConfigInfoDiskFileBackingInfo)
+		src := ctx.Map.Get(req.Datastore).(*Datastore)
+		dc := ctx.Map.getEntityDatacenter(src)
+		fm := ctx.Map.FileManager()
+
+		path := object.DatastorePath{Datastore: ds.Name, Path: spec.Path}
+		if path.Path == "" {
+			path.Path = fcdDir + "/" + obj.Config.Id.Id + ".vmdk"
+		}
+
+		objects := m.datastoreObjects(ds)
+
+		from, fault := fm.resolve(ctx, &dc.Self, backing.FilePath)
+		if fault != nil {
+			return nil, fault
+		}
+		to, fault := fm.resolve(ctx, &dc.Self, path.String())
+		if fault != nil {
+			return nil, fault
+		}
+
+		names := vdmNames(to)
+		for i, name := range vdmNames(from) {
+			if err := os.Rename(name, names[i]); err != nil {
+				return nil, fm.fault(name, err, new(types.CannotAccessFile))
+			}
+		}
+
+		backing.Datastore = ds.Self
+		backing.FilePath = path.String()
+		delete(m.objects[req.Datastore], req.Id)
+		objects[req.Id] = obj
+
+		return obj.VStorageObject, nil
+	})
+
+	return &methods.RelocateVStorageObject_TaskBody{
+		Res: &types.RelocateVStorageObject_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// associations returns the VM disks backed by the given object
+func (m *VcenterVStorageObjectManager) associations(ctx *Context, id types.ID) []types.VStorageObjectAssociationsVmDiskAssociations {
+	var disks []types.VStorageObjectAssociationsVmDiskAssociations
+
+	for _, e := range ctx.Map.All("VirtualMachine") {
+		vm := e.(*VirtualMachine)
+		for _, device := range vm.Config.Hardware.Device {
+			if disk, ok := device.(*types.VirtualDisk); ok && disk.VDiskId != nil && *disk.VDiskId == id {
+				disks = append(disks, types.VStorageObjectAssociationsVmDiskAssociations{
+					VmId:    vm.Self.Value,
+					DiskKey: disk.Key,
+				})
+			}
+		}
+	}
+
+	return disks
+}
+
+func (m *VcenterVStorageObjectManager) RetrieveVStorageObjectAssociations(ctx *Context, req *types.RetrieveVStorageObjectAssociations) soap.HasFault {
+	body := &methods.RetrieveVStorageObjectAssociationsBody{
+		Res: new(types.RetrieveVStorageObjectAssociationsResponse),
+	}
+
+	for _, spec := range req.Ids {
+		association := types.VStorageObjectAssociations{Id: spec.Id}
+
+		if m.object(spec.Datastore, spec.Id) == nil {
+			association.Fault = &types.LocalizedMethodFault{Fault: new(types.NotFound)}
+		} else {
+			association.VmDiskAssociations = m.associations(ctx, spec.Id)
+		}
+
+		body.Res.Returnval = append(body.Res.Returnval, association)
+	}
+
+	return body
+}
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sms
+
+import (
+	"context"
+
+	"github.com/vmware/govmomi/sms/methods"
+	"github.com/vmware/govmomi/sms/types"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// Namespace and Path constants
+const (
+	Namespace = "sms"
+	Path      = "/sms/sdk"
+)
+
+var (
+	ServiceInstance = vim.ManagedObjectReference{
+		Type:  "SmsServiceInstance",
+		Value: "ServiceInstance",
+	}
+)
+
+// Client used for accessing the Storage Monitoring Service (SMS) APIs.
+type Client struct {
+	*soap.Client
+
+	StorageManager vim.ManagedObjectReference
+
+	RoundTripper soap.RoundTripper
+}
+
+// NewClient creates a new SMS client, connected to the SmsStorageManager of the given vCenter.
+func NewClient(ctx context.Context, c *vim25.Client) (*Client, error) {
+	sc := c.Client.NewServiceClient(Path, Namespace)
+
+	req := types.QueryStorageManager{
+		This: ServiceInstance,
+	}
+
+	res, err := methods.QueryStorageManager(ctx, sc, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{sc, res.Returnval, sc}, nil
+}
+
+// RoundTrip dispatches to the RoundTripper field.
+func (c *Client) RoundTrip(ctx context.Context, req, res soap.HasFault) error {
+	return c.RoundTripper.RoundTrip(ctx, req, res)
+}
+
+// AboutInfo returns the SMS service about info.
+func (c *Client) AboutInfo(ctx context.Context) (*types.SmsAboutInfo, error) {
+	req := types.QueryAboutInfo{
+		This: ServiceInstance,
+	}
+
+	res, err := methods.QueryAboutInfo(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
+// QueryProvider returns the registered storage providers.
+func (c *Client) QueryProvider(ctx context.Context) ([]*Provider, error) {
+	req := types.QueryProvider{
+		This: c.StorageManager,
+	}
+
+	res, err := methods.QueryProvider(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]*Provider, len(res.Returnval))
+	for i := range res.Returnval {
+		providers[i] = NewProvider(c, res.Returnval[i])
+	}
+
+	return providers, nil
+}
+
+// RegisterProvider registers a VASA provider with the given spec.
+// The Task result is the ManagedObjectReference of the new provider.
+func (c *Client) RegisterProvider(ctx context.Context, spec types.BaseSmsProviderSpec) (*Task, error) {
+	req := types.RegisterProvider_Task{
+		This:         c.StorageManager,
+		ProviderSpec: spec,
+	}
+
+	res, err := methods.RegisterProvider_Task(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(c, res.Returnval), nil
+}
+
+// UnregisterProvider unregisters the provider with the given uid.
+func (c *Client) UnregisterProvider(ctx context.Context, uid string) (*Task, error) {
+	req := types.UnregisterProvider_Task{
+		This:       c.StorageManager,
+		ProviderId: uid,
+	}
+
+	res, err := methods.UnregisterProvider_Task(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(c, res.Returnval), nil
+}
+
+// QueryArray returns the storage arrays managed by the given providers, or all arrays if no uid is given.
+func (c *Client) QueryArray(ctx context.Context, uid ...string) ([]types.StorageArray, error) {
+	req := types.QueryArray{
+		This:       c.StorageManager,
+		ProviderId: uid,
+	}
+
+	res, err := methods.QueryArray(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// QueryDatastoreCapability returns the storage capability of the given datastore.
+func (c *Client) QueryDatastoreCapability(ctx context.Context, ds vim.ManagedObjectReference) (*types.StorageCapability, error) {
+	req := types.QueryDatastoreCapability{
+		This:      c.StorageManager,
+		Datastore: ds,
+	}
+
+	res, err := methods.QueryDatastoreCapability(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// QueryDatastoreBackingPoolMapping returns the backing storage pools of the given datastores.
+func (c *Client) QueryDatastoreBackingPoolMapping(ctx context.Context, ds []vim.ManagedObjectReference) ([]types.DatastoreBackingPoolMapping, error) {
+	req := types.QueryDatastoreBackingPoolMapping{
+		This:      c.StorageManager,
+		Datastore: ds,
+	}
+
+	res, err := methods.QueryDatastoreBackingPoolMapping(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// QueryStorageContainer returns the storage containers matching the given spec, or all containers if spec is nil.
+func (c *Client) QueryStorageContainer(ctx context.Context, spec *types.StorageContainerSpec) (*types.StorageContainerResult, error) {
+	req := types.QueryStorageContainer{
+		This:          c.StorageManager,
+		ContainerSpec: spec,
+	}
+
+	res, err := methods.QueryStorageContainer(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// QueryFaultDomain returns the replication fault domains matching the given filter, or all fault domains if filter is nil.
+func (c *Client) QueryFaultDomain(ctx context.Context, filter *types.FaultDomainFilter) ([]vim.FaultDomainId, error) {
+	req := types.QueryFaultDomain{
+		This:   c.StorageManager,
+		Filter: filter,
+	}
+
+	res, err := methods.QueryFaultDomain(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// QueryReplicationGroupInfo returns info for the replication groups matching the given filter.
+func (c *Client) QueryReplicationGroupInfo(ctx context.Context, filter types.ReplicationGroupFilter) ([]types.BaseGroupOperationResult, error) {
+	req := types.QueryReplicationGroupInfo{
+		This:     c.StorageManager,
+		RgFilter: filter,
+	}
+
+	res, err := methods.QueryReplicationGroupInfo(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
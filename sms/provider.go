@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sms
+
+import (
+	"context"
+
+	"github.com/vmware/govmomi/sms/methods"
+	"github.com/vmware/govmomi/sms/types"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// Provider wraps a VasaProvider managed object.
+type Provider struct {
+	vim.ManagedObjectReference
+
+	c *Client
+}
+
+// NewProvider returns a Provider for the given reference.
+func NewProvider(c *Client, ref vim.ManagedObjectReference) *Provider {
+	return &Provider{
+		ManagedObjectReference: ref,
+		c:                      c,
+	}
+}
+
+// Info returns the provider info, which is a *types.VasaProviderInfo for VASA providers.
+func (p *Provider) Info(ctx context.Context) (types.BaseSmsProviderInfo, error) {
+	req := types.QueryProviderInfo{
+		This: p.Reference(),
+	}
+
+	res, err := methods.QueryProviderInfo(ctx, p.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// Sync synchronizes the provider's storage array with the given id, or all arrays if id is empty.
+func (p *Provider) Sync(ctx context.Context, id string) (*Task, error) {
+	req := types.VasaProviderSync_Task{
+		This:    p.Reference(),
+		ArrayId: id,
+	}
+
+	res, err := methods.VasaProviderSync_Task(ctx, p.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(p.c, res.Returnval), nil
+}
+
+// Reconnect reconnects to the provider.
+func (p *Provider) Reconnect(ctx context.Context) (*Task, error) {
+	req := types.VasaProviderReconnect_Task{
+		This: p.Reference(),
+	}
+
+	res, err := methods.VasaProviderReconnect_Task(ctx, p.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(p.c, res.Returnval), nil
+}
+
+// RefreshCertificate refreshes the certificate issued to the provider by the vCenter CA.
+func (p *Provider) RefreshCertificate(ctx context.Context) (*Task, error) {
+	req := types.VasaProviderRefreshCertificate_Task{
+		This: p.Reference(),
+	}
+
+	res, err := methods.VasaProviderRefreshCertificate_Task(ctx, p.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(p.c, res.Returnval), nil
+}
+
+// RevokeCertificate revokes the certificate issued to the provider by the vCenter CA.
+func (p *Provider) RevokeCertificate(ctx context.Context) (*Task, error) {
+	req := types.VasaProviderRevokeCertificate_Task{
+		This: p.Reference(),
+	}
+
+	res, err := methods.VasaProviderRevokeCertificate_Task(ctx, p.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(p.c, res.Returnval), nil
+}
+
+// QueryReplicationPeer returns the replication target fault domains of the given source fault domains,
+// or of all source fault domains if none are given.
+func (p *Provider) QueryReplicationPeer(ctx context.Context, ids ...vim.FaultDomainId) ([]types.QueryReplicationPeerResult, error) {
+	req := types.QueryReplicationPeer{
+		This:          p.Reference(),
+		FaultDomainId: ids,
+	}
+
+	res, err := methods.QueryReplicationPeer(ctx, p.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// QueryReplicationGroup returns the given replication groups, or all groups if none are given.
+func (p *Provider) QueryReplicationGroup(ctx context.Context, ids ...vim.ReplicationGroupId) ([]types.BaseGroupOperationResult, error) {
+	req := types.QueryReplicationGroup{
+		This:    p.Reference(),
+		GroupId: ids,
+	}
+
+	res, err := methods.QueryReplicationGroup(ctx, p.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
@@ -0,0 +1,303 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"time"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/sms/methods"
+	"github.com/vmware/govmomi/sms/types"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// VasaProvider is a fake VASA provider, managing a single storage array with a single storage container.
+// The array has a source and target replication fault domain, with one replication group in each.
+type VasaProvider struct {
+	vim.ManagedObjectReference
+
+	Info             types.VasaProviderInfo
+	StorageArray     []types.StorageArray
+	StorageContainer []types.StorageContainer
+	FaultDomain      []types.FaultDomainInfo
+	ReplicationPeer  map[string][]vim.FaultDomainId
+	ReplicationGroup []types.BaseGroupInfo
+}
+
+// newProvider creates a VasaProvider for the given spec and adds it to Registry r
+func newProvider(r *simulator.Registry, spec *types.VasaProviderSpec) *VasaProvider {
+	uid := newUUID("")
+	now := time.Now()
+
+	array := types.StorageArray{
+		Name:                         spec.Name + "-array",
+		Uuid:                         "vcsim-array-" + newUUID(uid),
+		VendorId:                     "VMware",
+		ModelId:                      "vcsim",
+		Firmware:                     "1.0",
+		SupportedBlockInterface:      []string{string(types.BlockDeviceInterfaceIscsi)},
+		SupportedFileSystemInterface: []string{string(types.FileSystemInterfaceNfs)},
+		SupportedProfile: []string{
+			string(types.VasaProfileBlockDevice),
+			string(types.VasaProfileFileSystem),
+			string(types.VasaProfileCapability),
+			string(types.VasaProfilePolicy),
+			string(types.VasaProfileObject),
+		},
+		Priority: 1,
+	}
+
+	p := &VasaProvider{
+		ManagedObjectReference: vim.ManagedObjectReference{Type: "VasaProvider", Value: "VasaProvider-" + uid},
+		Info: types.VasaProviderInfo{
+			SmsProviderInfo: types.SmsProviderInfo{
+				Uid:         uid,
+				Name:        spec.Name,
+				Description: spec.Description,
+				Version:     "3.0",
+			},
+			Url:                         spec.Url,
+			Certificate:                 spec.Certificate,
+			Status:                      string(types.VasaProviderStatusOnline),
+			VasaVersion:                 "3.0",
+			Namespace:                   "vcsim",
+			LastSyncTime:                now.Format(time.RFC3339),
+			SupportedVendorModelMapping: []types.SupportedVendorModelMapping{{VendorId: array.VendorId, ModelId: array.ModelId}},
+			SupportedProfile:            array.SupportedProfile,
+			SupportedProviderProfile: []string{
+				string(types.VasaProviderProfileBlockDevice),
+				string(types.VasaProviderProfileFileSystem),
+				string(types.VasaProviderProfileCapability),
+			},
+			RelatedStorageArray: []types.RelatedStorageArray{{
+				ArrayId:    array.Uuid,
+				Active:     true,
+				Manageable: true,
+				Priority:   1,
+			}},
+			ProviderId:            uid,
+			CertificateExpiryDate: now.AddDate(1, 0, 0).Format(time.RFC3339),
+			CertificateStatus:     string(types.VasaProviderCertificateStatusValid),
+			Type:                  string(types.VpTypePERSISTENCE),
+			Category:              string(types.VpCategoryExternal),
+			Priority:              1,
+		},
+		StorageArray: []types.StorageArray{array},
+		StorageContainer: []types.StorageContainer{{
+			Uuid:            "vvol:" + newUUID(array.Uuid),
+			Name:            spec.Name + "-container",
+			MaxVvolSizeInMB: 62 * 1024 * 1024,
+			ProviderId:      []string{uid},
+			ArrayId:         []string{array.Uuid},
+		}},
+		ReplicationPeer: make(map[string][]vim.FaultDomainId),
+	}
+
+	r.Put(p)
+
+	source := vim.FaultDomainId{Id: "fd-" + newUUID(array.Uuid+"-source")}
+	target := vim.FaultDomainId{Id: "fd-" + newUUID(array.Uuid+"-target")}
+	p.ReplicationPeer[source.Id] = []vim.FaultDomainId{target}
+
+	for _, fd := range []struct {
+		id   vim.FaultDomainId
+		name string
+	}{{source, "source"}, {target, "target"}} {
+		p.FaultDomain = append(p.FaultDomain, types.FaultDomainInfo{
+			FaultDomainId:  fd.id,
+			Name:           spec.Name + "-" + fd.name,
+			StorageArrayId: array.Uuid,
+			Provider:       &p.ManagedObjectReference,
+		})
+	}
+
+	sourceGroup := vim.ReplicationGroupId{FaultDomainId: source, DeviceGroupId: vim.DeviceGroupId{Id: newUUID(source.Id)}}
+	targetGroup := vim.ReplicationGroupId{FaultDomainId: target, DeviceGroupId: vim.DeviceGroupId{Id: newUUID(target.Id)}}
+
+	p.ReplicationGroup = []types.BaseGroupInfo{
+		&types.SourceGroupInfo{
+			GroupInfo: types.GroupInfo{GroupId: sourceGroup},
+			Name:      spec.Name + "-rg",
+			State:     string(types.ReplicationReplicationStateSOURCE),
+			Replica:   []types.ReplicationTargetInfo{{TargetGroupId: targetGroup}},
+		},
+		&types.TargetGroupInfo{
+			GroupInfo:        types.GroupInfo{GroupId: targetGroup},
+			SourceInfo:       types.TargetToSourceInfo{SourceGroupId: sourceGroup},
+			State:            string(types.ReplicationReplicationStateTARGET),
+			IsPromoteCapable: vim.NewBool(true),
+		},
+	}
+
+	return p
+}
+
+// array returns true if the provider manages the array with the given id
+func (p *VasaProvider) array(id string) bool {
+	for _, a := range p.StorageArray {
+		if a.Uuid == id {
+			return true
+		}
+	}
+	return false
+}
+
+// faultDomain returns true if the provider manages the fault domain with the given id
+func (p *VasaProvider) faultDomain(id vim.FaultDomainId) bool {
+	for _, fd := range p.FaultDomain {
+		if fd.FaultDomainId.Id == id.Id {
+			return true
+		}
+	}
+	return false
+}
+
+// queryReplicationGroup returns the groups with the given ids, or all groups if ids is empty.
+// If a group is not found, a GroupErrorResult is returned for that id.
+func (p *VasaProvider) queryReplicationGroup(ids []vim.ReplicationGroupId) []types.BaseGroupOperationResult {
+	var res []types.BaseGroupOperationResult
+
+	if len(ids) == 0 {
+		for _, g := range p.ReplicationGroup {
+			ids = append(ids, g.GetGroupInfo().GroupId)
+		}
+	}
+
+	for _, id := range ids {
+		var group types.BaseGroupInfo
+		for _, g := range p.ReplicationGroup {
+			if g.GetGroupInfo().GroupId == id {
+				group = g
+				break
+			}
+		}
+
+		if group == nil {
+			res = append(res, &types.GroupErrorResult{
+				GroupOperationResult: types.GroupOperationResult{GroupId: id},
+				Error:                []vim.LocalizedMethodFault{{Fault: new(vim.NotFound), LocalizedMessage: id.DeviceGroupId.Id}},
+			})
+			continue
+		}
+
+		res = append(res, &types.QueryReplicationGroupSuccessResult{
+			GroupOperationResult: types.GroupOperationResult{GroupId: id},
+			RgInfo:               group,
+		})
+	}
+
+	return res
+}
+
+func (p *VasaProvider) QueryProviderInfo(_ *types.QueryProviderInfo) soap.HasFault {
+	return &methods.QueryProviderInfoBody{
+		Res: &types.QueryProviderInfoResponse{
+			Returnval: &p.Info,
+		},
+	}
+}
+
+func (p *VasaProvider) VasaProviderSyncTask(ctx *simulator.Context, req *types.VasaProviderSync_Task) soap.HasFault {
+	var fault vim.BaseMethodFault
+
+	if req.ArrayId != "" && !p.array(req.ArrayId) {
+		fault = &vim.InvalidArgument{InvalidProperty: "arrayId"}
+	} else {
+		p.Info.LastSyncTime = time.Now().Format(time.RFC3339)
+	}
+
+	return &methods.VasaProviderSync_TaskBody{
+		Res: &types.VasaProviderSync_TaskResponse{
+			Returnval: newTask(ctx, p, "vasaProviderSync", nil, fault),
+		},
+	}
+}
+
+func (p *VasaProvider) VasaProviderReconnectTask(ctx *simulator.Context, _ *types.VasaProviderReconnect_Task) soap.HasFault {
+	var fault vim.BaseMethodFault
+
+	if p.Info.CertificateStatus == string(types.VasaProviderCertificateStatusInvalid) {
+		fault = &types.CertificateNotTrusted{Certificate: p.Info.Certificate}
+	} else {
+		p.Info.Status = string(types.VasaProviderStatusOnline)
+		p.Info.StatusFault = nil
+	}
+
+	return &methods.VasaProviderReconnect_TaskBody{
+		Res: &types.VasaProviderReconnect_TaskResponse{
+			Returnval: newTask(ctx, p, "vasaProviderReconnect", nil, fault),
+		},
+	}
+}
+
+func (p *VasaProvider) VasaProviderRefreshCertificateTask(ctx *simulator.Context, _ *types.VasaProviderRefreshCertificate_Task) soap.HasFault {
+	p.Info.CertificateExpiryDate = time.Now().AddDate(1, 0, 0).Format(time.RFC3339)
+	p.Info.CertificateStatus = string(types.VasaProviderCertificateStatusValid)
+
+	return &methods.VasaProviderRefreshCertificate_TaskBody{
+		Res: &types.VasaProviderRefreshCertificate_TaskResponse{
+			Returnval: newTask(ctx, p, "vasaProviderRefreshCertificate", nil, nil),
+		},
+	}
+}
+
+func (p *VasaProvider) VasaProviderRevokeCertificateTask(ctx *simulator.Context, _ *types.VasaProviderRevokeCertificate_Task) soap.HasFault {
+	p.Info.CertificateStatus = string(types.VasaProviderCertificateStatusInvalid)
+	p.Info.Status = string(types.VasaProviderStatusDisconnected)
+
+	return &methods.VasaProviderRevokeCertificate_TaskBody{
+		Res: &types.VasaProviderRevokeCertificate_TaskResponse{
+			Returnval: newTask(ctx, p, "vasaProviderRevokeCertificate", nil, nil),
+		},
+	}
+}
+
+func (p *VasaProvider) QueryReplicationPeer(req *types.QueryReplicationPeer) soap.HasFault {
+	ids := req.FaultDomainId
+	if len(ids) == 0 {
+		for _, fd := range p.FaultDomain {
+			if _, ok := p.ReplicationPeer[fd.Id]; ok {
+				ids = append(ids, fd.FaultDomainId)
+			}
+		}
+	}
+
+	res := make([]types.QueryReplicationPeerResult, len(ids))
+	for i, id := range ids {
+		res[i].SourceDomain = id
+		if p.faultDomain(id) {
+			res[i].TargetDomain = p.ReplicationPeer[id.Id]
+		} else {
+			res[i].Error = []vim.LocalizedMethodFault{{Fault: new(vim.NotFound), LocalizedMessage: id.Id}}
+		}
+	}
+
+	return &methods.QueryReplicationPeerBody{
+		Res: &types.QueryReplicationPeerResponse{
+			Returnval: res,
+		},
+	}
+}
+
+func (p *VasaProvider) QueryReplicationGroup(req *types.QueryReplicationGroup) soap.HasFault {
+	return &methods.QueryReplicationGroupBody{
+		Res: &types.QueryReplicationGroupResponse{
+			Returnval: p.queryReplicationGroup(req.GroupId),
+		},
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/sms"
+	"github.com/vmware/govmomi/sms/methods"
+	"github.com/vmware/govmomi/sms/types"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+var content = struct {
+	AboutInfo      types.SmsAboutInfo
+	StorageManager vim.ManagedObjectReference
+	SessionManager vim.ManagedObjectReference
+}{
+	AboutInfo: types.SmsAboutInfo{
+		Name:           "SMS",
+		FullName:       "Storage Management Service",
+		Vendor:         "VMware, Inc.",
+		ApiVersion:     "6.0",
+		InstanceUuid:   "6c1d1ad4-6b8d-4b6d-a6f8-2e3a1b2c5d7e",
+		VasaApiVersion: "3.0",
+	},
+	StorageManager: vim.ManagedObjectReference{Type: "SmsStorageManager", Value: "SmsStorageManager"},
+	SessionManager: vim.ManagedObjectReference{Type: "SmsSessionManager", Value: "SmsSessionManager"},
+}
+
+// DefaultProvider is the spec of the VASA provider registered by New()
+var DefaultProvider = types.VasaProviderSpec{
+	SmsProviderSpec: types.SmsProviderSpec{
+		Name:        "vcsim",
+		Description: "vcsim VASA provider",
+	},
+	Username: "user",
+	Password: "pass",
+	Url:      "https://127.0.0.1:8443/vasa/version.xml",
+}
+
+func init() {
+	simulator.RegisterEndpoint(func(s *simulator.Service, r *simulator.Registry) {
+		if r.IsVPX() {
+			s.RegisterSDK(New())
+		}
+	})
+}
+
+func New() *simulator.Registry {
+	r := simulator.NewRegistry()
+	r.Namespace = sms.Namespace
+	r.Path = sms.Path
+
+	r.Put(&ServiceInstance{
+		ManagedObjectReference: sms.ServiceInstance,
+	})
+
+	m := &StorageManager{
+		ManagedObjectReference: content.StorageManager,
+	}
+	r.Put(m)
+
+	spec := DefaultProvider
+	m.Provider = append(m.Provider, newProvider(r, &spec).Reference())
+
+	return r
+}
+
+type ServiceInstance struct {
+	vim.ManagedObjectReference
+}
+
+func (s *ServiceInstance) QueryStorageManager(_ *types.QueryStorageManager) soap.HasFault {
+	return &methods.QueryStorageManagerBody{
+		Res: &types.QueryStorageManagerResponse{
+			Returnval: content.StorageManager,
+		},
+	}
+}
+
+func (s *ServiceInstance) QuerySessionManager(_ *types.QuerySessionManager) soap.HasFault {
+	return &methods.QuerySessionManagerBody{
+		Res: &types.QuerySessionManagerResponse{
+			Returnval: content.SessionManager,
+		},
+	}
+}
+
+func (s *ServiceInstance) QueryAboutInfo(_ *types.QueryAboutInfo) soap.HasFault {
+	return &methods.QueryAboutInfoBody{
+		Res: &types.QueryAboutInfoResponse{
+			Returnval: content.AboutInfo,
+		},
+	}
+}
+
+// Task wraps a vim25 Task, as the sms API has its own task type.
+type Task struct {
+	vim.ManagedObjectReference
+
+	Task vim.ManagedObjectReference
+}
+
+// newTask runs a vim25 Task for the given object and returns an SmsTask wrapping it.
+// The work is done by the caller while holding the object's lock, the Task just reports the result or fault.
+func newTask(ctx *simulator.Context, obj mo.Reference, name string, res vim.AnyType, fault vim.BaseMethodFault) vim.ManagedObjectReference {
+	ref := simulator.CreateTask(ctx, obj, name, func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
+		return res, fault
+	}).Run(ctx)
+
+	task := &Task{
+		ManagedObjectReference: vim.ManagedObjectReference{Type: "SmsTask", Value: ref.Value},
+		Task:                   ref,
+	}
+	ctx.Map.Put(task)
+
+	return task.ManagedObjectReference
+}
+
+func (t *Task) info(ctx *simulator.Context) types.SmsTaskInfo {
+	vimMap := ctx.VimMap()
+	task := vimMap.Get(t.Task).(*simulator.Task)
+
+	var info vim.TaskInfo
+	vimMap.WithLock(ctx, task, func() {
+		info = task.Info
+	})
+
+	state := types.SmsTaskStateRunning
+	switch info.State {
+	case vim.TaskInfoStateQueued:
+		state = types.SmsTaskStateQueued
+	case vim.TaskInfoStateSuccess:
+		state = types.SmsTaskStateSuccess
+	case vim.TaskInfoStateError:
+		state = types.SmsTaskStateError
+	}
+
+	return types.SmsTaskInfo{
+		Key:            info.Key,
+		Task:           t.ManagedObjectReference,
+		Object:         info.Entity,
+		Error:          info.Error,
+		Result:         info.Result,
+		StartTime:      info.StartTime,
+		CompletionTime: info.CompleteTime,
+		State:          string(state),
+		Progress:       info.Progress,
+	}
+}
+
+func (t *Task) QuerySmsTaskInfo(ctx *simulator.Context, _ *types.QuerySmsTaskInfo) soap.HasFault {
+	return &methods.QuerySmsTaskInfoBody{
+		Res: &types.QuerySmsTaskInfoResponse{
+			Returnval: t.info(ctx),
+		},
+	}
+}
+
+func (t *Task) QuerySmsTaskResult(ctx *simulator.Context, _ *types.QuerySmsTaskResult) soap.HasFault {
+	body := new(methods.QuerySmsTaskResultBody)
+
+	info := t.info(ctx)
+	switch types.SmsTaskState(info.State) {
+	case types.SmsTaskStateSuccess:
+		body.Res = &types.QuerySmsTaskResultResponse{
+			Returnval: info.Result,
+		}
+	case types.SmsTaskStateError:
+		body.Fault_ = simulator.Fault(info.Error.LocalizedMessage, info.Error.Fault)
+	default:
+		body.Fault_ = simulator.Fault("", new(vim.InvalidState))
+	}
+
+	return body
+}
+
+// newUUID returns a random UUID, or a name based UUID if the given name is not empty
+func newUUID(name string) string {
+	if name == "" {
+		return uuid.New().String()
+	}
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)).String()
+}
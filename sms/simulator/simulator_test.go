@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/sms"
+	"github.com/vmware/govmomi/sms/types"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+
+	_ "github.com/vmware/govmomi/sms/simulator"
+)
+
+func TestClient(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		sc, err := sms.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		about, err := sc.AboutInfo(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if about.Name != "SMS" {
+			t.Errorf("about=%s", about.Name)
+		}
+
+		providers, err := sc.QueryProvider(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(providers) != 1 {
+			t.Fatalf("providers=%d", len(providers))
+		}
+
+		// register and unregister a provider
+		spec := &types.VasaProviderSpec{
+			SmsProviderSpec: types.SmsProviderSpec{Name: "vasa-2"},
+			Url:             "https://vasa-2.local:8443/vasa/version.xml",
+		}
+		task, err := sc.RegisterProvider(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := task.Wait(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		provider := sms.NewProvider(sc, info.Result.(vim.ManagedObjectReference))
+
+		task, err = sc.RegisterProvider(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = task.Wait(ctx)
+		if _, ok := soap.ToVimFault(err).(*types.DuplicateEntry); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		pinfo, err := provider.Info(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vp := pinfo.(*types.VasaProviderInfo)
+		if vp.Url != spec.Url || vp.Status != string(types.VasaProviderStatusOnline) {
+			t.Errorf("url=%s status=%s", vp.Url, vp.Status)
+		}
+
+		arrays, err := sc.QueryArray(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(arrays) != 2 {
+			t.Errorf("arrays=%d", len(arrays))
+		}
+		arrays, err = sc.QueryArray(ctx, vp.Uid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(arrays) != 1 || arrays[0].Uuid != vp.RelatedStorageArray[0].ArrayId {
+			t.Errorf("arrays=%v", arrays)
+		}
+		if _, err = sc.QueryArray(ctx, "invalid"); err == nil {
+			t.Error("expected error")
+		}
+
+		containers, err := sc.QueryStorageContainer(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(containers.StorageContainer) != 2 || len(containers.ProviderInfo) != 2 {
+			t.Errorf("containers=%d", len(containers.StorageContainer))
+		}
+		containers, err = sc.QueryStorageContainer(ctx, &types.StorageContainerSpec{
+			ContainerId: []string{containers.StorageContainer[1].Uuid},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(containers.StorageContainer) != 1 {
+			t.Errorf("containers=%d", len(containers.StorageContainer))
+		}
+
+		// datastore capability and backing pools
+		ds, err := find.NewFinder(c).DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		capability, err := sc.QueryDatastoreCapability(ctx, ds.Reference())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if capability == nil || capability.Uuid == "" {
+			t.Errorf("capability=%#v", capability)
+		}
+		mapping, err := sc.QueryDatastoreBackingPoolMapping(ctx, []vim.ManagedObjectReference{ds.Reference()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(mapping) != 1 || mapping[0].BackingStoragePool[0].CapacityInMB == 0 {
+			t.Errorf("mapping=%#v", mapping)
+		}
+
+		// replication
+		domains, err := sc.QueryFaultDomain(ctx, &types.FaultDomainFilter{ProviderId: vp.Uid})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(domains) != 2 {
+			t.Fatalf("domains=%d", len(domains))
+		}
+		peers, err := provider.QueryReplicationPeer(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(peers) != 1 || peers[0].SourceDomain != domains[0] || peers[0].TargetDomain[0] != domains[1] {
+			t.Errorf("peers=%#v", peers)
+		}
+		groups, err := provider.QueryReplicationGroup(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(groups) != 2 {
+			t.Fatalf("groups=%d", len(groups))
+		}
+		source := groups[0].(*types.QueryReplicationGroupSuccessResult).RgInfo.(*types.SourceGroupInfo)
+		groups, err = sc.QueryReplicationGroupInfo(ctx, types.ReplicationGroupFilter{
+			GroupId: []vim.ReplicationGroupId{source.Replica[0].TargetGroupId, {FaultDomainId: vim.FaultDomainId{Id: "invalid"}}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := groups[0].(*types.QueryReplicationGroupSuccessResult).RgInfo.(*types.TargetGroupInfo); !ok {
+			t.Errorf("group=%#v", groups[0])
+		}
+		if _, ok := groups[1].(*types.GroupErrorResult); !ok {
+			t.Errorf("group=%#v", groups[1])
+		}
+
+		// certificate lifecycle
+		wait := func(task *sms.Task, err error) error {
+			if err != nil {
+				return err
+			}
+			_, err = task.Wait(ctx)
+			return err
+		}
+		if err = wait(provider.RevokeCertificate(ctx)); err != nil {
+			t.Fatal(err)
+		}
+		if err = wait(provider.Reconnect(ctx)); err == nil {
+			t.Error("expected error")
+		}
+		if err = wait(provider.RefreshCertificate(ctx)); err != nil {
+			t.Fatal(err)
+		}
+		if err = wait(provider.Reconnect(ctx)); err != nil {
+			t.Fatal(err)
+		}
+		if err = wait(provider.Sync(ctx, "")); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = wait(sc.UnregisterProvider(ctx, vp.Uid)); err != nil {
+			t.Fatal(err)
+		}
+		if err = wait(sc.UnregisterProvider(ctx, vp.Uid)); err == nil {
+			t.Error("expected error")
+		}
+		providers, err = sc.QueryProvider(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(providers) != 1 {
+			t.Errorf("providers=%d", len(providers))
+		}
+	})
+}
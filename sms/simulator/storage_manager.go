@@ -0,0 +1,324 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/sms/methods"
+	"github.com/vmware/govmomi/sms/types"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+type StorageManager struct {
+	vim.ManagedObjectReference
+
+	Provider []vim.ManagedObjectReference
+}
+
+// providers returns the registered providers with the given uids, or all providers if uids is empty
+func (m *StorageManager) providers(ctx *simulator.Context, uids ...string) ([]*VasaProvider, vim.BaseMethodFault) {
+	var providers []*VasaProvider
+
+	for _, ref := range m.Provider {
+		providers = append(providers, ctx.Map.Get(ref).(*VasaProvider))
+	}
+
+	if len(uids) == 0 {
+		return providers, nil
+	}
+
+	var res []*VasaProvider
+
+	for _, uid := range uids {
+		found := false
+		for _, p := range providers {
+			if p.Info.Uid == uid {
+				res = append(res, p)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, new(types.ProviderNotFound)
+		}
+	}
+
+	return res, nil
+}
+
+func (m *StorageManager) QueryProvider(_ *types.QueryProvider) soap.HasFault {
+	return &methods.QueryProviderBody{
+		Res: &types.QueryProviderResponse{
+			Returnval: m.Provider,
+		},
+	}
+}
+
+func (m *StorageManager) RegisterProviderTask(ctx *simulator.Context, req *types.RegisterProvider_Task) soap.HasFault {
+	body := new(methods.RegisterProvider_TaskBody)
+
+	spec, ok := req.ProviderSpec.(*types.VasaProviderSpec)
+	if !ok {
+		body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "providerSpec"})
+		return body
+	}
+
+	if spec.Name == "" || spec.Url == "" {
+		body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "providerSpec"})
+		return body
+	}
+
+	var res vim.AnyType
+	var fault vim.BaseMethodFault
+
+	providers, _ := m.providers(ctx)
+	for _, p := range providers {
+		if p.Info.Url == spec.Url || p.Info.Name == spec.Name {
+			fault = new(types.DuplicateEntry)
+		}
+	}
+
+	if fault == nil {
+		p := newProvider(ctx.Map, spec)
+		m.Provider = append(m.Provider, p.Reference())
+		res = p.Reference()
+	}
+
+	body.Res = &types.RegisterProvider_TaskResponse{
+		Returnval: newTask(ctx, m, "registerProvider", res, fault),
+	}
+
+	return body
+}
+
+func (m *StorageManager) UnregisterProviderTask(ctx *simulator.Context, req *types.UnregisterProvider_Task) soap.HasFault {
+	providers, fault := m.providers(ctx, req.ProviderId)
+	if fault == nil {
+		ref := providers[0].Reference()
+		for i := range m.Provider {
+			if m.Provider[i] == ref {
+				m.Provider = append(m.Provider[:i], m.Provider[i+1:]...)
+				break
+			}
+		}
+		ctx.Map.Remove(ctx, ref)
+	}
+
+	return &methods.UnregisterProvider_TaskBody{
+		Res: &types.UnregisterProvider_TaskResponse{
+			Returnval: newTask(ctx, m, "unregisterProvider", nil, fault),
+		},
+	}
+}
+
+func (m *StorageManager) SmsRefreshCACertificatesAndCRLsTask(ctx *simulator.Context, req *types.SmsRefreshCACertificatesAndCRLs_Task) soap.HasFault {
+	_, fault := m.providers(ctx, req.ProviderId...)
+
+	return &methods.SmsRefreshCACertificatesAndCRLs_TaskBody{
+		Res: &types.SmsRefreshCACertificatesAndCRLs_TaskResponse{
+			Returnval: newTask(ctx, m, "smsRefreshCACertificatesAndCRLs", nil, fault),
+		},
+	}
+}
+
+func (m *StorageManager) QueryArray(ctx *simulator.Context, req *types.QueryArray) soap.HasFault {
+	body := new(methods.QueryArrayBody)
+
+	providers, fault := m.providers(ctx, req.ProviderId...)
+	if fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.QueryArrayResponse)
+	for _, p := range providers {
+		body.Res.Returnval = append(body.Res.Returnval, p.StorageArray...)
+	}
+
+	return body
+}
+
+// datastore returns the vim25 Datastore for the given reference
+func datastore(ctx *simulator.Context, ref vim.ManagedObjectReference) (*simulator.Datastore, vim.BaseMethodFault) {
+	ds, ok := ctx.VimMap().Get(ref).(*simulator.Datastore)
+	if !ok {
+		return nil, &vim.ManagedObjectNotFound{Obj: ref}
+	}
+	return ds, nil
+}
+
+func (m *StorageManager) QueryDatastoreCapability(ctx *simulator.Context, req *types.QueryDatastoreCapability) soap.HasFault {
+	body := new(methods.QueryDatastoreCapabilityBody)
+
+	ds, fault := datastore(ctx, req.Datastore)
+	if fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.QueryDatastoreCapabilityResponse)
+
+	// datastores are backed by the first online provider's array, if any
+	providers, _ := m.providers(ctx)
+	for _, p := range providers {
+		if p.Info.Status != string(types.VasaProviderStatusOnline) {
+			continue
+		}
+		body.Res.Returnval = &types.StorageCapability{
+			Uuid:        newUUID(p.StorageArray[0].Uuid + ds.Self.Value),
+			Name:        p.Info.Name + "-" + ds.Name,
+			Description: "Storage capability of " + ds.Name,
+		}
+		break
+	}
+
+	return body
+}
+
+func (m *StorageManager) QueryDatastoreBackingPoolMapping(ctx *simulator.Context, req *types.QueryDatastoreBackingPoolMapping) soap.HasFault {
+	body := new(methods.QueryDatastoreBackingPoolMappingBody)
+
+	var mapping []types.DatastoreBackingPoolMapping
+
+	for _, ref := range req.Datastore {
+		ds, fault := datastore(ctx, ref)
+		if fault != nil {
+			body.Fault_ = simulator.Fault("", fault)
+			return body
+		}
+
+		summary := ds.Summary
+		mapping = append(mapping, types.DatastoreBackingPoolMapping{
+			Datastore: []vim.ManagedObjectReference{ref},
+			BackingStoragePool: []types.BackingStoragePool{{
+				Uuid:          newUUID(summary.Url),
+				Type:          string(types.BackingStoragePoolTypeThinProvisioningPool),
+				CapacityInMB:  summary.Capacity / 1024 / 1024,
+				UsedSpaceInMB: (summary.Capacity - summary.FreeSpace) / 1024 / 1024,
+			}},
+		})
+	}
+
+	body.Res = &types.QueryDatastoreBackingPoolMappingResponse{
+		Returnval: mapping,
+	}
+
+	return body
+}
+
+func (m *StorageManager) QueryStorageContainer(ctx *simulator.Context, req *types.QueryStorageContainer) soap.HasFault {
+	var ids []string
+	if req.ContainerSpec != nil {
+		ids = req.ContainerSpec.ContainerId
+	}
+
+	match := func(c types.StorageContainer) bool {
+		if len(ids) == 0 {
+			return true
+		}
+		for _, id := range ids {
+			if c.Uuid == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	res := new(types.StorageContainerResult)
+	providers, _ := m.providers(ctx)
+
+	for _, p := range providers {
+		n := len(res.StorageContainer)
+		for _, c := range p.StorageContainer {
+			if match(c) {
+				res.StorageContainer = append(res.StorageContainer, c)
+			}
+		}
+		if len(res.StorageContainer) != n {
+			res.ProviderInfo = append(res.ProviderInfo, &p.Info)
+		}
+	}
+
+	return &methods.QueryStorageContainerBody{
+		Res: &types.QueryStorageContainerResponse{
+			Returnval: res,
+		},
+	}
+}
+
+func (m *StorageManager) QueryFaultDomain(ctx *simulator.Context, req *types.QueryFaultDomain) soap.HasFault {
+	body := new(methods.QueryFaultDomainBody)
+
+	var uids []string
+	if req.Filter != nil && req.Filter.ProviderId != "" {
+		uids = append(uids, req.Filter.ProviderId)
+	}
+
+	providers, fault := m.providers(ctx, uids...)
+	if fault != nil {
+		body.Fault_ = simulator.Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.QueryFaultDomainResponse)
+	for _, p := range providers {
+		for _, fd := range p.FaultDomain {
+			body.Res.Returnval = append(body.Res.Returnval, fd.FaultDomainId)
+		}
+	}
+
+	return body
+}
+
+func (m *StorageManager) QueryReplicationGroupInfo(ctx *simulator.Context, req *types.QueryReplicationGroupInfo) soap.HasFault {
+	var res []types.BaseGroupOperationResult
+	providers, _ := m.providers(ctx)
+
+	if len(req.RgFilter.GroupId) == 0 {
+		for _, p := range providers {
+			res = append(res, p.queryReplicationGroup(nil)...)
+		}
+	}
+
+	// query each group via the provider that manages its fault domain
+	for _, id := range req.RgFilter.GroupId {
+		var provider *VasaProvider
+		for _, p := range providers {
+			if p.faultDomain(id.FaultDomainId) {
+				provider = p
+				break
+			}
+		}
+
+		if provider == nil {
+			res = append(res, &types.GroupErrorResult{
+				GroupOperationResult: types.GroupOperationResult{GroupId: id},
+				Error:                []vim.LocalizedMethodFault{{Fault: new(vim.NotFound), LocalizedMessage: id.FaultDomainId.Id}},
+			})
+			continue
+		}
+
+		res = append(res, provider.queryReplicationGroup([]vim.ReplicationGroupId{id})...)
+	}
+
+	return &methods.QueryReplicationGroupInfoBody{
+		Res: &types.QueryReplicationGroupInfoResponse{
+			Returnval: res,
+		},
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sms
+
+import (
+	"context"
+	"time"
+
+	"github.com/vmware/govmomi/sms/methods"
+	"github.com/vmware/govmomi/sms/types"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// Task wraps an SmsTask managed object.
+// SMS tasks are not visible to the vim25 PropertyCollector, so Wait polls the task info.
+type Task struct {
+	vim.ManagedObjectReference
+
+	c *Client
+}
+
+// NewTask returns a Task for the given reference.
+func NewTask(c *Client, ref vim.ManagedObjectReference) *Task {
+	return &Task{
+		ManagedObjectReference: ref,
+		c:                      c,
+	}
+}
+
+// QueryInfo returns the task info.
+func (t *Task) QueryInfo(ctx context.Context) (*types.SmsTaskInfo, error) {
+	req := types.QuerySmsTaskInfo{
+		This: t.Reference(),
+	}
+
+	res, err := methods.QuerySmsTaskInfo(ctx, t.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
+// QueryResult returns the task result.
+func (t *Task) QueryResult(ctx context.Context) (vim.AnyType, error) {
+	req := types.QuerySmsTaskResult{
+		This: t.Reference(),
+	}
+
+	res, err := methods.QuerySmsTaskResult(ctx, t.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// Wait waits for the task to complete, returning its info.
+// If the task failed, the error wraps the task's fault.
+func (t *Task) Wait(ctx context.Context) (*types.SmsTaskInfo, error) {
+	delay := 10 * time.Millisecond
+
+	for {
+		info, err := t.QueryInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		switch types.SmsTaskState(info.State) {
+		case types.SmsTaskStateSuccess:
+			return info, nil
+		case types.SmsTaskStateError:
+			return info, soap.WrapVimFault(info.Error.Fault)
+		}
+
+		select {
+		case <-time.After(delay):
+			if delay < time.Second {
+				delay *= 2
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
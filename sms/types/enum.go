@@ -34,6 +34,19 @@ const (
 	AlarmTypeReplicationAlarm   = AlarmType("ReplicationAlarm")
 )
 
+// Values returns all known values of the AlarmType enum
+func (e AlarmType) Values() []AlarmType {
+	return []AlarmType{
+		AlarmTypeSpaceCapacityAlarm,
+		AlarmTypeCapabilityAlarm,
+		AlarmTypeStorageObjectAlarm,
+		AlarmTypeObjectAlarm,
+		AlarmTypeComplianceAlarm,
+		AlarmTypeManageabilityAlarm,
+		AlarmTypeReplicationAlarm,
+	}
+}
+
 func init() {
 	types.Add("sms:AlarmType", reflect.TypeOf((*AlarmType)(nil)).Elem())
 }
@@ -46,6 +59,15 @@ const (
 	BackingStoragePoolTypeThinAndDeduplicationCombinedPool = BackingStoragePoolType("thinAndDeduplicationCombinedPool")
 )
 
+// Values returns all known values of the BackingStoragePoolType enum
+func (e BackingStoragePoolType) Values() []BackingStoragePoolType {
+	return []BackingStoragePoolType{
+		BackingStoragePoolTypeThinProvisioningPool,
+		BackingStoragePoolTypeDeduplicationPool,
+		BackingStoragePoolTypeThinAndDeduplicationCombinedPool,
+	}
+}
+
 func init() {
 	types.Add("sms:BackingStoragePoolType", reflect.TypeOf((*BackingStoragePoolType)(nil)).Elem())
 }
@@ -59,6 +81,16 @@ const (
 	BlockDeviceInterfaceOtherBlock = BlockDeviceInterface("otherBlock")
 )
 
+// Values returns all known values of the BlockDeviceInterface enum
+func (e BlockDeviceInterface) Values() []BlockDeviceInterface {
+	return []BlockDeviceInterface{
+		BlockDeviceInterfaceFc,
+		BlockDeviceInterfaceIscsi,
+		BlockDeviceInterfaceFcoe,
+		BlockDeviceInterfaceOtherBlock,
+	}
+}
+
 func init() {
 	types.Add("sms:BlockDeviceInterface", reflect.TypeOf((*BlockDeviceInterface)(nil)).Elem())
 }
@@ -81,6 +113,25 @@ const (
 	EntityReferenceEntityTypeNasMount     = EntityReferenceEntityType("nasMount")
 )
 
+// Values returns all known values of the EntityReferenceEntityType enum
+func (e EntityReferenceEntityType) Values() []EntityReferenceEntityType {
+	return []EntityReferenceEntityType{
+		EntityReferenceEntityTypeDatacenter,
+		EntityReferenceEntityTypeResourcePool,
+		EntityReferenceEntityTypeStoragePod,
+		EntityReferenceEntityTypeCluster,
+		EntityReferenceEntityTypeVm,
+		EntityReferenceEntityTypeDatastore,
+		EntityReferenceEntityTypeHost,
+		EntityReferenceEntityTypeVmFile,
+		EntityReferenceEntityTypeScsiPath,
+		EntityReferenceEntityTypeScsiTarget,
+		EntityReferenceEntityTypeScsiVolume,
+		EntityReferenceEntityTypeScsiAdapter,
+		EntityReferenceEntityTypeNasMount,
+	}
+}
+
 func init() {
 	types.Add("sms:EntityReferenceEntityType", reflect.TypeOf((*EntityReferenceEntityType)(nil)).Elem())
 }
@@ -92,6 +143,14 @@ const (
 	FileSystemInterfaceOtherFileSystem = FileSystemInterface("otherFileSystem")
 )
 
+// Values returns all known values of the FileSystemInterface enum
+func (e FileSystemInterface) Values() []FileSystemInterface {
+	return []FileSystemInterface{
+		FileSystemInterfaceNfs,
+		FileSystemInterfaceOtherFileSystem,
+	}
+}
+
 func init() {
 	types.Add("sms:FileSystemInterface", reflect.TypeOf((*FileSystemInterface)(nil)).Elem())
 }
@@ -102,6 +161,13 @@ const (
 	FileSystemInterfaceVersionNFSV3_0 = FileSystemInterfaceVersion("NFSV3_0")
 )
 
+// Values returns all known values of the FileSystemInterfaceVersion enum
+func (e FileSystemInterfaceVersion) Values() []FileSystemInterfaceVersion {
+	return []FileSystemInterfaceVersion{
+		FileSystemInterfaceVersionNFSV3_0,
+	}
+}
+
 func init() {
 	types.Add("sms:FileSystemInterfaceVersion", reflect.TypeOf((*FileSystemInterfaceVersion)(nil)).Elem())
 }
@@ -113,6 +179,14 @@ const (
 	ProviderProfileReplication            = ProviderProfile("Replication")
 )
 
+// Values returns all known values of the ProviderProfile enum
+func (e ProviderProfile) Values() []ProviderProfile {
+	return []ProviderProfile{
+		ProviderProfileProfileBasedManagement,
+		ProviderProfileReplication,
+	}
+}
+
 func init() {
 	types.Add("sms:ProviderProfile", reflect.TypeOf((*ProviderProfile)(nil)).Elem())
 }
@@ -127,6 +201,17 @@ const (
 	ReplicationReplicationStateREMOTE_FAILEDOVER = ReplicationReplicationState("REMOTE_FAILEDOVER")
 )
 
+// Values returns all known values of the ReplicationReplicationState enum
+func (e ReplicationReplicationState) Values() []ReplicationReplicationState {
+	return []ReplicationReplicationState{
+		ReplicationReplicationStateSOURCE,
+		ReplicationReplicationStateTARGET,
+		ReplicationReplicationStateFAILEDOVER,
+		ReplicationReplicationStateINTEST,
+		ReplicationReplicationStateREMOTE_FAILEDOVER,
+	}
+}
+
 func init() {
 	types.Add("sms:ReplicationReplicationState", reflect.TypeOf((*ReplicationReplicationState)(nil)).Elem())
 }
@@ -139,6 +224,15 @@ const (
 	SmsAlarmStatusYellow = SmsAlarmStatus("Yellow")
 )
 
+// Values returns all known values of the SmsAlarmStatus enum
+func (e SmsAlarmStatus) Values() []SmsAlarmStatus {
+	return []SmsAlarmStatus{
+		SmsAlarmStatusRed,
+		SmsAlarmStatusGreen,
+		SmsAlarmStatusYellow,
+	}
+}
+
 func init() {
 	types.Add("sms:SmsAlarmStatus", reflect.TypeOf((*SmsAlarmStatus)(nil)).Elem())
 }
@@ -166,6 +260,30 @@ const (
 	SmsEntityTypeReplicationGroupEntity    = SmsEntityType("ReplicationGroupEntity")
 )
 
+// Values returns all known values of the SmsEntityType enum
+func (e SmsEntityType) Values() []SmsEntityType {
+	return []SmsEntityType{
+		SmsEntityTypeStorageArrayEntity,
+		SmsEntityTypeStorageProcessorEntity,
+		SmsEntityTypeStoragePortEntity,
+		SmsEntityTypeStorageLunEntity,
+		SmsEntityTypeStorageFileSystemEntity,
+		SmsEntityTypeStorageCapabilityEntity,
+		SmsEntityTypeCapabilitySchemaEntity,
+		SmsEntityTypeCapabilityProfileEntity,
+		SmsEntityTypeDefaultProfileEntity,
+		SmsEntityTypeResourceAssociationEntity,
+		SmsEntityTypeStorageContainerEntity,
+		SmsEntityTypeStorageObjectEntity,
+		SmsEntityTypeMessageCatalogEntity,
+		SmsEntityTypeProtocolEndpointEntity,
+		SmsEntityTypeVirtualVolumeInfoEntity,
+		SmsEntityTypeBackingStoragePoolEntity,
+		SmsEntityTypeFaultDomainEntity,
+		SmsEntityTypeReplicationGroupEntity,
+	}
+}
+
 func init() {
 	types.Add("sms:SmsEntityType", reflect.TypeOf((*SmsEntityType)(nil)).Elem())
 }
@@ -179,6 +297,16 @@ const (
 	SmsTaskStateError   = SmsTaskState("error")
 )
 
+// Values returns all known values of the SmsTaskState enum
+func (e SmsTaskState) Values() []SmsTaskState {
+	return []SmsTaskState{
+		SmsTaskStateQueued,
+		SmsTaskStateRunning,
+		SmsTaskStateSuccess,
+		SmsTaskStateError,
+	}
+}
+
 func init() {
 	types.Add("sms:SmsTaskState", reflect.TypeOf((*SmsTaskState)(nil)).Elem())
 }
@@ -191,6 +319,15 @@ const (
 	ThinProvisioningStatusGREEN  = ThinProvisioningStatus("GREEN")
 )
 
+// Values returns all known values of the ThinProvisioningStatus enum
+func (e ThinProvisioningStatus) Values() []ThinProvisioningStatus {
+	return []ThinProvisioningStatus{
+		ThinProvisioningStatusRED,
+		ThinProvisioningStatusYELLOW,
+		ThinProvisioningStatusGREEN,
+	}
+}
+
 func init() {
 	types.Add("sms:ThinProvisioningStatus", reflect.TypeOf((*ThinProvisioningStatus)(nil)).Elem())
 }
@@ -202,6 +339,14 @@ const (
 	VasaAuthenticationTypeUseSessionId = VasaAuthenticationType("UseSessionId")
 )
 
+// Values returns all known values of the VasaAuthenticationType enum
+func (e VasaAuthenticationType) Values() []VasaAuthenticationType {
+	return []VasaAuthenticationType{
+		VasaAuthenticationTypeLoginByToken,
+		VasaAuthenticationTypeUseSessionId,
+	}
+}
+
 func init() {
 	types.Add("sms:VasaAuthenticationType", reflect.TypeOf((*VasaAuthenticationType)(nil)).Elem())
 }
@@ -219,6 +364,20 @@ const (
 	VasaProfileStorageDrsFileSystem  = VasaProfile("storageDrsFileSystem")
 )
 
+// Values returns all known values of the VasaProfile enum
+func (e VasaProfile) Values() []VasaProfile {
+	return []VasaProfile{
+		VasaProfileBlockDevice,
+		VasaProfileFileSystem,
+		VasaProfileCapability,
+		VasaProfilePolicy,
+		VasaProfileObject,
+		VasaProfileStatistics,
+		VasaProfileStorageDrsBlockDevice,
+		VasaProfileStorageDrsFileSystem,
+	}
+}
+
 func init() {
 	types.Add("sms:VasaProfile", reflect.TypeOf((*VasaProfile)(nil)).Elem())
 }
@@ -233,6 +392,17 @@ const (
 	VasaProviderCertificateStatusInvalid                = VasaProviderCertificateStatus("invalid")
 )
 
+// Values returns all known values of the VasaProviderCertificateStatus enum
+func (e VasaProviderCertificateStatus) Values() []VasaProviderCertificateStatus {
+	return []VasaProviderCertificateStatus{
+		VasaProviderCertificateStatusValid,
+		VasaProviderCertificateStatusExpirySoftLimitReached,
+		VasaProviderCertificateStatusExpiryHardLimitReached,
+		VasaProviderCertificateStatusExpired,
+		VasaProviderCertificateStatusInvalid,
+	}
+}
+
 func init() {
 	types.Add("sms:VasaProviderCertificateStatus", reflect.TypeOf((*VasaProviderCertificateStatus)(nil)).Elem())
 }
@@ -245,6 +415,15 @@ const (
 	VasaProviderProfileCapability  = VasaProviderProfile("capability")
 )
 
+// Values returns all known values of the VasaProviderProfile enum
+func (e VasaProviderProfile) Values() []VasaProviderProfile {
+	return []VasaProviderProfile{
+		VasaProviderProfileBlockDevice,
+		VasaProviderProfileFileSystem,
+		VasaProviderProfileCapability,
+	}
+}
+
 func init() {
 	types.Add("sms:VasaProviderProfile", reflect.TypeOf((*VasaProviderProfile)(nil)).Elem())
 }
@@ -260,6 +439,18 @@ const (
 	VasaProviderStatusDisconnected = VasaProviderStatus("disconnected")
 )
 
+// Values returns all known values of the VasaProviderStatus enum
+func (e VasaProviderStatus) Values() []VasaProviderStatus {
+	return []VasaProviderStatus{
+		VasaProviderStatusOnline,
+		VasaProviderStatusOffline,
+		VasaProviderStatusSyncError,
+		VasaProviderStatusUnknown,
+		VasaProviderStatusConnected,
+		VasaProviderStatusDisconnected,
+	}
+}
+
 func init() {
 	types.Add("sms:VasaProviderStatus", reflect.TypeOf((*VasaProviderStatus)(nil)).Elem())
 }
@@ -271,6 +462,14 @@ const (
 	VpCategoryExternal = VpCategory("external")
 )
 
+// Values returns all known values of the VpCategory enum
+func (e VpCategory) Values() []VpCategory {
+	return []VpCategory{
+		VpCategoryInternal,
+		VpCategoryExternal,
+	}
+}
+
 func init() {
 	types.Add("sms:VpCategory", reflect.TypeOf((*VpCategory)(nil)).Elem())
 }
@@ -283,6 +482,15 @@ const (
 	VpTypeUNKNOWN     = VpType("UNKNOWN")
 )
 
+// Values returns all known values of the VpType enum
+func (e VpType) Values() []VpType {
+	return []VpType{
+		VpTypePERSISTENCE,
+		VpTypeDATASERVICE,
+		VpTypeUNKNOWN,
+	}
+}
+
 func init() {
 	types.Add("sms:VpType", reflect.TypeOf((*VpType)(nil)).Elem())
 }
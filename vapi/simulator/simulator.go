@@ -281,6 +281,42 @@ func (s *handler) AttachTag(ref vim.ManagedObjectReference, tag vim.VslmTagEntry
 	return nil
 }
 
+// CreateTag creates the given tag and its parent category, if either does not already exist.
+// CreateTag is meant for internal use via simulator.Registry.tagManager
+func (s *handler) CreateTag(tag vim.VslmTagEntry) vim.BaseMethodFault {
+	if s.findTag(tag) != nil {
+		return nil
+	}
+
+	var category *tags.Category
+	for _, c := range s.Category {
+		if c.Name == tag.ParentCategoryName {
+			category = c
+			break
+		}
+	}
+
+	if category == nil {
+		category = &tags.Category{
+			ID:              newID("Category"),
+			Name:            tag.ParentCategoryName,
+			Cardinality:     "MULTIPLE",
+			AssociableTypes: []string{},
+		}
+		s.Category[category.ID] = category
+	}
+
+	id := newID("Tag")
+	s.Tag[id] = &tags.Tag{
+		ID:         id,
+		Name:       tag.TagName,
+		CategoryID: category.ID,
+	}
+	s.Association[id] = make(map[internal.AssociatedObject]bool)
+
+	return nil
+}
+
 // DetachTag is meant for internal use via simulator.Registry.tagManager
 func (s *handler) DetachTag(id vim.ManagedObjectReference, tag vim.VslmTagEntry) vim.BaseMethodFault {
 	t := s.findTag(tag)
@@ -17,22 +17,27 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
 	"crypto/tls"
+	"encoding/json"
 	"expvar"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
 	"syscall"
 
 	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
 
 	"github.com/vmware/govmomi/session"
 	"github.com/vmware/govmomi/simulator"
@@ -44,6 +49,7 @@ import (
 	_ "github.com/vmware/govmomi/eam/simulator"
 	_ "github.com/vmware/govmomi/lookup/simulator"
 	_ "github.com/vmware/govmomi/pbm/simulator"
+	_ "github.com/vmware/govmomi/sms/simulator"
 	_ "github.com/vmware/govmomi/ssoadmin/simulator"
 	_ "github.com/vmware/govmomi/sts/simulator"
 	_ "github.com/vmware/govmomi/vapi/appliance/simulator"
@@ -51,6 +57,7 @@ import (
 	_ "github.com/vmware/govmomi/vapi/namespace/simulator"
 	_ "github.com/vmware/govmomi/vapi/simulator"
 	_ "github.com/vmware/govmomi/vsan/simulator"
+	_ "github.com/vmware/govmomi/vslm/simulator"
 )
 
 var (
@@ -67,6 +74,7 @@ func main() {
 	flag.IntVar(&model.ClusterHost, "host", model.ClusterHost, "Number of hosts per cluster")
 	flag.IntVar(&model.Host, "standalone-host", model.Host, "Number of standalone hosts")
 	flag.IntVar(&model.Datastore, "ds", model.Datastore, "Number of local datastores")
+	flag.IntVar(&model.Lun, "lun", model.Lun, "Number of Fibre Channel LUNs per host")
 	flag.IntVar(&model.Machine, "vm", model.Machine, "Number of virtual machines per resource pool")
 	flag.IntVar(&model.Pool, "pool", model.Pool, "Number of resource pools per compute resource")
 	flag.IntVar(&model.App, "app", model.App, "Number of virtual apps per compute resource")
@@ -89,14 +97,23 @@ func main() {
 	pass := flag.String("password", "", "Login password for vcsim (any password allowed by default)")
 	tunnel := flag.Int("tunnel", -1, "SDK tunnel port")
 	flag.BoolVar(&simulator.Trace, "trace", simulator.Trace, "Trace SOAP to -trace-file")
+	flag.BoolVar(&model.StrictValidation, "strict", model.StrictValidation, "Reject SOAP requests that are invalid per the WSDL")
 	trace := flag.String("trace-file", "", "Trace output file (defaults to stderr)")
 	stdinExit := flag.Bool("stdinexit", false, "Press any key to exit")
 	dir := flag.String("load", "", "Load model from directory")
+	inventory := flag.String("inventory", "", "Create model from JSON or YAML inventory spec file")
 
 	flag.IntVar(&model.DelayConfig.Delay, "delay", model.DelayConfig.Delay, "Method response delay across all methods")
 	methodDelayP := flag.String("method-delay", "", "Delay per method on the form 'method1:delay1,method2:delay2...'")
 	flag.Float64Var(&model.DelayConfig.DelayJitter, "delay-jitter", model.DelayConfig.DelayJitter, "Delay jitter coefficient of variation (tip: 0.5 is a good starting value)")
 
+	guestSubnet := flag.String("guest-subnet", "", "Assign guest IPs on the form 'network1=cidr1,network2=cidr2...'")
+	flag.DurationVar(&model.GuestIPDelay, "guest-ip-delay", model.GuestIPDelay, "Delay before guest IPs are assigned after power on")
+	flag.BoolVar(&model.GuestTools, "guest-tools", model.GuestTools, "Install guest tools in each VM")
+	flag.DurationVar(&model.GuestToolsDelay, "guest-tools-delay", model.GuestToolsDelay, "Delay before guest tools are running after power on")
+	flag.BoolVar(&model.HostListen, "host-listen", model.HostListen, "Start an ESX SOAP listener for each host")
+	iscsiTarget := flag.String("iscsi-target", "", "iSCSI targets on the form 'address1=luns1,address2=luns2...'")
+
 	flag.Parse()
 
 	if *trace != "" {
@@ -144,6 +161,32 @@ func main() {
 		model.DelayConfig.MethodDelay = m
 	}
 
+	if *guestSubnet != "" {
+		model.GuestSubnet = make(map[string]string)
+		for _, s := range strings.Split(*guestSubnet, ",") {
+			tuples := strings.SplitN(s, "=", 2)
+			if len(tuples) != 2 {
+				log.Fatal("Incorrect guest subnet format.")
+			}
+			model.GuestSubnet[strings.TrimSpace(tuples[0])] = strings.TrimSpace(tuples[1])
+		}
+	}
+
+	if *iscsiTarget != "" {
+		model.IscsiTarget = make(map[string]int)
+		for _, s := range strings.Split(*iscsiTarget, ",") {
+			tuples := strings.SplitN(s, "=", 2)
+			if len(tuples) != 2 {
+				log.Fatal("Incorrect iSCSI target format.")
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(tuples[1]))
+			if err != nil {
+				log.Fatalf("Incorrect format of iscsi-target argument: %s", err)
+			}
+			model.IscsiTarget[strings.TrimSpace(tuples[0])] = n
+		}
+	}
+
 	var err error
 	out := os.Stdout
 
@@ -176,10 +219,17 @@ func main() {
 
 	esx.HostSystem.Summary.Hardware.Vendor += tag
 
-	if *dir == "" {
-		err = model.Create()
-	} else {
+	switch {
+	case *dir != "":
 		err = model.Load(*dir)
+	case *inventory != "":
+		var spec *simulator.InventorySpec
+		spec, err = loadInventory(*inventory)
+		if err == nil {
+			err = model.CreateFromSpec(spec)
+		}
+	default:
+		err = model.Create()
 	}
 	if err != nil {
 		log.Fatal(err)
@@ -222,6 +272,12 @@ func main() {
 		}
 	}
 
+	for _, host := range simulator.Map.All("HostSystem") {
+		if u := s.HostURL(host); u != nil {
+			log.Printf("%s listening on %s", host.(*simulator.HostSystem).Name, u.Host)
+		}
+	}
+
 	fmt.Fprintf(out, "export GOVC_URL=%s GOVC_SIM_PID=%d\n", s.URL, os.Getpid())
 	if out != os.Stdout {
 		err = out.Close()
@@ -249,6 +305,75 @@ func main() {
 	}
 }
 
+// loadInventory decodes the InventorySpec in the given file.
+// Files with a ".yaml" or ".yml" extension are decoded as YAML, using the same field names as JSON.
+// All other files are decoded as JSON.
+func loadInventory(name string) (*simulator.InventorySpec, error) {
+	ext := strings.ToLower(filepath.Ext(name))
+	if ext != ".yaml" && ext != ".yml" {
+		return simulator.LoadInventorySpec(name)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Clean(name))
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err = yamlToJSON(data); err == nil {
+		var spec *simulator.InventorySpec
+		if spec, err = simulator.DecodeInventorySpec(bytes.NewReader(data)); err == nil {
+			return spec, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: %s", name, err)
+}
+
+// yamlToJSON converts a YAML document to JSON, such that the InventorySpec json tags apply to both formats.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	val, err := yamlValue(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(val)
+}
+
+// yamlValue converts the map[interface{}]interface{} values decoded by yaml to map[string]interface{}
+func yamlValue(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			name, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid key %v (%T)", key, key)
+			}
+			elem, err := yamlValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			m[name] = elem
+		}
+		return m, nil
+	case []interface{}:
+		for i := range v {
+			elem, err := yamlValue(v[i])
+			if err != nil {
+				return nil, err
+			}
+			v[i] = elem
+		}
+	}
+
+	return val, nil
+}
+
 func updateHostTemplate(ip string) error {
 	addr, port, err := net.SplitHostPort(ip)
 	if err != nil {
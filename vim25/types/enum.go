@@ -32,6 +32,21 @@ const (
 	ActionParameterAlarm             = ActionParameter("alarm")
 )
 
+// Values returns all known values of the ActionParameter enum
+func (e ActionParameter) Values() []ActionParameter {
+	return []ActionParameter{
+		ActionParameterTargetName,
+		ActionParameterAlarmName,
+		ActionParameterOldStatus,
+		ActionParameterNewStatus,
+		ActionParameterTriggeringSummary,
+		ActionParameterDeclaringSummary,
+		ActionParameterEventDescription,
+		ActionParameterTarget,
+		ActionParameterAlarm,
+	}
+}
+
 func init() {
 	t["ActionParameter"] = reflect.TypeOf((*ActionParameter)(nil)).Elem()
 }
@@ -49,6 +64,20 @@ const (
 	ActionTypeHostInfraUpdateHaV1 = ActionType("HostInfraUpdateHaV1")
 )
 
+// Values returns all known values of the ActionType enum
+func (e ActionType) Values() []ActionType {
+	return []ActionType{
+		ActionTypeMigrationV1,
+		ActionTypeVmPowerV1,
+		ActionTypeHostPowerV1,
+		ActionTypeHostMaintenanceV1,
+		ActionTypeStorageMigrationV1,
+		ActionTypeStoragePlacementV1,
+		ActionTypePlacementV1,
+		ActionTypeHostInfraUpdateHaV1,
+	}
+}
+
 func init() {
 	t["ActionType"] = reflect.TypeOf((*ActionType)(nil)).Elem()
 }
@@ -60,6 +89,14 @@ const (
 	AffinityTypeCpu    = AffinityType("cpu")
 )
 
+// Values returns all known values of the AffinityType enum
+func (e AffinityType) Values() []AffinityType {
+	return []AffinityType{
+		AffinityTypeMemory,
+		AffinityTypeCpu,
+	}
+}
+
 func init() {
 	t["AffinityType"] = reflect.TypeOf((*AffinityType)(nil)).Elem()
 }
@@ -78,6 +115,21 @@ const (
 	AgentInstallFailedReasonUnknownInstallerError       = AgentInstallFailedReason("UnknownInstallerError")
 )
 
+// Values returns all known values of the AgentInstallFailedReason enum
+func (e AgentInstallFailedReason) Values() []AgentInstallFailedReason {
+	return []AgentInstallFailedReason{
+		AgentInstallFailedReasonNotEnoughSpaceOnDevice,
+		AgentInstallFailedReasonPrepareToUpgradeFailed,
+		AgentInstallFailedReasonAgentNotRunning,
+		AgentInstallFailedReasonAgentNotReachable,
+		AgentInstallFailedReasonInstallTimedout,
+		AgentInstallFailedReasonSignatureVerificationFailed,
+		AgentInstallFailedReasonAgentUploadFailed,
+		AgentInstallFailedReasonAgentUploadTimedout,
+		AgentInstallFailedReasonUnknownInstallerError,
+	}
+}
+
 func init() {
 	t["AgentInstallFailedReason"] = reflect.TypeOf((*AgentInstallFailedReason)(nil)).Elem()
 }
@@ -90,6 +142,15 @@ const (
 	AlarmFilterSpecAlarmTypeByEntityEntityTypeVm   = AlarmFilterSpecAlarmTypeByEntity("entityTypeVm")
 )
 
+// Values returns all known values of the AlarmFilterSpecAlarmTypeByEntity enum
+func (e AlarmFilterSpecAlarmTypeByEntity) Values() []AlarmFilterSpecAlarmTypeByEntity {
+	return []AlarmFilterSpecAlarmTypeByEntity{
+		AlarmFilterSpecAlarmTypeByEntityEntityTypeAll,
+		AlarmFilterSpecAlarmTypeByEntityEntityTypeHost,
+		AlarmFilterSpecAlarmTypeByEntityEntityTypeVm,
+	}
+}
+
 func init() {
 	t["AlarmFilterSpecAlarmTypeByEntity"] = reflect.TypeOf((*AlarmFilterSpecAlarmTypeByEntity)(nil)).Elem()
 }
@@ -102,6 +163,15 @@ const (
 	AlarmFilterSpecAlarmTypeByTriggerTriggerTypeMetric = AlarmFilterSpecAlarmTypeByTrigger("triggerTypeMetric")
 )
 
+// Values returns all known values of the AlarmFilterSpecAlarmTypeByTrigger enum
+func (e AlarmFilterSpecAlarmTypeByTrigger) Values() []AlarmFilterSpecAlarmTypeByTrigger {
+	return []AlarmFilterSpecAlarmTypeByTrigger{
+		AlarmFilterSpecAlarmTypeByTriggerTriggerTypeAll,
+		AlarmFilterSpecAlarmTypeByTriggerTriggerTypeEvent,
+		AlarmFilterSpecAlarmTypeByTriggerTriggerTypeMetric,
+	}
+}
+
 func init() {
 	t["AlarmFilterSpecAlarmTypeByTrigger"] = reflect.TypeOf((*AlarmFilterSpecAlarmTypeByTrigger)(nil)).Elem()
 }
@@ -114,6 +184,15 @@ const (
 	AnswerFileValidationInfoStatusFailed_defaults = AnswerFileValidationInfoStatus("failed_defaults")
 )
 
+// Values returns all known values of the AnswerFileValidationInfoStatus enum
+func (e AnswerFileValidationInfoStatus) Values() []AnswerFileValidationInfoStatus {
+	return []AnswerFileValidationInfoStatus{
+		AnswerFileValidationInfoStatusSuccess,
+		AnswerFileValidationInfoStatusFailed,
+		AnswerFileValidationInfoStatusFailed_defaults,
+	}
+}
+
 func init() {
 	t["AnswerFileValidationInfoStatus"] = reflect.TypeOf((*AnswerFileValidationInfoStatus)(nil)).Elem()
 }
@@ -131,6 +210,20 @@ const (
 	ApplyHostProfileConfigurationResultStatusCanceled                    = ApplyHostProfileConfigurationResultStatus("canceled")
 )
 
+// Values returns all known values of the ApplyHostProfileConfigurationResultStatus enum
+func (e ApplyHostProfileConfigurationResultStatus) Values() []ApplyHostProfileConfigurationResultStatus {
+	return []ApplyHostProfileConfigurationResultStatus{
+		ApplyHostProfileConfigurationResultStatusSuccess,
+		ApplyHostProfileConfigurationResultStatusFailed,
+		ApplyHostProfileConfigurationResultStatusReboot_failed,
+		ApplyHostProfileConfigurationResultStatusStateless_reboot_failed,
+		ApplyHostProfileConfigurationResultStatusCheck_compliance_failed,
+		ApplyHostProfileConfigurationResultStatusState_not_satisfied,
+		ApplyHostProfileConfigurationResultStatusExit_maintenancemode_failed,
+		ApplyHostProfileConfigurationResultStatusCanceled,
+	}
+}
+
 func init() {
 	t["ApplyHostProfileConfigurationResultStatus"] = reflect.TypeOf((*ApplyHostProfileConfigurationResultStatus)(nil)).Elem()
 }
@@ -143,6 +236,15 @@ const (
 	ArrayUpdateOperationEdit   = ArrayUpdateOperation("edit")
 )
 
+// Values returns all known values of the ArrayUpdateOperation enum
+func (e ArrayUpdateOperation) Values() []ArrayUpdateOperation {
+	return []ArrayUpdateOperation{
+		ArrayUpdateOperationAdd,
+		ArrayUpdateOperationRemove,
+		ArrayUpdateOperationEdit,
+	}
+}
+
 func init() {
 	t["ArrayUpdateOperation"] = reflect.TypeOf((*ArrayUpdateOperation)(nil)).Elem()
 }
@@ -158,6 +260,18 @@ const (
 	AutoStartActionSuspend       = AutoStartAction("suspend")
 )
 
+// Values returns all known values of the AutoStartAction enum
+func (e AutoStartAction) Values() []AutoStartAction {
+	return []AutoStartAction{
+		AutoStartActionNone,
+		AutoStartActionSystemDefault,
+		AutoStartActionPowerOn,
+		AutoStartActionPowerOff,
+		AutoStartActionGuestShutdown,
+		AutoStartActionSuspend,
+	}
+}
+
 func init() {
 	t["AutoStartAction"] = reflect.TypeOf((*AutoStartAction)(nil)).Elem()
 }
@@ -170,6 +284,15 @@ const (
 	AutoStartWaitHeartbeatSettingSystemDefault = AutoStartWaitHeartbeatSetting("systemDefault")
 )
 
+// Values returns all known values of the AutoStartWaitHeartbeatSetting enum
+func (e AutoStartWaitHeartbeatSetting) Values() []AutoStartWaitHeartbeatSetting {
+	return []AutoStartWaitHeartbeatSetting{
+		AutoStartWaitHeartbeatSettingYes,
+		AutoStartWaitHeartbeatSettingNo,
+		AutoStartWaitHeartbeatSettingSystemDefault,
+	}
+}
+
 func init() {
 	t["AutoStartWaitHeartbeatSetting"] = reflect.TypeOf((*AutoStartWaitHeartbeatSetting)(nil)).Elem()
 }
@@ -182,6 +305,15 @@ const (
 	BaseConfigInfoDiskFileBackingInfoProvisioningTypeLazyZeroedThick  = BaseConfigInfoDiskFileBackingInfoProvisioningType("lazyZeroedThick")
 )
 
+// Values returns all known values of the BaseConfigInfoDiskFileBackingInfoProvisioningType enum
+func (e BaseConfigInfoDiskFileBackingInfoProvisioningType) Values() []BaseConfigInfoDiskFileBackingInfoProvisioningType {
+	return []BaseConfigInfoDiskFileBackingInfoProvisioningType{
+		BaseConfigInfoDiskFileBackingInfoProvisioningTypeThin,
+		BaseConfigInfoDiskFileBackingInfoProvisioningTypeEagerZeroedThick,
+		BaseConfigInfoDiskFileBackingInfoProvisioningTypeLazyZeroedThick,
+	}
+}
+
 func init() {
 	t["BaseConfigInfoDiskFileBackingInfoProvisioningType"] = reflect.TypeOf((*BaseConfigInfoDiskFileBackingInfoProvisioningType)(nil)).Elem()
 }
@@ -193,6 +325,14 @@ const (
 	BatchResultResultFail    = BatchResultResult("fail")
 )
 
+// Values returns all known values of the BatchResultResult enum
+func (e BatchResultResult) Values() []BatchResultResult {
+	return []BatchResultResult{
+		BatchResultResultSuccess,
+		BatchResultResultFail,
+	}
+}
+
 func init() {
 	t["BatchResultResult"] = reflect.TypeOf((*BatchResultResult)(nil)).Elem()
 }
@@ -203,6 +343,13 @@ const (
 	CannotEnableVmcpForClusterReasonAPDTimeoutDisabled = CannotEnableVmcpForClusterReason("APDTimeoutDisabled")
 )
 
+// Values returns all known values of the CannotEnableVmcpForClusterReason enum
+func (e CannotEnableVmcpForClusterReason) Values() []CannotEnableVmcpForClusterReason {
+	return []CannotEnableVmcpForClusterReason{
+		CannotEnableVmcpForClusterReasonAPDTimeoutDisabled,
+	}
+}
+
 func init() {
 	t["CannotEnableVmcpForClusterReason"] = reflect.TypeOf((*CannotEnableVmcpForClusterReason)(nil)).Elem()
 }
@@ -214,6 +361,14 @@ const (
 	CannotMoveFaultToleranceVmMoveTypeCluster      = CannotMoveFaultToleranceVmMoveType("cluster")
 )
 
+// Values returns all known values of the CannotMoveFaultToleranceVmMoveType enum
+func (e CannotMoveFaultToleranceVmMoveType) Values() []CannotMoveFaultToleranceVmMoveType {
+	return []CannotMoveFaultToleranceVmMoveType{
+		CannotMoveFaultToleranceVmMoveTypeResourcePool,
+		CannotMoveFaultToleranceVmMoveTypeCluster,
+	}
+}
+
 func init() {
 	t["CannotMoveFaultToleranceVmMoveType"] = reflect.TypeOf((*CannotMoveFaultToleranceVmMoveType)(nil)).Elem()
 }
@@ -227,6 +382,16 @@ const (
 	CannotPowerOffVmInClusterOperationGuestSuspend  = CannotPowerOffVmInClusterOperation("guestSuspend")
 )
 
+// Values returns all known values of the CannotPowerOffVmInClusterOperation enum
+func (e CannotPowerOffVmInClusterOperation) Values() []CannotPowerOffVmInClusterOperation {
+	return []CannotPowerOffVmInClusterOperation{
+		CannotPowerOffVmInClusterOperationSuspend,
+		CannotPowerOffVmInClusterOperationPowerOff,
+		CannotPowerOffVmInClusterOperationGuestShutdown,
+		CannotPowerOffVmInClusterOperationGuestSuspend,
+	}
+}
+
 func init() {
 	t["CannotPowerOffVmInClusterOperation"] = reflect.TypeOf((*CannotPowerOffVmInClusterOperation)(nil)).Elem()
 }
@@ -242,6 +407,18 @@ const (
 	CannotUseNetworkReasonMismatchedEnsMode               = CannotUseNetworkReason("MismatchedEnsMode")
 )
 
+// Values returns all known values of the CannotUseNetworkReason enum
+func (e CannotUseNetworkReason) Values() []CannotUseNetworkReason {
+	return []CannotUseNetworkReason{
+		CannotUseNetworkReasonNetworkReservationNotSupported,
+		CannotUseNetworkReasonMismatchedNetworkPolicies,
+		CannotUseNetworkReasonMismatchedDvsVersionOrVendor,
+		CannotUseNetworkReasonVMotionToUnsupportedNetworkType,
+		CannotUseNetworkReasonNetworkUnderMaintenance,
+		CannotUseNetworkReasonMismatchedEnsMode,
+	}
+}
+
 func init() {
 	t["CannotUseNetworkReason"] = reflect.TypeOf((*CannotUseNetworkReason)(nil)).Elem()
 }
@@ -256,6 +433,17 @@ const (
 	CheckTestTypeNetworkTests      = CheckTestType("networkTests")
 )
 
+// Values returns all known values of the CheckTestType enum
+func (e CheckTestType) Values() []CheckTestType {
+	return []CheckTestType{
+		CheckTestTypeSourceTests,
+		CheckTestTypeHostTests,
+		CheckTestTypeResourcePoolTests,
+		CheckTestTypeDatastoreTests,
+		CheckTestTypeNetworkTests,
+	}
+}
+
 func init() {
 	t["CheckTestType"] = reflect.TypeOf((*CheckTestType)(nil)).Elem()
 }
@@ -268,6 +456,15 @@ const (
 	ClusterComputeResourceHCIWorkflowStateInvalid     = ClusterComputeResourceHCIWorkflowState("invalid")
 )
 
+// Values returns all known values of the ClusterComputeResourceHCIWorkflowState enum
+func (e ClusterComputeResourceHCIWorkflowState) Values() []ClusterComputeResourceHCIWorkflowState {
+	return []ClusterComputeResourceHCIWorkflowState{
+		ClusterComputeResourceHCIWorkflowStateIn_progress,
+		ClusterComputeResourceHCIWorkflowStateDone,
+		ClusterComputeResourceHCIWorkflowStateInvalid,
+	}
+}
+
 func init() {
 	t["ClusterComputeResourceHCIWorkflowState"] = reflect.TypeOf((*ClusterComputeResourceHCIWorkflowState)(nil)).Elem()
 }
@@ -280,6 +477,15 @@ const (
 	ClusterComputeResourceVcsHealthStatusNonhealthy = ClusterComputeResourceVcsHealthStatus("nonhealthy")
 )
 
+// Values returns all known values of the ClusterComputeResourceVcsHealthStatus enum
+func (e ClusterComputeResourceVcsHealthStatus) Values() []ClusterComputeResourceVcsHealthStatus {
+	return []ClusterComputeResourceVcsHealthStatus{
+		ClusterComputeResourceVcsHealthStatusHealthy,
+		ClusterComputeResourceVcsHealthStatusDegraded,
+		ClusterComputeResourceVcsHealthStatusNonhealthy,
+	}
+}
+
 func init() {
 	t["ClusterComputeResourceVcsHealthStatus"] = reflect.TypeOf((*ClusterComputeResourceVcsHealthStatus)(nil)).Elem()
 }
@@ -291,6 +497,14 @@ const (
 	ClusterCryptoConfigInfoCryptoModeForceEnable = ClusterCryptoConfigInfoCryptoMode("forceEnable")
 )
 
+// Values returns all known values of the ClusterCryptoConfigInfoCryptoMode enum
+func (e ClusterCryptoConfigInfoCryptoMode) Values() []ClusterCryptoConfigInfoCryptoMode {
+	return []ClusterCryptoConfigInfoCryptoMode{
+		ClusterCryptoConfigInfoCryptoModeOnDemand,
+		ClusterCryptoConfigInfoCryptoModeForceEnable,
+	}
+}
+
 func init() {
 	t["ClusterCryptoConfigInfoCryptoMode"] = reflect.TypeOf((*ClusterCryptoConfigInfoCryptoMode)(nil)).Elem()
 }
@@ -308,6 +522,20 @@ const (
 	ClusterDasAamNodeStateDasStateNodeFailed    = ClusterDasAamNodeStateDasState("nodeFailed")
 )
 
+// Values returns all known values of the ClusterDasAamNodeStateDasState enum
+func (e ClusterDasAamNodeStateDasState) Values() []ClusterDasAamNodeStateDasState {
+	return []ClusterDasAamNodeStateDasState{
+		ClusterDasAamNodeStateDasStateUninitialized,
+		ClusterDasAamNodeStateDasStateInitialized,
+		ClusterDasAamNodeStateDasStateConfiguring,
+		ClusterDasAamNodeStateDasStateUnconfiguring,
+		ClusterDasAamNodeStateDasStateRunning,
+		ClusterDasAamNodeStateDasStateError,
+		ClusterDasAamNodeStateDasStateAgentShutdown,
+		ClusterDasAamNodeStateDasStateNodeFailed,
+	}
+}
+
 func init() {
 	t["ClusterDasAamNodeStateDasState"] = reflect.TypeOf((*ClusterDasAamNodeStateDasState)(nil)).Elem()
 }
@@ -320,6 +548,15 @@ const (
 	ClusterDasConfigInfoHBDatastoreCandidateAllFeasibleDsWithUserPreference = ClusterDasConfigInfoHBDatastoreCandidate("allFeasibleDsWithUserPreference")
 )
 
+// Values returns all known values of the ClusterDasConfigInfoHBDatastoreCandidate enum
+func (e ClusterDasConfigInfoHBDatastoreCandidate) Values() []ClusterDasConfigInfoHBDatastoreCandidate {
+	return []ClusterDasConfigInfoHBDatastoreCandidate{
+		ClusterDasConfigInfoHBDatastoreCandidateUserSelectedDs,
+		ClusterDasConfigInfoHBDatastoreCandidateAllFeasibleDs,
+		ClusterDasConfigInfoHBDatastoreCandidateAllFeasibleDsWithUserPreference,
+	}
+}
+
 func init() {
 	t["ClusterDasConfigInfoHBDatastoreCandidate"] = reflect.TypeOf((*ClusterDasConfigInfoHBDatastoreCandidate)(nil)).Elem()
 }
@@ -331,6 +568,14 @@ const (
 	ClusterDasConfigInfoServiceStateEnabled  = ClusterDasConfigInfoServiceState("enabled")
 )
 
+// Values returns all known values of the ClusterDasConfigInfoServiceState enum
+func (e ClusterDasConfigInfoServiceState) Values() []ClusterDasConfigInfoServiceState {
+	return []ClusterDasConfigInfoServiceState{
+		ClusterDasConfigInfoServiceStateDisabled,
+		ClusterDasConfigInfoServiceStateEnabled,
+	}
+}
+
 func init() {
 	t["ClusterDasConfigInfoServiceState"] = reflect.TypeOf((*ClusterDasConfigInfoServiceState)(nil)).Elem()
 }
@@ -343,6 +588,15 @@ const (
 	ClusterDasConfigInfoVmMonitoringStateVmAndAppMonitoring   = ClusterDasConfigInfoVmMonitoringState("vmAndAppMonitoring")
 )
 
+// Values returns all known values of the ClusterDasConfigInfoVmMonitoringState enum
+func (e ClusterDasConfigInfoVmMonitoringState) Values() []ClusterDasConfigInfoVmMonitoringState {
+	return []ClusterDasConfigInfoVmMonitoringState{
+		ClusterDasConfigInfoVmMonitoringStateVmMonitoringDisabled,
+		ClusterDasConfigInfoVmMonitoringStateVmMonitoringOnly,
+		ClusterDasConfigInfoVmMonitoringStateVmAndAppMonitoring,
+	}
+}
+
 func init() {
 	t["ClusterDasConfigInfoVmMonitoringState"] = reflect.TypeOf((*ClusterDasConfigInfoVmMonitoringState)(nil)).Elem()
 }
@@ -362,6 +616,22 @@ const (
 	ClusterDasFdmAvailabilityStateFdmUnreachable               = ClusterDasFdmAvailabilityState("fdmUnreachable")
 )
 
+// Values returns all known values of the ClusterDasFdmAvailabilityState enum
+func (e ClusterDasFdmAvailabilityState) Values() []ClusterDasFdmAvailabilityState {
+	return []ClusterDasFdmAvailabilityState{
+		ClusterDasFdmAvailabilityStateUninitialized,
+		ClusterDasFdmAvailabilityStateElection,
+		ClusterDasFdmAvailabilityStateMaster,
+		ClusterDasFdmAvailabilityStateConnectedToMaster,
+		ClusterDasFdmAvailabilityStateNetworkPartitionedFromMaster,
+		ClusterDasFdmAvailabilityStateNetworkIsolated,
+		ClusterDasFdmAvailabilityStateHostDown,
+		ClusterDasFdmAvailabilityStateInitializationError,
+		ClusterDasFdmAvailabilityStateUninitializationError,
+		ClusterDasFdmAvailabilityStateFdmUnreachable,
+	}
+}
+
 func init() {
 	t["ClusterDasFdmAvailabilityState"] = reflect.TypeOf((*ClusterDasFdmAvailabilityState)(nil)).Elem()
 }
@@ -375,6 +645,16 @@ const (
 	ClusterDasVmSettingsIsolationResponseClusterIsolationResponse = ClusterDasVmSettingsIsolationResponse("clusterIsolationResponse")
 )
 
+// Values returns all known values of the ClusterDasVmSettingsIsolationResponse enum
+func (e ClusterDasVmSettingsIsolationResponse) Values() []ClusterDasVmSettingsIsolationResponse {
+	return []ClusterDasVmSettingsIsolationResponse{
+		ClusterDasVmSettingsIsolationResponseNone,
+		ClusterDasVmSettingsIsolationResponsePowerOff,
+		ClusterDasVmSettingsIsolationResponseShutdown,
+		ClusterDasVmSettingsIsolationResponseClusterIsolationResponse,
+	}
+}
+
 func init() {
 	t["ClusterDasVmSettingsIsolationResponse"] = reflect.TypeOf((*ClusterDasVmSettingsIsolationResponse)(nil)).Elem()
 }
@@ -391,6 +671,19 @@ const (
 	ClusterDasVmSettingsRestartPriorityClusterRestartPriority = ClusterDasVmSettingsRestartPriority("clusterRestartPriority")
 )
 
+// Values returns all known values of the ClusterDasVmSettingsRestartPriority enum
+func (e ClusterDasVmSettingsRestartPriority) Values() []ClusterDasVmSettingsRestartPriority {
+	return []ClusterDasVmSettingsRestartPriority{
+		ClusterDasVmSettingsRestartPriorityDisabled,
+		ClusterDasVmSettingsRestartPriorityLowest,
+		ClusterDasVmSettingsRestartPriorityLow,
+		ClusterDasVmSettingsRestartPriorityMedium,
+		ClusterDasVmSettingsRestartPriorityHigh,
+		ClusterDasVmSettingsRestartPriorityHighest,
+		ClusterDasVmSettingsRestartPriorityClusterRestartPriority,
+	}
+}
+
 func init() {
 	t["ClusterDasVmSettingsRestartPriority"] = reflect.TypeOf((*ClusterDasVmSettingsRestartPriority)(nil)).Elem()
 }
@@ -403,6 +696,15 @@ const (
 	ClusterHostInfraUpdateHaModeActionOperationTypeEnterMaintenance = ClusterHostInfraUpdateHaModeActionOperationType("enterMaintenance")
 )
 
+// Values returns all known values of the ClusterHostInfraUpdateHaModeActionOperationType enum
+func (e ClusterHostInfraUpdateHaModeActionOperationType) Values() []ClusterHostInfraUpdateHaModeActionOperationType {
+	return []ClusterHostInfraUpdateHaModeActionOperationType{
+		ClusterHostInfraUpdateHaModeActionOperationTypeEnterQuarantine,
+		ClusterHostInfraUpdateHaModeActionOperationTypeExitQuarantine,
+		ClusterHostInfraUpdateHaModeActionOperationTypeEnterMaintenance,
+	}
+}
+
 func init() {
 	t["ClusterHostInfraUpdateHaModeActionOperationType"] = reflect.TypeOf((*ClusterHostInfraUpdateHaModeActionOperationType)(nil)).Elem()
 }
@@ -414,6 +716,14 @@ const (
 	ClusterInfraUpdateHaConfigInfoBehaviorTypeAutomated = ClusterInfraUpdateHaConfigInfoBehaviorType("Automated")
 )
 
+// Values returns all known values of the ClusterInfraUpdateHaConfigInfoBehaviorType enum
+func (e ClusterInfraUpdateHaConfigInfoBehaviorType) Values() []ClusterInfraUpdateHaConfigInfoBehaviorType {
+	return []ClusterInfraUpdateHaConfigInfoBehaviorType{
+		ClusterInfraUpdateHaConfigInfoBehaviorTypeManual,
+		ClusterInfraUpdateHaConfigInfoBehaviorTypeAutomated,
+	}
+}
+
 func init() {
 	t["ClusterInfraUpdateHaConfigInfoBehaviorType"] = reflect.TypeOf((*ClusterInfraUpdateHaConfigInfoBehaviorType)(nil)).Elem()
 }
@@ -425,6 +735,14 @@ const (
 	ClusterInfraUpdateHaConfigInfoRemediationTypeMaintenanceMode = ClusterInfraUpdateHaConfigInfoRemediationType("MaintenanceMode")
 )
 
+// Values returns all known values of the ClusterInfraUpdateHaConfigInfoRemediationType enum
+func (e ClusterInfraUpdateHaConfigInfoRemediationType) Values() []ClusterInfraUpdateHaConfigInfoRemediationType {
+	return []ClusterInfraUpdateHaConfigInfoRemediationType{
+		ClusterInfraUpdateHaConfigInfoRemediationTypeQuarantineMode,
+		ClusterInfraUpdateHaConfigInfoRemediationTypeMaintenanceMode,
+	}
+}
+
 func init() {
 	t["ClusterInfraUpdateHaConfigInfoRemediationType"] = reflect.TypeOf((*ClusterInfraUpdateHaConfigInfoRemediationType)(nil)).Elem()
 }
@@ -436,6 +754,14 @@ const (
 	ClusterPowerOnVmOptionReserveResources        = ClusterPowerOnVmOption("ReserveResources")
 )
 
+// Values returns all known values of the ClusterPowerOnVmOption enum
+func (e ClusterPowerOnVmOption) Values() []ClusterPowerOnVmOption {
+	return []ClusterPowerOnVmOption{
+		ClusterPowerOnVmOptionOverrideAutomationLevel,
+		ClusterPowerOnVmOptionReserveResources,
+	}
+}
+
 func init() {
 	t["ClusterPowerOnVmOption"] = reflect.TypeOf((*ClusterPowerOnVmOption)(nil)).Elem()
 }
@@ -449,6 +775,16 @@ const (
 	ClusterProfileServiceTypeFT  = ClusterProfileServiceType("FT")
 )
 
+// Values returns all known values of the ClusterProfileServiceType enum
+func (e ClusterProfileServiceType) Values() []ClusterProfileServiceType {
+	return []ClusterProfileServiceType{
+		ClusterProfileServiceTypeDRS,
+		ClusterProfileServiceTypeHA,
+		ClusterProfileServiceTypeDPM,
+		ClusterProfileServiceTypeFT,
+	}
+}
+
 func init() {
 	t["ClusterProfileServiceType"] = reflect.TypeOf((*ClusterProfileServiceType)(nil)).Elem()
 }
@@ -463,6 +799,17 @@ const (
 	ClusterVmComponentProtectionSettingsStorageVmReactionClusterDefault      = ClusterVmComponentProtectionSettingsStorageVmReaction("clusterDefault")
 )
 
+// Values returns all known values of the ClusterVmComponentProtectionSettingsStorageVmReaction enum
+func (e ClusterVmComponentProtectionSettingsStorageVmReaction) Values() []ClusterVmComponentProtectionSettingsStorageVmReaction {
+	return []ClusterVmComponentProtectionSettingsStorageVmReaction{
+		ClusterVmComponentProtectionSettingsStorageVmReactionDisabled,
+		ClusterVmComponentProtectionSettingsStorageVmReactionWarning,
+		ClusterVmComponentProtectionSettingsStorageVmReactionRestartConservative,
+		ClusterVmComponentProtectionSettingsStorageVmReactionRestartAggressive,
+		ClusterVmComponentProtectionSettingsStorageVmReactionClusterDefault,
+	}
+}
+
 func init() {
 	t["ClusterVmComponentProtectionSettingsStorageVmReaction"] = reflect.TypeOf((*ClusterVmComponentProtectionSettingsStorageVmReaction)(nil)).Elem()
 }
@@ -475,6 +822,15 @@ const (
 	ClusterVmComponentProtectionSettingsVmReactionOnAPDClearedUseClusterDefault = ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared("useClusterDefault")
 )
 
+// Values returns all known values of the ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared enum
+func (e ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared) Values() []ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared {
+	return []ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared{
+		ClusterVmComponentProtectionSettingsVmReactionOnAPDClearedNone,
+		ClusterVmComponentProtectionSettingsVmReactionOnAPDClearedReset,
+		ClusterVmComponentProtectionSettingsVmReactionOnAPDClearedUseClusterDefault,
+	}
+}
+
 func init() {
 	t["ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared"] = reflect.TypeOf((*ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared)(nil)).Elem()
 }
@@ -489,6 +845,17 @@ const (
 	ClusterVmReadinessReadyConditionUseClusterDefault  = ClusterVmReadinessReadyCondition("useClusterDefault")
 )
 
+// Values returns all known values of the ClusterVmReadinessReadyCondition enum
+func (e ClusterVmReadinessReadyCondition) Values() []ClusterVmReadinessReadyCondition {
+	return []ClusterVmReadinessReadyCondition{
+		ClusterVmReadinessReadyConditionNone,
+		ClusterVmReadinessReadyConditionPoweredOn,
+		ClusterVmReadinessReadyConditionGuestHbStatusGreen,
+		ClusterVmReadinessReadyConditionAppHbStatusGreen,
+		ClusterVmReadinessReadyConditionUseClusterDefault,
+	}
+}
+
 func init() {
 	t["ClusterVmReadinessReadyCondition"] = reflect.TypeOf((*ClusterVmReadinessReadyCondition)(nil)).Elem()
 }
@@ -502,6 +869,16 @@ const (
 	ComplianceResultStatusRunning      = ComplianceResultStatus("running")
 )
 
+// Values returns all known values of the ComplianceResultStatus enum
+func (e ComplianceResultStatus) Values() []ComplianceResultStatus {
+	return []ComplianceResultStatus{
+		ComplianceResultStatusCompliant,
+		ComplianceResultStatusNonCompliant,
+		ComplianceResultStatusUnknown,
+		ComplianceResultStatusRunning,
+	}
+}
+
 func init() {
 	t["ComplianceResultStatus"] = reflect.TypeOf((*ComplianceResultStatus)(nil)).Elem()
 }
@@ -514,6 +891,15 @@ const (
 	ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseStateUnknown    = ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState("unknown")
 )
 
+// Values returns all known values of the ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState enum
+func (e ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState) Values() []ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState {
+	return []ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState{
+		ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseStateLicensed,
+		ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseStateUnlicensed,
+		ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseStateUnknown,
+	}
+}
+
 func init() {
 	t["ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState"] = reflect.TypeOf((*ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState)(nil)).Elem()
 }
@@ -526,6 +912,15 @@ const (
 	ConfigSpecOperationRemove = ConfigSpecOperation("remove")
 )
 
+// Values returns all known values of the ConfigSpecOperation enum
+func (e ConfigSpecOperation) Values() []ConfigSpecOperation {
+	return []ConfigSpecOperation{
+		ConfigSpecOperationAdd,
+		ConfigSpecOperationEdit,
+		ConfigSpecOperationRemove,
+	}
+}
+
 func init() {
 	t["ConfigSpecOperation"] = reflect.TypeOf((*ConfigSpecOperation)(nil)).Elem()
 }
@@ -541,6 +936,18 @@ const (
 	CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateManagedByTrustAuthority = CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason("KeyStateManagedByTrustAuthority")
 )
 
+// Values returns all known values of the CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason enum
+func (e CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason) Values() []CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason {
+	return []CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason{
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateMissingInCache,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateClusterInvalid,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateClusterUnreachable,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateMissingInKMS,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateNotActiveOrEnabled,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateManagedByTrustAuthority,
+	}
+}
+
 func init() {
 	t["CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason"] = reflect.TypeOf((*CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason)(nil)).Elem()
 }
@@ -554,6 +961,16 @@ const (
 	CustomizationFailedReasonCodeWrongMetadataFormat       = CustomizationFailedReasonCode("wrongMetadataFormat")
 )
 
+// Values returns all known values of the CustomizationFailedReasonCode enum
+func (e CustomizationFailedReasonCode) Values() []CustomizationFailedReasonCode {
+	return []CustomizationFailedReasonCode{
+		CustomizationFailedReasonCodeUserDefinedScriptDisabled,
+		CustomizationFailedReasonCodeCustomizationDisabled,
+		CustomizationFailedReasonCodeRawDataIsNotSupported,
+		CustomizationFailedReasonCodeWrongMetadataFormat,
+	}
+}
+
 func init() {
 	t["CustomizationFailedReasonCode"] = reflect.TypeOf((*CustomizationFailedReasonCode)(nil)).Elem()
 }
@@ -565,6 +982,14 @@ const (
 	CustomizationLicenseDataModePerSeat   = CustomizationLicenseDataMode("perSeat")
 )
 
+// Values returns all known values of the CustomizationLicenseDataMode enum
+func (e CustomizationLicenseDataMode) Values() []CustomizationLicenseDataMode {
+	return []CustomizationLicenseDataMode{
+		CustomizationLicenseDataModePerServer,
+		CustomizationLicenseDataModePerSeat,
+	}
+}
+
 func init() {
 	t["CustomizationLicenseDataMode"] = reflect.TypeOf((*CustomizationLicenseDataMode)(nil)).Elem()
 }
@@ -577,6 +1002,15 @@ const (
 	CustomizationNetBIOSModeDisableNetBIOS       = CustomizationNetBIOSMode("disableNetBIOS")
 )
 
+// Values returns all known values of the CustomizationNetBIOSMode enum
+func (e CustomizationNetBIOSMode) Values() []CustomizationNetBIOSMode {
+	return []CustomizationNetBIOSMode{
+		CustomizationNetBIOSModeEnableNetBIOSViaDhcp,
+		CustomizationNetBIOSModeEnableNetBIOS,
+		CustomizationNetBIOSModeDisableNetBIOS,
+	}
+}
+
 func init() {
 	t["CustomizationNetBIOSMode"] = reflect.TypeOf((*CustomizationNetBIOSMode)(nil)).Elem()
 }
@@ -589,6 +1023,15 @@ const (
 	CustomizationSysprepRebootOptionShutdown = CustomizationSysprepRebootOption("shutdown")
 )
 
+// Values returns all known values of the CustomizationSysprepRebootOption enum
+func (e CustomizationSysprepRebootOption) Values() []CustomizationSysprepRebootOption {
+	return []CustomizationSysprepRebootOption{
+		CustomizationSysprepRebootOptionReboot,
+		CustomizationSysprepRebootOptionNoreboot,
+		CustomizationSysprepRebootOptionShutdown,
+	}
+}
+
 func init() {
 	t["CustomizationSysprepRebootOption"] = reflect.TypeOf((*CustomizationSysprepRebootOption)(nil)).Elem()
 }
@@ -602,6 +1045,16 @@ const (
 	DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptDisabledForPort             = DVPortStatusVmDirectPathGen2InactiveReasonNetwork("portNptDisabledForPort")
 )
 
+// Values returns all known values of the DVPortStatusVmDirectPathGen2InactiveReasonNetwork enum
+func (e DVPortStatusVmDirectPathGen2InactiveReasonNetwork) Values() []DVPortStatusVmDirectPathGen2InactiveReasonNetwork {
+	return []DVPortStatusVmDirectPathGen2InactiveReasonNetwork{
+		DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptIncompatibleDvs,
+		DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptNoCompatibleNics,
+		DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptNoVirtualFunctionsAvailable,
+		DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptDisabledForPort,
+	}
+}
+
 func init() {
 	t["DVPortStatusVmDirectPathGen2InactiveReasonNetwork"] = reflect.TypeOf((*DVPortStatusVmDirectPathGen2InactiveReasonNetwork)(nil)).Elem()
 }
@@ -613,6 +1066,14 @@ const (
 	DVPortStatusVmDirectPathGen2InactiveReasonOtherPortNptIncompatibleConnectee = DVPortStatusVmDirectPathGen2InactiveReasonOther("portNptIncompatibleConnectee")
 )
 
+// Values returns all known values of the DVPortStatusVmDirectPathGen2InactiveReasonOther enum
+func (e DVPortStatusVmDirectPathGen2InactiveReasonOther) Values() []DVPortStatusVmDirectPathGen2InactiveReasonOther {
+	return []DVPortStatusVmDirectPathGen2InactiveReasonOther{
+		DVPortStatusVmDirectPathGen2InactiveReasonOtherPortNptIncompatibleHost,
+		DVPortStatusVmDirectPathGen2InactiveReasonOtherPortNptIncompatibleConnectee,
+	}
+}
+
 func init() {
 	t["DVPortStatusVmDirectPathGen2InactiveReasonOther"] = reflect.TypeOf((*DVPortStatusVmDirectPathGen2InactiveReasonOther)(nil)).Elem()
 }
@@ -624,6 +1085,14 @@ const (
 	DVSMacLimitPolicyTypeDrop  = DVSMacLimitPolicyType("drop")
 )
 
+// Values returns all known values of the DVSMacLimitPolicyType enum
+func (e DVSMacLimitPolicyType) Values() []DVSMacLimitPolicyType {
+	return []DVSMacLimitPolicyType{
+		DVSMacLimitPolicyTypeAllow,
+		DVSMacLimitPolicyTypeDrop,
+	}
+}
+
 func init() {
 	t["DVSMacLimitPolicyType"] = reflect.TypeOf((*DVSMacLimitPolicyType)(nil)).Elem()
 }
@@ -644,6 +1113,23 @@ const (
 	DasConfigFaultDasConfigFaultReasonApplyHAVibsOnClusterFailed  = DasConfigFaultDasConfigFaultReason("ApplyHAVibsOnClusterFailed")
 )
 
+// Values returns all known values of the DasConfigFaultDasConfigFaultReason enum
+func (e DasConfigFaultDasConfigFaultReason) Values() []DasConfigFaultDasConfigFaultReason {
+	return []DasConfigFaultDasConfigFaultReason{
+		DasConfigFaultDasConfigFaultReasonHostNetworkMisconfiguration,
+		DasConfigFaultDasConfigFaultReasonHostMisconfiguration,
+		DasConfigFaultDasConfigFaultReasonInsufficientPrivileges,
+		DasConfigFaultDasConfigFaultReasonNoPrimaryAgentAvailable,
+		DasConfigFaultDasConfigFaultReasonOther,
+		DasConfigFaultDasConfigFaultReasonNoDatastoresConfigured,
+		DasConfigFaultDasConfigFaultReasonCreateConfigVvolFailed,
+		DasConfigFaultDasConfigFaultReasonVSanNotSupportedOnHost,
+		DasConfigFaultDasConfigFaultReasonDasNetworkMisconfiguration,
+		DasConfigFaultDasConfigFaultReasonSetDesiredImageSpecFailed,
+		DasConfigFaultDasConfigFaultReasonApplyHAVibsOnClusterFailed,
+	}
+}
+
 func init() {
 	t["DasConfigFaultDasConfigFaultReason"] = reflect.TypeOf((*DasConfigFaultDasConfigFaultReason)(nil)).Elem()
 }
@@ -657,6 +1143,16 @@ const (
 	DasVmPriorityHigh     = DasVmPriority("high")
 )
 
+// Values returns all known values of the DasVmPriority enum
+func (e DasVmPriority) Values() []DasVmPriority {
+	return []DasVmPriority{
+		DasVmPriorityDisabled,
+		DasVmPriorityLow,
+		DasVmPriorityMedium,
+		DasVmPriorityHigh,
+	}
+}
+
 func init() {
 	t["DasVmPriority"] = reflect.TypeOf((*DasVmPriority)(nil)).Elem()
 }
@@ -668,6 +1164,14 @@ const (
 	DatastoreAccessibleFalse = DatastoreAccessible("False")
 )
 
+// Values returns all known values of the DatastoreAccessible enum
+func (e DatastoreAccessible) Values() []DatastoreAccessible {
+	return []DatastoreAccessible{
+		DatastoreAccessibleTrue,
+		DatastoreAccessibleFalse,
+	}
+}
+
 func init() {
 	t["DatastoreAccessible"] = reflect.TypeOf((*DatastoreAccessible)(nil)).Elem()
 }
@@ -680,6 +1184,15 @@ const (
 	DatastoreSummaryMaintenanceModeStateInMaintenance       = DatastoreSummaryMaintenanceModeState("inMaintenance")
 )
 
+// Values returns all known values of the DatastoreSummaryMaintenanceModeState enum
+func (e DatastoreSummaryMaintenanceModeState) Values() []DatastoreSummaryMaintenanceModeState {
+	return []DatastoreSummaryMaintenanceModeState{
+		DatastoreSummaryMaintenanceModeStateNormal,
+		DatastoreSummaryMaintenanceModeStateEnteringMaintenance,
+		DatastoreSummaryMaintenanceModeStateInMaintenance,
+	}
+}
+
 func init() {
 	t["DatastoreSummaryMaintenanceModeState"] = reflect.TypeOf((*DatastoreSummaryMaintenanceModeState)(nil)).Elem()
 }
@@ -696,6 +1209,19 @@ const (
 	DayOfWeekSaturday  = DayOfWeek("saturday")
 )
 
+// Values returns all known values of the DayOfWeek enum
+func (e DayOfWeek) Values() []DayOfWeek {
+	return []DayOfWeek{
+		DayOfWeekSunday,
+		DayOfWeekMonday,
+		DayOfWeekTuesday,
+		DayOfWeekWednesday,
+		DayOfWeekThursday,
+		DayOfWeekFriday,
+		DayOfWeekSaturday,
+	}
+}
+
 func init() {
 	t["DayOfWeek"] = reflect.TypeOf((*DayOfWeek)(nil)).Elem()
 }
@@ -707,6 +1233,14 @@ const (
 	DeviceNotSupportedReasonGuest = DeviceNotSupportedReason("guest")
 )
 
+// Values returns all known values of the DeviceNotSupportedReason enum
+func (e DeviceNotSupportedReason) Values() []DeviceNotSupportedReason {
+	return []DeviceNotSupportedReason{
+		DeviceNotSupportedReasonHost,
+		DeviceNotSupportedReasonGuest,
+	}
+}
+
 func init() {
 	t["DeviceNotSupportedReason"] = reflect.TypeOf((*DeviceNotSupportedReason)(nil)).Elem()
 }
@@ -723,6 +1257,19 @@ const (
 	DiagnosticManagerLogCreatorRecordLog = DiagnosticManagerLogCreator("recordLog")
 )
 
+// Values returns all known values of the DiagnosticManagerLogCreator enum
+func (e DiagnosticManagerLogCreator) Values() []DiagnosticManagerLogCreator {
+	return []DiagnosticManagerLogCreator{
+		DiagnosticManagerLogCreatorVpxd,
+		DiagnosticManagerLogCreatorVpxa,
+		DiagnosticManagerLogCreatorHostd,
+		DiagnosticManagerLogCreatorServerd,
+		DiagnosticManagerLogCreatorInstall,
+		DiagnosticManagerLogCreatorVpxClient,
+		DiagnosticManagerLogCreatorRecordLog,
+	}
+}
+
 func init() {
 	t["DiagnosticManagerLogCreator"] = reflect.TypeOf((*DiagnosticManagerLogCreator)(nil)).Elem()
 }
@@ -733,6 +1280,13 @@ const (
 	DiagnosticManagerLogFormatPlain = DiagnosticManagerLogFormat("plain")
 )
 
+// Values returns all known values of the DiagnosticManagerLogFormat enum
+func (e DiagnosticManagerLogFormat) Values() []DiagnosticManagerLogFormat {
+	return []DiagnosticManagerLogFormat{
+		DiagnosticManagerLogFormatPlain,
+	}
+}
+
 func init() {
 	t["DiagnosticManagerLogFormat"] = reflect.TypeOf((*DiagnosticManagerLogFormat)(nil)).Elem()
 }
@@ -744,6 +1298,14 @@ const (
 	DiagnosticPartitionStorageTypeNetworkAttached = DiagnosticPartitionStorageType("networkAttached")
 )
 
+// Values returns all known values of the DiagnosticPartitionStorageType enum
+func (e DiagnosticPartitionStorageType) Values() []DiagnosticPartitionStorageType {
+	return []DiagnosticPartitionStorageType{
+		DiagnosticPartitionStorageTypeDirectAttached,
+		DiagnosticPartitionStorageTypeNetworkAttached,
+	}
+}
+
 func init() {
 	t["DiagnosticPartitionStorageType"] = reflect.TypeOf((*DiagnosticPartitionStorageType)(nil)).Elem()
 }
@@ -755,6 +1317,14 @@ const (
 	DiagnosticPartitionTypeMultiHost  = DiagnosticPartitionType("multiHost")
 )
 
+// Values returns all known values of the DiagnosticPartitionType enum
+func (e DiagnosticPartitionType) Values() []DiagnosticPartitionType {
+	return []DiagnosticPartitionType{
+		DiagnosticPartitionTypeSingleHost,
+		DiagnosticPartitionTypeMultiHost,
+	}
+}
+
 func init() {
 	t["DiagnosticPartitionType"] = reflect.TypeOf((*DiagnosticPartitionType)(nil)).Elem()
 }
@@ -765,6 +1335,13 @@ const (
 	DisallowedChangeByServiceDisallowedChangeHotExtendDisk = DisallowedChangeByServiceDisallowedChange("hotExtendDisk")
 )
 
+// Values returns all known values of the DisallowedChangeByServiceDisallowedChange enum
+func (e DisallowedChangeByServiceDisallowedChange) Values() []DisallowedChangeByServiceDisallowedChange {
+	return []DisallowedChangeByServiceDisallowedChange{
+		DisallowedChangeByServiceDisallowedChangeHotExtendDisk,
+	}
+}
+
 func init() {
 	t["DisallowedChangeByServiceDisallowedChange"] = reflect.TypeOf((*DisallowedChangeByServiceDisallowedChange)(nil)).Elem()
 }
@@ -776,6 +1353,14 @@ const (
 	DistributedVirtualPortgroupBackingTypeNsx      = DistributedVirtualPortgroupBackingType("nsx")
 )
 
+// Values returns all known values of the DistributedVirtualPortgroupBackingType enum
+func (e DistributedVirtualPortgroupBackingType) Values() []DistributedVirtualPortgroupBackingType {
+	return []DistributedVirtualPortgroupBackingType{
+		DistributedVirtualPortgroupBackingTypeStandard,
+		DistributedVirtualPortgroupBackingTypeNsx,
+	}
+}
+
 func init() {
 	t["DistributedVirtualPortgroupBackingType"] = reflect.TypeOf((*DistributedVirtualPortgroupBackingType)(nil)).Elem()
 }
@@ -788,6 +1373,15 @@ const (
 	DistributedVirtualPortgroupMetaTagNamePortIndex     = DistributedVirtualPortgroupMetaTagName("portIndex")
 )
 
+// Values returns all known values of the DistributedVirtualPortgroupMetaTagName enum
+func (e DistributedVirtualPortgroupMetaTagName) Values() []DistributedVirtualPortgroupMetaTagName {
+	return []DistributedVirtualPortgroupMetaTagName{
+		DistributedVirtualPortgroupMetaTagNameDvsName,
+		DistributedVirtualPortgroupMetaTagNamePortgroupName,
+		DistributedVirtualPortgroupMetaTagNamePortIndex,
+	}
+}
+
 func init() {
 	t["DistributedVirtualPortgroupMetaTagName"] = reflect.TypeOf((*DistributedVirtualPortgroupMetaTagName)(nil)).Elem()
 }
@@ -800,6 +1394,15 @@ const (
 	DistributedVirtualPortgroupPortgroupTypeEphemeral    = DistributedVirtualPortgroupPortgroupType("ephemeral")
 )
 
+// Values returns all known values of the DistributedVirtualPortgroupPortgroupType enum
+func (e DistributedVirtualPortgroupPortgroupType) Values() []DistributedVirtualPortgroupPortgroupType {
+	return []DistributedVirtualPortgroupPortgroupType{
+		DistributedVirtualPortgroupPortgroupTypeEarlyBinding,
+		DistributedVirtualPortgroupPortgroupTypeLateBinding,
+		DistributedVirtualPortgroupPortgroupTypeEphemeral,
+	}
+}
+
 func init() {
 	t["DistributedVirtualPortgroupPortgroupType"] = reflect.TypeOf((*DistributedVirtualPortgroupPortgroupType)(nil)).Elem()
 }
@@ -820,6 +1423,23 @@ const (
 	DistributedVirtualSwitchHostInfrastructureTrafficClassNvmetcp        = DistributedVirtualSwitchHostInfrastructureTrafficClass("nvmetcp")
 )
 
+// Values returns all known values of the DistributedVirtualSwitchHostInfrastructureTrafficClass enum
+func (e DistributedVirtualSwitchHostInfrastructureTrafficClass) Values() []DistributedVirtualSwitchHostInfrastructureTrafficClass {
+	return []DistributedVirtualSwitchHostInfrastructureTrafficClass{
+		DistributedVirtualSwitchHostInfrastructureTrafficClassManagement,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassFaultTolerance,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassVmotion,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassVirtualMachine,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassISCSI,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassNfs,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassHbr,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassVsan,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassVdp,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassBackupNfc,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassNvmetcp,
+	}
+}
+
 func init() {
 	t["DistributedVirtualSwitchHostInfrastructureTrafficClass"] = reflect.TypeOf((*DistributedVirtualSwitchHostInfrastructureTrafficClass)(nil)).Elem()
 }
@@ -835,6 +1455,18 @@ const (
 	DistributedVirtualSwitchHostMemberHostComponentStateDown         = DistributedVirtualSwitchHostMemberHostComponentState("down")
 )
 
+// Values returns all known values of the DistributedVirtualSwitchHostMemberHostComponentState enum
+func (e DistributedVirtualSwitchHostMemberHostComponentState) Values() []DistributedVirtualSwitchHostMemberHostComponentState {
+	return []DistributedVirtualSwitchHostMemberHostComponentState{
+		DistributedVirtualSwitchHostMemberHostComponentStateUp,
+		DistributedVirtualSwitchHostMemberHostComponentStatePending,
+		DistributedVirtualSwitchHostMemberHostComponentStateOutOfSync,
+		DistributedVirtualSwitchHostMemberHostComponentStateWarning,
+		DistributedVirtualSwitchHostMemberHostComponentStateDisconnected,
+		DistributedVirtualSwitchHostMemberHostComponentStateDown,
+	}
+}
+
 func init() {
 	t["DistributedVirtualSwitchHostMemberHostComponentState"] = reflect.TypeOf((*DistributedVirtualSwitchHostMemberHostComponentState)(nil)).Elem()
 }
@@ -846,6 +1478,14 @@ const (
 	DistributedVirtualSwitchHostMemberTransportZoneTypeOverlay = DistributedVirtualSwitchHostMemberTransportZoneType("overlay")
 )
 
+// Values returns all known values of the DistributedVirtualSwitchHostMemberTransportZoneType enum
+func (e DistributedVirtualSwitchHostMemberTransportZoneType) Values() []DistributedVirtualSwitchHostMemberTransportZoneType {
+	return []DistributedVirtualSwitchHostMemberTransportZoneType{
+		DistributedVirtualSwitchHostMemberTransportZoneTypeVlan,
+		DistributedVirtualSwitchHostMemberTransportZoneTypeOverlay,
+	}
+}
+
 func init() {
 	t["DistributedVirtualSwitchHostMemberTransportZoneType"] = reflect.TypeOf((*DistributedVirtualSwitchHostMemberTransportZoneType)(nil)).Elem()
 }
@@ -857,6 +1497,14 @@ const (
 	DistributedVirtualSwitchNetworkResourceControlVersionVersion3 = DistributedVirtualSwitchNetworkResourceControlVersion("version3")
 )
 
+// Values returns all known values of the DistributedVirtualSwitchNetworkResourceControlVersion enum
+func (e DistributedVirtualSwitchNetworkResourceControlVersion) Values() []DistributedVirtualSwitchNetworkResourceControlVersion {
+	return []DistributedVirtualSwitchNetworkResourceControlVersion{
+		DistributedVirtualSwitchNetworkResourceControlVersionVersion2,
+		DistributedVirtualSwitchNetworkResourceControlVersionVersion3,
+	}
+}
+
 func init() {
 	t["DistributedVirtualSwitchNetworkResourceControlVersion"] = reflect.TypeOf((*DistributedVirtualSwitchNetworkResourceControlVersion)(nil)).Elem()
 }
@@ -871,6 +1519,17 @@ const (
 	DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_loadbased = DistributedVirtualSwitchNicTeamingPolicyMode("loadbalance_loadbased")
 )
 
+// Values returns all known values of the DistributedVirtualSwitchNicTeamingPolicyMode enum
+func (e DistributedVirtualSwitchNicTeamingPolicyMode) Values() []DistributedVirtualSwitchNicTeamingPolicyMode {
+	return []DistributedVirtualSwitchNicTeamingPolicyMode{
+		DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_ip,
+		DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_srcmac,
+		DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_srcid,
+		DistributedVirtualSwitchNicTeamingPolicyModeFailover_explicit,
+		DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_loadbased,
+	}
+}
+
 func init() {
 	t["DistributedVirtualSwitchNicTeamingPolicyMode"] = reflect.TypeOf((*DistributedVirtualSwitchNicTeamingPolicyMode)(nil)).Elem()
 }
@@ -884,6 +1543,16 @@ const (
 	DistributedVirtualSwitchPortConnecteeConnecteeTypeHostVmkVnic     = DistributedVirtualSwitchPortConnecteeConnecteeType("hostVmkVnic")
 )
 
+// Values returns all known values of the DistributedVirtualSwitchPortConnecteeConnecteeType enum
+func (e DistributedVirtualSwitchPortConnecteeConnecteeType) Values() []DistributedVirtualSwitchPortConnecteeConnecteeType {
+	return []DistributedVirtualSwitchPortConnecteeConnecteeType{
+		DistributedVirtualSwitchPortConnecteeConnecteeTypePnic,
+		DistributedVirtualSwitchPortConnecteeConnecteeTypeVmVnic,
+		DistributedVirtualSwitchPortConnecteeConnecteeTypeHostConsoleVnic,
+		DistributedVirtualSwitchPortConnecteeConnecteeTypeHostVmkVnic,
+	}
+}
+
 func init() {
 	t["DistributedVirtualSwitchPortConnecteeConnecteeType"] = reflect.TypeOf((*DistributedVirtualSwitchPortConnecteeConnecteeType)(nil)).Elem()
 }
@@ -898,6 +1567,17 @@ const (
 	DistributedVirtualSwitchProductSpecOperationTypeUpdateBundleInfo       = DistributedVirtualSwitchProductSpecOperationType("updateBundleInfo")
 )
 
+// Values returns all known values of the DistributedVirtualSwitchProductSpecOperationType enum
+func (e DistributedVirtualSwitchProductSpecOperationType) Values() []DistributedVirtualSwitchProductSpecOperationType {
+	return []DistributedVirtualSwitchProductSpecOperationType{
+		DistributedVirtualSwitchProductSpecOperationTypePreInstall,
+		DistributedVirtualSwitchProductSpecOperationTypeUpgrade,
+		DistributedVirtualSwitchProductSpecOperationTypeNotifyAvailableUpgrade,
+		DistributedVirtualSwitchProductSpecOperationTypeProceedWithUpgrade,
+		DistributedVirtualSwitchProductSpecOperationTypeUpdateBundleInfo,
+	}
+}
+
 func init() {
 	t["DistributedVirtualSwitchProductSpecOperationType"] = reflect.TypeOf((*DistributedVirtualSwitchProductSpecOperationType)(nil)).Elem()
 }
@@ -909,6 +1589,14 @@ const (
 	DpmBehaviorAutomated = DpmBehavior("automated")
 )
 
+// Values returns all known values of the DpmBehavior enum
+func (e DpmBehavior) Values() []DpmBehavior {
+	return []DpmBehavior{
+		DpmBehaviorManual,
+		DpmBehaviorAutomated,
+	}
+}
+
 func init() {
 	t["DpmBehavior"] = reflect.TypeOf((*DpmBehavior)(nil)).Elem()
 }
@@ -921,6 +1609,15 @@ const (
 	DrsBehaviorFullyAutomated     = DrsBehavior("fullyAutomated")
 )
 
+// Values returns all known values of the DrsBehavior enum
+func (e DrsBehavior) Values() []DrsBehavior {
+	return []DrsBehavior{
+		DrsBehaviorManual,
+		DrsBehaviorPartiallyAutomated,
+		DrsBehaviorFullyAutomated,
+	}
+}
+
 func init() {
 	t["DrsBehavior"] = reflect.TypeOf((*DrsBehavior)(nil)).Elem()
 }
@@ -932,6 +1629,14 @@ const (
 	DrsInjectorWorkloadCorrelationStateUncorrelated = DrsInjectorWorkloadCorrelationState("Uncorrelated")
 )
 
+// Values returns all known values of the DrsInjectorWorkloadCorrelationState enum
+func (e DrsInjectorWorkloadCorrelationState) Values() []DrsInjectorWorkloadCorrelationState {
+	return []DrsInjectorWorkloadCorrelationState{
+		DrsInjectorWorkloadCorrelationStateCorrelated,
+		DrsInjectorWorkloadCorrelationStateUncorrelated,
+	}
+}
+
 func init() {
 	t["DrsInjectorWorkloadCorrelationState"] = reflect.TypeOf((*DrsInjectorWorkloadCorrelationState)(nil)).Elem()
 }
@@ -946,6 +1651,17 @@ const (
 	DrsRecommendationReasonCodeHostMaint      = DrsRecommendationReasonCode("hostMaint")
 )
 
+// Values returns all known values of the DrsRecommendationReasonCode enum
+func (e DrsRecommendationReasonCode) Values() []DrsRecommendationReasonCode {
+	return []DrsRecommendationReasonCode{
+		DrsRecommendationReasonCodeFairnessCpuAvg,
+		DrsRecommendationReasonCodeFairnessMemAvg,
+		DrsRecommendationReasonCodeJointAffin,
+		DrsRecommendationReasonCodeAntiAffin,
+		DrsRecommendationReasonCodeHostMaint,
+	}
+}
+
 func init() {
 	t["DrsRecommendationReasonCode"] = reflect.TypeOf((*DrsRecommendationReasonCode)(nil)).Elem()
 }
@@ -959,6 +1675,16 @@ const (
 	DvsEventPortBlockStateUnknown   = DvsEventPortBlockState("unknown")
 )
 
+// Values returns all known values of the DvsEventPortBlockState enum
+func (e DvsEventPortBlockState) Values() []DvsEventPortBlockState {
+	return []DvsEventPortBlockState{
+		DvsEventPortBlockStateUnset,
+		DvsEventPortBlockStateBlocked,
+		DvsEventPortBlockStateUnblocked,
+		DvsEventPortBlockStateUnknown,
+	}
+}
+
 func init() {
 	t["DvsEventPortBlockState"] = reflect.TypeOf((*DvsEventPortBlockState)(nil)).Elem()
 }
@@ -970,6 +1696,14 @@ const (
 	DvsFilterOnFailureFailClosed = DvsFilterOnFailure("failClosed")
 )
 
+// Values returns all known values of the DvsFilterOnFailure enum
+func (e DvsFilterOnFailure) Values() []DvsFilterOnFailure {
+	return []DvsFilterOnFailure{
+		DvsFilterOnFailureFailOpen,
+		DvsFilterOnFailureFailClosed,
+	}
+}
+
 func init() {
 	t["DvsFilterOnFailure"] = reflect.TypeOf((*DvsFilterOnFailure)(nil)).Elem()
 }
@@ -982,6 +1716,15 @@ const (
 	DvsNetworkRuleDirectionTypeBoth            = DvsNetworkRuleDirectionType("both")
 )
 
+// Values returns all known values of the DvsNetworkRuleDirectionType enum
+func (e DvsNetworkRuleDirectionType) Values() []DvsNetworkRuleDirectionType {
+	return []DvsNetworkRuleDirectionType{
+		DvsNetworkRuleDirectionTypeIncomingPackets,
+		DvsNetworkRuleDirectionTypeOutgoingPackets,
+		DvsNetworkRuleDirectionTypeBoth,
+	}
+}
+
 func init() {
 	t["DvsNetworkRuleDirectionType"] = reflect.TypeOf((*DvsNetworkRuleDirectionType)(nil)).Elem()
 }
@@ -994,6 +1737,15 @@ const (
 	EntityImportTypeApplyToEntitySpecified             = EntityImportType("applyToEntitySpecified")
 )
 
+// Values returns all known values of the EntityImportType enum
+func (e EntityImportType) Values() []EntityImportType {
+	return []EntityImportType{
+		EntityImportTypeCreateEntityWithNewIdentifier,
+		EntityImportTypeCreateEntityWithOriginalIdentifier,
+		EntityImportTypeApplyToEntitySpecified,
+	}
+}
+
 func init() {
 	t["EntityImportType"] = reflect.TypeOf((*EntityImportType)(nil)).Elem()
 }
@@ -1005,6 +1757,14 @@ const (
 	EntityTypeDistributedVirtualPortgroup = EntityType("distributedVirtualPortgroup")
 )
 
+// Values returns all known values of the EntityType enum
+func (e EntityType) Values() []EntityType {
+	return []EntityType{
+		EntityTypeDistributedVirtualSwitch,
+		EntityTypeDistributedVirtualPortgroup,
+	}
+}
+
 func init() {
 	t["EntityType"] = reflect.TypeOf((*EntityType)(nil)).Elem()
 }
@@ -1020,6 +1780,18 @@ const (
 	EventAlarmExpressionComparisonOperatorDoesNotEndWith   = EventAlarmExpressionComparisonOperator("doesNotEndWith")
 )
 
+// Values returns all known values of the EventAlarmExpressionComparisonOperator enum
+func (e EventAlarmExpressionComparisonOperator) Values() []EventAlarmExpressionComparisonOperator {
+	return []EventAlarmExpressionComparisonOperator{
+		EventAlarmExpressionComparisonOperatorEquals,
+		EventAlarmExpressionComparisonOperatorNotEqualTo,
+		EventAlarmExpressionComparisonOperatorStartsWith,
+		EventAlarmExpressionComparisonOperatorDoesNotStartWith,
+		EventAlarmExpressionComparisonOperatorEndsWith,
+		EventAlarmExpressionComparisonOperatorDoesNotEndWith,
+	}
+}
+
 func init() {
 	t["EventAlarmExpressionComparisonOperator"] = reflect.TypeOf((*EventAlarmExpressionComparisonOperator)(nil)).Elem()
 }
@@ -1033,6 +1805,16 @@ const (
 	EventCategoryUser    = EventCategory("user")
 )
 
+// Values returns all known values of the EventCategory enum
+func (e EventCategory) Values() []EventCategory {
+	return []EventCategory{
+		EventCategoryInfo,
+		EventCategoryWarning,
+		EventCategoryError,
+		EventCategoryUser,
+	}
+}
+
 func init() {
 	t["EventCategory"] = reflect.TypeOf((*EventCategory)(nil)).Elem()
 }
@@ -1046,6 +1828,16 @@ const (
 	EventEventSeverityUser    = EventEventSeverity("user")
 )
 
+// Values returns all known values of the EventEventSeverity enum
+func (e EventEventSeverity) Values() []EventEventSeverity {
+	return []EventEventSeverity{
+		EventEventSeverityError,
+		EventEventSeverityWarning,
+		EventEventSeverityInfo,
+		EventEventSeverityUser,
+	}
+}
+
 func init() {
 	t["EventEventSeverity"] = reflect.TypeOf((*EventEventSeverity)(nil)).Elem()
 }
@@ -1058,6 +1850,15 @@ const (
 	EventFilterSpecRecursionOptionAll      = EventFilterSpecRecursionOption("all")
 )
 
+// Values returns all known values of the EventFilterSpecRecursionOption enum
+func (e EventFilterSpecRecursionOption) Values() []EventFilterSpecRecursionOption {
+	return []EventFilterSpecRecursionOption{
+		EventFilterSpecRecursionOptionSelf,
+		EventFilterSpecRecursionOptionChildren,
+		EventFilterSpecRecursionOptionAll,
+	}
+}
+
 func init() {
 	t["EventFilterSpecRecursionOption"] = reflect.TypeOf((*EventFilterSpecRecursionOption)(nil)).Elem()
 }
@@ -1071,6 +1872,16 @@ const (
 	FibreChannelPortTypeUnknown      = FibreChannelPortType("unknown")
 )
 
+// Values returns all known values of the FibreChannelPortType enum
+func (e FibreChannelPortType) Values() []FibreChannelPortType {
+	return []FibreChannelPortType{
+		FibreChannelPortTypeFabric,
+		FibreChannelPortTypeLoop,
+		FibreChannelPortTypePointToPoint,
+		FibreChannelPortTypeUnknown,
+	}
+}
+
 func init() {
 	t["FibreChannelPortType"] = reflect.TypeOf((*FibreChannelPortType)(nil)).Elem()
 }
@@ -1083,6 +1894,15 @@ const (
 	FileSystemMountInfoVStorageSupportStatusVStorageUnknown     = FileSystemMountInfoVStorageSupportStatus("vStorageUnknown")
 )
 
+// Values returns all known values of the FileSystemMountInfoVStorageSupportStatus enum
+func (e FileSystemMountInfoVStorageSupportStatus) Values() []FileSystemMountInfoVStorageSupportStatus {
+	return []FileSystemMountInfoVStorageSupportStatus{
+		FileSystemMountInfoVStorageSupportStatusVStorageSupported,
+		FileSystemMountInfoVStorageSupportStatusVStorageUnsupported,
+		FileSystemMountInfoVStorageSupportStatusVStorageUnknown,
+	}
+}
+
 func init() {
 	t["FileSystemMountInfoVStorageSupportStatus"] = reflect.TypeOf((*FileSystemMountInfoVStorageSupportStatus)(nil)).Elem()
 }
@@ -1094,6 +1914,14 @@ const (
 	FolderDesiredHostStateNon_maintenance = FolderDesiredHostState("non_maintenance")
 )
 
+// Values returns all known values of the FolderDesiredHostState enum
+func (e FolderDesiredHostState) Values() []FolderDesiredHostState {
+	return []FolderDesiredHostState{
+		FolderDesiredHostStateMaintenance,
+		FolderDesiredHostStateNon_maintenance,
+	}
+}
+
 func init() {
 	t["FolderDesiredHostState"] = reflect.TypeOf((*FolderDesiredHostState)(nil)).Elem()
 }
@@ -1106,6 +1934,15 @@ const (
 	FtIssuesOnHostHostSelectionTypeDrs  = FtIssuesOnHostHostSelectionType("drs")
 )
 
+// Values returns all known values of the FtIssuesOnHostHostSelectionType enum
+func (e FtIssuesOnHostHostSelectionType) Values() []FtIssuesOnHostHostSelectionType {
+	return []FtIssuesOnHostHostSelectionType{
+		FtIssuesOnHostHostSelectionTypeUser,
+		FtIssuesOnHostHostSelectionTypeVc,
+		FtIssuesOnHostHostSelectionTypeDrs,
+	}
+}
+
 func init() {
 	t["FtIssuesOnHostHostSelectionType"] = reflect.TypeOf((*FtIssuesOnHostHostSelectionType)(nil)).Elem()
 }
@@ -1118,6 +1955,15 @@ const (
 	GuestFileTypeSymlink   = GuestFileType("symlink")
 )
 
+// Values returns all known values of the GuestFileType enum
+func (e GuestFileType) Values() []GuestFileType {
+	return []GuestFileType{
+		GuestFileTypeFile,
+		GuestFileTypeDirectory,
+		GuestFileTypeSymlink,
+	}
+}
+
 func init() {
 	t["GuestFileType"] = reflect.TypeOf((*GuestFileType)(nil)).Elem()
 }
@@ -1130,6 +1976,15 @@ const (
 	GuestInfoAppStateTypeAppStateNeedReset = GuestInfoAppStateType("appStateNeedReset")
 )
 
+// Values returns all known values of the GuestInfoAppStateType enum
+func (e GuestInfoAppStateType) Values() []GuestInfoAppStateType {
+	return []GuestInfoAppStateType{
+		GuestInfoAppStateTypeNone,
+		GuestInfoAppStateTypeAppStateOk,
+		GuestInfoAppStateTypeAppStateNeedReset,
+	}
+}
+
 func init() {
 	t["GuestInfoAppStateType"] = reflect.TypeOf((*GuestInfoAppStateType)(nil)).Elem()
 }
@@ -1144,6 +1999,17 @@ const (
 	GuestInfoCustomizationStatusTOOLSDEPLOYPKG_FAILED    = GuestInfoCustomizationStatus("TOOLSDEPLOYPKG_FAILED")
 )
 
+// Values returns all known values of the GuestInfoCustomizationStatus enum
+func (e GuestInfoCustomizationStatus) Values() []GuestInfoCustomizationStatus {
+	return []GuestInfoCustomizationStatus{
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_IDLE,
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_PENDING,
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_RUNNING,
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_SUCCEEDED,
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_FAILED,
+	}
+}
+
 func init() {
 	t["GuestInfoCustomizationStatus"] = reflect.TypeOf((*GuestInfoCustomizationStatus)(nil)).Elem()
 }
@@ -1155,6 +2021,14 @@ const (
 	GuestOsDescriptorFirmwareTypeEfi  = GuestOsDescriptorFirmwareType("efi")
 )
 
+// Values returns all known values of the GuestOsDescriptorFirmwareType enum
+func (e GuestOsDescriptorFirmwareType) Values() []GuestOsDescriptorFirmwareType {
+	return []GuestOsDescriptorFirmwareType{
+		GuestOsDescriptorFirmwareTypeBios,
+		GuestOsDescriptorFirmwareTypeEfi,
+	}
+}
+
 func init() {
 	t["GuestOsDescriptorFirmwareType"] = reflect.TypeOf((*GuestOsDescriptorFirmwareType)(nil)).Elem()
 }
@@ -1171,6 +2045,19 @@ const (
 	GuestOsDescriptorSupportLevelTechPreview  = GuestOsDescriptorSupportLevel("techPreview")
 )
 
+// Values returns all known values of the GuestOsDescriptorSupportLevel enum
+func (e GuestOsDescriptorSupportLevel) Values() []GuestOsDescriptorSupportLevel {
+	return []GuestOsDescriptorSupportLevel{
+		GuestOsDescriptorSupportLevelExperimental,
+		GuestOsDescriptorSupportLevelLegacy,
+		GuestOsDescriptorSupportLevelTerminated,
+		GuestOsDescriptorSupportLevelSupported,
+		GuestOsDescriptorSupportLevelUnsupported,
+		GuestOsDescriptorSupportLevelDeprecated,
+		GuestOsDescriptorSupportLevelTechPreview,
+	}
+}
+
 func init() {
 	t["GuestOsDescriptorSupportLevel"] = reflect.TypeOf((*GuestOsDescriptorSupportLevel)(nil)).Elem()
 }
@@ -1183,6 +2070,15 @@ const (
 	GuestRegKeyWowSpecWOW64     = GuestRegKeyWowSpec("WOW64")
 )
 
+// Values returns all known values of the GuestRegKeyWowSpec enum
+func (e GuestRegKeyWowSpec) Values() []GuestRegKeyWowSpec {
+	return []GuestRegKeyWowSpec{
+		GuestRegKeyWowSpecWOWNative,
+		GuestRegKeyWowSpecWOW32,
+		GuestRegKeyWowSpecWOW64,
+	}
+}
+
 func init() {
 	t["GuestRegKeyWowSpec"] = reflect.TypeOf((*GuestRegKeyWowSpec)(nil)).Elem()
 }
@@ -1197,6 +2093,17 @@ const (
 	HealthUpdateInfoComponentTypeStorage = HealthUpdateInfoComponentType("Storage")
 )
 
+// Values returns all known values of the HealthUpdateInfoComponentType enum
+func (e HealthUpdateInfoComponentType) Values() []HealthUpdateInfoComponentType {
+	return []HealthUpdateInfoComponentType{
+		HealthUpdateInfoComponentTypeMemory,
+		HealthUpdateInfoComponentTypePower,
+		HealthUpdateInfoComponentTypeFan,
+		HealthUpdateInfoComponentTypeNetwork,
+		HealthUpdateInfoComponentTypeStorage,
+	}
+}
+
 func init() {
 	t["HealthUpdateInfoComponentType"] = reflect.TypeOf((*HealthUpdateInfoComponentType)(nil)).Elem()
 }
@@ -1211,6 +2118,17 @@ const (
 	HostAccessModeAccessOther    = HostAccessMode("accessOther")
 )
 
+// Values returns all known values of the HostAccessMode enum
+func (e HostAccessMode) Values() []HostAccessMode {
+	return []HostAccessMode{
+		HostAccessModeAccessNone,
+		HostAccessModeAccessAdmin,
+		HostAccessModeAccessNoAccess,
+		HostAccessModeAccessReadOnly,
+		HostAccessModeAccessOther,
+	}
+}
+
 func init() {
 	t["HostAccessMode"] = reflect.TypeOf((*HostAccessMode)(nil)).Elem()
 }
@@ -1221,6 +2139,13 @@ const (
 	HostActiveDirectoryAuthenticationCertificateDigestSHA1 = HostActiveDirectoryAuthenticationCertificateDigest("SHA1")
 )
 
+// Values returns all known values of the HostActiveDirectoryAuthenticationCertificateDigest enum
+func (e HostActiveDirectoryAuthenticationCertificateDigest) Values() []HostActiveDirectoryAuthenticationCertificateDigest {
+	return []HostActiveDirectoryAuthenticationCertificateDigest{
+		HostActiveDirectoryAuthenticationCertificateDigestSHA1,
+	}
+}
+
 func init() {
 	t["HostActiveDirectoryAuthenticationCertificateDigest"] = reflect.TypeOf((*HostActiveDirectoryAuthenticationCertificateDigest)(nil)).Elem()
 }
@@ -1237,6 +2162,19 @@ const (
 	HostActiveDirectoryInfoDomainMembershipStatusOtherProblem      = HostActiveDirectoryInfoDomainMembershipStatus("otherProblem")
 )
 
+// Values returns all known values of the HostActiveDirectoryInfoDomainMembershipStatus enum
+func (e HostActiveDirectoryInfoDomainMembershipStatus) Values() []HostActiveDirectoryInfoDomainMembershipStatus {
+	return []HostActiveDirectoryInfoDomainMembershipStatus{
+		HostActiveDirectoryInfoDomainMembershipStatusUnknown,
+		HostActiveDirectoryInfoDomainMembershipStatusOk,
+		HostActiveDirectoryInfoDomainMembershipStatusNoServers,
+		HostActiveDirectoryInfoDomainMembershipStatusClientTrustBroken,
+		HostActiveDirectoryInfoDomainMembershipStatusServerTrustBroken,
+		HostActiveDirectoryInfoDomainMembershipStatusInconsistentTrust,
+		HostActiveDirectoryInfoDomainMembershipStatusOtherProblem,
+	}
+}
+
 func init() {
 	t["HostActiveDirectoryInfoDomainMembershipStatus"] = reflect.TypeOf((*HostActiveDirectoryInfoDomainMembershipStatus)(nil)).Elem()
 }
@@ -1255,6 +2193,21 @@ const (
 	HostCapabilityFtUnsupportedReasonCpuHvDisabled       = HostCapabilityFtUnsupportedReason("cpuHvDisabled")
 )
 
+// Values returns all known values of the HostCapabilityFtUnsupportedReason enum
+func (e HostCapabilityFtUnsupportedReason) Values() []HostCapabilityFtUnsupportedReason {
+	return []HostCapabilityFtUnsupportedReason{
+		HostCapabilityFtUnsupportedReasonVMotionNotLicensed,
+		HostCapabilityFtUnsupportedReasonMissingVMotionNic,
+		HostCapabilityFtUnsupportedReasonMissingFTLoggingNic,
+		HostCapabilityFtUnsupportedReasonFtNotLicensed,
+		HostCapabilityFtUnsupportedReasonHaAgentIssue,
+		HostCapabilityFtUnsupportedReasonUnsupportedProduct,
+		HostCapabilityFtUnsupportedReasonCpuHvUnsupported,
+		HostCapabilityFtUnsupportedReasonCpuHwmmuUnsupported,
+		HostCapabilityFtUnsupportedReasonCpuHvDisabled,
+	}
+}
+
 func init() {
 	t["HostCapabilityFtUnsupportedReason"] = reflect.TypeOf((*HostCapabilityFtUnsupportedReason)(nil)).Elem()
 }
@@ -1267,6 +2220,15 @@ const (
 	HostCapabilityUnmapMethodSupportedDynamic  = HostCapabilityUnmapMethodSupported("dynamic")
 )
 
+// Values returns all known values of the HostCapabilityUnmapMethodSupported enum
+func (e HostCapabilityUnmapMethodSupported) Values() []HostCapabilityUnmapMethodSupported {
+	return []HostCapabilityUnmapMethodSupported{
+		HostCapabilityUnmapMethodSupportedPriority,
+		HostCapabilityUnmapMethodSupportedFixed,
+		HostCapabilityUnmapMethodSupportedDynamic,
+	}
+}
+
 func init() {
 	t["HostCapabilityUnmapMethodSupported"] = reflect.TypeOf((*HostCapabilityUnmapMethodSupported)(nil)).Elem()
 }
@@ -1279,6 +2241,15 @@ const (
 	HostCapabilityVmDirectPathGen2UnsupportedReasonHostNptDisabled             = HostCapabilityVmDirectPathGen2UnsupportedReason("hostNptDisabled")
 )
 
+// Values returns all known values of the HostCapabilityVmDirectPathGen2UnsupportedReason enum
+func (e HostCapabilityVmDirectPathGen2UnsupportedReason) Values() []HostCapabilityVmDirectPathGen2UnsupportedReason {
+	return []HostCapabilityVmDirectPathGen2UnsupportedReason{
+		HostCapabilityVmDirectPathGen2UnsupportedReasonHostNptIncompatibleProduct,
+		HostCapabilityVmDirectPathGen2UnsupportedReasonHostNptIncompatibleHardware,
+		HostCapabilityVmDirectPathGen2UnsupportedReasonHostNptDisabled,
+	}
+}
+
 func init() {
 	t["HostCapabilityVmDirectPathGen2UnsupportedReason"] = reflect.TypeOf((*HostCapabilityVmDirectPathGen2UnsupportedReason)(nil)).Elem()
 }
@@ -1294,6 +2265,18 @@ const (
 	HostCertificateManagerCertificateInfoCertificateStatusGood               = HostCertificateManagerCertificateInfoCertificateStatus("good")
 )
 
+// Values returns all known values of the HostCertificateManagerCertificateInfoCertificateStatus enum
+func (e HostCertificateManagerCertificateInfoCertificateStatus) Values() []HostCertificateManagerCertificateInfoCertificateStatus {
+	return []HostCertificateManagerCertificateInfoCertificateStatus{
+		HostCertificateManagerCertificateInfoCertificateStatusUnknown,
+		HostCertificateManagerCertificateInfoCertificateStatusExpired,
+		HostCertificateManagerCertificateInfoCertificateStatusExpiring,
+		HostCertificateManagerCertificateInfoCertificateStatusExpiringShortly,
+		HostCertificateManagerCertificateInfoCertificateStatusExpirationImminent,
+		HostCertificateManagerCertificateInfoCertificateStatusGood,
+	}
+}
+
 func init() {
 	t["HostCertificateManagerCertificateInfoCertificateStatus"] = reflect.TypeOf((*HostCertificateManagerCertificateInfoCertificateStatus)(nil)).Elem()
 }
@@ -1305,6 +2288,14 @@ const (
 	HostConfigChangeModeReplace = HostConfigChangeMode("replace")
 )
 
+// Values returns all known values of the HostConfigChangeMode enum
+func (e HostConfigChangeMode) Values() []HostConfigChangeMode {
+	return []HostConfigChangeMode{
+		HostConfigChangeModeModify,
+		HostConfigChangeModeReplace,
+	}
+}
+
 func init() {
 	t["HostConfigChangeMode"] = reflect.TypeOf((*HostConfigChangeMode)(nil)).Elem()
 }
@@ -1318,6 +2309,16 @@ const (
 	HostConfigChangeOperationIgnore = HostConfigChangeOperation("ignore")
 )
 
+// Values returns all known values of the HostConfigChangeOperation enum
+func (e HostConfigChangeOperation) Values() []HostConfigChangeOperation {
+	return []HostConfigChangeOperation{
+		HostConfigChangeOperationAdd,
+		HostConfigChangeOperationRemove,
+		HostConfigChangeOperationEdit,
+		HostConfigChangeOperationIgnore,
+	}
+}
+
 func init() {
 	t["HostConfigChangeOperation"] = reflect.TypeOf((*HostConfigChangeOperation)(nil)).Elem()
 }
@@ -1331,6 +2332,16 @@ const (
 	HostCpuPackageVendorHygon   = HostCpuPackageVendor("hygon")
 )
 
+// Values returns all known values of the HostCpuPackageVendor enum
+func (e HostCpuPackageVendor) Values() []HostCpuPackageVendor {
+	return []HostCpuPackageVendor{
+		HostCpuPackageVendorUnknown,
+		HostCpuPackageVendorIntel,
+		HostCpuPackageVendorAmd,
+		HostCpuPackageVendorHygon,
+	}
+}
+
 func init() {
 	t["HostCpuPackageVendor"] = reflect.TypeOf((*HostCpuPackageVendor)(nil)).Elem()
 }
@@ -1343,6 +2354,15 @@ const (
 	HostCpuPowerManagementInfoPolicyTypeDynamicPolicy = HostCpuPowerManagementInfoPolicyType("dynamicPolicy")
 )
 
+// Values returns all known values of the HostCpuPowerManagementInfoPolicyType enum
+func (e HostCpuPowerManagementInfoPolicyType) Values() []HostCpuPowerManagementInfoPolicyType {
+	return []HostCpuPowerManagementInfoPolicyType{
+		HostCpuPowerManagementInfoPolicyTypeOff,
+		HostCpuPowerManagementInfoPolicyTypeStaticPolicy,
+		HostCpuPowerManagementInfoPolicyTypeDynamicPolicy,
+	}
+}
+
 func init() {
 	t["HostCpuPowerManagementInfoPolicyType"] = reflect.TypeOf((*HostCpuPowerManagementInfoPolicyType)(nil)).Elem()
 }
@@ -1356,6 +2376,16 @@ const (
 	HostCryptoStatePendingIncapable = HostCryptoState("pendingIncapable")
 )
 
+// Values returns all known values of the HostCryptoState enum
+func (e HostCryptoState) Values() []HostCryptoState {
+	return []HostCryptoState{
+		HostCryptoStateIncapable,
+		HostCryptoStatePrepared,
+		HostCryptoStateSafe,
+		HostCryptoStatePendingIncapable,
+	}
+}
+
 func init() {
 	t["HostCryptoState"] = reflect.TypeOf((*HostCryptoState)(nil)).Elem()
 }
@@ -1373,6 +2403,20 @@ const (
 	HostDasErrorEventHostDasErrorReasonOther                      = HostDasErrorEventHostDasErrorReason("other")
 )
 
+// Values returns all known values of the HostDasErrorEventHostDasErrorReason enum
+func (e HostDasErrorEventHostDasErrorReason) Values() []HostDasErrorEventHostDasErrorReason {
+	return []HostDasErrorEventHostDasErrorReason{
+		HostDasErrorEventHostDasErrorReasonConfigFailed,
+		HostDasErrorEventHostDasErrorReasonTimeout,
+		HostDasErrorEventHostDasErrorReasonCommunicationInitFailed,
+		HostDasErrorEventHostDasErrorReasonHealthCheckScriptFailed,
+		HostDasErrorEventHostDasErrorReasonAgentFailed,
+		HostDasErrorEventHostDasErrorReasonAgentShutdown,
+		HostDasErrorEventHostDasErrorReasonIsolationAddressUnpingable,
+		HostDasErrorEventHostDasErrorReasonOther,
+	}
+}
+
 func init() {
 	t["HostDasErrorEventHostDasErrorReason"] = reflect.TypeOf((*HostDasErrorEventHostDasErrorReason)(nil)).Elem()
 }
@@ -1384,6 +2428,14 @@ const (
 	HostDateTimeInfoProtocolPtp = HostDateTimeInfoProtocol("ptp")
 )
 
+// Values returns all known values of the HostDateTimeInfoProtocol enum
+func (e HostDateTimeInfoProtocol) Values() []HostDateTimeInfoProtocol {
+	return []HostDateTimeInfoProtocol{
+		HostDateTimeInfoProtocolNtp,
+		HostDateTimeInfoProtocolPtp,
+	}
+}
+
 func init() {
 	t["HostDateTimeInfoProtocol"] = reflect.TypeOf((*HostDateTimeInfoProtocol)(nil)).Elem()
 }
@@ -1399,6 +2451,18 @@ const (
 	HostDigestInfoDigestMethodTypeSM3_256 = HostDigestInfoDigestMethodType("SM3_256")
 )
 
+// Values returns all known values of the HostDigestInfoDigestMethodType enum
+func (e HostDigestInfoDigestMethodType) Values() []HostDigestInfoDigestMethodType {
+	return []HostDigestInfoDigestMethodType{
+		HostDigestInfoDigestMethodTypeSHA1,
+		HostDigestInfoDigestMethodTypeMD5,
+		HostDigestInfoDigestMethodTypeSHA256,
+		HostDigestInfoDigestMethodTypeSHA384,
+		HostDigestInfoDigestMethodTypeSHA512,
+		HostDigestInfoDigestMethodTypeSM3_256,
+	}
+}
+
 func init() {
 	t["HostDigestInfoDigestMethodType"] = reflect.TypeOf((*HostDigestInfoDigestMethodType)(nil)).Elem()
 }
@@ -1412,6 +2476,16 @@ const (
 	HostDigestVerificationSettingHeaderAndData  = HostDigestVerificationSetting("headerAndData")
 )
 
+// Values returns all known values of the HostDigestVerificationSetting enum
+func (e HostDigestVerificationSetting) Values() []HostDigestVerificationSetting {
+	return []HostDigestVerificationSetting{
+		HostDigestVerificationSettingDigestDisabled,
+		HostDigestVerificationSettingHeaderOnly,
+		HostDigestVerificationSettingDataOnly,
+		HostDigestVerificationSettingHeaderAndData,
+	}
+}
+
 func init() {
 	t["HostDigestVerificationSetting"] = reflect.TypeOf((*HostDigestVerificationSetting)(nil)).Elem()
 }
@@ -1430,6 +2504,21 @@ const (
 	HostDisconnectedEventReasonCodeVcVRAMCapacityExceeded    = HostDisconnectedEventReasonCode("vcVRAMCapacityExceeded")
 )
 
+// Values returns all known values of the HostDisconnectedEventReasonCode enum
+func (e HostDisconnectedEventReasonCode) Values() []HostDisconnectedEventReasonCode {
+	return []HostDisconnectedEventReasonCode{
+		HostDisconnectedEventReasonCodeSslThumbprintVerifyFailed,
+		HostDisconnectedEventReasonCodeLicenseExpired,
+		HostDisconnectedEventReasonCodeAgentUpgrade,
+		HostDisconnectedEventReasonCodeUserRequest,
+		HostDisconnectedEventReasonCodeInsufficientLicenses,
+		HostDisconnectedEventReasonCodeAgentOutOfDate,
+		HostDisconnectedEventReasonCodePasswordDecryptFailure,
+		HostDisconnectedEventReasonCodeUnknown,
+		HostDisconnectedEventReasonCodeVcVRAMCapacityExceeded,
+	}
+}
+
 func init() {
 	t["HostDisconnectedEventReasonCode"] = reflect.TypeOf((*HostDisconnectedEventReasonCode)(nil)).Elem()
 }
@@ -1442,6 +2531,15 @@ const (
 	HostDiskPartitionInfoPartitionFormatUnknown = HostDiskPartitionInfoPartitionFormat("unknown")
 )
 
+// Values returns all known values of the HostDiskPartitionInfoPartitionFormat enum
+func (e HostDiskPartitionInfoPartitionFormat) Values() []HostDiskPartitionInfoPartitionFormat {
+	return []HostDiskPartitionInfoPartitionFormat{
+		HostDiskPartitionInfoPartitionFormatGpt,
+		HostDiskPartitionInfoPartitionFormatMbr,
+		HostDiskPartitionInfoPartitionFormatUnknown,
+	}
+}
+
 func init() {
 	t["HostDiskPartitionInfoPartitionFormat"] = reflect.TypeOf((*HostDiskPartitionInfoPartitionFormat)(nil)).Elem()
 }
@@ -1459,6 +2557,20 @@ const (
 	HostDiskPartitionInfoTypeVffs          = HostDiskPartitionInfoType("vffs")
 )
 
+// Values returns all known values of the HostDiskPartitionInfoType enum
+func (e HostDiskPartitionInfoType) Values() []HostDiskPartitionInfoType {
+	return []HostDiskPartitionInfoType{
+		HostDiskPartitionInfoTypeNone,
+		HostDiskPartitionInfoTypeVmfs,
+		HostDiskPartitionInfoTypeLinuxNative,
+		HostDiskPartitionInfoTypeLinuxSwap,
+		HostDiskPartitionInfoTypeExtended,
+		HostDiskPartitionInfoTypeNtfs,
+		HostDiskPartitionInfoTypeVmkDiagnostic,
+		HostDiskPartitionInfoTypeVffs,
+	}
+}
+
 func init() {
 	t["HostDiskPartitionInfoType"] = reflect.TypeOf((*HostDiskPartitionInfoType)(nil)).Elem()
 }
@@ -1469,6 +2581,13 @@ const (
 	HostFeatureVersionKeyFaultTolerance = HostFeatureVersionKey("faultTolerance")
 )
 
+// Values returns all known values of the HostFeatureVersionKey enum
+func (e HostFeatureVersionKey) Values() []HostFeatureVersionKey {
+	return []HostFeatureVersionKey{
+		HostFeatureVersionKeyFaultTolerance,
+	}
+}
+
 func init() {
 	t["HostFeatureVersionKey"] = reflect.TypeOf((*HostFeatureVersionKey)(nil)).Elem()
 }
@@ -1488,6 +2607,22 @@ const (
 	HostFileSystemVolumeFileSystemTypeOTHER = HostFileSystemVolumeFileSystemType("OTHER")
 )
 
+// Values returns all known values of the HostFileSystemVolumeFileSystemType enum
+func (e HostFileSystemVolumeFileSystemType) Values() []HostFileSystemVolumeFileSystemType {
+	return []HostFileSystemVolumeFileSystemType{
+		HostFileSystemVolumeFileSystemTypeVMFS,
+		HostFileSystemVolumeFileSystemTypeNFS,
+		HostFileSystemVolumeFileSystemTypeNFS41,
+		HostFileSystemVolumeFileSystemTypeCIFS,
+		HostFileSystemVolumeFileSystemTypeVsan,
+		HostFileSystemVolumeFileSystemTypeVFFS,
+		HostFileSystemVolumeFileSystemTypeVVOL,
+		HostFileSystemVolumeFileSystemTypePMEM,
+		HostFileSystemVolumeFileSystemTypeVsanD,
+		HostFileSystemVolumeFileSystemTypeOTHER,
+	}
+}
+
 func init() {
 	t["HostFileSystemVolumeFileSystemType"] = reflect.TypeOf((*HostFileSystemVolumeFileSystemType)(nil)).Elem()
 }
@@ -1499,6 +2634,14 @@ const (
 	HostFirewallRuleDirectionOutbound = HostFirewallRuleDirection("outbound")
 )
 
+// Values returns all known values of the HostFirewallRuleDirection enum
+func (e HostFirewallRuleDirection) Values() []HostFirewallRuleDirection {
+	return []HostFirewallRuleDirection{
+		HostFirewallRuleDirectionInbound,
+		HostFirewallRuleDirectionOutbound,
+	}
+}
+
 func init() {
 	t["HostFirewallRuleDirection"] = reflect.TypeOf((*HostFirewallRuleDirection)(nil)).Elem()
 }
@@ -1510,6 +2653,14 @@ const (
 	HostFirewallRulePortTypeDst = HostFirewallRulePortType("dst")
 )
 
+// Values returns all known values of the HostFirewallRulePortType enum
+func (e HostFirewallRulePortType) Values() []HostFirewallRulePortType {
+	return []HostFirewallRulePortType{
+		HostFirewallRulePortTypeSrc,
+		HostFirewallRulePortTypeDst,
+	}
+}
+
 func init() {
 	t["HostFirewallRulePortType"] = reflect.TypeOf((*HostFirewallRulePortType)(nil)).Elem()
 }
@@ -1521,6 +2672,14 @@ const (
 	HostFirewallRuleProtocolUdp = HostFirewallRuleProtocol("udp")
 )
 
+// Values returns all known values of the HostFirewallRuleProtocol enum
+func (e HostFirewallRuleProtocol) Values() []HostFirewallRuleProtocol {
+	return []HostFirewallRuleProtocol{
+		HostFirewallRuleProtocolTcp,
+		HostFirewallRuleProtocolUdp,
+	}
+}
+
 func init() {
 	t["HostFirewallRuleProtocol"] = reflect.TypeOf((*HostFirewallRuleProtocol)(nil)).Elem()
 }
@@ -1533,6 +2692,15 @@ const (
 	HostFruFruTypeProduct   = HostFruFruType("product")
 )
 
+// Values returns all known values of the HostFruFruType enum
+func (e HostFruFruType) Values() []HostFruFruType {
+	return []HostFruFruType{
+		HostFruFruTypeUndefined,
+		HostFruFruTypeBoard,
+		HostFruFruTypeProduct,
+	}
+}
+
 func init() {
 	t["HostFruFruType"] = reflect.TypeOf((*HostFruFruType)(nil)).Elem()
 }
@@ -1544,6 +2712,14 @@ const (
 	HostGraphicsConfigGraphicsTypeSharedDirect = HostGraphicsConfigGraphicsType("sharedDirect")
 )
 
+// Values returns all known values of the HostGraphicsConfigGraphicsType enum
+func (e HostGraphicsConfigGraphicsType) Values() []HostGraphicsConfigGraphicsType {
+	return []HostGraphicsConfigGraphicsType{
+		HostGraphicsConfigGraphicsTypeShared,
+		HostGraphicsConfigGraphicsTypeSharedDirect,
+	}
+}
+
 func init() {
 	t["HostGraphicsConfigGraphicsType"] = reflect.TypeOf((*HostGraphicsConfigGraphicsType)(nil)).Elem()
 }
@@ -1555,6 +2731,14 @@ const (
 	HostGraphicsConfigSharedPassthruAssignmentPolicyConsolidation = HostGraphicsConfigSharedPassthruAssignmentPolicy("consolidation")
 )
 
+// Values returns all known values of the HostGraphicsConfigSharedPassthruAssignmentPolicy enum
+func (e HostGraphicsConfigSharedPassthruAssignmentPolicy) Values() []HostGraphicsConfigSharedPassthruAssignmentPolicy {
+	return []HostGraphicsConfigSharedPassthruAssignmentPolicy{
+		HostGraphicsConfigSharedPassthruAssignmentPolicyPerformance,
+		HostGraphicsConfigSharedPassthruAssignmentPolicyConsolidation,
+	}
+}
+
 func init() {
 	t["HostGraphicsConfigSharedPassthruAssignmentPolicy"] = reflect.TypeOf((*HostGraphicsConfigSharedPassthruAssignmentPolicy)(nil)).Elem()
 }
@@ -1568,6 +2752,16 @@ const (
 	HostGraphicsInfoGraphicsTypeSharedDirect = HostGraphicsInfoGraphicsType("sharedDirect")
 )
 
+// Values returns all known values of the HostGraphicsInfoGraphicsType enum
+func (e HostGraphicsInfoGraphicsType) Values() []HostGraphicsInfoGraphicsType {
+	return []HostGraphicsInfoGraphicsType{
+		HostGraphicsInfoGraphicsTypeBasic,
+		HostGraphicsInfoGraphicsTypeShared,
+		HostGraphicsInfoGraphicsTypeDirect,
+		HostGraphicsInfoGraphicsTypeSharedDirect,
+	}
+}
+
 func init() {
 	t["HostGraphicsInfoGraphicsType"] = reflect.TypeOf((*HostGraphicsInfoGraphicsType)(nil)).Elem()
 }
@@ -1581,6 +2775,16 @@ const (
 	HostHardwareElementStatusRed     = HostHardwareElementStatus("Red")
 )
 
+// Values returns all known values of the HostHardwareElementStatus enum
+func (e HostHardwareElementStatus) Values() []HostHardwareElementStatus {
+	return []HostHardwareElementStatus{
+		HostHardwareElementStatusUnknown,
+		HostHardwareElementStatusGreen,
+		HostHardwareElementStatusYellow,
+		HostHardwareElementStatusRed,
+	}
+}
+
 func init() {
 	t["HostHardwareElementStatus"] = reflect.TypeOf((*HostHardwareElementStatus)(nil)).Elem()
 }
@@ -1591,6 +2795,13 @@ const (
 	HostHasComponentFailureHostComponentTypeDatastore = HostHasComponentFailureHostComponentType("Datastore")
 )
 
+// Values returns all known values of the HostHasComponentFailureHostComponentType enum
+func (e HostHasComponentFailureHostComponentType) Values() []HostHasComponentFailureHostComponentType {
+	return []HostHasComponentFailureHostComponentType{
+		HostHasComponentFailureHostComponentTypeDatastore,
+	}
+}
+
 func init() {
 	t["HostHasComponentFailureHostComponentType"] = reflect.TypeOf((*HostHasComponentFailureHostComponentType)(nil)).Elem()
 }
@@ -1604,6 +2815,16 @@ const (
 	HostImageAcceptanceLevelCommunity        = HostImageAcceptanceLevel("community")
 )
 
+// Values returns all known values of the HostImageAcceptanceLevel enum
+func (e HostImageAcceptanceLevel) Values() []HostImageAcceptanceLevel {
+	return []HostImageAcceptanceLevel{
+		HostImageAcceptanceLevelVmware_certified,
+		HostImageAcceptanceLevelVmware_accepted,
+		HostImageAcceptanceLevelPartner,
+		HostImageAcceptanceLevelCommunity,
+	}
+}
+
 func init() {
 	t["HostImageAcceptanceLevel"] = reflect.TypeOf((*HostImageAcceptanceLevel)(nil)).Elem()
 }
@@ -1615,6 +2836,14 @@ const (
 	HostIncompatibleForFaultToleranceReasonProcessor = HostIncompatibleForFaultToleranceReason("processor")
 )
 
+// Values returns all known values of the HostIncompatibleForFaultToleranceReason enum
+func (e HostIncompatibleForFaultToleranceReason) Values() []HostIncompatibleForFaultToleranceReason {
+	return []HostIncompatibleForFaultToleranceReason{
+		HostIncompatibleForFaultToleranceReasonProduct,
+		HostIncompatibleForFaultToleranceReasonProcessor,
+	}
+}
+
 func init() {
 	t["HostIncompatibleForFaultToleranceReason"] = reflect.TypeOf((*HostIncompatibleForFaultToleranceReason)(nil)).Elem()
 }
@@ -1626,6 +2855,14 @@ const (
 	HostIncompatibleForRecordReplayReasonProcessor = HostIncompatibleForRecordReplayReason("processor")
 )
 
+// Values returns all known values of the HostIncompatibleForRecordReplayReason enum
+func (e HostIncompatibleForRecordReplayReason) Values() []HostIncompatibleForRecordReplayReason {
+	return []HostIncompatibleForRecordReplayReason{
+		HostIncompatibleForRecordReplayReasonProduct,
+		HostIncompatibleForRecordReplayReasonProcessor,
+	}
+}
+
 func init() {
 	t["HostIncompatibleForRecordReplayReason"] = reflect.TypeOf((*HostIncompatibleForRecordReplayReason)(nil)).Elem()
 }
@@ -1639,6 +2876,16 @@ const (
 	HostInternetScsiHbaChapAuthenticationTypeChapRequired    = HostInternetScsiHbaChapAuthenticationType("chapRequired")
 )
 
+// Values returns all known values of the HostInternetScsiHbaChapAuthenticationType enum
+func (e HostInternetScsiHbaChapAuthenticationType) Values() []HostInternetScsiHbaChapAuthenticationType {
+	return []HostInternetScsiHbaChapAuthenticationType{
+		HostInternetScsiHbaChapAuthenticationTypeChapProhibited,
+		HostInternetScsiHbaChapAuthenticationTypeChapDiscouraged,
+		HostInternetScsiHbaChapAuthenticationTypeChapPreferred,
+		HostInternetScsiHbaChapAuthenticationTypeChapRequired,
+	}
+}
+
 func init() {
 	t["HostInternetScsiHbaChapAuthenticationType"] = reflect.TypeOf((*HostInternetScsiHbaChapAuthenticationType)(nil)).Elem()
 }
@@ -1652,6 +2899,16 @@ const (
 	HostInternetScsiHbaDigestTypeDigestRequired    = HostInternetScsiHbaDigestType("digestRequired")
 )
 
+// Values returns all known values of the HostInternetScsiHbaDigestType enum
+func (e HostInternetScsiHbaDigestType) Values() []HostInternetScsiHbaDigestType {
+	return []HostInternetScsiHbaDigestType{
+		HostInternetScsiHbaDigestTypeDigestProhibited,
+		HostInternetScsiHbaDigestTypeDigestDiscouraged,
+		HostInternetScsiHbaDigestTypeDigestPreferred,
+		HostInternetScsiHbaDigestTypeDigestRequired,
+	}
+}
+
 func init() {
 	t["HostInternetScsiHbaDigestType"] = reflect.TypeOf((*HostInternetScsiHbaDigestType)(nil)).Elem()
 }
@@ -1665,6 +2922,16 @@ const (
 	HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeOther          = HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType("Other")
 )
 
+// Values returns all known values of the HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType enum
+func (e HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType) Values() []HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType {
+	return []HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType{
+		HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeDHCP,
+		HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeAutoConfigured,
+		HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeStatic,
+		HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeOther,
+	}
+}
+
 func init() {
 	t["HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType"] = reflect.TypeOf((*HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType)(nil)).Elem()
 }
@@ -1676,6 +2943,14 @@ const (
 	HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperationRemove = HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation("remove")
 )
 
+// Values returns all known values of the HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation enum
+func (e HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation) Values() []HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation {
+	return []HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation{
+		HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperationAdd,
+		HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperationRemove,
+	}
+}
+
 func init() {
 	t["HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation"] = reflect.TypeOf((*HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation)(nil)).Elem()
 }
@@ -1688,6 +2963,15 @@ const (
 	HostInternetScsiHbaNetworkBindingSupportTypeRequired     = HostInternetScsiHbaNetworkBindingSupportType("required")
 )
 
+// Values returns all known values of the HostInternetScsiHbaNetworkBindingSupportType enum
+func (e HostInternetScsiHbaNetworkBindingSupportType) Values() []HostInternetScsiHbaNetworkBindingSupportType {
+	return []HostInternetScsiHbaNetworkBindingSupportType{
+		HostInternetScsiHbaNetworkBindingSupportTypeNotsupported,
+		HostInternetScsiHbaNetworkBindingSupportTypeOptional,
+		HostInternetScsiHbaNetworkBindingSupportTypeRequired,
+	}
+}
+
 func init() {
 	t["HostInternetScsiHbaNetworkBindingSupportType"] = reflect.TypeOf((*HostInternetScsiHbaNetworkBindingSupportType)(nil)).Elem()
 }
@@ -1702,6 +2986,17 @@ const (
 	HostInternetScsiHbaStaticTargetTargetDiscoveryMethodUnknownMethod    = HostInternetScsiHbaStaticTargetTargetDiscoveryMethod("unknownMethod")
 )
 
+// Values returns all known values of the HostInternetScsiHbaStaticTargetTargetDiscoveryMethod enum
+func (e HostInternetScsiHbaStaticTargetTargetDiscoveryMethod) Values() []HostInternetScsiHbaStaticTargetTargetDiscoveryMethod {
+	return []HostInternetScsiHbaStaticTargetTargetDiscoveryMethod{
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodStaticMethod,
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodSendTargetMethod,
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodSlpMethod,
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodIsnsMethod,
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodUnknownMethod,
+	}
+}
+
 func init() {
 	t["HostInternetScsiHbaStaticTargetTargetDiscoveryMethod"] = reflect.TypeOf((*HostInternetScsiHbaStaticTargetTargetDiscoveryMethod)(nil)).Elem()
 }
@@ -1716,6 +3011,17 @@ const (
 	HostIpConfigIpV6AddressConfigTypeRandom    = HostIpConfigIpV6AddressConfigType("random")
 )
 
+// Values returns all known values of the HostIpConfigIpV6AddressConfigType enum
+func (e HostIpConfigIpV6AddressConfigType) Values() []HostIpConfigIpV6AddressConfigType {
+	return []HostIpConfigIpV6AddressConfigType{
+		HostIpConfigIpV6AddressConfigTypeOther,
+		HostIpConfigIpV6AddressConfigTypeManual,
+		HostIpConfigIpV6AddressConfigTypeDhcp,
+		HostIpConfigIpV6AddressConfigTypeLinklayer,
+		HostIpConfigIpV6AddressConfigTypeRandom,
+	}
+}
+
 func init() {
 	t["HostIpConfigIpV6AddressConfigType"] = reflect.TypeOf((*HostIpConfigIpV6AddressConfigType)(nil)).Elem()
 }
@@ -1732,6 +3038,19 @@ const (
 	HostIpConfigIpV6AddressStatusDuplicate    = HostIpConfigIpV6AddressStatus("duplicate")
 )
 
+// Values returns all known values of the HostIpConfigIpV6AddressStatus enum
+func (e HostIpConfigIpV6AddressStatus) Values() []HostIpConfigIpV6AddressStatus {
+	return []HostIpConfigIpV6AddressStatus{
+		HostIpConfigIpV6AddressStatusPreferred,
+		HostIpConfigIpV6AddressStatusDeprecated,
+		HostIpConfigIpV6AddressStatusInvalid,
+		HostIpConfigIpV6AddressStatusInaccessible,
+		HostIpConfigIpV6AddressStatusUnknown,
+		HostIpConfigIpV6AddressStatusTentative,
+		HostIpConfigIpV6AddressStatusDuplicate,
+	}
+}
+
 func init() {
 	t["HostIpConfigIpV6AddressStatus"] = reflect.TypeOf((*HostIpConfigIpV6AddressStatus)(nil)).Elem()
 }
@@ -1747,6 +3066,18 @@ const (
 	HostLicensableResourceKeyNumVmsStarting = HostLicensableResourceKey("numVmsStarting")
 )
 
+// Values returns all known values of the HostLicensableResourceKey enum
+func (e HostLicensableResourceKey) Values() []HostLicensableResourceKey {
+	return []HostLicensableResourceKey{
+		HostLicensableResourceKeyNumCpuPackages,
+		HostLicensableResourceKeyNumCpuCores,
+		HostLicensableResourceKeyMemorySize,
+		HostLicensableResourceKeyMemoryForVms,
+		HostLicensableResourceKeyNumVmsStarted,
+		HostLicensableResourceKeyNumVmsStarting,
+	}
+}
+
 func init() {
 	t["HostLicensableResourceKey"] = reflect.TypeOf((*HostLicensableResourceKey)(nil)).Elem()
 }
@@ -1759,6 +3090,15 @@ const (
 	HostLockdownModeLockdownStrict   = HostLockdownMode("lockdownStrict")
 )
 
+// Values returns all known values of the HostLockdownMode enum
+func (e HostLockdownMode) Values() []HostLockdownMode {
+	return []HostLockdownMode{
+		HostLockdownModeLockdownDisabled,
+		HostLockdownModeLockdownNormal,
+		HostLockdownModeLockdownStrict,
+	}
+}
+
 func init() {
 	t["HostLockdownMode"] = reflect.TypeOf((*HostLockdownMode)(nil)).Elem()
 }
@@ -1771,6 +3111,15 @@ const (
 	HostLowLevelProvisioningManagerFileTypeDirectory   = HostLowLevelProvisioningManagerFileType("Directory")
 )
 
+// Values returns all known values of the HostLowLevelProvisioningManagerFileType enum
+func (e HostLowLevelProvisioningManagerFileType) Values() []HostLowLevelProvisioningManagerFileType {
+	return []HostLowLevelProvisioningManagerFileType{
+		HostLowLevelProvisioningManagerFileTypeFile,
+		HostLowLevelProvisioningManagerFileTypeVirtualDisk,
+		HostLowLevelProvisioningManagerFileTypeDirectory,
+	}
+}
+
 func init() {
 	t["HostLowLevelProvisioningManagerFileType"] = reflect.TypeOf((*HostLowLevelProvisioningManagerFileType)(nil)).Elem()
 }
@@ -1782,6 +3131,14 @@ const (
 	HostLowLevelProvisioningManagerReloadTargetSnapshotConfig = HostLowLevelProvisioningManagerReloadTarget("snapshotConfig")
 )
 
+// Values returns all known values of the HostLowLevelProvisioningManagerReloadTarget enum
+func (e HostLowLevelProvisioningManagerReloadTarget) Values() []HostLowLevelProvisioningManagerReloadTarget {
+	return []HostLowLevelProvisioningManagerReloadTarget{
+		HostLowLevelProvisioningManagerReloadTargetCurrentConfig,
+		HostLowLevelProvisioningManagerReloadTargetSnapshotConfig,
+	}
+}
+
 func init() {
 	t["HostLowLevelProvisioningManagerReloadTarget"] = reflect.TypeOf((*HostLowLevelProvisioningManagerReloadTarget)(nil)).Elem()
 }
@@ -1792,6 +3149,13 @@ const (
 	HostMaintenanceSpecPurposeHostUpgrade = HostMaintenanceSpecPurpose("hostUpgrade")
 )
 
+// Values returns all known values of the HostMaintenanceSpecPurpose enum
+func (e HostMaintenanceSpecPurpose) Values() []HostMaintenanceSpecPurpose {
+	return []HostMaintenanceSpecPurpose{
+		HostMaintenanceSpecPurposeHostUpgrade,
+	}
+}
+
 func init() {
 	t["HostMaintenanceSpecPurpose"] = reflect.TypeOf((*HostMaintenanceSpecPurpose)(nil)).Elem()
 }
@@ -1804,6 +3168,15 @@ const (
 	HostMemoryTierFlagsCachingTier    = HostMemoryTierFlags("cachingTier")
 )
 
+// Values returns all known values of the HostMemoryTierFlags enum
+func (e HostMemoryTierFlags) Values() []HostMemoryTierFlags {
+	return []HostMemoryTierFlags{
+		HostMemoryTierFlagsMemoryTier,
+		HostMemoryTierFlagsPersistentTier,
+		HostMemoryTierFlagsCachingTier,
+	}
+}
+
 func init() {
 	t["HostMemoryTierFlags"] = reflect.TypeOf((*HostMemoryTierFlags)(nil)).Elem()
 }
@@ -1815,6 +3188,14 @@ const (
 	HostMemoryTierTypePMem = HostMemoryTierType("PMem")
 )
 
+// Values returns all known values of the HostMemoryTierType enum
+func (e HostMemoryTierType) Values() []HostMemoryTierType {
+	return []HostMemoryTierType{
+		HostMemoryTierTypeDRAM,
+		HostMemoryTierTypePMem,
+	}
+}
+
 func init() {
 	t["HostMemoryTierType"] = reflect.TypeOf((*HostMemoryTierType)(nil)).Elem()
 }
@@ -1826,6 +3207,14 @@ const (
 	HostMemoryTieringTypeHardwareTiering = HostMemoryTieringType("hardwareTiering")
 )
 
+// Values returns all known values of the HostMemoryTieringType enum
+func (e HostMemoryTieringType) Values() []HostMemoryTieringType {
+	return []HostMemoryTieringType{
+		HostMemoryTieringTypeNoTiering,
+		HostMemoryTieringTypeHardwareTiering,
+	}
+}
+
 func init() {
 	t["HostMemoryTieringType"] = reflect.TypeOf((*HostMemoryTieringType)(nil)).Elem()
 }
@@ -1838,6 +3227,15 @@ const (
 	HostMountInfoInaccessibleReasonPermanentDeviceLoss  = HostMountInfoInaccessibleReason("PermanentDeviceLoss")
 )
 
+// Values returns all known values of the HostMountInfoInaccessibleReason enum
+func (e HostMountInfoInaccessibleReason) Values() []HostMountInfoInaccessibleReason {
+	return []HostMountInfoInaccessibleReason{
+		HostMountInfoInaccessibleReasonAllPathsDown_Start,
+		HostMountInfoInaccessibleReasonAllPathsDown_Timeout,
+		HostMountInfoInaccessibleReasonPermanentDeviceLoss,
+	}
+}
+
 func init() {
 	t["HostMountInfoInaccessibleReason"] = reflect.TypeOf((*HostMountInfoInaccessibleReason)(nil)).Elem()
 }
@@ -1849,6 +3247,14 @@ const (
 	HostMountModeReadOnly  = HostMountMode("readOnly")
 )
 
+// Values returns all known values of the HostMountMode enum
+func (e HostMountMode) Values() []HostMountMode {
+	return []HostMountMode{
+		HostMountModeReadWrite,
+		HostMountModeReadOnly,
+	}
+}
+
 func init() {
 	t["HostMountMode"] = reflect.TypeOf((*HostMountMode)(nil)).Elem()
 }
@@ -1861,6 +3267,15 @@ const (
 	HostNasVolumeSecurityTypeSEC_KRB5I = HostNasVolumeSecurityType("SEC_KRB5I")
 )
 
+// Values returns all known values of the HostNasVolumeSecurityType enum
+func (e HostNasVolumeSecurityType) Values() []HostNasVolumeSecurityType {
+	return []HostNasVolumeSecurityType{
+		HostNasVolumeSecurityTypeAUTH_SYS,
+		HostNasVolumeSecurityTypeSEC_KRB5,
+		HostNasVolumeSecurityTypeSEC_KRB5I,
+	}
+}
+
 func init() {
 	t["HostNasVolumeSecurityType"] = reflect.TypeOf((*HostNasVolumeSecurityType)(nil)).Elem()
 }
@@ -1872,6 +3287,14 @@ const (
 	HostNetStackInstanceCongestionControlAlgorithmTypeCubic   = HostNetStackInstanceCongestionControlAlgorithmType("cubic")
 )
 
+// Values returns all known values of the HostNetStackInstanceCongestionControlAlgorithmType enum
+func (e HostNetStackInstanceCongestionControlAlgorithmType) Values() []HostNetStackInstanceCongestionControlAlgorithmType {
+	return []HostNetStackInstanceCongestionControlAlgorithmType{
+		HostNetStackInstanceCongestionControlAlgorithmTypeNewreno,
+		HostNetStackInstanceCongestionControlAlgorithmTypeCubic,
+	}
+}
+
 func init() {
 	t["HostNetStackInstanceCongestionControlAlgorithmType"] = reflect.TypeOf((*HostNetStackInstanceCongestionControlAlgorithmType)(nil)).Elem()
 }
@@ -1884,6 +3307,15 @@ const (
 	HostNetStackInstanceSystemStackKeyVSphereProvisioning = HostNetStackInstanceSystemStackKey("vSphereProvisioning")
 )
 
+// Values returns all known values of the HostNetStackInstanceSystemStackKey enum
+func (e HostNetStackInstanceSystemStackKey) Values() []HostNetStackInstanceSystemStackKey {
+	return []HostNetStackInstanceSystemStackKey{
+		HostNetStackInstanceSystemStackKeyDefaultTcpipStack,
+		HostNetStackInstanceSystemStackKeyVmotion,
+		HostNetStackInstanceSystemStackKeyVSphereProvisioning,
+	}
+}
+
 func init() {
 	t["HostNetStackInstanceSystemStackKey"] = reflect.TypeOf((*HostNetStackInstanceSystemStackKey)(nil)).Elem()
 }
@@ -1897,6 +3329,16 @@ const (
 	HostNumericSensorHealthStateRed     = HostNumericSensorHealthState("red")
 )
 
+// Values returns all known values of the HostNumericSensorHealthState enum
+func (e HostNumericSensorHealthState) Values() []HostNumericSensorHealthState {
+	return []HostNumericSensorHealthState{
+		HostNumericSensorHealthStateUnknown,
+		HostNumericSensorHealthStateGreen,
+		HostNumericSensorHealthStateYellow,
+		HostNumericSensorHealthStateRed,
+	}
+}
+
 func init() {
 	t["HostNumericSensorHealthState"] = reflect.TypeOf((*HostNumericSensorHealthState)(nil)).Elem()
 }
@@ -1919,6 +3361,25 @@ const (
 	HostNumericSensorTypeWatchdog    = HostNumericSensorType("watchdog")
 )
 
+// Values returns all known values of the HostNumericSensorType enum
+func (e HostNumericSensorType) Values() []HostNumericSensorType {
+	return []HostNumericSensorType{
+		HostNumericSensorTypeFan,
+		HostNumericSensorTypePower,
+		HostNumericSensorTypeTemperature,
+		HostNumericSensorTypeVoltage,
+		HostNumericSensorTypeOther,
+		HostNumericSensorTypeProcessor,
+		HostNumericSensorTypeMemory,
+		HostNumericSensorTypeStorage,
+		HostNumericSensorTypeSystemBoard,
+		HostNumericSensorTypeBattery,
+		HostNumericSensorTypeBios,
+		HostNumericSensorTypeCable,
+		HostNumericSensorTypeWatchdog,
+	}
+}
+
 func init() {
 	t["HostNumericSensorType"] = reflect.TypeOf((*HostNumericSensorType)(nil)).Elem()
 }
@@ -1930,6 +3391,14 @@ const (
 	HostNvmeDiscoveryLogSubsystemTypeNvm       = HostNvmeDiscoveryLogSubsystemType("nvm")
 )
 
+// Values returns all known values of the HostNvmeDiscoveryLogSubsystemType enum
+func (e HostNvmeDiscoveryLogSubsystemType) Values() []HostNvmeDiscoveryLogSubsystemType {
+	return []HostNvmeDiscoveryLogSubsystemType{
+		HostNvmeDiscoveryLogSubsystemTypeDiscovery,
+		HostNvmeDiscoveryLogSubsystemTypeNvm,
+	}
+}
+
 func init() {
 	t["HostNvmeDiscoveryLogSubsystemType"] = reflect.TypeOf((*HostNvmeDiscoveryLogSubsystemType)(nil)).Elem()
 }
@@ -1942,6 +3411,15 @@ const (
 	HostNvmeDiscoveryLogTransportRequirementsRequirementsNotSpecified = HostNvmeDiscoveryLogTransportRequirements("requirementsNotSpecified")
 )
 
+// Values returns all known values of the HostNvmeDiscoveryLogTransportRequirements enum
+func (e HostNvmeDiscoveryLogTransportRequirements) Values() []HostNvmeDiscoveryLogTransportRequirements {
+	return []HostNvmeDiscoveryLogTransportRequirements{
+		HostNvmeDiscoveryLogTransportRequirementsSecureChannelRequired,
+		HostNvmeDiscoveryLogTransportRequirementsSecureChannelNotRequired,
+		HostNvmeDiscoveryLogTransportRequirementsRequirementsNotSpecified,
+	}
+}
+
 func init() {
 	t["HostNvmeDiscoveryLogTransportRequirements"] = reflect.TypeOf((*HostNvmeDiscoveryLogTransportRequirements)(nil)).Elem()
 }
@@ -1957,6 +3435,18 @@ const (
 	HostNvmeTransportParametersNvmeAddressFamilyUnknown    = HostNvmeTransportParametersNvmeAddressFamily("unknown")
 )
 
+// Values returns all known values of the HostNvmeTransportParametersNvmeAddressFamily enum
+func (e HostNvmeTransportParametersNvmeAddressFamily) Values() []HostNvmeTransportParametersNvmeAddressFamily {
+	return []HostNvmeTransportParametersNvmeAddressFamily{
+		HostNvmeTransportParametersNvmeAddressFamilyIpv4,
+		HostNvmeTransportParametersNvmeAddressFamilyIpv6,
+		HostNvmeTransportParametersNvmeAddressFamilyInfiniBand,
+		HostNvmeTransportParametersNvmeAddressFamilyFc,
+		HostNvmeTransportParametersNvmeAddressFamilyLoopback,
+		HostNvmeTransportParametersNvmeAddressFamilyUnknown,
+	}
+}
+
 func init() {
 	t["HostNvmeTransportParametersNvmeAddressFamily"] = reflect.TypeOf((*HostNvmeTransportParametersNvmeAddressFamily)(nil)).Elem()
 }
@@ -1972,6 +3462,18 @@ const (
 	HostNvmeTransportTypeUnsupported  = HostNvmeTransportType("unsupported")
 )
 
+// Values returns all known values of the HostNvmeTransportType enum
+func (e HostNvmeTransportType) Values() []HostNvmeTransportType {
+	return []HostNvmeTransportType{
+		HostNvmeTransportTypePcie,
+		HostNvmeTransportTypeFibreChannel,
+		HostNvmeTransportTypeRdma,
+		HostNvmeTransportTypeTcp,
+		HostNvmeTransportTypeLoopback,
+		HostNvmeTransportTypeUnsupported,
+	}
+}
+
 func init() {
 	t["HostNvmeTransportType"] = reflect.TypeOf((*HostNvmeTransportType)(nil)).Elem()
 }
@@ -1985,6 +3487,16 @@ const (
 	HostOpaqueSwitchOpaqueSwitchStateMaintenance = HostOpaqueSwitchOpaqueSwitchState("maintenance")
 )
 
+// Values returns all known values of the HostOpaqueSwitchOpaqueSwitchState enum
+func (e HostOpaqueSwitchOpaqueSwitchState) Values() []HostOpaqueSwitchOpaqueSwitchState {
+	return []HostOpaqueSwitchOpaqueSwitchState{
+		HostOpaqueSwitchOpaqueSwitchStateUp,
+		HostOpaqueSwitchOpaqueSwitchStateWarning,
+		HostOpaqueSwitchOpaqueSwitchStateDown,
+		HostOpaqueSwitchOpaqueSwitchStateMaintenance,
+	}
+}
+
 func init() {
 	t["HostOpaqueSwitchOpaqueSwitchState"] = reflect.TypeOf((*HostOpaqueSwitchOpaqueSwitchState)(nil)).Elem()
 }
@@ -1996,6 +3508,14 @@ const (
 	HostPatchManagerInstallStateImageActive   = HostPatchManagerInstallState("imageActive")
 )
 
+// Values returns all known values of the HostPatchManagerInstallState enum
+func (e HostPatchManagerInstallState) Values() []HostPatchManagerInstallState {
+	return []HostPatchManagerInstallState{
+		HostPatchManagerInstallStateHostRestarted,
+		HostPatchManagerInstallStateImageActive,
+	}
+}
+
 func init() {
 	t["HostPatchManagerInstallState"] = reflect.TypeOf((*HostPatchManagerInstallState)(nil)).Elem()
 }
@@ -2012,6 +3532,19 @@ const (
 	HostPatchManagerIntegrityStatusValidationError     = HostPatchManagerIntegrityStatus("validationError")
 )
 
+// Values returns all known values of the HostPatchManagerIntegrityStatus enum
+func (e HostPatchManagerIntegrityStatus) Values() []HostPatchManagerIntegrityStatus {
+	return []HostPatchManagerIntegrityStatus{
+		HostPatchManagerIntegrityStatusValidated,
+		HostPatchManagerIntegrityStatusKeyNotFound,
+		HostPatchManagerIntegrityStatusKeyRevoked,
+		HostPatchManagerIntegrityStatusKeyExpired,
+		HostPatchManagerIntegrityStatusDigestMismatch,
+		HostPatchManagerIntegrityStatusNotEnoughSignatures,
+		HostPatchManagerIntegrityStatusValidationError,
+	}
+}
+
 func init() {
 	t["HostPatchManagerIntegrityStatus"] = reflect.TypeOf((*HostPatchManagerIntegrityStatus)(nil)).Elem()
 }
@@ -2027,6 +3560,18 @@ const (
 	HostPatchManagerReasonConflictLib       = HostPatchManagerReason("conflictLib")
 )
 
+// Values returns all known values of the HostPatchManagerReason enum
+func (e HostPatchManagerReason) Values() []HostPatchManagerReason {
+	return []HostPatchManagerReason{
+		HostPatchManagerReasonObsoleted,
+		HostPatchManagerReasonMissingPatch,
+		HostPatchManagerReasonMissingLib,
+		HostPatchManagerReasonHasDependentPatch,
+		HostPatchManagerReasonConflictPatch,
+		HostPatchManagerReasonConflictLib,
+	}
+}
+
 func init() {
 	t["HostPatchManagerReason"] = reflect.TypeOf((*HostPatchManagerReason)(nil)).Elem()
 }
@@ -2038,6 +3583,14 @@ const (
 	HostPowerOperationTypePowerOff = HostPowerOperationType("powerOff")
 )
 
+// Values returns all known values of the HostPowerOperationType enum
+func (e HostPowerOperationType) Values() []HostPowerOperationType {
+	return []HostPowerOperationType{
+		HostPowerOperationTypePowerOn,
+		HostPowerOperationTypePowerOff,
+	}
+}
+
 func init() {
 	t["HostPowerOperationType"] = reflect.TypeOf((*HostPowerOperationType)(nil)).Elem()
 }
@@ -2050,6 +3603,15 @@ const (
 	HostProfileManagerAnswerFileStatusUnknown = HostProfileManagerAnswerFileStatus("unknown")
 )
 
+// Values returns all known values of the HostProfileManagerAnswerFileStatus enum
+func (e HostProfileManagerAnswerFileStatus) Values() []HostProfileManagerAnswerFileStatus {
+	return []HostProfileManagerAnswerFileStatus{
+		HostProfileManagerAnswerFileStatusValid,
+		HostProfileManagerAnswerFileStatusInvalid,
+		HostProfileManagerAnswerFileStatusUnknown,
+	}
+}
+
 func init() {
 	t["HostProfileManagerAnswerFileStatus"] = reflect.TypeOf((*HostProfileManagerAnswerFileStatus)(nil)).Elem()
 }
@@ -2061,6 +3623,14 @@ const (
 	HostProfileManagerCompositionResultResultElementStatusError   = HostProfileManagerCompositionResultResultElementStatus("error")
 )
 
+// Values returns all known values of the HostProfileManagerCompositionResultResultElementStatus enum
+func (e HostProfileManagerCompositionResultResultElementStatus) Values() []HostProfileManagerCompositionResultResultElementStatus {
+	return []HostProfileManagerCompositionResultResultElementStatus{
+		HostProfileManagerCompositionResultResultElementStatusSuccess,
+		HostProfileManagerCompositionResultResultElementStatusError,
+	}
+}
+
 func init() {
 	t["HostProfileManagerCompositionResultResultElementStatus"] = reflect.TypeOf((*HostProfileManagerCompositionResultResultElementStatus)(nil)).Elem()
 }
@@ -2072,6 +3642,14 @@ const (
 	HostProfileManagerCompositionValidationResultResultElementStatusError   = HostProfileManagerCompositionValidationResultResultElementStatus("error")
 )
 
+// Values returns all known values of the HostProfileManagerCompositionValidationResultResultElementStatus enum
+func (e HostProfileManagerCompositionValidationResultResultElementStatus) Values() []HostProfileManagerCompositionValidationResultResultElementStatus {
+	return []HostProfileManagerCompositionValidationResultResultElementStatus{
+		HostProfileManagerCompositionValidationResultResultElementStatusSuccess,
+		HostProfileManagerCompositionValidationResultResultElementStatusError,
+	}
+}
+
 func init() {
 	t["HostProfileManagerCompositionValidationResultResultElementStatus"] = reflect.TypeOf((*HostProfileManagerCompositionValidationResultResultElementStatus)(nil)).Elem()
 }
@@ -2083,6 +3661,14 @@ const (
 	HostProfileManagerTaskListRequirementRebootRequired          = HostProfileManagerTaskListRequirement("rebootRequired")
 )
 
+// Values returns all known values of the HostProfileManagerTaskListRequirement enum
+func (e HostProfileManagerTaskListRequirement) Values() []HostProfileManagerTaskListRequirement {
+	return []HostProfileManagerTaskListRequirement{
+		HostProfileManagerTaskListRequirementMaintenanceModeRequired,
+		HostProfileManagerTaskListRequirementRebootRequired,
+	}
+}
+
 func init() {
 	t["HostProfileManagerTaskListRequirement"] = reflect.TypeOf((*HostProfileManagerTaskListRequirement)(nil)).Elem()
 }
@@ -2096,6 +3682,16 @@ const (
 	HostProfileValidationFailureInfoUpdateTypeCompose   = HostProfileValidationFailureInfoUpdateType("Compose")
 )
 
+// Values returns all known values of the HostProfileValidationFailureInfoUpdateType enum
+func (e HostProfileValidationFailureInfoUpdateType) Values() []HostProfileValidationFailureInfoUpdateType {
+	return []HostProfileValidationFailureInfoUpdateType{
+		HostProfileValidationFailureInfoUpdateTypeHostBased,
+		HostProfileValidationFailureInfoUpdateTypeImport,
+		HostProfileValidationFailureInfoUpdateTypeEdit,
+		HostProfileValidationFailureInfoUpdateTypeCompose,
+	}
+}
+
 func init() {
 	t["HostProfileValidationFailureInfoUpdateType"] = reflect.TypeOf((*HostProfileValidationFailureInfoUpdateType)(nil)).Elem()
 }
@@ -2108,6 +3704,15 @@ const (
 	HostProfileValidationStateFailed  = HostProfileValidationState("Failed")
 )
 
+// Values returns all known values of the HostProfileValidationState enum
+func (e HostProfileValidationState) Values() []HostProfileValidationState {
+	return []HostProfileValidationState{
+		HostProfileValidationStateReady,
+		HostProfileValidationStateRunning,
+		HostProfileValidationStateFailed,
+	}
+}
+
 func init() {
 	t["HostProfileValidationState"] = reflect.TypeOf((*HostProfileValidationState)(nil)).Elem()
 }
@@ -2119,6 +3724,14 @@ const (
 	HostProtocolEndpointPETypeNas   = HostProtocolEndpointPEType("nas")
 )
 
+// Values returns all known values of the HostProtocolEndpointPEType enum
+func (e HostProtocolEndpointPEType) Values() []HostProtocolEndpointPEType {
+	return []HostProtocolEndpointPEType{
+		HostProtocolEndpointPETypeBlock,
+		HostProtocolEndpointPETypeNas,
+	}
+}
+
 func init() {
 	t["HostProtocolEndpointPEType"] = reflect.TypeOf((*HostProtocolEndpointPEType)(nil)).Elem()
 }
@@ -2131,6 +3744,15 @@ const (
 	HostProtocolEndpointProtocolEndpointTypeNfs4x = HostProtocolEndpointProtocolEndpointType("nfs4x")
 )
 
+// Values returns all known values of the HostProtocolEndpointProtocolEndpointType enum
+func (e HostProtocolEndpointProtocolEndpointType) Values() []HostProtocolEndpointProtocolEndpointType {
+	return []HostProtocolEndpointProtocolEndpointType{
+		HostProtocolEndpointProtocolEndpointTypeScsi,
+		HostProtocolEndpointProtocolEndpointTypeNfs,
+		HostProtocolEndpointProtocolEndpointTypeNfs4x,
+	}
+}
+
 func init() {
 	t["HostProtocolEndpointProtocolEndpointType"] = reflect.TypeOf((*HostProtocolEndpointProtocolEndpointType)(nil)).Elem()
 }
@@ -2143,6 +3765,15 @@ const (
 	HostPtpConfigDeviceTypePciPassthruNic = HostPtpConfigDeviceType("pciPassthruNic")
 )
 
+// Values returns all known values of the HostPtpConfigDeviceType enum
+func (e HostPtpConfigDeviceType) Values() []HostPtpConfigDeviceType {
+	return []HostPtpConfigDeviceType{
+		HostPtpConfigDeviceTypeNone,
+		HostPtpConfigDeviceTypeVirtualNic,
+		HostPtpConfigDeviceTypePciPassthruNic,
+	}
+}
+
 func init() {
 	t["HostPtpConfigDeviceType"] = reflect.TypeOf((*HostPtpConfigDeviceType)(nil)).Elem()
 }
@@ -2153,6 +3784,13 @@ const (
 	HostQualifiedNameTypeNvmeQualifiedName = HostQualifiedNameType("nvmeQualifiedName")
 )
 
+// Values returns all known values of the HostQualifiedNameType enum
+func (e HostQualifiedNameType) Values() []HostQualifiedNameType {
+	return []HostQualifiedNameType{
+		HostQualifiedNameTypeNvmeQualifiedName,
+	}
+}
+
 func init() {
 	t["HostQualifiedNameType"] = reflect.TypeOf((*HostQualifiedNameType)(nil)).Elem()
 }
@@ -2168,6 +3806,18 @@ const (
 	HostRdmaDeviceConnectionStateActiveDefer = HostRdmaDeviceConnectionState("activeDefer")
 )
 
+// Values returns all known values of the HostRdmaDeviceConnectionState enum
+func (e HostRdmaDeviceConnectionState) Values() []HostRdmaDeviceConnectionState {
+	return []HostRdmaDeviceConnectionState{
+		HostRdmaDeviceConnectionStateUnknown,
+		HostRdmaDeviceConnectionStateDown,
+		HostRdmaDeviceConnectionStateInit,
+		HostRdmaDeviceConnectionStateArmed,
+		HostRdmaDeviceConnectionStateActive,
+		HostRdmaDeviceConnectionStateActiveDefer,
+	}
+}
+
 func init() {
 	t["HostRdmaDeviceConnectionState"] = reflect.TypeOf((*HostRdmaDeviceConnectionState)(nil)).Elem()
 }
@@ -2183,6 +3833,18 @@ const (
 	HostReplayUnsupportedReasonUnknown             = HostReplayUnsupportedReason("unknown")
 )
 
+// Values returns all known values of the HostReplayUnsupportedReason enum
+func (e HostReplayUnsupportedReason) Values() []HostReplayUnsupportedReason {
+	return []HostReplayUnsupportedReason{
+		HostReplayUnsupportedReasonIncompatibleProduct,
+		HostReplayUnsupportedReasonIncompatibleCpu,
+		HostReplayUnsupportedReasonHvDisabled,
+		HostReplayUnsupportedReasonCpuidLimitSet,
+		HostReplayUnsupportedReasonOldBIOS,
+		HostReplayUnsupportedReasonUnknown,
+	}
+}
+
 func init() {
 	t["HostReplayUnsupportedReason"] = reflect.TypeOf((*HostReplayUnsupportedReason)(nil)).Elem()
 }
@@ -2196,6 +3858,16 @@ const (
 	HostRuntimeInfoNetStackInstanceRuntimeInfoStateActivating   = HostRuntimeInfoNetStackInstanceRuntimeInfoState("activating")
 )
 
+// Values returns all known values of the HostRuntimeInfoNetStackInstanceRuntimeInfoState enum
+func (e HostRuntimeInfoNetStackInstanceRuntimeInfoState) Values() []HostRuntimeInfoNetStackInstanceRuntimeInfoState {
+	return []HostRuntimeInfoNetStackInstanceRuntimeInfoState{
+		HostRuntimeInfoNetStackInstanceRuntimeInfoStateInactive,
+		HostRuntimeInfoNetStackInstanceRuntimeInfoStateActive,
+		HostRuntimeInfoNetStackInstanceRuntimeInfoStateDeactivating,
+		HostRuntimeInfoNetStackInstanceRuntimeInfoStateActivating,
+	}
+}
+
 func init() {
 	t["HostRuntimeInfoNetStackInstanceRuntimeInfoState"] = reflect.TypeOf((*HostRuntimeInfoNetStackInstanceRuntimeInfoState)(nil)).Elem()
 }
@@ -2207,6 +3879,14 @@ const (
 	HostRuntimeInfoStateEncryptionInfoProtectionModeTpm  = HostRuntimeInfoStateEncryptionInfoProtectionMode("tpm")
 )
 
+// Values returns all known values of the HostRuntimeInfoStateEncryptionInfoProtectionMode enum
+func (e HostRuntimeInfoStateEncryptionInfoProtectionMode) Values() []HostRuntimeInfoStateEncryptionInfoProtectionMode {
+	return []HostRuntimeInfoStateEncryptionInfoProtectionMode{
+		HostRuntimeInfoStateEncryptionInfoProtectionModeNone,
+		HostRuntimeInfoStateEncryptionInfoProtectionModeTpm,
+	}
+}
+
 func init() {
 	t["HostRuntimeInfoStateEncryptionInfoProtectionMode"] = reflect.TypeOf((*HostRuntimeInfoStateEncryptionInfoProtectionMode)(nil)).Elem()
 }
@@ -2219,6 +3899,15 @@ const (
 	HostRuntimeInfoStatelessNvdsMigrationStateUnknown   = HostRuntimeInfoStatelessNvdsMigrationState("unknown")
 )
 
+// Values returns all known values of the HostRuntimeInfoStatelessNvdsMigrationState enum
+func (e HostRuntimeInfoStatelessNvdsMigrationState) Values() []HostRuntimeInfoStatelessNvdsMigrationState {
+	return []HostRuntimeInfoStatelessNvdsMigrationState{
+		HostRuntimeInfoStatelessNvdsMigrationStateReady,
+		HostRuntimeInfoStatelessNvdsMigrationStateNotNeeded,
+		HostRuntimeInfoStatelessNvdsMigrationStateUnknown,
+	}
+}
+
 func init() {
 	t["HostRuntimeInfoStatelessNvdsMigrationState"] = reflect.TypeOf((*HostRuntimeInfoStatelessNvdsMigrationState)(nil)).Elem()
 }
@@ -2231,6 +3920,15 @@ const (
 	HostServicePolicyOff       = HostServicePolicy("off")
 )
 
+// Values returns all known values of the HostServicePolicy enum
+func (e HostServicePolicy) Values() []HostServicePolicy {
+	return []HostServicePolicy{
+		HostServicePolicyOn,
+		HostServicePolicyAutomatic,
+		HostServicePolicyOff,
+	}
+}
+
 func init() {
 	t["HostServicePolicy"] = reflect.TypeOf((*HostServicePolicy)(nil)).Elem()
 }
@@ -2243,6 +3941,15 @@ const (
 	HostSevInfoSevStateWorking       = HostSevInfoSevState("working")
 )
 
+// Values returns all known values of the HostSevInfoSevState enum
+func (e HostSevInfoSevState) Values() []HostSevInfoSevState {
+	return []HostSevInfoSevState{
+		HostSevInfoSevStateUninitialized,
+		HostSevInfoSevStateInitialized,
+		HostSevInfoSevStateWorking,
+	}
+}
+
 func init() {
 	t["HostSevInfoSevState"] = reflect.TypeOf((*HostSevInfoSevState)(nil)).Elem()
 }
@@ -2255,6 +3962,15 @@ const (
 	HostSgxInfoFlcModesUnlocked = HostSgxInfoFlcModes("unlocked")
 )
 
+// Values returns all known values of the HostSgxInfoFlcModes enum
+func (e HostSgxInfoFlcModes) Values() []HostSgxInfoFlcModes {
+	return []HostSgxInfoFlcModes{
+		HostSgxInfoFlcModesOff,
+		HostSgxInfoFlcModesLocked,
+		HostSgxInfoFlcModesUnlocked,
+	}
+}
+
 func init() {
 	t["HostSgxInfoFlcModes"] = reflect.TypeOf((*HostSgxInfoFlcModes)(nil)).Elem()
 }
@@ -2272,6 +3988,20 @@ const (
 	HostSgxInfoSgxStatesEnabled             = HostSgxInfoSgxStates("enabled")
 )
 
+// Values returns all known values of the HostSgxInfoSgxStates enum
+func (e HostSgxInfoSgxStates) Values() []HostSgxInfoSgxStates {
+	return []HostSgxInfoSgxStates{
+		HostSgxInfoSgxStatesNotPresent,
+		HostSgxInfoSgxStatesDisabledBIOS,
+		HostSgxInfoSgxStatesDisabledCFW101,
+		HostSgxInfoSgxStatesDisabledCPUMismatch,
+		HostSgxInfoSgxStatesDisabledNoFLC,
+		HostSgxInfoSgxStatesDisabledNUMAUnsup,
+		HostSgxInfoSgxStatesDisabledMaxEPCRegs,
+		HostSgxInfoSgxStatesEnabled,
+	}
+}
+
 func init() {
 	t["HostSgxInfoSgxStates"] = reflect.TypeOf((*HostSgxInfoSgxStates)(nil)).Elem()
 }
@@ -2284,6 +4014,15 @@ const (
 	HostSnmpAgentCapabilityCONFIGURATION = HostSnmpAgentCapability("CONFIGURATION")
 )
 
+// Values returns all known values of the HostSnmpAgentCapability enum
+func (e HostSnmpAgentCapability) Values() []HostSnmpAgentCapability {
+	return []HostSnmpAgentCapability{
+		HostSnmpAgentCapabilityCOMPLETE,
+		HostSnmpAgentCapabilityDIAGNOSTICS,
+		HostSnmpAgentCapabilityCONFIGURATION,
+	}
+}
+
 func init() {
 	t["HostSnmpAgentCapability"] = reflect.TypeOf((*HostSnmpAgentCapability)(nil)).Elem()
 }
@@ -2297,6 +4036,16 @@ const (
 	HostStandbyModeNone     = HostStandbyMode("none")
 )
 
+// Values returns all known values of the HostStandbyMode enum
+func (e HostStandbyMode) Values() []HostStandbyMode {
+	return []HostStandbyMode{
+		HostStandbyModeEntering,
+		HostStandbyModeExiting,
+		HostStandbyModeIn,
+		HostStandbyModeNone,
+	}
+}
+
 func init() {
 	t["HostStandbyMode"] = reflect.TypeOf((*HostStandbyMode)(nil)).Elem()
 }
@@ -2308,6 +4057,14 @@ const (
 	HostStorageProtocolNvme = HostStorageProtocol("nvme")
 )
 
+// Values returns all known values of the HostStorageProtocol enum
+func (e HostStorageProtocol) Values() []HostStorageProtocol {
+	return []HostStorageProtocol{
+		HostStorageProtocolScsi,
+		HostStorageProtocolNvme,
+	}
+}
+
 func init() {
 	t["HostStorageProtocol"] = reflect.TypeOf((*HostStorageProtocol)(nil)).Elem()
 }
@@ -2320,6 +4077,15 @@ const (
 	HostSystemConnectionStateDisconnected  = HostSystemConnectionState("disconnected")
 )
 
+// Values returns all known values of the HostSystemConnectionState enum
+func (e HostSystemConnectionState) Values() []HostSystemConnectionState {
+	return []HostSystemConnectionState{
+		HostSystemConnectionStateConnected,
+		HostSystemConnectionStateNotResponding,
+		HostSystemConnectionStateDisconnected,
+	}
+}
+
 func init() {
 	t["HostSystemConnectionState"] = reflect.TypeOf((*HostSystemConnectionState)(nil)).Elem()
 }
@@ -2334,6 +4100,17 @@ const (
 	HostSystemIdentificationInfoIdentifierSerialNumberTag          = HostSystemIdentificationInfoIdentifier("SerialNumberTag")
 )
 
+// Values returns all known values of the HostSystemIdentificationInfoIdentifier enum
+func (e HostSystemIdentificationInfoIdentifier) Values() []HostSystemIdentificationInfoIdentifier {
+	return []HostSystemIdentificationInfoIdentifier{
+		HostSystemIdentificationInfoIdentifierAssetTag,
+		HostSystemIdentificationInfoIdentifierServiceTag,
+		HostSystemIdentificationInfoIdentifierOemSpecificString,
+		HostSystemIdentificationInfoIdentifierEnclosureSerialNumberTag,
+		HostSystemIdentificationInfoIdentifierSerialNumberTag,
+	}
+}
+
 func init() {
 	t["HostSystemIdentificationInfoIdentifier"] = reflect.TypeOf((*HostSystemIdentificationInfoIdentifier)(nil)).Elem()
 }
@@ -2347,6 +4124,16 @@ const (
 	HostSystemPowerStateUnknown    = HostSystemPowerState("unknown")
 )
 
+// Values returns all known values of the HostSystemPowerState enum
+func (e HostSystemPowerState) Values() []HostSystemPowerState {
+	return []HostSystemPowerState{
+		HostSystemPowerStatePoweredOn,
+		HostSystemPowerStatePoweredOff,
+		HostSystemPowerStateStandBy,
+		HostSystemPowerStateUnknown,
+	}
+}
+
 func init() {
 	t["HostSystemPowerState"] = reflect.TypeOf((*HostSystemPowerState)(nil)).Elem()
 }
@@ -2362,6 +4149,18 @@ const (
 	HostSystemRemediationStateStateRemediationFailed           = HostSystemRemediationStateState("remediationFailed")
 )
 
+// Values returns all known values of the HostSystemRemediationStateState enum
+func (e HostSystemRemediationStateState) Values() []HostSystemRemediationStateState {
+	return []HostSystemRemediationStateState{
+		HostSystemRemediationStateStateRemediationReady,
+		HostSystemRemediationStateStatePrecheckRemediationRunning,
+		HostSystemRemediationStateStatePrecheckRemediationComplete,
+		HostSystemRemediationStateStatePrecheckRemediationFailed,
+		HostSystemRemediationStateStateRemediationRunning,
+		HostSystemRemediationStateStateRemediationFailed,
+	}
+}
+
 func init() {
 	t["HostSystemRemediationStateState"] = reflect.TypeOf((*HostSystemRemediationStateState)(nil)).Elem()
 }
@@ -2373,6 +4172,14 @@ const (
 	HostTpmAttestationInfoAcceptanceStatusAccepted    = HostTpmAttestationInfoAcceptanceStatus("accepted")
 )
 
+// Values returns all known values of the HostTpmAttestationInfoAcceptanceStatus enum
+func (e HostTpmAttestationInfoAcceptanceStatus) Values() []HostTpmAttestationInfoAcceptanceStatus {
+	return []HostTpmAttestationInfoAcceptanceStatus{
+		HostTpmAttestationInfoAcceptanceStatusNotAccepted,
+		HostTpmAttestationInfoAcceptanceStatusAccepted,
+	}
+}
+
 func init() {
 	t["HostTpmAttestationInfoAcceptanceStatus"] = reflect.TypeOf((*HostTpmAttestationInfoAcceptanceStatus)(nil)).Elem()
 }
@@ -2385,6 +4192,15 @@ const (
 	HostTrustAuthorityAttestationInfoAttestationStatusUnknown     = HostTrustAuthorityAttestationInfoAttestationStatus("unknown")
 )
 
+// Values returns all known values of the HostTrustAuthorityAttestationInfoAttestationStatus enum
+func (e HostTrustAuthorityAttestationInfoAttestationStatus) Values() []HostTrustAuthorityAttestationInfoAttestationStatus {
+	return []HostTrustAuthorityAttestationInfoAttestationStatus{
+		HostTrustAuthorityAttestationInfoAttestationStatusAttested,
+		HostTrustAuthorityAttestationInfoAttestationStatusNotAttested,
+		HostTrustAuthorityAttestationInfoAttestationStatusUnknown,
+	}
+}
+
 func init() {
 	t["HostTrustAuthorityAttestationInfoAttestationStatus"] = reflect.TypeOf((*HostTrustAuthorityAttestationInfoAttestationStatus)(nil)).Elem()
 }
@@ -2396,6 +4212,14 @@ const (
 	HostUnresolvedVmfsExtentUnresolvedReasonUuidConflict   = HostUnresolvedVmfsExtentUnresolvedReason("uuidConflict")
 )
 
+// Values returns all known values of the HostUnresolvedVmfsExtentUnresolvedReason enum
+func (e HostUnresolvedVmfsExtentUnresolvedReason) Values() []HostUnresolvedVmfsExtentUnresolvedReason {
+	return []HostUnresolvedVmfsExtentUnresolvedReason{
+		HostUnresolvedVmfsExtentUnresolvedReasonDiskIdMismatch,
+		HostUnresolvedVmfsExtentUnresolvedReasonUuidConflict,
+	}
+}
+
 func init() {
 	t["HostUnresolvedVmfsExtentUnresolvedReason"] = reflect.TypeOf((*HostUnresolvedVmfsExtentUnresolvedReason)(nil)).Elem()
 }
@@ -2407,6 +4231,14 @@ const (
 	HostUnresolvedVmfsResolutionSpecVmfsUuidResolutionForceMount  = HostUnresolvedVmfsResolutionSpecVmfsUuidResolution("forceMount")
 )
 
+// Values returns all known values of the HostUnresolvedVmfsResolutionSpecVmfsUuidResolution enum
+func (e HostUnresolvedVmfsResolutionSpecVmfsUuidResolution) Values() []HostUnresolvedVmfsResolutionSpecVmfsUuidResolution {
+	return []HostUnresolvedVmfsResolutionSpecVmfsUuidResolution{
+		HostUnresolvedVmfsResolutionSpecVmfsUuidResolutionResignature,
+		HostUnresolvedVmfsResolutionSpecVmfsUuidResolutionForceMount,
+	}
+}
+
 func init() {
 	t["HostUnresolvedVmfsResolutionSpecVmfsUuidResolution"] = reflect.TypeOf((*HostUnresolvedVmfsResolutionSpecVmfsUuidResolution)(nil)).Elem()
 }
@@ -2428,6 +4260,24 @@ const (
 	HostVirtualNicManagerNicTypeNvmeRdma              = HostVirtualNicManagerNicType("nvmeRdma")
 )
 
+// Values returns all known values of the HostVirtualNicManagerNicType enum
+func (e HostVirtualNicManagerNicType) Values() []HostVirtualNicManagerNicType {
+	return []HostVirtualNicManagerNicType{
+		HostVirtualNicManagerNicTypeVmotion,
+		HostVirtualNicManagerNicTypeFaultToleranceLogging,
+		HostVirtualNicManagerNicTypeVSphereReplication,
+		HostVirtualNicManagerNicTypeVSphereReplicationNFC,
+		HostVirtualNicManagerNicTypeManagement,
+		HostVirtualNicManagerNicTypeVsan,
+		HostVirtualNicManagerNicTypeVSphereProvisioning,
+		HostVirtualNicManagerNicTypeVsanWitness,
+		HostVirtualNicManagerNicTypeVSphereBackupNFC,
+		HostVirtualNicManagerNicTypePtp,
+		HostVirtualNicManagerNicTypeNvmeTcp,
+		HostVirtualNicManagerNicTypeNvmeRdma,
+	}
+}
+
 func init() {
 	t["HostVirtualNicManagerNicType"] = reflect.TypeOf((*HostVirtualNicManagerNicType)(nil)).Elem()
 }
@@ -2440,6 +4290,15 @@ const (
 	HostVmciAccessManagerModeRevoke  = HostVmciAccessManagerMode("revoke")
 )
 
+// Values returns all known values of the HostVmciAccessManagerMode enum
+func (e HostVmciAccessManagerMode) Values() []HostVmciAccessManagerMode {
+	return []HostVmciAccessManagerMode{
+		HostVmciAccessManagerModeGrant,
+		HostVmciAccessManagerModeReplace,
+		HostVmciAccessManagerModeRevoke,
+	}
+}
+
 func init() {
 	t["HostVmciAccessManagerMode"] = reflect.TypeOf((*HostVmciAccessManagerMode)(nil)).Elem()
 }
@@ -2451,6 +4310,14 @@ const (
 	HostVmfsVolumeUnmapBandwidthPolicyDynamic = HostVmfsVolumeUnmapBandwidthPolicy("dynamic")
 )
 
+// Values returns all known values of the HostVmfsVolumeUnmapBandwidthPolicy enum
+func (e HostVmfsVolumeUnmapBandwidthPolicy) Values() []HostVmfsVolumeUnmapBandwidthPolicy {
+	return []HostVmfsVolumeUnmapBandwidthPolicy{
+		HostVmfsVolumeUnmapBandwidthPolicyFixed,
+		HostVmfsVolumeUnmapBandwidthPolicyDynamic,
+	}
+}
+
 func init() {
 	t["HostVmfsVolumeUnmapBandwidthPolicy"] = reflect.TypeOf((*HostVmfsVolumeUnmapBandwidthPolicy)(nil)).Elem()
 }
@@ -2462,6 +4329,14 @@ const (
 	HostVmfsVolumeUnmapPriorityLow  = HostVmfsVolumeUnmapPriority("low")
 )
 
+// Values returns all known values of the HostVmfsVolumeUnmapPriority enum
+func (e HostVmfsVolumeUnmapPriority) Values() []HostVmfsVolumeUnmapPriority {
+	return []HostVmfsVolumeUnmapPriority{
+		HostVmfsVolumeUnmapPriorityNone,
+		HostVmfsVolumeUnmapPriorityLow,
+	}
+}
+
 func init() {
 	t["HostVmfsVolumeUnmapPriority"] = reflect.TypeOf((*HostVmfsVolumeUnmapPriority)(nil)).Elem()
 }
@@ -2473,6 +4348,14 @@ const (
 	HttpNfcLeaseManifestEntryChecksumTypeSha256 = HttpNfcLeaseManifestEntryChecksumType("sha256")
 )
 
+// Values returns all known values of the HttpNfcLeaseManifestEntryChecksumType enum
+func (e HttpNfcLeaseManifestEntryChecksumType) Values() []HttpNfcLeaseManifestEntryChecksumType {
+	return []HttpNfcLeaseManifestEntryChecksumType{
+		HttpNfcLeaseManifestEntryChecksumTypeSha1,
+		HttpNfcLeaseManifestEntryChecksumTypeSha256,
+	}
+}
+
 func init() {
 	t["HttpNfcLeaseManifestEntryChecksumType"] = reflect.TypeOf((*HttpNfcLeaseManifestEntryChecksumType)(nil)).Elem()
 }
@@ -2484,6 +4367,14 @@ const (
 	HttpNfcLeaseModePull      = HttpNfcLeaseMode("pull")
 )
 
+// Values returns all known values of the HttpNfcLeaseMode enum
+func (e HttpNfcLeaseMode) Values() []HttpNfcLeaseMode {
+	return []HttpNfcLeaseMode{
+		HttpNfcLeaseModePushOrGet,
+		HttpNfcLeaseModePull,
+	}
+}
+
 func init() {
 	t["HttpNfcLeaseMode"] = reflect.TypeOf((*HttpNfcLeaseMode)(nil)).Elem()
 }
@@ -2497,6 +4388,16 @@ const (
 	HttpNfcLeaseStateError        = HttpNfcLeaseState("error")
 )
 
+// Values returns all known values of the HttpNfcLeaseState enum
+func (e HttpNfcLeaseState) Values() []HttpNfcLeaseState {
+	return []HttpNfcLeaseState{
+		HttpNfcLeaseStateInitializing,
+		HttpNfcLeaseStateReady,
+		HttpNfcLeaseStateDone,
+		HttpNfcLeaseStateError,
+	}
+}
+
 func init() {
 	t["HttpNfcLeaseState"] = reflect.TypeOf((*HttpNfcLeaseState)(nil)).Elem()
 }
@@ -2508,6 +4409,14 @@ const (
 	IncompatibleHostForVmReplicationIncompatibleReasonNetCompression = IncompatibleHostForVmReplicationIncompatibleReason("netCompression")
 )
 
+// Values returns all known values of the IncompatibleHostForVmReplicationIncompatibleReason enum
+func (e IncompatibleHostForVmReplicationIncompatibleReason) Values() []IncompatibleHostForVmReplicationIncompatibleReason {
+	return []IncompatibleHostForVmReplicationIncompatibleReason{
+		IncompatibleHostForVmReplicationIncompatibleReasonRpo,
+		IncompatibleHostForVmReplicationIncompatibleReasonNetCompression,
+	}
+}
+
 func init() {
 	t["IncompatibleHostForVmReplicationIncompatibleReason"] = reflect.TypeOf((*IncompatibleHostForVmReplicationIncompatibleReason)(nil)).Elem()
 }
@@ -2520,6 +4429,15 @@ const (
 	InternetScsiSnsDiscoveryMethodIsnsSlp    = InternetScsiSnsDiscoveryMethod("isnsSlp")
 )
 
+// Values returns all known values of the InternetScsiSnsDiscoveryMethod enum
+func (e InternetScsiSnsDiscoveryMethod) Values() []InternetScsiSnsDiscoveryMethod {
+	return []InternetScsiSnsDiscoveryMethod{
+		InternetScsiSnsDiscoveryMethodIsnsStatic,
+		InternetScsiSnsDiscoveryMethodIsnsDhcp,
+		InternetScsiSnsDiscoveryMethodIsnsSlp,
+	}
+}
+
 func init() {
 	t["InternetScsiSnsDiscoveryMethod"] = reflect.TypeOf((*InternetScsiSnsDiscoveryMethod)(nil)).Elem()
 }
@@ -2532,6 +4450,15 @@ const (
 	InvalidDasConfigArgumentEntryForInvalidArgumentVmConfig         = InvalidDasConfigArgumentEntryForInvalidArgument("vmConfig")
 )
 
+// Values returns all known values of the InvalidDasConfigArgumentEntryForInvalidArgument enum
+func (e InvalidDasConfigArgumentEntryForInvalidArgument) Values() []InvalidDasConfigArgumentEntryForInvalidArgument {
+	return []InvalidDasConfigArgumentEntryForInvalidArgument{
+		InvalidDasConfigArgumentEntryForInvalidArgumentAdmissionControl,
+		InvalidDasConfigArgumentEntryForInvalidArgumentUserHeartbeatDs,
+		InvalidDasConfigArgumentEntryForInvalidArgumentVmConfig,
+	}
+}
+
 func init() {
 	t["InvalidDasConfigArgumentEntryForInvalidArgument"] = reflect.TypeOf((*InvalidDasConfigArgumentEntryForInvalidArgument)(nil)).Elem()
 }
@@ -2543,6 +4470,14 @@ const (
 	InvalidProfileReferenceHostReasonMissingReferenceHost = InvalidProfileReferenceHostReason("missingReferenceHost")
 )
 
+// Values returns all known values of the InvalidProfileReferenceHostReason enum
+func (e InvalidProfileReferenceHostReason) Values() []InvalidProfileReferenceHostReason {
+	return []InvalidProfileReferenceHostReason{
+		InvalidProfileReferenceHostReasonIncompatibleVersion,
+		InvalidProfileReferenceHostReasonMissingReferenceHost,
+	}
+}
+
 func init() {
 	t["InvalidProfileReferenceHostReason"] = reflect.TypeOf((*InvalidProfileReferenceHostReason)(nil)).Elem()
 }
@@ -2555,6 +4490,15 @@ const (
 	IoFilterOperationUpgrade   = IoFilterOperation("upgrade")
 )
 
+// Values returns all known values of the IoFilterOperation enum
+func (e IoFilterOperation) Values() []IoFilterOperation {
+	return []IoFilterOperation{
+		IoFilterOperationInstall,
+		IoFilterOperationUninstall,
+		IoFilterOperationUpgrade,
+	}
+}
+
 func init() {
 	t["IoFilterOperation"] = reflect.TypeOf((*IoFilterOperation)(nil)).Elem()
 }
@@ -2572,6 +4516,20 @@ const (
 	IoFilterTypeDataCapture        = IoFilterType("dataCapture")
 )
 
+// Values returns all known values of the IoFilterType enum
+func (e IoFilterType) Values() []IoFilterType {
+	return []IoFilterType{
+		IoFilterTypeCache,
+		IoFilterTypeReplication,
+		IoFilterTypeEncryption,
+		IoFilterTypeCompression,
+		IoFilterTypeInspection,
+		IoFilterTypeDatastoreIoControl,
+		IoFilterTypeDataProvider,
+		IoFilterTypeDataCapture,
+	}
+}
+
 func init() {
 	t["IoFilterType"] = reflect.TypeOf((*IoFilterType)(nil)).Elem()
 }
@@ -2585,6 +4543,16 @@ const (
 	IscsiPortInfoPathStatusLastActive = IscsiPortInfoPathStatus("lastActive")
 )
 
+// Values returns all known values of the IscsiPortInfoPathStatus enum
+func (e IscsiPortInfoPathStatus) Values() []IscsiPortInfoPathStatus {
+	return []IscsiPortInfoPathStatus{
+		IscsiPortInfoPathStatusNotUsed,
+		IscsiPortInfoPathStatusActive,
+		IscsiPortInfoPathStatusStandBy,
+		IscsiPortInfoPathStatusLastActive,
+	}
+}
+
 func init() {
 	t["IscsiPortInfoPathStatus"] = reflect.TypeOf((*IscsiPortInfoPathStatus)(nil)).Elem()
 }
@@ -2598,6 +4566,16 @@ const (
 	KmipClusterInfoKmsManagementTypeNativeProvider = KmipClusterInfoKmsManagementType("nativeProvider")
 )
 
+// Values returns all known values of the KmipClusterInfoKmsManagementType enum
+func (e KmipClusterInfoKmsManagementType) Values() []KmipClusterInfoKmsManagementType {
+	return []KmipClusterInfoKmsManagementType{
+		KmipClusterInfoKmsManagementTypeUnknown,
+		KmipClusterInfoKmsManagementTypeVCenter,
+		KmipClusterInfoKmsManagementTypeTrustAuthority,
+		KmipClusterInfoKmsManagementTypeNativeProvider,
+	}
+}
+
 func init() {
 	t["KmipClusterInfoKmsManagementType"] = reflect.TypeOf((*KmipClusterInfoKmsManagementType)(nil)).Elem()
 }
@@ -2612,6 +4590,17 @@ const (
 	LatencySensitivitySensitivityLevelCustom = LatencySensitivitySensitivityLevel("custom")
 )
 
+// Values returns all known values of the LatencySensitivitySensitivityLevel enum
+func (e LatencySensitivitySensitivityLevel) Values() []LatencySensitivitySensitivityLevel {
+	return []LatencySensitivitySensitivityLevel{
+		LatencySensitivitySensitivityLevelLow,
+		LatencySensitivitySensitivityLevelNormal,
+		LatencySensitivitySensitivityLevelMedium,
+		LatencySensitivitySensitivityLevelHigh,
+		LatencySensitivitySensitivityLevelCustom,
+	}
+}
+
 func init() {
 	t["LatencySensitivitySensitivityLevel"] = reflect.TypeOf((*LatencySensitivitySensitivityLevel)(nil)).Elem()
 }
@@ -2625,6 +4614,16 @@ const (
 	LicenseAssignmentFailedReasonHostsUnmanageableByVirtualCenterWithoutLicenseServer = LicenseAssignmentFailedReason("hostsUnmanageableByVirtualCenterWithoutLicenseServer")
 )
 
+// Values returns all known values of the LicenseAssignmentFailedReason enum
+func (e LicenseAssignmentFailedReason) Values() []LicenseAssignmentFailedReason {
+	return []LicenseAssignmentFailedReason{
+		LicenseAssignmentFailedReasonKeyEntityMismatch,
+		LicenseAssignmentFailedReasonDowngradeDisallowed,
+		LicenseAssignmentFailedReasonInventoryNotManageableByVirtualCenter,
+		LicenseAssignmentFailedReasonHostsUnmanageableByVirtualCenterWithoutLicenseServer,
+	}
+}
+
 func init() {
 	t["LicenseAssignmentFailedReason"] = reflect.TypeOf((*LicenseAssignmentFailedReason)(nil)).Elem()
 }
@@ -2637,6 +4636,15 @@ const (
 	LicenseFeatureInfoSourceRestrictionFile         = LicenseFeatureInfoSourceRestriction("file")
 )
 
+// Values returns all known values of the LicenseFeatureInfoSourceRestriction enum
+func (e LicenseFeatureInfoSourceRestriction) Values() []LicenseFeatureInfoSourceRestriction {
+	return []LicenseFeatureInfoSourceRestriction{
+		LicenseFeatureInfoSourceRestrictionUnrestricted,
+		LicenseFeatureInfoSourceRestrictionServed,
+		LicenseFeatureInfoSourceRestrictionFile,
+	}
+}
+
 func init() {
 	t["LicenseFeatureInfoSourceRestriction"] = reflect.TypeOf((*LicenseFeatureInfoSourceRestriction)(nil)).Elem()
 }
@@ -2649,6 +4657,15 @@ const (
 	LicenseFeatureInfoStateOptional = LicenseFeatureInfoState("optional")
 )
 
+// Values returns all known values of the LicenseFeatureInfoState enum
+func (e LicenseFeatureInfoState) Values() []LicenseFeatureInfoState {
+	return []LicenseFeatureInfoState{
+		LicenseFeatureInfoStateEnabled,
+		LicenseFeatureInfoStateDisabled,
+		LicenseFeatureInfoStateOptional,
+	}
+}
+
 func init() {
 	t["LicenseFeatureInfoState"] = reflect.TypeOf((*LicenseFeatureInfoState)(nil)).Elem()
 }
@@ -2663,6 +4680,17 @@ const (
 	LicenseFeatureInfoUnitVm         = LicenseFeatureInfoUnit("vm")
 )
 
+// Values returns all known values of the LicenseFeatureInfoUnit enum
+func (e LicenseFeatureInfoUnit) Values() []LicenseFeatureInfoUnit {
+	return []LicenseFeatureInfoUnit{
+		LicenseFeatureInfoUnitHost,
+		LicenseFeatureInfoUnitCpuCore,
+		LicenseFeatureInfoUnitCpuPackage,
+		LicenseFeatureInfoUnitServer,
+		LicenseFeatureInfoUnitVm,
+	}
+}
+
 func init() {
 	t["LicenseFeatureInfoUnit"] = reflect.TypeOf((*LicenseFeatureInfoUnit)(nil)).Elem()
 }
@@ -2689,6 +4717,29 @@ const (
 	LicenseManagerLicenseKeyDas        = LicenseManagerLicenseKey("das")
 )
 
+// Values returns all known values of the LicenseManagerLicenseKey enum
+func (e LicenseManagerLicenseKey) Values() []LicenseManagerLicenseKey {
+	return []LicenseManagerLicenseKey{
+		LicenseManagerLicenseKeyEsxFull,
+		LicenseManagerLicenseKeyEsxVmtn,
+		LicenseManagerLicenseKeyEsxExpress,
+		LicenseManagerLicenseKeySan,
+		LicenseManagerLicenseKeyIscsi,
+		LicenseManagerLicenseKeyNas,
+		LicenseManagerLicenseKeyVsmp,
+		LicenseManagerLicenseKeyBackup,
+		LicenseManagerLicenseKeyVc,
+		LicenseManagerLicenseKeyVcExpress,
+		LicenseManagerLicenseKeyEsxHost,
+		LicenseManagerLicenseKeyGsxHost,
+		LicenseManagerLicenseKeyServerHost,
+		LicenseManagerLicenseKeyDrsPower,
+		LicenseManagerLicenseKeyVmotion,
+		LicenseManagerLicenseKeyDrs,
+		LicenseManagerLicenseKeyDas,
+	}
+}
+
 func init() {
 	t["LicenseManagerLicenseKey"] = reflect.TypeOf((*LicenseManagerLicenseKey)(nil)).Elem()
 }
@@ -2702,6 +4753,16 @@ const (
 	LicenseManagerStateFault        = LicenseManagerState("fault")
 )
 
+// Values returns all known values of the LicenseManagerState enum
+func (e LicenseManagerState) Values() []LicenseManagerState {
+	return []LicenseManagerState{
+		LicenseManagerStateInitializing,
+		LicenseManagerStateNormal,
+		LicenseManagerStateMarginal,
+		LicenseManagerStateFault,
+	}
+}
+
 func init() {
 	t["LicenseManagerState"] = reflect.TypeOf((*LicenseManagerState)(nil)).Elem()
 }
@@ -2715,6 +4776,16 @@ const (
 	LicenseReservationInfoStateLicensed      = LicenseReservationInfoState("licensed")
 )
 
+// Values returns all known values of the LicenseReservationInfoState enum
+func (e LicenseReservationInfoState) Values() []LicenseReservationInfoState {
+	return []LicenseReservationInfoState{
+		LicenseReservationInfoStateNotUsed,
+		LicenseReservationInfoStateNoLicense,
+		LicenseReservationInfoStateUnlicensedUse,
+		LicenseReservationInfoStateLicensed,
+	}
+}
+
 func init() {
 	t["LicenseReservationInfoState"] = reflect.TypeOf((*LicenseReservationInfoState)(nil)).Elem()
 }
@@ -2728,6 +4799,16 @@ const (
 	LinkDiscoveryProtocolConfigOperationTypeBoth      = LinkDiscoveryProtocolConfigOperationType("both")
 )
 
+// Values returns all known values of the LinkDiscoveryProtocolConfigOperationType enum
+func (e LinkDiscoveryProtocolConfigOperationType) Values() []LinkDiscoveryProtocolConfigOperationType {
+	return []LinkDiscoveryProtocolConfigOperationType{
+		LinkDiscoveryProtocolConfigOperationTypeNone,
+		LinkDiscoveryProtocolConfigOperationTypeListen,
+		LinkDiscoveryProtocolConfigOperationTypeAdvertise,
+		LinkDiscoveryProtocolConfigOperationTypeBoth,
+	}
+}
+
 func init() {
 	t["LinkDiscoveryProtocolConfigOperationType"] = reflect.TypeOf((*LinkDiscoveryProtocolConfigOperationType)(nil)).Elem()
 }
@@ -2739,6 +4820,14 @@ const (
 	LinkDiscoveryProtocolConfigProtocolTypeLldp = LinkDiscoveryProtocolConfigProtocolType("lldp")
 )
 
+// Values returns all known values of the LinkDiscoveryProtocolConfigProtocolType enum
+func (e LinkDiscoveryProtocolConfigProtocolType) Values() []LinkDiscoveryProtocolConfigProtocolType {
+	return []LinkDiscoveryProtocolConfigProtocolType{
+		LinkDiscoveryProtocolConfigProtocolTypeCdp,
+		LinkDiscoveryProtocolConfigProtocolTypeLldp,
+	}
+}
+
 func init() {
 	t["LinkDiscoveryProtocolConfigProtocolType"] = reflect.TypeOf((*LinkDiscoveryProtocolConfigProtocolType)(nil)).Elem()
 }
@@ -2752,6 +4841,16 @@ const (
 	ManagedEntityStatusRed    = ManagedEntityStatus("red")
 )
 
+// Values returns all known values of the ManagedEntityStatus enum
+func (e ManagedEntityStatus) Values() []ManagedEntityStatus {
+	return []ManagedEntityStatus{
+		ManagedEntityStatusGray,
+		ManagedEntityStatusGreen,
+		ManagedEntityStatusYellow,
+		ManagedEntityStatusRed,
+	}
+}
+
 func init() {
 	t["ManagedEntityStatus"] = reflect.TypeOf((*ManagedEntityStatus)(nil)).Elem()
 }
@@ -2763,6 +4862,14 @@ const (
 	MetricAlarmOperatorIsBelow = MetricAlarmOperator("isBelow")
 )
 
+// Values returns all known values of the MetricAlarmOperator enum
+func (e MetricAlarmOperator) Values() []MetricAlarmOperator {
+	return []MetricAlarmOperator{
+		MetricAlarmOperatorIsAbove,
+		MetricAlarmOperatorIsBelow,
+	}
+}
+
 func init() {
 	t["MetricAlarmOperator"] = reflect.TypeOf((*MetricAlarmOperator)(nil)).Elem()
 }
@@ -2777,6 +4884,17 @@ const (
 	MultipathStateUnknown  = MultipathState("unknown")
 )
 
+// Values returns all known values of the MultipathState enum
+func (e MultipathState) Values() []MultipathState {
+	return []MultipathState{
+		MultipathStateStandby,
+		MultipathStateActive,
+		MultipathStateDisabled,
+		MultipathStateDead,
+		MultipathStateUnknown,
+	}
+}
+
 func init() {
 	t["MultipathState"] = reflect.TypeOf((*MultipathState)(nil)).Elem()
 }
@@ -2790,6 +4908,16 @@ const (
 	NetBIOSConfigInfoModeEnabledViaDHCP = NetBIOSConfigInfoMode("enabledViaDHCP")
 )
 
+// Values returns all known values of the NetBIOSConfigInfoMode enum
+func (e NetBIOSConfigInfoMode) Values() []NetBIOSConfigInfoMode {
+	return []NetBIOSConfigInfoMode{
+		NetBIOSConfigInfoModeUnknown,
+		NetBIOSConfigInfoModeEnabled,
+		NetBIOSConfigInfoModeDisabled,
+		NetBIOSConfigInfoModeEnabledViaDHCP,
+	}
+}
+
 func init() {
 	t["NetBIOSConfigInfoMode"] = reflect.TypeOf((*NetBIOSConfigInfoMode)(nil)).Elem()
 }
@@ -2804,6 +4932,17 @@ const (
 	NetIpConfigInfoIpAddressOriginRandom    = NetIpConfigInfoIpAddressOrigin("random")
 )
 
+// Values returns all known values of the NetIpConfigInfoIpAddressOrigin enum
+func (e NetIpConfigInfoIpAddressOrigin) Values() []NetIpConfigInfoIpAddressOrigin {
+	return []NetIpConfigInfoIpAddressOrigin{
+		NetIpConfigInfoIpAddressOriginOther,
+		NetIpConfigInfoIpAddressOriginManual,
+		NetIpConfigInfoIpAddressOriginDhcp,
+		NetIpConfigInfoIpAddressOriginLinklayer,
+		NetIpConfigInfoIpAddressOriginRandom,
+	}
+}
+
 func init() {
 	t["NetIpConfigInfoIpAddressOrigin"] = reflect.TypeOf((*NetIpConfigInfoIpAddressOrigin)(nil)).Elem()
 }
@@ -2820,6 +4959,19 @@ const (
 	NetIpConfigInfoIpAddressStatusDuplicate    = NetIpConfigInfoIpAddressStatus("duplicate")
 )
 
+// Values returns all known values of the NetIpConfigInfoIpAddressStatus enum
+func (e NetIpConfigInfoIpAddressStatus) Values() []NetIpConfigInfoIpAddressStatus {
+	return []NetIpConfigInfoIpAddressStatus{
+		NetIpConfigInfoIpAddressStatusPreferred,
+		NetIpConfigInfoIpAddressStatusDeprecated,
+		NetIpConfigInfoIpAddressStatusInvalid,
+		NetIpConfigInfoIpAddressStatusInaccessible,
+		NetIpConfigInfoIpAddressStatusUnknown,
+		NetIpConfigInfoIpAddressStatusTentative,
+		NetIpConfigInfoIpAddressStatusDuplicate,
+	}
+}
+
 func init() {
 	t["NetIpConfigInfoIpAddressStatus"] = reflect.TypeOf((*NetIpConfigInfoIpAddressStatus)(nil)).Elem()
 }
@@ -2833,6 +4985,16 @@ const (
 	NetIpStackInfoEntryTypeManual  = NetIpStackInfoEntryType("manual")
 )
 
+// Values returns all known values of the NetIpStackInfoEntryType enum
+func (e NetIpStackInfoEntryType) Values() []NetIpStackInfoEntryType {
+	return []NetIpStackInfoEntryType{
+		NetIpStackInfoEntryTypeOther,
+		NetIpStackInfoEntryTypeInvalid,
+		NetIpStackInfoEntryTypeDynamic,
+		NetIpStackInfoEntryTypeManual,
+	}
+}
+
 func init() {
 	t["NetIpStackInfoEntryType"] = reflect.TypeOf((*NetIpStackInfoEntryType)(nil)).Elem()
 }
@@ -2846,6 +5008,16 @@ const (
 	NetIpStackInfoPreferenceHigh     = NetIpStackInfoPreference("high")
 )
 
+// Values returns all known values of the NetIpStackInfoPreference enum
+func (e NetIpStackInfoPreference) Values() []NetIpStackInfoPreference {
+	return []NetIpStackInfoPreference{
+		NetIpStackInfoPreferenceReserved,
+		NetIpStackInfoPreferenceLow,
+		NetIpStackInfoPreferenceMedium,
+		NetIpStackInfoPreferenceHigh,
+	}
+}
+
 func init() {
 	t["NetIpStackInfoPreference"] = reflect.TypeOf((*NetIpStackInfoPreference)(nil)).Elem()
 }
@@ -2857,6 +5029,14 @@ const (
 	NotSupportedDeviceForFTDeviceTypeParaVirtualSCSIController = NotSupportedDeviceForFTDeviceType("paraVirtualSCSIController")
 )
 
+// Values returns all known values of the NotSupportedDeviceForFTDeviceType enum
+func (e NotSupportedDeviceForFTDeviceType) Values() []NotSupportedDeviceForFTDeviceType {
+	return []NotSupportedDeviceForFTDeviceType{
+		NotSupportedDeviceForFTDeviceTypeVirtualVmxnet3,
+		NotSupportedDeviceForFTDeviceTypeParaVirtualSCSIController,
+	}
+}
+
 func init() {
 	t["NotSupportedDeviceForFTDeviceType"] = reflect.TypeOf((*NotSupportedDeviceForFTDeviceType)(nil)).Elem()
 }
@@ -2868,6 +5048,14 @@ const (
 	NumVirtualCpusIncompatibleReasonFaultTolerance = NumVirtualCpusIncompatibleReason("faultTolerance")
 )
 
+// Values returns all known values of the NumVirtualCpusIncompatibleReason enum
+func (e NumVirtualCpusIncompatibleReason) Values() []NumVirtualCpusIncompatibleReason {
+	return []NumVirtualCpusIncompatibleReason{
+		NumVirtualCpusIncompatibleReasonRecordReplay,
+		NumVirtualCpusIncompatibleReasonFaultTolerance,
+	}
+}
+
 func init() {
 	t["NumVirtualCpusIncompatibleReason"] = reflect.TypeOf((*NumVirtualCpusIncompatibleReason)(nil)).Elem()
 }
@@ -2879,6 +5067,14 @@ const (
 	NvdimmInterleaveSetStateActive  = NvdimmInterleaveSetState("active")
 )
 
+// Values returns all known values of the NvdimmInterleaveSetState enum
+func (e NvdimmInterleaveSetState) Values() []NvdimmInterleaveSetState {
+	return []NvdimmInterleaveSetState{
+		NvdimmInterleaveSetStateInvalid,
+		NvdimmInterleaveSetStateActive,
+	}
+}
+
 func init() {
 	t["NvdimmInterleaveSetState"] = reflect.TypeOf((*NvdimmInterleaveSetState)(nil)).Elem()
 }
@@ -2893,6 +5089,17 @@ const (
 	NvdimmNamespaceDetailsHealthStatusLabelInconsistent = NvdimmNamespaceDetailsHealthStatus("labelInconsistent")
 )
 
+// Values returns all known values of the NvdimmNamespaceDetailsHealthStatus enum
+func (e NvdimmNamespaceDetailsHealthStatus) Values() []NvdimmNamespaceDetailsHealthStatus {
+	return []NvdimmNamespaceDetailsHealthStatus{
+		NvdimmNamespaceDetailsHealthStatusNormal,
+		NvdimmNamespaceDetailsHealthStatusMissing,
+		NvdimmNamespaceDetailsHealthStatusLabelMissing,
+		NvdimmNamespaceDetailsHealthStatusInterleaveBroken,
+		NvdimmNamespaceDetailsHealthStatusLabelInconsistent,
+	}
+}
+
 func init() {
 	t["NvdimmNamespaceDetailsHealthStatus"] = reflect.TypeOf((*NvdimmNamespaceDetailsHealthStatus)(nil)).Elem()
 }
@@ -2905,6 +5112,15 @@ const (
 	NvdimmNamespaceDetailsStateInUse    = NvdimmNamespaceDetailsState("inUse")
 )
 
+// Values returns all known values of the NvdimmNamespaceDetailsState enum
+func (e NvdimmNamespaceDetailsState) Values() []NvdimmNamespaceDetailsState {
+	return []NvdimmNamespaceDetailsState{
+		NvdimmNamespaceDetailsStateInvalid,
+		NvdimmNamespaceDetailsStateNotInUse,
+		NvdimmNamespaceDetailsStateInUse,
+	}
+}
+
 func init() {
 	t["NvdimmNamespaceDetailsState"] = reflect.TypeOf((*NvdimmNamespaceDetailsState)(nil)).Elem()
 }
@@ -2921,6 +5137,19 @@ const (
 	NvdimmNamespaceHealthStatusBadBlockSize      = NvdimmNamespaceHealthStatus("badBlockSize")
 )
 
+// Values returns all known values of the NvdimmNamespaceHealthStatus enum
+func (e NvdimmNamespaceHealthStatus) Values() []NvdimmNamespaceHealthStatus {
+	return []NvdimmNamespaceHealthStatus{
+		NvdimmNamespaceHealthStatusNormal,
+		NvdimmNamespaceHealthStatusMissing,
+		NvdimmNamespaceHealthStatusLabelMissing,
+		NvdimmNamespaceHealthStatusInterleaveBroken,
+		NvdimmNamespaceHealthStatusLabelInconsistent,
+		NvdimmNamespaceHealthStatusBttCorrupt,
+		NvdimmNamespaceHealthStatusBadBlockSize,
+	}
+}
+
 func init() {
 	t["NvdimmNamespaceHealthStatus"] = reflect.TypeOf((*NvdimmNamespaceHealthStatus)(nil)).Elem()
 }
@@ -2933,6 +5162,15 @@ const (
 	NvdimmNamespaceStateInUse    = NvdimmNamespaceState("inUse")
 )
 
+// Values returns all known values of the NvdimmNamespaceState enum
+func (e NvdimmNamespaceState) Values() []NvdimmNamespaceState {
+	return []NvdimmNamespaceState{
+		NvdimmNamespaceStateInvalid,
+		NvdimmNamespaceStateNotInUse,
+		NvdimmNamespaceStateInUse,
+	}
+}
+
 func init() {
 	t["NvdimmNamespaceState"] = reflect.TypeOf((*NvdimmNamespaceState)(nil)).Elem()
 }
@@ -2944,6 +5182,14 @@ const (
 	NvdimmNamespaceTypePersistentNamespace = NvdimmNamespaceType("persistentNamespace")
 )
 
+// Values returns all known values of the NvdimmNamespaceType enum
+func (e NvdimmNamespaceType) Values() []NvdimmNamespaceType {
+	return []NvdimmNamespaceType{
+		NvdimmNamespaceTypeBlockNamespace,
+		NvdimmNamespaceTypePersistentNamespace,
+	}
+}
+
 func init() {
 	t["NvdimmNamespaceType"] = reflect.TypeOf((*NvdimmNamespaceType)(nil)).Elem()
 }
@@ -2955,6 +5201,14 @@ const (
 	NvdimmNvdimmHealthInfoStateError  = NvdimmNvdimmHealthInfoState("error")
 )
 
+// Values returns all known values of the NvdimmNvdimmHealthInfoState enum
+func (e NvdimmNvdimmHealthInfoState) Values() []NvdimmNvdimmHealthInfoState {
+	return []NvdimmNvdimmHealthInfoState{
+		NvdimmNvdimmHealthInfoStateNormal,
+		NvdimmNvdimmHealthInfoStateError,
+	}
+}
+
 func init() {
 	t["NvdimmNvdimmHealthInfoState"] = reflect.TypeOf((*NvdimmNvdimmHealthInfoState)(nil)).Elem()
 }
@@ -2972,6 +5226,20 @@ const (
 	NvdimmRangeTypePersistentVirtualCDRange   = NvdimmRangeType("persistentVirtualCDRange")
 )
 
+// Values returns all known values of the NvdimmRangeType enum
+func (e NvdimmRangeType) Values() []NvdimmRangeType {
+	return []NvdimmRangeType{
+		NvdimmRangeTypeVolatileRange,
+		NvdimmRangeTypePersistentRange,
+		NvdimmRangeTypeControlRange,
+		NvdimmRangeTypeBlockRange,
+		NvdimmRangeTypeVolatileVirtualDiskRange,
+		NvdimmRangeTypeVolatileVirtualCDRange,
+		NvdimmRangeTypePersistentVirtualDiskRange,
+		NvdimmRangeTypePersistentVirtualCDRange,
+	}
+}
+
 func init() {
 	t["NvdimmRangeType"] = reflect.TypeOf((*NvdimmRangeType)(nil)).Elem()
 }
@@ -2984,6 +5252,15 @@ const (
 	ObjectUpdateKindLeave  = ObjectUpdateKind("leave")
 )
 
+// Values returns all known values of the ObjectUpdateKind enum
+func (e ObjectUpdateKind) Values() []ObjectUpdateKind {
+	return []ObjectUpdateKind{
+		ObjectUpdateKindModify,
+		ObjectUpdateKindEnter,
+		ObjectUpdateKindLeave,
+	}
+}
+
 func init() {
 	t["ObjectUpdateKind"] = reflect.TypeOf((*ObjectUpdateKind)(nil)).Elem()
 }
@@ -2996,6 +5273,15 @@ const (
 	OvfConsumerOstNodeTypeVirtualSystemCollection = OvfConsumerOstNodeType("virtualSystemCollection")
 )
 
+// Values returns all known values of the OvfConsumerOstNodeType enum
+func (e OvfConsumerOstNodeType) Values() []OvfConsumerOstNodeType {
+	return []OvfConsumerOstNodeType{
+		OvfConsumerOstNodeTypeEnvelope,
+		OvfConsumerOstNodeTypeVirtualSystem,
+		OvfConsumerOstNodeTypeVirtualSystemCollection,
+	}
+}
+
 func init() {
 	t["OvfConsumerOstNodeType"] = reflect.TypeOf((*OvfConsumerOstNodeType)(nil)).Elem()
 }
@@ -3015,6 +5301,22 @@ const (
 	OvfCreateImportSpecParamsDiskProvisioningTypeFlat                 = OvfCreateImportSpecParamsDiskProvisioningType("flat")
 )
 
+// Values returns all known values of the OvfCreateImportSpecParamsDiskProvisioningType enum
+func (e OvfCreateImportSpecParamsDiskProvisioningType) Values() []OvfCreateImportSpecParamsDiskProvisioningType {
+	return []OvfCreateImportSpecParamsDiskProvisioningType{
+		OvfCreateImportSpecParamsDiskProvisioningTypeMonolithicSparse,
+		OvfCreateImportSpecParamsDiskProvisioningTypeMonolithicFlat,
+		OvfCreateImportSpecParamsDiskProvisioningTypeTwoGbMaxExtentSparse,
+		OvfCreateImportSpecParamsDiskProvisioningTypeTwoGbMaxExtentFlat,
+		OvfCreateImportSpecParamsDiskProvisioningTypeThin,
+		OvfCreateImportSpecParamsDiskProvisioningTypeThick,
+		OvfCreateImportSpecParamsDiskProvisioningTypeSeSparse,
+		OvfCreateImportSpecParamsDiskProvisioningTypeEagerZeroedThick,
+		OvfCreateImportSpecParamsDiskProvisioningTypeSparse,
+		OvfCreateImportSpecParamsDiskProvisioningTypeFlat,
+	}
+}
+
 func init() {
 	t["OvfCreateImportSpecParamsDiskProvisioningType"] = reflect.TypeOf((*OvfCreateImportSpecParamsDiskProvisioningType)(nil)).Elem()
 }
@@ -3026,6 +5328,14 @@ const (
 	PerfFormatCsv    = PerfFormat("csv")
 )
 
+// Values returns all known values of the PerfFormat enum
+func (e PerfFormat) Values() []PerfFormat {
+	return []PerfFormat{
+		PerfFormatNormal,
+		PerfFormatCsv,
+	}
+}
+
 func init() {
 	t["PerfFormat"] = reflect.TypeOf((*PerfFormat)(nil)).Elem()
 }
@@ -3038,6 +5348,15 @@ const (
 	PerfStatsTypeRate     = PerfStatsType("rate")
 )
 
+// Values returns all known values of the PerfStatsType enum
+func (e PerfStatsType) Values() []PerfStatsType {
+	return []PerfStatsType{
+		PerfStatsTypeAbsolute,
+		PerfStatsTypeDelta,
+		PerfStatsTypeRate,
+	}
+}
+
 func init() {
 	t["PerfStatsType"] = reflect.TypeOf((*PerfStatsType)(nil)).Elem()
 }
@@ -3053,6 +5372,18 @@ const (
 	PerfSummaryTypeNone      = PerfSummaryType("none")
 )
 
+// Values returns all known values of the PerfSummaryType enum
+func (e PerfSummaryType) Values() []PerfSummaryType {
+	return []PerfSummaryType{
+		PerfSummaryTypeAverage,
+		PerfSummaryTypeMaximum,
+		PerfSummaryTypeMinimum,
+		PerfSummaryTypeLatest,
+		PerfSummaryTypeSummation,
+		PerfSummaryTypeNone,
+	}
+}
+
 func init() {
 	t["PerfSummaryType"] = reflect.TypeOf((*PerfSummaryType)(nil)).Elem()
 }
@@ -3076,6 +5407,26 @@ const (
 	PerformanceManagerUnitCelsius            = PerformanceManagerUnit("celsius")
 )
 
+// Values returns all known values of the PerformanceManagerUnit enum
+func (e PerformanceManagerUnit) Values() []PerformanceManagerUnit {
+	return []PerformanceManagerUnit{
+		PerformanceManagerUnitPercent,
+		PerformanceManagerUnitKiloBytes,
+		PerformanceManagerUnitMegaBytes,
+		PerformanceManagerUnitMegaHertz,
+		PerformanceManagerUnitNumber,
+		PerformanceManagerUnitMicrosecond,
+		PerformanceManagerUnitMillisecond,
+		PerformanceManagerUnitSecond,
+		PerformanceManagerUnitKiloBytesPerSecond,
+		PerformanceManagerUnitMegaBytesPerSecond,
+		PerformanceManagerUnitWatt,
+		PerformanceManagerUnitJoule,
+		PerformanceManagerUnitTeraBytes,
+		PerformanceManagerUnitCelsius,
+	}
+}
+
 func init() {
 	t["PerformanceManagerUnit"] = reflect.TypeOf((*PerformanceManagerUnit)(nil)).Elem()
 }
@@ -3087,6 +5438,14 @@ const (
 	PhysicalNicResourcePoolSchedulerDisallowedReasonHardwareUnsupported = PhysicalNicResourcePoolSchedulerDisallowedReason("hardwareUnsupported")
 )
 
+// Values returns all known values of the PhysicalNicResourcePoolSchedulerDisallowedReason enum
+func (e PhysicalNicResourcePoolSchedulerDisallowedReason) Values() []PhysicalNicResourcePoolSchedulerDisallowedReason {
+	return []PhysicalNicResourcePoolSchedulerDisallowedReason{
+		PhysicalNicResourcePoolSchedulerDisallowedReasonUserOptOut,
+		PhysicalNicResourcePoolSchedulerDisallowedReasonHardwareUnsupported,
+	}
+}
+
 func init() {
 	t["PhysicalNicResourcePoolSchedulerDisallowedReason"] = reflect.TypeOf((*PhysicalNicResourcePoolSchedulerDisallowedReason)(nil)).Elem()
 }
@@ -3097,6 +5456,13 @@ const (
 	PhysicalNicVmDirectPathGen2SupportedModeUpt = PhysicalNicVmDirectPathGen2SupportedMode("upt")
 )
 
+// Values returns all known values of the PhysicalNicVmDirectPathGen2SupportedMode enum
+func (e PhysicalNicVmDirectPathGen2SupportedMode) Values() []PhysicalNicVmDirectPathGen2SupportedMode {
+	return []PhysicalNicVmDirectPathGen2SupportedMode{
+		PhysicalNicVmDirectPathGen2SupportedModeUpt,
+	}
+}
+
 func init() {
 	t["PhysicalNicVmDirectPathGen2SupportedMode"] = reflect.TypeOf((*PhysicalNicVmDirectPathGen2SupportedMode)(nil)).Elem()
 }
@@ -3110,6 +5476,16 @@ const (
 	PlacementAffinityRuleRuleScopeDatastore  = PlacementAffinityRuleRuleScope("datastore")
 )
 
+// Values returns all known values of the PlacementAffinityRuleRuleScope enum
+func (e PlacementAffinityRuleRuleScope) Values() []PlacementAffinityRuleRuleScope {
+	return []PlacementAffinityRuleRuleScope{
+		PlacementAffinityRuleRuleScopeCluster,
+		PlacementAffinityRuleRuleScopeHost,
+		PlacementAffinityRuleRuleScopeStoragePod,
+		PlacementAffinityRuleRuleScopeDatastore,
+	}
+}
+
 func init() {
 	t["PlacementAffinityRuleRuleScope"] = reflect.TypeOf((*PlacementAffinityRuleRuleScope)(nil)).Elem()
 }
@@ -3123,6 +5499,16 @@ const (
 	PlacementAffinityRuleRuleTypeSoftAntiAffinity = PlacementAffinityRuleRuleType("softAntiAffinity")
 )
 
+// Values returns all known values of the PlacementAffinityRuleRuleType enum
+func (e PlacementAffinityRuleRuleType) Values() []PlacementAffinityRuleRuleType {
+	return []PlacementAffinityRuleRuleType{
+		PlacementAffinityRuleRuleTypeAffinity,
+		PlacementAffinityRuleRuleTypeAntiAffinity,
+		PlacementAffinityRuleRuleTypeSoftAffinity,
+		PlacementAffinityRuleRuleTypeSoftAntiAffinity,
+	}
+}
+
 func init() {
 	t["PlacementAffinityRuleRuleType"] = reflect.TypeOf((*PlacementAffinityRuleRuleType)(nil)).Elem()
 }
@@ -3136,6 +5522,16 @@ const (
 	PlacementSpecPlacementTypeClone       = PlacementSpecPlacementType("clone")
 )
 
+// Values returns all known values of the PlacementSpecPlacementType enum
+func (e PlacementSpecPlacementType) Values() []PlacementSpecPlacementType {
+	return []PlacementSpecPlacementType{
+		PlacementSpecPlacementTypeCreate,
+		PlacementSpecPlacementTypeReconfigure,
+		PlacementSpecPlacementTypeRelocate,
+		PlacementSpecPlacementTypeClone,
+	}
+}
+
 func init() {
 	t["PlacementSpecPlacementType"] = reflect.TypeOf((*PlacementSpecPlacementType)(nil)).Elem()
 }
@@ -3149,6 +5545,16 @@ const (
 	PortGroupConnecteeTypeUnknown          = PortGroupConnecteeType("unknown")
 )
 
+// Values returns all known values of the PortGroupConnecteeType enum
+func (e PortGroupConnecteeType) Values() []PortGroupConnecteeType {
+	return []PortGroupConnecteeType{
+		PortGroupConnecteeTypeVirtualMachine,
+		PortGroupConnecteeTypeSystemManagement,
+		PortGroupConnecteeTypeHost,
+		PortGroupConnecteeTypeUnknown,
+	}
+}
+
 func init() {
 	t["PortGroupConnecteeType"] = reflect.TypeOf((*PortGroupConnecteeType)(nil)).Elem()
 }
@@ -3161,6 +5567,15 @@ const (
 	ProfileExecuteResultStatusError     = ProfileExecuteResultStatus("error")
 )
 
+// Values returns all known values of the ProfileExecuteResultStatus enum
+func (e ProfileExecuteResultStatus) Values() []ProfileExecuteResultStatus {
+	return []ProfileExecuteResultStatus{
+		ProfileExecuteResultStatusSuccess,
+		ProfileExecuteResultStatusNeedInput,
+		ProfileExecuteResultStatusError,
+	}
+}
+
 func init() {
 	t["ProfileExecuteResultStatus"] = reflect.TypeOf((*ProfileExecuteResultStatus)(nil)).Elem()
 }
@@ -3176,6 +5591,18 @@ const (
 	ProfileNumericComparatorGreaterThan      = ProfileNumericComparator("greaterThan")
 )
 
+// Values returns all known values of the ProfileNumericComparator enum
+func (e ProfileNumericComparator) Values() []ProfileNumericComparator {
+	return []ProfileNumericComparator{
+		ProfileNumericComparatorLessThan,
+		ProfileNumericComparatorLessThanEqual,
+		ProfileNumericComparatorEqual,
+		ProfileNumericComparatorNotEqual,
+		ProfileNumericComparatorGreaterThanEqual,
+		ProfileNumericComparatorGreaterThan,
+	}
+}
+
 func init() {
 	t["ProfileNumericComparator"] = reflect.TypeOf((*ProfileNumericComparator)(nil)).Elem()
 }
@@ -3190,6 +5617,17 @@ const (
 	ProfileParameterMetadataRelationTypeValidation_relation  = ProfileParameterMetadataRelationType("validation_relation")
 )
 
+// Values returns all known values of the ProfileParameterMetadataRelationType enum
+func (e ProfileParameterMetadataRelationType) Values() []ProfileParameterMetadataRelationType {
+	return []ProfileParameterMetadataRelationType{
+		ProfileParameterMetadataRelationTypeDynamic_relation,
+		ProfileParameterMetadataRelationTypeExtensible_relation,
+		ProfileParameterMetadataRelationTypeLocalizable_relation,
+		ProfileParameterMetadataRelationTypeStatic_relation,
+		ProfileParameterMetadataRelationTypeValidation_relation,
+	}
+}
+
 func init() {
 	t["ProfileParameterMetadataRelationType"] = reflect.TypeOf((*ProfileParameterMetadataRelationType)(nil)).Elem()
 }
@@ -3203,6 +5641,16 @@ const (
 	PropertyChangeOpIndirectRemove = PropertyChangeOp("indirectRemove")
 )
 
+// Values returns all known values of the PropertyChangeOp enum
+func (e PropertyChangeOp) Values() []PropertyChangeOp {
+	return []PropertyChangeOp{
+		PropertyChangeOpAdd,
+		PropertyChangeOpRemove,
+		PropertyChangeOpAssign,
+		PropertyChangeOpIndirectRemove,
+	}
+}
+
 func init() {
 	t["PropertyChangeOp"] = reflect.TypeOf((*PropertyChangeOp)(nil)).Elem()
 }
@@ -3215,6 +5663,15 @@ const (
 	QuarantineModeFaultFaultTypeCorrectionImpact               = QuarantineModeFaultFaultType("CorrectionImpact")
 )
 
+// Values returns all known values of the QuarantineModeFaultFaultType enum
+func (e QuarantineModeFaultFaultType) Values() []QuarantineModeFaultFaultType {
+	return []QuarantineModeFaultFaultType{
+		QuarantineModeFaultFaultTypeNoCompatibleNonQuarantinedHost,
+		QuarantineModeFaultFaultTypeCorrectionDisallowed,
+		QuarantineModeFaultFaultTypeCorrectionImpact,
+	}
+}
+
 func init() {
 	t["QuarantineModeFaultFaultType"] = reflect.TypeOf((*QuarantineModeFaultFaultType)(nil)).Elem()
 }
@@ -3227,6 +5684,15 @@ const (
 	QuiesceModeNone        = QuiesceMode("none")
 )
 
+// Values returns all known values of the QuiesceMode enum
+func (e QuiesceMode) Values() []QuiesceMode {
+	return []QuiesceMode{
+		QuiesceModeApplication,
+		QuiesceModeFilesystem,
+		QuiesceModeNone,
+	}
+}
+
 func init() {
 	t["QuiesceMode"] = reflect.TypeOf((*QuiesceMode)(nil)).Elem()
 }
@@ -3270,6 +5736,46 @@ const (
 	RecommendationReasonCodeBalanceVsanUsage                = RecommendationReasonCode("balanceVsanUsage")
 )
 
+// Values returns all known values of the RecommendationReasonCode enum
+func (e RecommendationReasonCode) Values() []RecommendationReasonCode {
+	return []RecommendationReasonCode{
+		RecommendationReasonCodeFairnessCpuAvg,
+		RecommendationReasonCodeFairnessMemAvg,
+		RecommendationReasonCodeJointAffin,
+		RecommendationReasonCodeAntiAffin,
+		RecommendationReasonCodeHostMaint,
+		RecommendationReasonCodeEnterStandby,
+		RecommendationReasonCodeReservationCpu,
+		RecommendationReasonCodeReservationMem,
+		RecommendationReasonCodePowerOnVm,
+		RecommendationReasonCodePowerSaving,
+		RecommendationReasonCodeIncreaseCapacity,
+		RecommendationReasonCodeCheckResource,
+		RecommendationReasonCodeUnreservedCapacity,
+		RecommendationReasonCodeVmHostHardAffinity,
+		RecommendationReasonCodeVmHostSoftAffinity,
+		RecommendationReasonCodeBalanceDatastoreSpaceUsage,
+		RecommendationReasonCodeBalanceDatastoreIOLoad,
+		RecommendationReasonCodeBalanceDatastoreIOPSReservation,
+		RecommendationReasonCodeDatastoreMaint,
+		RecommendationReasonCodeVirtualDiskJointAffin,
+		RecommendationReasonCodeVirtualDiskAntiAffin,
+		RecommendationReasonCodeDatastoreSpaceOutage,
+		RecommendationReasonCodeStoragePlacement,
+		RecommendationReasonCodeIolbDisabledInternal,
+		RecommendationReasonCodeXvmotionPlacement,
+		RecommendationReasonCodeNetworkBandwidthReservation,
+		RecommendationReasonCodeHostInDegradation,
+		RecommendationReasonCodeHostExitDegradation,
+		RecommendationReasonCodeMaxVmsConstraint,
+		RecommendationReasonCodeFtConstraints,
+		RecommendationReasonCodeVmHostAffinityPolicy,
+		RecommendationReasonCodeVmHostAntiAffinityPolicy,
+		RecommendationReasonCodeVmAntiAffinityPolicy,
+		RecommendationReasonCodeBalanceVsanUsage,
+	}
+}
+
 func init() {
 	t["RecommendationReasonCode"] = reflect.TypeOf((*RecommendationReasonCode)(nil)).Elem()
 }
@@ -3280,6 +5786,13 @@ const (
 	RecommendationTypeV1 = RecommendationType("V1")
 )
 
+// Values returns all known values of the RecommendationType enum
+func (e RecommendationType) Values() []RecommendationType {
+	return []RecommendationType{
+		RecommendationTypeV1,
+	}
+}
+
 func init() {
 	t["RecommendationType"] = reflect.TypeOf((*RecommendationType)(nil)).Elem()
 }
@@ -3296,6 +5809,19 @@ const (
 	ReplicationDiskConfigFaultReasonForFaultReconfigureDiskReplicationIdNotAllowed = ReplicationDiskConfigFaultReasonForFault("reconfigureDiskReplicationIdNotAllowed")
 )
 
+// Values returns all known values of the ReplicationDiskConfigFaultReasonForFault enum
+func (e ReplicationDiskConfigFaultReasonForFault) Values() []ReplicationDiskConfigFaultReasonForFault {
+	return []ReplicationDiskConfigFaultReasonForFault{
+		ReplicationDiskConfigFaultReasonForFaultDiskNotFound,
+		ReplicationDiskConfigFaultReasonForFaultDiskTypeNotSupported,
+		ReplicationDiskConfigFaultReasonForFaultInvalidDiskKey,
+		ReplicationDiskConfigFaultReasonForFaultInvalidDiskReplicationId,
+		ReplicationDiskConfigFaultReasonForFaultDuplicateDiskReplicationId,
+		ReplicationDiskConfigFaultReasonForFaultInvalidPersistentFilePath,
+		ReplicationDiskConfigFaultReasonForFaultReconfigureDiskReplicationIdNotAllowed,
+	}
+}
+
 func init() {
 	t["ReplicationDiskConfigFaultReasonForFault"] = reflect.TypeOf((*ReplicationDiskConfigFaultReasonForFault)(nil)).Elem()
 }
@@ -3322,6 +5848,29 @@ const (
 	ReplicationVmConfigFaultReasonForFaultIncompatibleDevice                       = ReplicationVmConfigFaultReasonForFault("incompatibleDevice")
 )
 
+// Values returns all known values of the ReplicationVmConfigFaultReasonForFault enum
+func (e ReplicationVmConfigFaultReasonForFault) Values() []ReplicationVmConfigFaultReasonForFault {
+	return []ReplicationVmConfigFaultReasonForFault{
+		ReplicationVmConfigFaultReasonForFaultIncompatibleHwVersion,
+		ReplicationVmConfigFaultReasonForFaultInvalidVmReplicationId,
+		ReplicationVmConfigFaultReasonForFaultInvalidGenerationNumber,
+		ReplicationVmConfigFaultReasonForFaultOutOfBoundsRpoValue,
+		ReplicationVmConfigFaultReasonForFaultInvalidDestinationIpAddress,
+		ReplicationVmConfigFaultReasonForFaultInvalidDestinationPort,
+		ReplicationVmConfigFaultReasonForFaultInvalidExtraVmOptions,
+		ReplicationVmConfigFaultReasonForFaultStaleGenerationNumber,
+		ReplicationVmConfigFaultReasonForFaultReconfigureVmReplicationIdNotAllowed,
+		ReplicationVmConfigFaultReasonForFaultCannotRetrieveVmReplicationConfiguration,
+		ReplicationVmConfigFaultReasonForFaultReplicationAlreadyEnabled,
+		ReplicationVmConfigFaultReasonForFaultInvalidPriorConfiguration,
+		ReplicationVmConfigFaultReasonForFaultReplicationNotEnabled,
+		ReplicationVmConfigFaultReasonForFaultReplicationConfigurationFailed,
+		ReplicationVmConfigFaultReasonForFaultEncryptedVm,
+		ReplicationVmConfigFaultReasonForFaultInvalidThumbprint,
+		ReplicationVmConfigFaultReasonForFaultIncompatibleDevice,
+	}
+}
+
 func init() {
 	t["ReplicationVmConfigFaultReasonForFault"] = reflect.TypeOf((*ReplicationVmConfigFaultReasonForFault)(nil)).Elem()
 }
@@ -3340,6 +5889,21 @@ const (
 	ReplicationVmFaultReasonForFaultGroupExist         = ReplicationVmFaultReasonForFault("groupExist")
 )
 
+// Values returns all known values of the ReplicationVmFaultReasonForFault enum
+func (e ReplicationVmFaultReasonForFault) Values() []ReplicationVmFaultReasonForFault {
+	return []ReplicationVmFaultReasonForFault{
+		ReplicationVmFaultReasonForFaultNotConfigured,
+		ReplicationVmFaultReasonForFaultPoweredOff,
+		ReplicationVmFaultReasonForFaultSuspended,
+		ReplicationVmFaultReasonForFaultPoweredOn,
+		ReplicationVmFaultReasonForFaultOfflineReplicating,
+		ReplicationVmFaultReasonForFaultInvalidState,
+		ReplicationVmFaultReasonForFaultInvalidInstanceId,
+		ReplicationVmFaultReasonForFaultCloseDiskError,
+		ReplicationVmFaultReasonForFaultGroupExist,
+	}
+}
+
 func init() {
 	t["ReplicationVmFaultReasonForFault"] = reflect.TypeOf((*ReplicationVmFaultReasonForFault)(nil)).Elem()
 }
@@ -3351,6 +5915,14 @@ const (
 	ReplicationVmInProgressFaultActivityDelta    = ReplicationVmInProgressFaultActivity("delta")
 )
 
+// Values returns all known values of the ReplicationVmInProgressFaultActivity enum
+func (e ReplicationVmInProgressFaultActivity) Values() []ReplicationVmInProgressFaultActivity {
+	return []ReplicationVmInProgressFaultActivity{
+		ReplicationVmInProgressFaultActivityFullSync,
+		ReplicationVmInProgressFaultActivityDelta,
+	}
+}
+
 func init() {
 	t["ReplicationVmInProgressFaultActivity"] = reflect.TypeOf((*ReplicationVmInProgressFaultActivity)(nil)).Elem()
 }
@@ -3366,6 +5938,18 @@ const (
 	ReplicationVmStateError   = ReplicationVmState("error")
 )
 
+// Values returns all known values of the ReplicationVmState enum
+func (e ReplicationVmState) Values() []ReplicationVmState {
+	return []ReplicationVmState{
+		ReplicationVmStateNone,
+		ReplicationVmStatePaused,
+		ReplicationVmStateSyncing,
+		ReplicationVmStateIdle,
+		ReplicationVmStateActive,
+		ReplicationVmStateError,
+	}
+}
+
 func init() {
 	t["ReplicationVmState"] = reflect.TypeOf((*ReplicationVmState)(nil)).Elem()
 }
@@ -3377,6 +5961,14 @@ const (
 	ResourceConfigSpecScaleSharesBehaviorScaleCpuAndMemoryShares = ResourceConfigSpecScaleSharesBehavior("scaleCpuAndMemoryShares")
 )
 
+// Values returns all known values of the ResourceConfigSpecScaleSharesBehavior enum
+func (e ResourceConfigSpecScaleSharesBehavior) Values() []ResourceConfigSpecScaleSharesBehavior {
+	return []ResourceConfigSpecScaleSharesBehavior{
+		ResourceConfigSpecScaleSharesBehaviorDisabled,
+		ResourceConfigSpecScaleSharesBehaviorScaleCpuAndMemoryShares,
+	}
+}
+
 func init() {
 	t["ResourceConfigSpecScaleSharesBehavior"] = reflect.TypeOf((*ResourceConfigSpecScaleSharesBehavior)(nil)).Elem()
 }
@@ -3389,6 +5981,15 @@ const (
 	ScheduledHardwareUpgradeInfoHardwareUpgradePolicyAlways         = ScheduledHardwareUpgradeInfoHardwareUpgradePolicy("always")
 )
 
+// Values returns all known values of the ScheduledHardwareUpgradeInfoHardwareUpgradePolicy enum
+func (e ScheduledHardwareUpgradeInfoHardwareUpgradePolicy) Values() []ScheduledHardwareUpgradeInfoHardwareUpgradePolicy {
+	return []ScheduledHardwareUpgradeInfoHardwareUpgradePolicy{
+		ScheduledHardwareUpgradeInfoHardwareUpgradePolicyNever,
+		ScheduledHardwareUpgradeInfoHardwareUpgradePolicyOnSoftPowerOff,
+		ScheduledHardwareUpgradeInfoHardwareUpgradePolicyAlways,
+	}
+}
+
 func init() {
 	t["ScheduledHardwareUpgradeInfoHardwareUpgradePolicy"] = reflect.TypeOf((*ScheduledHardwareUpgradeInfoHardwareUpgradePolicy)(nil)).Elem()
 }
@@ -3402,6 +6003,16 @@ const (
 	ScheduledHardwareUpgradeInfoHardwareUpgradeStatusFailed  = ScheduledHardwareUpgradeInfoHardwareUpgradeStatus("failed")
 )
 
+// Values returns all known values of the ScheduledHardwareUpgradeInfoHardwareUpgradeStatus enum
+func (e ScheduledHardwareUpgradeInfoHardwareUpgradeStatus) Values() []ScheduledHardwareUpgradeInfoHardwareUpgradeStatus {
+	return []ScheduledHardwareUpgradeInfoHardwareUpgradeStatus{
+		ScheduledHardwareUpgradeInfoHardwareUpgradeStatusNone,
+		ScheduledHardwareUpgradeInfoHardwareUpgradeStatusPending,
+		ScheduledHardwareUpgradeInfoHardwareUpgradeStatusSuccess,
+		ScheduledHardwareUpgradeInfoHardwareUpgradeStatusFailed,
+	}
+}
+
 func init() {
 	t["ScheduledHardwareUpgradeInfoHardwareUpgradeStatus"] = reflect.TypeOf((*ScheduledHardwareUpgradeInfoHardwareUpgradeStatus)(nil)).Elem()
 }
@@ -3416,6 +6027,17 @@ const (
 	ScsiDiskTypeUnknown            = ScsiDiskType("unknown")
 )
 
+// Values returns all known values of the ScsiDiskType enum
+func (e ScsiDiskType) Values() []ScsiDiskType {
+	return []ScsiDiskType{
+		ScsiDiskTypeNative512,
+		ScsiDiskTypeEmulated512,
+		ScsiDiskTypeNative4k,
+		ScsiDiskTypeSoftwareEmulated4k,
+		ScsiDiskTypeUnknown,
+	}
+}
+
 func init() {
 	t["ScsiDiskType"] = reflect.TypeOf((*ScsiDiskType)(nil)).Elem()
 }
@@ -3429,6 +6051,16 @@ const (
 	ScsiLunDescriptorQualityUnknownQuality = ScsiLunDescriptorQuality("unknownQuality")
 )
 
+// Values returns all known values of the ScsiLunDescriptorQuality enum
+func (e ScsiLunDescriptorQuality) Values() []ScsiLunDescriptorQuality {
+	return []ScsiLunDescriptorQuality{
+		ScsiLunDescriptorQualityHighQuality,
+		ScsiLunDescriptorQualityMediumQuality,
+		ScsiLunDescriptorQualityLowQuality,
+		ScsiLunDescriptorQualityUnknownQuality,
+	}
+}
+
 func init() {
 	t["ScsiLunDescriptorQuality"] = reflect.TypeOf((*ScsiLunDescriptorQuality)(nil)).Elem()
 }
@@ -3446,6 +6078,20 @@ const (
 	ScsiLunStateTimeout           = ScsiLunState("timeout")
 )
 
+// Values returns all known values of the ScsiLunState enum
+func (e ScsiLunState) Values() []ScsiLunState {
+	return []ScsiLunState{
+		ScsiLunStateUnknownState,
+		ScsiLunStateOk,
+		ScsiLunStateError,
+		ScsiLunStateOff,
+		ScsiLunStateQuiesced,
+		ScsiLunStateDegraded,
+		ScsiLunStateLostCommunication,
+		ScsiLunStateTimeout,
+	}
+}
+
 func init() {
 	t["ScsiLunState"] = reflect.TypeOf((*ScsiLunState)(nil)).Elem()
 }
@@ -3468,6 +6114,25 @@ const (
 	ScsiLunTypeUnknown                = ScsiLunType("unknown")
 )
 
+// Values returns all known values of the ScsiLunType enum
+func (e ScsiLunType) Values() []ScsiLunType {
+	return []ScsiLunType{
+		ScsiLunTypeDisk,
+		ScsiLunTypeTape,
+		ScsiLunTypePrinter,
+		ScsiLunTypeProcessor,
+		ScsiLunTypeWorm,
+		ScsiLunTypeCdrom,
+		ScsiLunTypeScanner,
+		ScsiLunTypeOpticalDevice,
+		ScsiLunTypeMediaChanger,
+		ScsiLunTypeCommunications,
+		ScsiLunTypeStorageArrayController,
+		ScsiLunTypeEnclosure,
+		ScsiLunTypeUnknown,
+	}
+}
+
 func init() {
 	t["ScsiLunType"] = reflect.TypeOf((*ScsiLunType)(nil)).Elem()
 }
@@ -3480,6 +6145,15 @@ const (
 	ScsiLunVStorageSupportStatusVStorageUnknown     = ScsiLunVStorageSupportStatus("vStorageUnknown")
 )
 
+// Values returns all known values of the ScsiLunVStorageSupportStatus enum
+func (e ScsiLunVStorageSupportStatus) Values() []ScsiLunVStorageSupportStatus {
+	return []ScsiLunVStorageSupportStatus{
+		ScsiLunVStorageSupportStatusVStorageSupported,
+		ScsiLunVStorageSupportStatusVStorageUnsupported,
+		ScsiLunVStorageSupportStatusVStorageUnknown,
+	}
+}
+
 func init() {
 	t["ScsiLunVStorageSupportStatus"] = reflect.TypeOf((*ScsiLunVStorageSupportStatus)(nil)).Elem()
 }
@@ -3492,6 +6166,15 @@ const (
 	SessionManagerGenericServiceTicketTicketTypeVcServiceTicket      = SessionManagerGenericServiceTicketTicketType("VcServiceTicket")
 )
 
+// Values returns all known values of the SessionManagerGenericServiceTicketTicketType enum
+func (e SessionManagerGenericServiceTicketTicketType) Values() []SessionManagerGenericServiceTicketTicketType {
+	return []SessionManagerGenericServiceTicketTicketType{
+		SessionManagerGenericServiceTicketTicketTypeHttpNfcServiceTicket,
+		SessionManagerGenericServiceTicketTicketTypeHostServiceTicket,
+		SessionManagerGenericServiceTicketTicketTypeVcServiceTicket,
+	}
+}
+
 func init() {
 	t["SessionManagerGenericServiceTicketTicketType"] = reflect.TypeOf((*SessionManagerGenericServiceTicketTicketType)(nil)).Elem()
 }
@@ -3509,6 +6192,20 @@ const (
 	SessionManagerHttpServiceRequestSpecMethodHttpConnect = SessionManagerHttpServiceRequestSpecMethod("httpConnect")
 )
 
+// Values returns all known values of the SessionManagerHttpServiceRequestSpecMethod enum
+func (e SessionManagerHttpServiceRequestSpecMethod) Values() []SessionManagerHttpServiceRequestSpecMethod {
+	return []SessionManagerHttpServiceRequestSpecMethod{
+		SessionManagerHttpServiceRequestSpecMethodHttpOptions,
+		SessionManagerHttpServiceRequestSpecMethodHttpGet,
+		SessionManagerHttpServiceRequestSpecMethodHttpHead,
+		SessionManagerHttpServiceRequestSpecMethodHttpPost,
+		SessionManagerHttpServiceRequestSpecMethodHttpPut,
+		SessionManagerHttpServiceRequestSpecMethodHttpDelete,
+		SessionManagerHttpServiceRequestSpecMethodHttpTrace,
+		SessionManagerHttpServiceRequestSpecMethodHttpConnect,
+	}
+}
+
 func init() {
 	t["SessionManagerHttpServiceRequestSpecMethod"] = reflect.TypeOf((*SessionManagerHttpServiceRequestSpecMethod)(nil)).Elem()
 }
@@ -3522,6 +6219,16 @@ const (
 	SharesLevelCustom = SharesLevel("custom")
 )
 
+// Values returns all known values of the SharesLevel enum
+func (e SharesLevel) Values() []SharesLevel {
+	return []SharesLevel{
+		SharesLevelLow,
+		SharesLevelNormal,
+		SharesLevelHigh,
+		SharesLevelCustom,
+	}
+}
+
 func init() {
 	t["SharesLevel"] = reflect.TypeOf((*SharesLevel)(nil)).Elem()
 }
@@ -3534,6 +6241,15 @@ const (
 	SimpleCommandEncodingSTRING = SimpleCommandEncoding("STRING")
 )
 
+// Values returns all known values of the SimpleCommandEncoding enum
+func (e SimpleCommandEncoding) Values() []SimpleCommandEncoding {
+	return []SimpleCommandEncoding{
+		SimpleCommandEncodingCSV,
+		SimpleCommandEncodingHEX,
+		SimpleCommandEncodingSTRING,
+	}
+}
+
 func init() {
 	t["SimpleCommandEncoding"] = reflect.TypeOf((*SimpleCommandEncoding)(nil)).Elem()
 }
@@ -3547,6 +6263,16 @@ const (
 	SlpDiscoveryMethodSlpManual        = SlpDiscoveryMethod("slpManual")
 )
 
+// Values returns all known values of the SlpDiscoveryMethod enum
+func (e SlpDiscoveryMethod) Values() []SlpDiscoveryMethod {
+	return []SlpDiscoveryMethod{
+		SlpDiscoveryMethodSlpDhcp,
+		SlpDiscoveryMethodSlpAutoUnicast,
+		SlpDiscoveryMethodSlpAutoMulticast,
+		SlpDiscoveryMethodSlpManual,
+	}
+}
+
 func init() {
 	t["SlpDiscoveryMethod"] = reflect.TypeOf((*SlpDiscoveryMethod)(nil)).Elem()
 }
@@ -3561,6 +6287,17 @@ const (
 	SoftwarePackageConstraintGreaterThan      = SoftwarePackageConstraint("greaterThan")
 )
 
+// Values returns all known values of the SoftwarePackageConstraint enum
+func (e SoftwarePackageConstraint) Values() []SoftwarePackageConstraint {
+	return []SoftwarePackageConstraint{
+		SoftwarePackageConstraintEquals,
+		SoftwarePackageConstraintLessThan,
+		SoftwarePackageConstraintLessThanEqual,
+		SoftwarePackageConstraintGreaterThanEqual,
+		SoftwarePackageConstraintGreaterThan,
+	}
+}
+
 func init() {
 	t["SoftwarePackageConstraint"] = reflect.TypeOf((*SoftwarePackageConstraint)(nil)).Elem()
 }
@@ -3573,6 +6310,15 @@ const (
 	SoftwarePackageVibTypeMeta     = SoftwarePackageVibType("meta")
 )
 
+// Values returns all known values of the SoftwarePackageVibType enum
+func (e SoftwarePackageVibType) Values() []SoftwarePackageVibType {
+	return []SoftwarePackageVibType{
+		SoftwarePackageVibTypeBootbank,
+		SoftwarePackageVibTypeTools,
+		SoftwarePackageVibTypeMeta,
+	}
+}
+
 func init() {
 	t["SoftwarePackageVibType"] = reflect.TypeOf((*SoftwarePackageVibType)(nil)).Elem()
 }
@@ -3584,6 +6330,14 @@ const (
 	StateAlarmOperatorIsUnequal = StateAlarmOperator("isUnequal")
 )
 
+// Values returns all known values of the StateAlarmOperator enum
+func (e StateAlarmOperator) Values() []StateAlarmOperator {
+	return []StateAlarmOperator{
+		StateAlarmOperatorIsEqual,
+		StateAlarmOperatorIsUnequal,
+	}
+}
+
 func init() {
 	t["StateAlarmOperator"] = reflect.TypeOf((*StateAlarmOperator)(nil)).Elem()
 }
@@ -3595,6 +6349,14 @@ const (
 	StorageDrsPodConfigInfoBehaviorAutomated = StorageDrsPodConfigInfoBehavior("automated")
 )
 
+// Values returns all known values of the StorageDrsPodConfigInfoBehavior enum
+func (e StorageDrsPodConfigInfoBehavior) Values() []StorageDrsPodConfigInfoBehavior {
+	return []StorageDrsPodConfigInfoBehavior{
+		StorageDrsPodConfigInfoBehaviorManual,
+		StorageDrsPodConfigInfoBehaviorAutomated,
+	}
+}
+
 func init() {
 	t["StorageDrsPodConfigInfoBehavior"] = reflect.TypeOf((*StorageDrsPodConfigInfoBehavior)(nil)).Elem()
 }
@@ -3606,6 +6368,14 @@ const (
 	StorageDrsSpaceLoadBalanceConfigSpaceThresholdModeFreeSpace   = StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode("freeSpace")
 )
 
+// Values returns all known values of the StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode enum
+func (e StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode) Values() []StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode {
+	return []StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode{
+		StorageDrsSpaceLoadBalanceConfigSpaceThresholdModeUtilization,
+		StorageDrsSpaceLoadBalanceConfigSpaceThresholdModeFreeSpace,
+	}
+}
+
 func init() {
 	t["StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode"] = reflect.TypeOf((*StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode)(nil)).Elem()
 }
@@ -3617,6 +6387,14 @@ const (
 	StorageIORMThresholdModeManual    = StorageIORMThresholdMode("manual")
 )
 
+// Values returns all known values of the StorageIORMThresholdMode enum
+func (e StorageIORMThresholdMode) Values() []StorageIORMThresholdMode {
+	return []StorageIORMThresholdMode{
+		StorageIORMThresholdModeAutomatic,
+		StorageIORMThresholdModeManual,
+	}
+}
+
 func init() {
 	t["StorageIORMThresholdMode"] = reflect.TypeOf((*StorageIORMThresholdMode)(nil)).Elem()
 }
@@ -3630,6 +6408,16 @@ const (
 	StoragePlacementSpecPlacementTypeClone       = StoragePlacementSpecPlacementType("clone")
 )
 
+// Values returns all known values of the StoragePlacementSpecPlacementType enum
+func (e StoragePlacementSpecPlacementType) Values() []StoragePlacementSpecPlacementType {
+	return []StoragePlacementSpecPlacementType{
+		StoragePlacementSpecPlacementTypeCreate,
+		StoragePlacementSpecPlacementTypeReconfigure,
+		StoragePlacementSpecPlacementTypeRelocate,
+		StoragePlacementSpecPlacementTypeClone,
+	}
+}
+
 func init() {
 	t["StoragePlacementSpecPlacementType"] = reflect.TypeOf((*StoragePlacementSpecPlacementType)(nil)).Elem()
 }
@@ -3642,6 +6430,15 @@ const (
 	TaskFilterSpecRecursionOptionAll      = TaskFilterSpecRecursionOption("all")
 )
 
+// Values returns all known values of the TaskFilterSpecRecursionOption enum
+func (e TaskFilterSpecRecursionOption) Values() []TaskFilterSpecRecursionOption {
+	return []TaskFilterSpecRecursionOption{
+		TaskFilterSpecRecursionOptionSelf,
+		TaskFilterSpecRecursionOptionChildren,
+		TaskFilterSpecRecursionOptionAll,
+	}
+}
+
 func init() {
 	t["TaskFilterSpecRecursionOption"] = reflect.TypeOf((*TaskFilterSpecRecursionOption)(nil)).Elem()
 }
@@ -3654,6 +6451,15 @@ const (
 	TaskFilterSpecTimeOptionCompletedTime = TaskFilterSpecTimeOption("completedTime")
 )
 
+// Values returns all known values of the TaskFilterSpecTimeOption enum
+func (e TaskFilterSpecTimeOption) Values() []TaskFilterSpecTimeOption {
+	return []TaskFilterSpecTimeOption{
+		TaskFilterSpecTimeOptionQueuedTime,
+		TaskFilterSpecTimeOptionStartedTime,
+		TaskFilterSpecTimeOptionCompletedTime,
+	}
+}
+
 func init() {
 	t["TaskFilterSpecTimeOption"] = reflect.TypeOf((*TaskFilterSpecTimeOption)(nil)).Elem()
 }
@@ -3667,6 +6473,16 @@ const (
 	TaskInfoStateError   = TaskInfoState("error")
 )
 
+// Values returns all known values of the TaskInfoState enum
+func (e TaskInfoState) Values() []TaskInfoState {
+	return []TaskInfoState{
+		TaskInfoStateQueued,
+		TaskInfoStateRunning,
+		TaskInfoStateSuccess,
+		TaskInfoStateError,
+	}
+}
+
 func init() {
 	t["TaskInfoState"] = reflect.TypeOf((*TaskInfoState)(nil)).Elem()
 }
@@ -3678,6 +6494,14 @@ const (
 	ThirdPartyLicenseAssignmentFailedReasonModuleNotInstalled      = ThirdPartyLicenseAssignmentFailedReason("moduleNotInstalled")
 )
 
+// Values returns all known values of the ThirdPartyLicenseAssignmentFailedReason enum
+func (e ThirdPartyLicenseAssignmentFailedReason) Values() []ThirdPartyLicenseAssignmentFailedReason {
+	return []ThirdPartyLicenseAssignmentFailedReason{
+		ThirdPartyLicenseAssignmentFailedReasonLicenseAssignmentFailed,
+		ThirdPartyLicenseAssignmentFailedReasonModuleNotInstalled,
+	}
+}
+
 func init() {
 	t["ThirdPartyLicenseAssignmentFailedReason"] = reflect.TypeOf((*ThirdPartyLicenseAssignmentFailedReason)(nil)).Elem()
 }
@@ -3689,6 +6513,14 @@ const (
 	UpgradePolicyUpgradeAtPowerCycle = UpgradePolicy("upgradeAtPowerCycle")
 )
 
+// Values returns all known values of the UpgradePolicy enum
+func (e UpgradePolicy) Values() []UpgradePolicy {
+	return []UpgradePolicy{
+		UpgradePolicyManual,
+		UpgradePolicyUpgradeAtPowerCycle,
+	}
+}
+
 func init() {
 	t["UpgradePolicy"] = reflect.TypeOf((*UpgradePolicy)(nil)).Elem()
 }
@@ -3703,6 +6535,17 @@ const (
 	VAppAutoStartActionSuspend       = VAppAutoStartAction("suspend")
 )
 
+// Values returns all known values of the VAppAutoStartAction enum
+func (e VAppAutoStartAction) Values() []VAppAutoStartAction {
+	return []VAppAutoStartAction{
+		VAppAutoStartActionNone,
+		VAppAutoStartActionPowerOn,
+		VAppAutoStartActionPowerOff,
+		VAppAutoStartActionGuestShutdown,
+		VAppAutoStartActionSuspend,
+	}
+}
+
 func init() {
 	t["VAppAutoStartAction"] = reflect.TypeOf((*VAppAutoStartAction)(nil)).Elem()
 }
@@ -3715,6 +6558,15 @@ const (
 	VAppCloneSpecProvisioningTypeThick        = VAppCloneSpecProvisioningType("thick")
 )
 
+// Values returns all known values of the VAppCloneSpecProvisioningType enum
+func (e VAppCloneSpecProvisioningType) Values() []VAppCloneSpecProvisioningType {
+	return []VAppCloneSpecProvisioningType{
+		VAppCloneSpecProvisioningTypeSameAsSource,
+		VAppCloneSpecProvisioningTypeThin,
+		VAppCloneSpecProvisioningTypeThick,
+	}
+}
+
 func init() {
 	t["VAppCloneSpecProvisioningType"] = reflect.TypeOf((*VAppCloneSpecProvisioningType)(nil)).Elem()
 }
@@ -3726,6 +6578,14 @@ const (
 	VAppIPAssignmentInfoAllocationSchemesOvfenv = VAppIPAssignmentInfoAllocationSchemes("ovfenv")
 )
 
+// Values returns all known values of the VAppIPAssignmentInfoAllocationSchemes enum
+func (e VAppIPAssignmentInfoAllocationSchemes) Values() []VAppIPAssignmentInfoAllocationSchemes {
+	return []VAppIPAssignmentInfoAllocationSchemes{
+		VAppIPAssignmentInfoAllocationSchemesDhcp,
+		VAppIPAssignmentInfoAllocationSchemesOvfenv,
+	}
+}
+
 func init() {
 	t["VAppIPAssignmentInfoAllocationSchemes"] = reflect.TypeOf((*VAppIPAssignmentInfoAllocationSchemes)(nil)).Elem()
 }
@@ -3739,6 +6599,16 @@ const (
 	VAppIPAssignmentInfoIpAllocationPolicyFixedAllocatedPolicy = VAppIPAssignmentInfoIpAllocationPolicy("fixedAllocatedPolicy")
 )
 
+// Values returns all known values of the VAppIPAssignmentInfoIpAllocationPolicy enum
+func (e VAppIPAssignmentInfoIpAllocationPolicy) Values() []VAppIPAssignmentInfoIpAllocationPolicy {
+	return []VAppIPAssignmentInfoIpAllocationPolicy{
+		VAppIPAssignmentInfoIpAllocationPolicyDhcpPolicy,
+		VAppIPAssignmentInfoIpAllocationPolicyTransientPolicy,
+		VAppIPAssignmentInfoIpAllocationPolicyFixedPolicy,
+		VAppIPAssignmentInfoIpAllocationPolicyFixedAllocatedPolicy,
+	}
+}
+
 func init() {
 	t["VAppIPAssignmentInfoIpAllocationPolicy"] = reflect.TypeOf((*VAppIPAssignmentInfoIpAllocationPolicy)(nil)).Elem()
 }
@@ -3750,6 +6620,14 @@ const (
 	VAppIPAssignmentInfoProtocolsIPv6 = VAppIPAssignmentInfoProtocols("IPv6")
 )
 
+// Values returns all known values of the VAppIPAssignmentInfoProtocols enum
+func (e VAppIPAssignmentInfoProtocols) Values() []VAppIPAssignmentInfoProtocols {
+	return []VAppIPAssignmentInfoProtocols{
+		VAppIPAssignmentInfoProtocolsIPv4,
+		VAppIPAssignmentInfoProtocolsIPv6,
+	}
+}
+
 func init() {
 	t["VAppIPAssignmentInfoProtocols"] = reflect.TypeOf((*VAppIPAssignmentInfoProtocols)(nil)).Elem()
 }
@@ -3764,6 +6642,17 @@ const (
 	VFlashModuleNotSupportedReasonDiskSizeNotSupported             = VFlashModuleNotSupportedReason("DiskSizeNotSupported")
 )
 
+// Values returns all known values of the VFlashModuleNotSupportedReason enum
+func (e VFlashModuleNotSupportedReason) Values() []VFlashModuleNotSupportedReason {
+	return []VFlashModuleNotSupportedReason{
+		VFlashModuleNotSupportedReasonCacheModeNotSupported,
+		VFlashModuleNotSupportedReasonCacheConsistencyTypeNotSupported,
+		VFlashModuleNotSupportedReasonCacheBlockSizeNotSupported,
+		VFlashModuleNotSupportedReasonCacheReservationNotSupported,
+		VFlashModuleNotSupportedReasonDiskSizeNotSupported,
+	}
+}
+
 func init() {
 	t["VFlashModuleNotSupportedReason"] = reflect.TypeOf((*VFlashModuleNotSupportedReason)(nil)).Elem()
 }
@@ -3775,6 +6664,14 @@ const (
 	VMotionCompatibilityTypeSoftware = VMotionCompatibilityType("software")
 )
 
+// Values returns all known values of the VMotionCompatibilityType enum
+func (e VMotionCompatibilityType) Values() []VMotionCompatibilityType {
+	return []VMotionCompatibilityType{
+		VMotionCompatibilityTypeCpu,
+		VMotionCompatibilityTypeSoftware,
+	}
+}
+
 func init() {
 	t["VMotionCompatibilityType"] = reflect.TypeOf((*VMotionCompatibilityType)(nil)).Elem()
 }
@@ -3788,6 +6685,16 @@ const (
 	VMwareDVSTeamingMatchStatusNonIphashMismatch = VMwareDVSTeamingMatchStatus("nonIphashMismatch")
 )
 
+// Values returns all known values of the VMwareDVSTeamingMatchStatus enum
+func (e VMwareDVSTeamingMatchStatus) Values() []VMwareDVSTeamingMatchStatus {
+	return []VMwareDVSTeamingMatchStatus{
+		VMwareDVSTeamingMatchStatusIphashMatch,
+		VMwareDVSTeamingMatchStatusNonIphashMatch,
+		VMwareDVSTeamingMatchStatusIphashMismatch,
+		VMwareDVSTeamingMatchStatusNonIphashMismatch,
+	}
+}
+
 func init() {
 	t["VMwareDVSTeamingMatchStatus"] = reflect.TypeOf((*VMwareDVSTeamingMatchStatus)(nil)).Elem()
 }
@@ -3800,6 +6707,15 @@ const (
 	VMwareDVSVspanSessionEncapTypeErspan3 = VMwareDVSVspanSessionEncapType("erspan3")
 )
 
+// Values returns all known values of the VMwareDVSVspanSessionEncapType enum
+func (e VMwareDVSVspanSessionEncapType) Values() []VMwareDVSVspanSessionEncapType {
+	return []VMwareDVSVspanSessionEncapType{
+		VMwareDVSVspanSessionEncapTypeGre,
+		VMwareDVSVspanSessionEncapTypeErspan2,
+		VMwareDVSVspanSessionEncapTypeErspan3,
+	}
+}
+
 func init() {
 	t["VMwareDVSVspanSessionEncapType"] = reflect.TypeOf((*VMwareDVSVspanSessionEncapType)(nil)).Elem()
 }
@@ -3814,6 +6730,17 @@ const (
 	VMwareDVSVspanSessionTypeEncapsulatedRemoteMirrorSource = VMwareDVSVspanSessionType("encapsulatedRemoteMirrorSource")
 )
 
+// Values returns all known values of the VMwareDVSVspanSessionType enum
+func (e VMwareDVSVspanSessionType) Values() []VMwareDVSVspanSessionType {
+	return []VMwareDVSVspanSessionType{
+		VMwareDVSVspanSessionTypeMixedDestMirror,
+		VMwareDVSVspanSessionTypeDvPortMirror,
+		VMwareDVSVspanSessionTypeRemoteMirrorSource,
+		VMwareDVSVspanSessionTypeRemoteMirrorDest,
+		VMwareDVSVspanSessionTypeEncapsulatedRemoteMirrorSource,
+	}
+}
+
 func init() {
 	t["VMwareDVSVspanSessionType"] = reflect.TypeOf((*VMwareDVSVspanSessionType)(nil)).Elem()
 }
@@ -3825,6 +6752,14 @@ const (
 	VMwareDvsLacpApiVersionMultipleLag = VMwareDvsLacpApiVersion("multipleLag")
 )
 
+// Values returns all known values of the VMwareDvsLacpApiVersion enum
+func (e VMwareDvsLacpApiVersion) Values() []VMwareDvsLacpApiVersion {
+	return []VMwareDvsLacpApiVersion{
+		VMwareDvsLacpApiVersionSingleLag,
+		VMwareDvsLacpApiVersionMultipleLag,
+	}
+}
+
 func init() {
 	t["VMwareDvsLacpApiVersion"] = reflect.TypeOf((*VMwareDvsLacpApiVersion)(nil)).Elem()
 }
@@ -3854,6 +6789,32 @@ const (
 	VMwareDvsLacpLoadBalanceAlgorithmSrcPortId               = VMwareDvsLacpLoadBalanceAlgorithm("srcPortId")
 )
 
+// Values returns all known values of the VMwareDvsLacpLoadBalanceAlgorithm enum
+func (e VMwareDvsLacpLoadBalanceAlgorithm) Values() []VMwareDvsLacpLoadBalanceAlgorithm {
+	return []VMwareDvsLacpLoadBalanceAlgorithm{
+		VMwareDvsLacpLoadBalanceAlgorithmSrcMac,
+		VMwareDvsLacpLoadBalanceAlgorithmDestMac,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestMac,
+		VMwareDvsLacpLoadBalanceAlgorithmDestIpVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcIpVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestIpVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmDestTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmDestIpTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcIpTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestIpTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmDestIpTcpUdpPortVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcIpTcpUdpPortVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestIpTcpUdpPortVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmDestIp,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcIp,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestIp,
+		VMwareDvsLacpLoadBalanceAlgorithmVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcPortId,
+	}
+}
+
 func init() {
 	t["VMwareDvsLacpLoadBalanceAlgorithm"] = reflect.TypeOf((*VMwareDvsLacpLoadBalanceAlgorithm)(nil)).Elem()
 }
@@ -3865,6 +6826,14 @@ const (
 	VMwareDvsMulticastFilteringModeSnooping        = VMwareDvsMulticastFilteringMode("snooping")
 )
 
+// Values returns all known values of the VMwareDvsMulticastFilteringMode enum
+func (e VMwareDvsMulticastFilteringMode) Values() []VMwareDvsMulticastFilteringMode {
+	return []VMwareDvsMulticastFilteringMode{
+		VMwareDvsMulticastFilteringModeLegacyFiltering,
+		VMwareDvsMulticastFilteringModeSnooping,
+	}
+}
+
 func init() {
 	t["VMwareDvsMulticastFilteringMode"] = reflect.TypeOf((*VMwareDvsMulticastFilteringMode)(nil)).Elem()
 }
@@ -3876,6 +6845,14 @@ const (
 	VMwareUplinkLacpModePassive = VMwareUplinkLacpMode("passive")
 )
 
+// Values returns all known values of the VMwareUplinkLacpMode enum
+func (e VMwareUplinkLacpMode) Values() []VMwareUplinkLacpMode {
+	return []VMwareUplinkLacpMode{
+		VMwareUplinkLacpModeActive,
+		VMwareUplinkLacpModePassive,
+	}
+}
+
 func init() {
 	t["VMwareUplinkLacpMode"] = reflect.TypeOf((*VMwareUplinkLacpMode)(nil)).Elem()
 }
@@ -3887,6 +6864,14 @@ const (
 	VMwareUplinkLacpTimeoutModeSlow = VMwareUplinkLacpTimeoutMode("slow")
 )
 
+// Values returns all known values of the VMwareUplinkLacpTimeoutMode enum
+func (e VMwareUplinkLacpTimeoutMode) Values() []VMwareUplinkLacpTimeoutMode {
+	return []VMwareUplinkLacpTimeoutMode{
+		VMwareUplinkLacpTimeoutModeFast,
+		VMwareUplinkLacpTimeoutModeSlow,
+	}
+}
+
 func init() {
 	t["VMwareUplinkLacpTimeoutMode"] = reflect.TypeOf((*VMwareUplinkLacpTimeoutMode)(nil)).Elem()
 }
@@ -3897,6 +6882,13 @@ const (
 	VStorageObjectConsumptionTypeDisk = VStorageObjectConsumptionType("disk")
 )
 
+// Values returns all known values of the VStorageObjectConsumptionType enum
+func (e VStorageObjectConsumptionType) Values() []VStorageObjectConsumptionType {
+	return []VStorageObjectConsumptionType{
+		VStorageObjectConsumptionTypeDisk,
+	}
+}
+
 func init() {
 	t["VStorageObjectConsumptionType"] = reflect.TypeOf((*VStorageObjectConsumptionType)(nil)).Elem()
 }
@@ -3910,6 +6902,16 @@ const (
 	ValidateMigrationTestTypeResourceTests          = ValidateMigrationTestType("resourceTests")
 )
 
+// Values returns all known values of the ValidateMigrationTestType enum
+func (e ValidateMigrationTestType) Values() []ValidateMigrationTestType {
+	return []ValidateMigrationTestType{
+		ValidateMigrationTestTypeSourceTests,
+		ValidateMigrationTestTypeCompatibilityTests,
+		ValidateMigrationTestTypeDiskAccessibilityTests,
+		ValidateMigrationTestTypeResourceTests,
+	}
+}
+
 func init() {
 	t["ValidateMigrationTestType"] = reflect.TypeOf((*ValidateMigrationTestType)(nil)).Elem()
 }
@@ -3922,6 +6924,15 @@ const (
 	VchaClusterModeMaintenance = VchaClusterMode("maintenance")
 )
 
+// Values returns all known values of the VchaClusterMode enum
+func (e VchaClusterMode) Values() []VchaClusterMode {
+	return []VchaClusterMode{
+		VchaClusterModeEnabled,
+		VchaClusterModeDisabled,
+		VchaClusterModeMaintenance,
+	}
+}
+
 func init() {
 	t["VchaClusterMode"] = reflect.TypeOf((*VchaClusterMode)(nil)).Elem()
 }
@@ -3934,6 +6945,15 @@ const (
 	VchaClusterStateIsolated = VchaClusterState("isolated")
 )
 
+// Values returns all known values of the VchaClusterState enum
+func (e VchaClusterState) Values() []VchaClusterState {
+	return []VchaClusterState{
+		VchaClusterStateHealthy,
+		VchaClusterStateDegraded,
+		VchaClusterStateIsolated,
+	}
+}
+
 func init() {
 	t["VchaClusterState"] = reflect.TypeOf((*VchaClusterState)(nil)).Elem()
 }
@@ -3946,6 +6966,15 @@ const (
 	VchaNodeRoleWitness = VchaNodeRole("witness")
 )
 
+// Values returns all known values of the VchaNodeRole enum
+func (e VchaNodeRole) Values() []VchaNodeRole {
+	return []VchaNodeRole{
+		VchaNodeRoleActive,
+		VchaNodeRolePassive,
+		VchaNodeRoleWitness,
+	}
+}
+
 func init() {
 	t["VchaNodeRole"] = reflect.TypeOf((*VchaNodeRole)(nil)).Elem()
 }
@@ -3957,6 +6986,14 @@ const (
 	VchaNodeStateDown = VchaNodeState("down")
 )
 
+// Values returns all known values of the VchaNodeState enum
+func (e VchaNodeState) Values() []VchaNodeState {
+	return []VchaNodeState{
+		VchaNodeStateUp,
+		VchaNodeStateDown,
+	}
+}
+
 func init() {
 	t["VchaNodeState"] = reflect.TypeOf((*VchaNodeState)(nil)).Elem()
 }
@@ -3970,6 +7007,16 @@ const (
 	VchaStatePrepared      = VchaState("prepared")
 )
 
+// Values returns all known values of the VchaState enum
+func (e VchaState) Values() []VchaState {
+	return []VchaState{
+		VchaStateConfigured,
+		VchaStateNotConfigured,
+		VchaStateInvalid,
+		VchaStatePrepared,
+	}
+}
+
 func init() {
 	t["VchaState"] = reflect.TypeOf((*VchaState)(nil)).Elem()
 }
@@ -3983,6 +7030,16 @@ const (
 	VirtualAppVAppStateStopping = VirtualAppVAppState("stopping")
 )
 
+// Values returns all known values of the VirtualAppVAppState enum
+func (e VirtualAppVAppState) Values() []VirtualAppVAppState {
+	return []VirtualAppVAppState{
+		VirtualAppVAppStateStarted,
+		VirtualAppVAppStateStopped,
+		VirtualAppVAppStateStarting,
+		VirtualAppVAppStateStopping,
+	}
+}
+
 func init() {
 	t["VirtualAppVAppState"] = reflect.TypeOf((*VirtualAppVAppState)(nil)).Elem()
 }
@@ -3995,6 +7052,15 @@ const (
 	VirtualDeviceConfigSpecFileOperationReplace = VirtualDeviceConfigSpecFileOperation("replace")
 )
 
+// Values returns all known values of the VirtualDeviceConfigSpecFileOperation enum
+func (e VirtualDeviceConfigSpecFileOperation) Values() []VirtualDeviceConfigSpecFileOperation {
+	return []VirtualDeviceConfigSpecFileOperation{
+		VirtualDeviceConfigSpecFileOperationCreate,
+		VirtualDeviceConfigSpecFileOperationDestroy,
+		VirtualDeviceConfigSpecFileOperationReplace,
+	}
+}
+
 func init() {
 	t["VirtualDeviceConfigSpecFileOperation"] = reflect.TypeOf((*VirtualDeviceConfigSpecFileOperation)(nil)).Elem()
 }
@@ -4007,6 +7073,15 @@ const (
 	VirtualDeviceConfigSpecOperationEdit   = VirtualDeviceConfigSpecOperation("edit")
 )
 
+// Values returns all known values of the VirtualDeviceConfigSpecOperation enum
+func (e VirtualDeviceConfigSpecOperation) Values() []VirtualDeviceConfigSpecOperation {
+	return []VirtualDeviceConfigSpecOperation{
+		VirtualDeviceConfigSpecOperationAdd,
+		VirtualDeviceConfigSpecOperationRemove,
+		VirtualDeviceConfigSpecOperationEdit,
+	}
+}
+
 func init() {
 	t["VirtualDeviceConfigSpecOperation"] = reflect.TypeOf((*VirtualDeviceConfigSpecOperation)(nil)).Elem()
 }
@@ -4019,6 +7094,15 @@ const (
 	VirtualDeviceConnectInfoMigrateConnectOpUnset      = VirtualDeviceConnectInfoMigrateConnectOp("unset")
 )
 
+// Values returns all known values of the VirtualDeviceConnectInfoMigrateConnectOp enum
+func (e VirtualDeviceConnectInfoMigrateConnectOp) Values() []VirtualDeviceConnectInfoMigrateConnectOp {
+	return []VirtualDeviceConnectInfoMigrateConnectOp{
+		VirtualDeviceConnectInfoMigrateConnectOpConnect,
+		VirtualDeviceConnectInfoMigrateConnectOpDisconnect,
+		VirtualDeviceConnectInfoMigrateConnectOpUnset,
+	}
+}
+
 func init() {
 	t["VirtualDeviceConnectInfoMigrateConnectOp"] = reflect.TypeOf((*VirtualDeviceConnectInfoMigrateConnectOp)(nil)).Elem()
 }
@@ -4032,6 +7116,16 @@ const (
 	VirtualDeviceConnectInfoStatusUntried            = VirtualDeviceConnectInfoStatus("untried")
 )
 
+// Values returns all known values of the VirtualDeviceConnectInfoStatus enum
+func (e VirtualDeviceConnectInfoStatus) Values() []VirtualDeviceConnectInfoStatus {
+	return []VirtualDeviceConnectInfoStatus{
+		VirtualDeviceConnectInfoStatusOk,
+		VirtualDeviceConnectInfoStatusRecoverableError,
+		VirtualDeviceConnectInfoStatusUnrecoverableError,
+		VirtualDeviceConnectInfoStatusUntried,
+	}
+}
+
 func init() {
 	t["VirtualDeviceConnectInfoStatus"] = reflect.TypeOf((*VirtualDeviceConnectInfoStatus)(nil)).Elem()
 }
@@ -4046,6 +7140,17 @@ const (
 	VirtualDeviceFileExtensionRdm  = VirtualDeviceFileExtension("rdm")
 )
 
+// Values returns all known values of the VirtualDeviceFileExtension enum
+func (e VirtualDeviceFileExtension) Values() []VirtualDeviceFileExtension {
+	return []VirtualDeviceFileExtension{
+		VirtualDeviceFileExtensionIso,
+		VirtualDeviceFileExtensionFlp,
+		VirtualDeviceFileExtensionVmdk,
+		VirtualDeviceFileExtensionDsk,
+		VirtualDeviceFileExtensionRdm,
+	}
+}
+
 func init() {
 	t["VirtualDeviceFileExtension"] = reflect.TypeOf((*VirtualDeviceFileExtension)(nil)).Elem()
 }
@@ -4057,6 +7162,14 @@ const (
 	VirtualDeviceURIBackingOptionDirectionClient = VirtualDeviceURIBackingOptionDirection("client")
 )
 
+// Values returns all known values of the VirtualDeviceURIBackingOptionDirection enum
+func (e VirtualDeviceURIBackingOptionDirection) Values() []VirtualDeviceURIBackingOptionDirection {
+	return []VirtualDeviceURIBackingOptionDirection{
+		VirtualDeviceURIBackingOptionDirectionServer,
+		VirtualDeviceURIBackingOptionDirectionClient,
+	}
+}
+
 func init() {
 	t["VirtualDeviceURIBackingOptionDirection"] = reflect.TypeOf((*VirtualDeviceURIBackingOptionDirection)(nil)).Elem()
 }
@@ -4069,6 +7182,15 @@ const (
 	VirtualDiskAdapterTypeLsiLogic = VirtualDiskAdapterType("lsiLogic")
 )
 
+// Values returns all known values of the VirtualDiskAdapterType enum
+func (e VirtualDiskAdapterType) Values() []VirtualDiskAdapterType {
+	return []VirtualDiskAdapterType{
+		VirtualDiskAdapterTypeIde,
+		VirtualDiskAdapterTypeBusLogic,
+		VirtualDiskAdapterTypeLsiLogic,
+	}
+}
+
 func init() {
 	t["VirtualDiskAdapterType"] = reflect.TypeOf((*VirtualDiskAdapterType)(nil)).Elem()
 }
@@ -4080,6 +7202,14 @@ const (
 	VirtualDiskCompatibilityModePhysicalMode = VirtualDiskCompatibilityMode("physicalMode")
 )
 
+// Values returns all known values of the VirtualDiskCompatibilityMode enum
+func (e VirtualDiskCompatibilityMode) Values() []VirtualDiskCompatibilityMode {
+	return []VirtualDiskCompatibilityMode{
+		VirtualDiskCompatibilityModeVirtualMode,
+		VirtualDiskCompatibilityModePhysicalMode,
+	}
+}
+
 func init() {
 	t["VirtualDiskCompatibilityMode"] = reflect.TypeOf((*VirtualDiskCompatibilityMode)(nil)).Elem()
 }
@@ -4092,6 +7222,15 @@ const (
 	VirtualDiskDeltaDiskFormatSeSparseFormat = VirtualDiskDeltaDiskFormat("seSparseFormat")
 )
 
+// Values returns all known values of the VirtualDiskDeltaDiskFormat enum
+func (e VirtualDiskDeltaDiskFormat) Values() []VirtualDiskDeltaDiskFormat {
+	return []VirtualDiskDeltaDiskFormat{
+		VirtualDiskDeltaDiskFormatRedoLogFormat,
+		VirtualDiskDeltaDiskFormatNativeFormat,
+		VirtualDiskDeltaDiskFormatSeSparseFormat,
+	}
+}
+
 func init() {
 	t["VirtualDiskDeltaDiskFormat"] = reflect.TypeOf((*VirtualDiskDeltaDiskFormat)(nil)).Elem()
 }
@@ -4103,6 +7242,14 @@ const (
 	VirtualDiskDeltaDiskFormatVariantVsanSparseVariant = VirtualDiskDeltaDiskFormatVariant("vsanSparseVariant")
 )
 
+// Values returns all known values of the VirtualDiskDeltaDiskFormatVariant enum
+func (e VirtualDiskDeltaDiskFormatVariant) Values() []VirtualDiskDeltaDiskFormatVariant {
+	return []VirtualDiskDeltaDiskFormatVariant{
+		VirtualDiskDeltaDiskFormatVariantVmfsSparseVariant,
+		VirtualDiskDeltaDiskFormatVariantVsanSparseVariant,
+	}
+}
+
 func init() {
 	t["VirtualDiskDeltaDiskFormatVariant"] = reflect.TypeOf((*VirtualDiskDeltaDiskFormatVariant)(nil)).Elem()
 }
@@ -4118,6 +7265,18 @@ const (
 	VirtualDiskModeAppend                    = VirtualDiskMode("append")
 )
 
+// Values returns all known values of the VirtualDiskMode enum
+func (e VirtualDiskMode) Values() []VirtualDiskMode {
+	return []VirtualDiskMode{
+		VirtualDiskModePersistent,
+		VirtualDiskModeNonpersistent,
+		VirtualDiskModeUndoable,
+		VirtualDiskModeIndependent_persistent,
+		VirtualDiskModeIndependent_nonpersistent,
+		VirtualDiskModeAppend,
+	}
+}
+
 func init() {
 	t["VirtualDiskMode"] = reflect.TypeOf((*VirtualDiskMode)(nil)).Elem()
 }
@@ -4130,6 +7289,15 @@ const (
 	VirtualDiskRuleSpecRuleTypeDisabled     = VirtualDiskRuleSpecRuleType("disabled")
 )
 
+// Values returns all known values of the VirtualDiskRuleSpecRuleType enum
+func (e VirtualDiskRuleSpecRuleType) Values() []VirtualDiskRuleSpecRuleType {
+	return []VirtualDiskRuleSpecRuleType{
+		VirtualDiskRuleSpecRuleTypeAffinity,
+		VirtualDiskRuleSpecRuleTypeAntiAffinity,
+		VirtualDiskRuleSpecRuleTypeDisabled,
+	}
+}
+
 func init() {
 	t["VirtualDiskRuleSpecRuleType"] = reflect.TypeOf((*VirtualDiskRuleSpecRuleType)(nil)).Elem()
 }
@@ -4141,6 +7309,14 @@ const (
 	VirtualDiskSharingSharingMultiWriter = VirtualDiskSharing("sharingMultiWriter")
 )
 
+// Values returns all known values of the VirtualDiskSharing enum
+func (e VirtualDiskSharing) Values() []VirtualDiskSharing {
+	return []VirtualDiskSharing{
+		VirtualDiskSharingSharingNone,
+		VirtualDiskSharingSharingMultiWriter,
+	}
+}
+
 func init() {
 	t["VirtualDiskSharing"] = reflect.TypeOf((*VirtualDiskSharing)(nil)).Elem()
 }
@@ -4163,6 +7339,25 @@ const (
 	VirtualDiskTypeThick            = VirtualDiskType("thick")
 )
 
+// Values returns all known values of the VirtualDiskType enum
+func (e VirtualDiskType) Values() []VirtualDiskType {
+	return []VirtualDiskType{
+		VirtualDiskTypePreallocated,
+		VirtualDiskTypeThin,
+		VirtualDiskTypeSeSparse,
+		VirtualDiskTypeRdm,
+		VirtualDiskTypeRdmp,
+		VirtualDiskTypeRaw,
+		VirtualDiskTypeDelta,
+		VirtualDiskTypeSparse2Gb,
+		VirtualDiskTypeThick2Gb,
+		VirtualDiskTypeEagerZeroedThick,
+		VirtualDiskTypeSparseMonolithic,
+		VirtualDiskTypeFlatMonolithic,
+		VirtualDiskTypeThick,
+	}
+}
+
 func init() {
 	t["VirtualDiskType"] = reflect.TypeOf((*VirtualDiskType)(nil)).Elem()
 }
@@ -4174,6 +7369,14 @@ const (
 	VirtualDiskVFlashCacheConfigInfoCacheConsistencyTypeWeak   = VirtualDiskVFlashCacheConfigInfoCacheConsistencyType("weak")
 )
 
+// Values returns all known values of the VirtualDiskVFlashCacheConfigInfoCacheConsistencyType enum
+func (e VirtualDiskVFlashCacheConfigInfoCacheConsistencyType) Values() []VirtualDiskVFlashCacheConfigInfoCacheConsistencyType {
+	return []VirtualDiskVFlashCacheConfigInfoCacheConsistencyType{
+		VirtualDiskVFlashCacheConfigInfoCacheConsistencyTypeStrong,
+		VirtualDiskVFlashCacheConfigInfoCacheConsistencyTypeWeak,
+	}
+}
+
 func init() {
 	t["VirtualDiskVFlashCacheConfigInfoCacheConsistencyType"] = reflect.TypeOf((*VirtualDiskVFlashCacheConfigInfoCacheConsistencyType)(nil)).Elem()
 }
@@ -4185,6 +7388,14 @@ const (
 	VirtualDiskVFlashCacheConfigInfoCacheModeWrite_back = VirtualDiskVFlashCacheConfigInfoCacheMode("write_back")
 )
 
+// Values returns all known values of the VirtualDiskVFlashCacheConfigInfoCacheMode enum
+func (e VirtualDiskVFlashCacheConfigInfoCacheMode) Values() []VirtualDiskVFlashCacheConfigInfoCacheMode {
+	return []VirtualDiskVFlashCacheConfigInfoCacheMode{
+		VirtualDiskVFlashCacheConfigInfoCacheModeWrite_thru,
+		VirtualDiskVFlashCacheConfigInfoCacheModeWrite_back,
+	}
+}
+
 func init() {
 	t["VirtualDiskVFlashCacheConfigInfoCacheMode"] = reflect.TypeOf((*VirtualDiskVFlashCacheConfigInfoCacheMode)(nil)).Elem()
 }
@@ -4197,6 +7408,15 @@ const (
 	VirtualEthernetCardLegacyNetworkDeviceNameHostonly = VirtualEthernetCardLegacyNetworkDeviceName("hostonly")
 )
 
+// Values returns all known values of the VirtualEthernetCardLegacyNetworkDeviceName enum
+func (e VirtualEthernetCardLegacyNetworkDeviceName) Values() []VirtualEthernetCardLegacyNetworkDeviceName {
+	return []VirtualEthernetCardLegacyNetworkDeviceName{
+		VirtualEthernetCardLegacyNetworkDeviceNameBridged,
+		VirtualEthernetCardLegacyNetworkDeviceNameNat,
+		VirtualEthernetCardLegacyNetworkDeviceNameHostonly,
+	}
+}
+
 func init() {
 	t["VirtualEthernetCardLegacyNetworkDeviceName"] = reflect.TypeOf((*VirtualEthernetCardLegacyNetworkDeviceName)(nil)).Elem()
 }
@@ -4209,6 +7429,15 @@ const (
 	VirtualEthernetCardMacTypeAssigned  = VirtualEthernetCardMacType("assigned")
 )
 
+// Values returns all known values of the VirtualEthernetCardMacType enum
+func (e VirtualEthernetCardMacType) Values() []VirtualEthernetCardMacType {
+	return []VirtualEthernetCardMacType{
+		VirtualEthernetCardMacTypeManual,
+		VirtualEthernetCardMacTypeGenerated,
+		VirtualEthernetCardMacTypeAssigned,
+	}
+}
+
 func init() {
 	t["VirtualEthernetCardMacType"] = reflect.TypeOf((*VirtualEthernetCardMacType)(nil)).Elem()
 }
@@ -4221,6 +7450,15 @@ const (
 	VirtualMachineAppHeartbeatStatusTypeAppStatusRed   = VirtualMachineAppHeartbeatStatusType("appStatusRed")
 )
 
+// Values returns all known values of the VirtualMachineAppHeartbeatStatusType enum
+func (e VirtualMachineAppHeartbeatStatusType) Values() []VirtualMachineAppHeartbeatStatusType {
+	return []VirtualMachineAppHeartbeatStatusType{
+		VirtualMachineAppHeartbeatStatusTypeAppStatusGray,
+		VirtualMachineAppHeartbeatStatusTypeAppStatusGreen,
+		VirtualMachineAppHeartbeatStatusTypeAppStatusRed,
+	}
+}
+
 func init() {
 	t["VirtualMachineAppHeartbeatStatusType"] = reflect.TypeOf((*VirtualMachineAppHeartbeatStatusType)(nil)).Elem()
 }
@@ -4232,6 +7470,14 @@ const (
 	VirtualMachineBootOptionsNetworkBootProtocolTypeIpv6 = VirtualMachineBootOptionsNetworkBootProtocolType("ipv6")
 )
 
+// Values returns all known values of the VirtualMachineBootOptionsNetworkBootProtocolType enum
+func (e VirtualMachineBootOptionsNetworkBootProtocolType) Values() []VirtualMachineBootOptionsNetworkBootProtocolType {
+	return []VirtualMachineBootOptionsNetworkBootProtocolType{
+		VirtualMachineBootOptionsNetworkBootProtocolTypeIpv4,
+		VirtualMachineBootOptionsNetworkBootProtocolTypeIpv6,
+	}
+}
+
 func init() {
 	t["VirtualMachineBootOptionsNetworkBootProtocolType"] = reflect.TypeOf((*VirtualMachineBootOptionsNetworkBootProtocolType)(nil)).Elem()
 }
@@ -4244,6 +7490,15 @@ const (
 	VirtualMachineConfigInfoNpivWwnTypeExternal = VirtualMachineConfigInfoNpivWwnType("external")
 )
 
+// Values returns all known values of the VirtualMachineConfigInfoNpivWwnType enum
+func (e VirtualMachineConfigInfoNpivWwnType) Values() []VirtualMachineConfigInfoNpivWwnType {
+	return []VirtualMachineConfigInfoNpivWwnType{
+		VirtualMachineConfigInfoNpivWwnTypeVc,
+		VirtualMachineConfigInfoNpivWwnTypeHost,
+		VirtualMachineConfigInfoNpivWwnTypeExternal,
+	}
+}
+
 func init() {
 	t["VirtualMachineConfigInfoNpivWwnType"] = reflect.TypeOf((*VirtualMachineConfigInfoNpivWwnType)(nil)).Elem()
 }
@@ -4256,6 +7511,15 @@ const (
 	VirtualMachineConfigInfoSwapPlacementTypeHostLocal   = VirtualMachineConfigInfoSwapPlacementType("hostLocal")
 )
 
+// Values returns all known values of the VirtualMachineConfigInfoSwapPlacementType enum
+func (e VirtualMachineConfigInfoSwapPlacementType) Values() []VirtualMachineConfigInfoSwapPlacementType {
+	return []VirtualMachineConfigInfoSwapPlacementType{
+		VirtualMachineConfigInfoSwapPlacementTypeInherit,
+		VirtualMachineConfigInfoSwapPlacementTypeVmDirectory,
+		VirtualMachineConfigInfoSwapPlacementTypeHostLocal,
+	}
+}
+
 func init() {
 	t["VirtualMachineConfigInfoSwapPlacementType"] = reflect.TypeOf((*VirtualMachineConfigInfoSwapPlacementType)(nil)).Elem()
 }
@@ -4268,6 +7532,15 @@ const (
 	VirtualMachineConfigSpecEncryptedFtModesFtEncryptionRequired      = VirtualMachineConfigSpecEncryptedFtModes("ftEncryptionRequired")
 )
 
+// Values returns all known values of the VirtualMachineConfigSpecEncryptedFtModes enum
+func (e VirtualMachineConfigSpecEncryptedFtModes) Values() []VirtualMachineConfigSpecEncryptedFtModes {
+	return []VirtualMachineConfigSpecEncryptedFtModes{
+		VirtualMachineConfigSpecEncryptedFtModesFtEncryptionDisabled,
+		VirtualMachineConfigSpecEncryptedFtModesFtEncryptionOpportunistic,
+		VirtualMachineConfigSpecEncryptedFtModesFtEncryptionRequired,
+	}
+}
+
 func init() {
 	t["VirtualMachineConfigSpecEncryptedFtModes"] = reflect.TypeOf((*VirtualMachineConfigSpecEncryptedFtModes)(nil)).Elem()
 }
@@ -4280,6 +7553,15 @@ const (
 	VirtualMachineConfigSpecEncryptedVMotionModesRequired      = VirtualMachineConfigSpecEncryptedVMotionModes("required")
 )
 
+// Values returns all known values of the VirtualMachineConfigSpecEncryptedVMotionModes enum
+func (e VirtualMachineConfigSpecEncryptedVMotionModes) Values() []VirtualMachineConfigSpecEncryptedVMotionModes {
+	return []VirtualMachineConfigSpecEncryptedVMotionModes{
+		VirtualMachineConfigSpecEncryptedVMotionModesDisabled,
+		VirtualMachineConfigSpecEncryptedVMotionModesOpportunistic,
+		VirtualMachineConfigSpecEncryptedVMotionModesRequired,
+	}
+}
+
 func init() {
 	t["VirtualMachineConfigSpecEncryptedVMotionModes"] = reflect.TypeOf((*VirtualMachineConfigSpecEncryptedVMotionModes)(nil)).Elem()
 }
@@ -4293,6 +7575,16 @@ const (
 	VirtualMachineConfigSpecNpivWwnOpExtend   = VirtualMachineConfigSpecNpivWwnOp("extend")
 )
 
+// Values returns all known values of the VirtualMachineConfigSpecNpivWwnOp enum
+func (e VirtualMachineConfigSpecNpivWwnOp) Values() []VirtualMachineConfigSpecNpivWwnOp {
+	return []VirtualMachineConfigSpecNpivWwnOp{
+		VirtualMachineConfigSpecNpivWwnOpGenerate,
+		VirtualMachineConfigSpecNpivWwnOpSet,
+		VirtualMachineConfigSpecNpivWwnOpRemove,
+		VirtualMachineConfigSpecNpivWwnOpExtend,
+	}
+}
+
 func init() {
 	t["VirtualMachineConfigSpecNpivWwnOp"] = reflect.TypeOf((*VirtualMachineConfigSpecNpivWwnOp)(nil)).Elem()
 }
@@ -4307,6 +7599,17 @@ const (
 	VirtualMachineConnectionStateInvalid      = VirtualMachineConnectionState("invalid")
 )
 
+// Values returns all known values of the VirtualMachineConnectionState enum
+func (e VirtualMachineConnectionState) Values() []VirtualMachineConnectionState {
+	return []VirtualMachineConnectionState{
+		VirtualMachineConnectionStateConnected,
+		VirtualMachineConnectionStateDisconnected,
+		VirtualMachineConnectionStateOrphaned,
+		VirtualMachineConnectionStateInaccessible,
+		VirtualMachineConnectionStateInvalid,
+	}
+}
+
 func init() {
 	t["VirtualMachineConnectionState"] = reflect.TypeOf((*VirtualMachineConnectionState)(nil)).Elem()
 }
@@ -4318,6 +7621,14 @@ const (
 	VirtualMachineCryptoStateLocked   = VirtualMachineCryptoState("locked")
 )
 
+// Values returns all known values of the VirtualMachineCryptoState enum
+func (e VirtualMachineCryptoState) Values() []VirtualMachineCryptoState {
+	return []VirtualMachineCryptoState{
+		VirtualMachineCryptoStateUnlocked,
+		VirtualMachineCryptoStateLocked,
+	}
+}
+
 func init() {
 	t["VirtualMachineCryptoState"] = reflect.TypeOf((*VirtualMachineCryptoState)(nil)).Elem()
 }
@@ -4329,6 +7640,14 @@ const (
 	VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOtherVmNptIncompatibleNetwork = VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther("vmNptIncompatibleNetwork")
 )
 
+// Values returns all known values of the VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther enum
+func (e VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther) Values() []VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther {
+	return []VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther{
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOtherVmNptIncompatibleHost,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOtherVmNptIncompatibleNetwork,
+	}
+}
+
 func init() {
 	t["VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther"] = reflect.TypeOf((*VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther)(nil)).Elem()
 }
@@ -4352,6 +7671,26 @@ const (
 	VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptVMCIActive                             = VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm("vmNptVMCIActive")
 )
 
+// Values returns all known values of the VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm enum
+func (e VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm) Values() []VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm {
+	return []VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm{
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleGuest,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleGuestDriver,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleAdapterType,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptDisabledOrDisconnectedAdapter,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleAdapterFeatures,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleBackingType,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptInsufficientMemoryReservation,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptFaultToleranceOrRecordReplayConfigured,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptConflictingIOChainConfigured,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptMonitorBlocks,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptConflictingOperationInProgress,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptRuntimeError,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptOutOfIntrVector,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptVMCIActive,
+	}
+}
+
 func init() {
 	t["VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm"] = reflect.TypeOf((*VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm)(nil)).Elem()
 }
@@ -4367,6 +7706,18 @@ const (
 	VirtualMachineFaultToleranceStateRunning       = VirtualMachineFaultToleranceState("running")
 )
 
+// Values returns all known values of the VirtualMachineFaultToleranceState enum
+func (e VirtualMachineFaultToleranceState) Values() []VirtualMachineFaultToleranceState {
+	return []VirtualMachineFaultToleranceState{
+		VirtualMachineFaultToleranceStateNotConfigured,
+		VirtualMachineFaultToleranceStateDisabled,
+		VirtualMachineFaultToleranceStateEnabled,
+		VirtualMachineFaultToleranceStateNeedSecondary,
+		VirtualMachineFaultToleranceStateStarting,
+		VirtualMachineFaultToleranceStateRunning,
+	}
+}
+
 func init() {
 	t["VirtualMachineFaultToleranceState"] = reflect.TypeOf((*VirtualMachineFaultToleranceState)(nil)).Elem()
 }
@@ -4379,6 +7730,15 @@ const (
 	VirtualMachineFaultToleranceTypeCheckpointing = VirtualMachineFaultToleranceType("checkpointing")
 )
 
+// Values returns all known values of the VirtualMachineFaultToleranceType enum
+func (e VirtualMachineFaultToleranceType) Values() []VirtualMachineFaultToleranceType {
+	return []VirtualMachineFaultToleranceType{
+		VirtualMachineFaultToleranceTypeUnset,
+		VirtualMachineFaultToleranceTypeRecordReplay,
+		VirtualMachineFaultToleranceTypeCheckpointing,
+	}
+}
+
 func init() {
 	t["VirtualMachineFaultToleranceType"] = reflect.TypeOf((*VirtualMachineFaultToleranceType)(nil)).Elem()
 }
@@ -4411,6 +7771,35 @@ const (
 	VirtualMachineFileLayoutExFileTypeGuestCustomization   = VirtualMachineFileLayoutExFileType("guestCustomization")
 )
 
+// Values returns all known values of the VirtualMachineFileLayoutExFileType enum
+func (e VirtualMachineFileLayoutExFileType) Values() []VirtualMachineFileLayoutExFileType {
+	return []VirtualMachineFileLayoutExFileType{
+		VirtualMachineFileLayoutExFileTypeConfig,
+		VirtualMachineFileLayoutExFileTypeExtendedConfig,
+		VirtualMachineFileLayoutExFileTypeDiskDescriptor,
+		VirtualMachineFileLayoutExFileTypeDiskExtent,
+		VirtualMachineFileLayoutExFileTypeDigestDescriptor,
+		VirtualMachineFileLayoutExFileTypeDigestExtent,
+		VirtualMachineFileLayoutExFileTypeDiskReplicationState,
+		VirtualMachineFileLayoutExFileTypeLog,
+		VirtualMachineFileLayoutExFileTypeStat,
+		VirtualMachineFileLayoutExFileTypeNamespaceData,
+		VirtualMachineFileLayoutExFileTypeNvram,
+		VirtualMachineFileLayoutExFileTypeSnapshotData,
+		VirtualMachineFileLayoutExFileTypeSnapshotMemory,
+		VirtualMachineFileLayoutExFileTypeSnapshotList,
+		VirtualMachineFileLayoutExFileTypeSnapshotManifestList,
+		VirtualMachineFileLayoutExFileTypeSuspend,
+		VirtualMachineFileLayoutExFileTypeSuspendMemory,
+		VirtualMachineFileLayoutExFileTypeSwap,
+		VirtualMachineFileLayoutExFileTypeUwswap,
+		VirtualMachineFileLayoutExFileTypeCore,
+		VirtualMachineFileLayoutExFileTypeScreenshot,
+		VirtualMachineFileLayoutExFileTypeFtMetadata,
+		VirtualMachineFileLayoutExFileTypeGuestCustomization,
+	}
+}
+
 func init() {
 	t["VirtualMachineFileLayoutExFileType"] = reflect.TypeOf((*VirtualMachineFileLayoutExFileType)(nil)).Elem()
 }
@@ -4423,6 +7812,15 @@ const (
 	VirtualMachineFlagInfoMonitorTypeStats   = VirtualMachineFlagInfoMonitorType("stats")
 )
 
+// Values returns all known values of the VirtualMachineFlagInfoMonitorType enum
+func (e VirtualMachineFlagInfoMonitorType) Values() []VirtualMachineFlagInfoMonitorType {
+	return []VirtualMachineFlagInfoMonitorType{
+		VirtualMachineFlagInfoMonitorTypeRelease,
+		VirtualMachineFlagInfoMonitorTypeDebug,
+		VirtualMachineFlagInfoMonitorTypeStats,
+	}
+}
+
 func init() {
 	t["VirtualMachineFlagInfoMonitorType"] = reflect.TypeOf((*VirtualMachineFlagInfoMonitorType)(nil)).Elem()
 }
@@ -4435,6 +7833,15 @@ const (
 	VirtualMachineFlagInfoVirtualExecUsageHvOff  = VirtualMachineFlagInfoVirtualExecUsage("hvOff")
 )
 
+// Values returns all known values of the VirtualMachineFlagInfoVirtualExecUsage enum
+func (e VirtualMachineFlagInfoVirtualExecUsage) Values() []VirtualMachineFlagInfoVirtualExecUsage {
+	return []VirtualMachineFlagInfoVirtualExecUsage{
+		VirtualMachineFlagInfoVirtualExecUsageHvAuto,
+		VirtualMachineFlagInfoVirtualExecUsageHvOn,
+		VirtualMachineFlagInfoVirtualExecUsageHvOff,
+	}
+}
+
 func init() {
 	t["VirtualMachineFlagInfoVirtualExecUsage"] = reflect.TypeOf((*VirtualMachineFlagInfoVirtualExecUsage)(nil)).Elem()
 }
@@ -4447,6 +7854,15 @@ const (
 	VirtualMachineFlagInfoVirtualMmuUsageOff       = VirtualMachineFlagInfoVirtualMmuUsage("off")
 )
 
+// Values returns all known values of the VirtualMachineFlagInfoVirtualMmuUsage enum
+func (e VirtualMachineFlagInfoVirtualMmuUsage) Values() []VirtualMachineFlagInfoVirtualMmuUsage {
+	return []VirtualMachineFlagInfoVirtualMmuUsage{
+		VirtualMachineFlagInfoVirtualMmuUsageAutomatic,
+		VirtualMachineFlagInfoVirtualMmuUsageOn,
+		VirtualMachineFlagInfoVirtualMmuUsageOff,
+	}
+}
+
 func init() {
 	t["VirtualMachineFlagInfoVirtualMmuUsage"] = reflect.TypeOf((*VirtualMachineFlagInfoVirtualMmuUsage)(nil)).Elem()
 }
@@ -4459,6 +7875,15 @@ const (
 	VirtualMachineForkConfigInfoChildTypeNonpersistent = VirtualMachineForkConfigInfoChildType("nonpersistent")
 )
 
+// Values returns all known values of the VirtualMachineForkConfigInfoChildType enum
+func (e VirtualMachineForkConfigInfoChildType) Values() []VirtualMachineForkConfigInfoChildType {
+	return []VirtualMachineForkConfigInfoChildType{
+		VirtualMachineForkConfigInfoChildTypeNone,
+		VirtualMachineForkConfigInfoChildTypePersistent,
+		VirtualMachineForkConfigInfoChildTypeNonpersistent,
+	}
+}
+
 func init() {
 	t["VirtualMachineForkConfigInfoChildType"] = reflect.TypeOf((*VirtualMachineForkConfigInfoChildType)(nil)).Elem()
 }
@@ -4474,6 +7899,18 @@ const (
 	VirtualMachineGuestOsFamilyOtherGuestFamily  = VirtualMachineGuestOsFamily("otherGuestFamily")
 )
 
+// Values returns all known values of the VirtualMachineGuestOsFamily enum
+func (e VirtualMachineGuestOsFamily) Values() []VirtualMachineGuestOsFamily {
+	return []VirtualMachineGuestOsFamily{
+		VirtualMachineGuestOsFamilyWindowsGuest,
+		VirtualMachineGuestOsFamilyLinuxGuest,
+		VirtualMachineGuestOsFamilyNetwareGuest,
+		VirtualMachineGuestOsFamilySolarisGuest,
+		VirtualMachineGuestOsFamilyDarwinGuestFamily,
+		VirtualMachineGuestOsFamilyOtherGuestFamily,
+	}
+}
+
 func init() {
 	t["VirtualMachineGuestOsFamily"] = reflect.TypeOf((*VirtualMachineGuestOsFamily)(nil)).Elem()
 }
@@ -4664,6 +8101,193 @@ const (
 	VirtualMachineGuestOsIdentifierOtherGuest64               = VirtualMachineGuestOsIdentifier("otherGuest64")
 )
 
+// Values returns all known values of the VirtualMachineGuestOsIdentifier enum
+func (e VirtualMachineGuestOsIdentifier) Values() []VirtualMachineGuestOsIdentifier {
+	return []VirtualMachineGuestOsIdentifier{
+		VirtualMachineGuestOsIdentifierDosGuest,
+		VirtualMachineGuestOsIdentifierWin31Guest,
+		VirtualMachineGuestOsIdentifierWin95Guest,
+		VirtualMachineGuestOsIdentifierWin98Guest,
+		VirtualMachineGuestOsIdentifierWinMeGuest,
+		VirtualMachineGuestOsIdentifierWinNTGuest,
+		VirtualMachineGuestOsIdentifierWin2000ProGuest,
+		VirtualMachineGuestOsIdentifierWin2000ServGuest,
+		VirtualMachineGuestOsIdentifierWin2000AdvServGuest,
+		VirtualMachineGuestOsIdentifierWinXPHomeGuest,
+		VirtualMachineGuestOsIdentifierWinXPProGuest,
+		VirtualMachineGuestOsIdentifierWinXPPro64Guest,
+		VirtualMachineGuestOsIdentifierWinNetWebGuest,
+		VirtualMachineGuestOsIdentifierWinNetStandardGuest,
+		VirtualMachineGuestOsIdentifierWinNetEnterpriseGuest,
+		VirtualMachineGuestOsIdentifierWinNetDatacenterGuest,
+		VirtualMachineGuestOsIdentifierWinNetBusinessGuest,
+		VirtualMachineGuestOsIdentifierWinNetStandard64Guest,
+		VirtualMachineGuestOsIdentifierWinNetEnterprise64Guest,
+		VirtualMachineGuestOsIdentifierWinLonghornGuest,
+		VirtualMachineGuestOsIdentifierWinLonghorn64Guest,
+		VirtualMachineGuestOsIdentifierWinNetDatacenter64Guest,
+		VirtualMachineGuestOsIdentifierWinVistaGuest,
+		VirtualMachineGuestOsIdentifierWinVista64Guest,
+		VirtualMachineGuestOsIdentifierWindows7Guest,
+		VirtualMachineGuestOsIdentifierWindows7_64Guest,
+		VirtualMachineGuestOsIdentifierWindows7Server64Guest,
+		VirtualMachineGuestOsIdentifierWindows8Guest,
+		VirtualMachineGuestOsIdentifierWindows8_64Guest,
+		VirtualMachineGuestOsIdentifierWindows8Server64Guest,
+		VirtualMachineGuestOsIdentifierWindows9Guest,
+		VirtualMachineGuestOsIdentifierWindows9_64Guest,
+		VirtualMachineGuestOsIdentifierWindows9Server64Guest,
+		VirtualMachineGuestOsIdentifierWindowsHyperVGuest,
+		VirtualMachineGuestOsIdentifierWindows2019srv_64Guest,
+		VirtualMachineGuestOsIdentifierWindows2019srvNext_64Guest,
+		VirtualMachineGuestOsIdentifierFreebsdGuest,
+		VirtualMachineGuestOsIdentifierFreebsd64Guest,
+		VirtualMachineGuestOsIdentifierFreebsd11Guest,
+		VirtualMachineGuestOsIdentifierFreebsd11_64Guest,
+		VirtualMachineGuestOsIdentifierFreebsd12Guest,
+		VirtualMachineGuestOsIdentifierFreebsd12_64Guest,
+		VirtualMachineGuestOsIdentifierFreebsd13Guest,
+		VirtualMachineGuestOsIdentifierFreebsd13_64Guest,
+		VirtualMachineGuestOsIdentifierRedhatGuest,
+		VirtualMachineGuestOsIdentifierRhel2Guest,
+		VirtualMachineGuestOsIdentifierRhel3Guest,
+		VirtualMachineGuestOsIdentifierRhel3_64Guest,
+		VirtualMachineGuestOsIdentifierRhel4Guest,
+		VirtualMachineGuestOsIdentifierRhel4_64Guest,
+		VirtualMachineGuestOsIdentifierRhel5Guest,
+		VirtualMachineGuestOsIdentifierRhel5_64Guest,
+		VirtualMachineGuestOsIdentifierRhel6Guest,
+		VirtualMachineGuestOsIdentifierRhel6_64Guest,
+		VirtualMachineGuestOsIdentifierRhel7Guest,
+		VirtualMachineGuestOsIdentifierRhel7_64Guest,
+		VirtualMachineGuestOsIdentifierRhel8_64Guest,
+		VirtualMachineGuestOsIdentifierRhel9_64Guest,
+		VirtualMachineGuestOsIdentifierCentosGuest,
+		VirtualMachineGuestOsIdentifierCentos64Guest,
+		VirtualMachineGuestOsIdentifierCentos6Guest,
+		VirtualMachineGuestOsIdentifierCentos6_64Guest,
+		VirtualMachineGuestOsIdentifierCentos7Guest,
+		VirtualMachineGuestOsIdentifierCentos7_64Guest,
+		VirtualMachineGuestOsIdentifierCentos8_64Guest,
+		VirtualMachineGuestOsIdentifierCentos9_64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinuxGuest,
+		VirtualMachineGuestOsIdentifierOracleLinux64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux6Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux6_64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux7Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux7_64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux8_64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux9_64Guest,
+		VirtualMachineGuestOsIdentifierSuseGuest,
+		VirtualMachineGuestOsIdentifierSuse64Guest,
+		VirtualMachineGuestOsIdentifierSlesGuest,
+		VirtualMachineGuestOsIdentifierSles64Guest,
+		VirtualMachineGuestOsIdentifierSles10Guest,
+		VirtualMachineGuestOsIdentifierSles10_64Guest,
+		VirtualMachineGuestOsIdentifierSles11Guest,
+		VirtualMachineGuestOsIdentifierSles11_64Guest,
+		VirtualMachineGuestOsIdentifierSles12Guest,
+		VirtualMachineGuestOsIdentifierSles12_64Guest,
+		VirtualMachineGuestOsIdentifierSles15_64Guest,
+		VirtualMachineGuestOsIdentifierSles16_64Guest,
+		VirtualMachineGuestOsIdentifierNld9Guest,
+		VirtualMachineGuestOsIdentifierOesGuest,
+		VirtualMachineGuestOsIdentifierSjdsGuest,
+		VirtualMachineGuestOsIdentifierMandrakeGuest,
+		VirtualMachineGuestOsIdentifierMandrivaGuest,
+		VirtualMachineGuestOsIdentifierMandriva64Guest,
+		VirtualMachineGuestOsIdentifierTurboLinuxGuest,
+		VirtualMachineGuestOsIdentifierTurboLinux64Guest,
+		VirtualMachineGuestOsIdentifierUbuntuGuest,
+		VirtualMachineGuestOsIdentifierUbuntu64Guest,
+		VirtualMachineGuestOsIdentifierDebian4Guest,
+		VirtualMachineGuestOsIdentifierDebian4_64Guest,
+		VirtualMachineGuestOsIdentifierDebian5Guest,
+		VirtualMachineGuestOsIdentifierDebian5_64Guest,
+		VirtualMachineGuestOsIdentifierDebian6Guest,
+		VirtualMachineGuestOsIdentifierDebian6_64Guest,
+		VirtualMachineGuestOsIdentifierDebian7Guest,
+		VirtualMachineGuestOsIdentifierDebian7_64Guest,
+		VirtualMachineGuestOsIdentifierDebian8Guest,
+		VirtualMachineGuestOsIdentifierDebian8_64Guest,
+		VirtualMachineGuestOsIdentifierDebian9Guest,
+		VirtualMachineGuestOsIdentifierDebian9_64Guest,
+		VirtualMachineGuestOsIdentifierDebian10Guest,
+		VirtualMachineGuestOsIdentifierDebian10_64Guest,
+		VirtualMachineGuestOsIdentifierDebian11Guest,
+		VirtualMachineGuestOsIdentifierDebian11_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux3Guest,
+		VirtualMachineGuestOsIdentifierAsianux3_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux4Guest,
+		VirtualMachineGuestOsIdentifierAsianux4_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux5_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux7_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux8_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux9_64Guest,
+		VirtualMachineGuestOsIdentifierOpensuseGuest,
+		VirtualMachineGuestOsIdentifierOpensuse64Guest,
+		VirtualMachineGuestOsIdentifierFedoraGuest,
+		VirtualMachineGuestOsIdentifierFedora64Guest,
+		VirtualMachineGuestOsIdentifierCoreos64Guest,
+		VirtualMachineGuestOsIdentifierVmwarePhoton64Guest,
+		VirtualMachineGuestOsIdentifierOther24xLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther26xLinuxGuest,
+		VirtualMachineGuestOsIdentifierOtherLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther3xLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther4xLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther5xLinuxGuest,
+		VirtualMachineGuestOsIdentifierGenericLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther24xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOther26xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOther3xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOther4xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOther5xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOtherLinux64Guest,
+		VirtualMachineGuestOsIdentifierSolaris6Guest,
+		VirtualMachineGuestOsIdentifierSolaris7Guest,
+		VirtualMachineGuestOsIdentifierSolaris8Guest,
+		VirtualMachineGuestOsIdentifierSolaris9Guest,
+		VirtualMachineGuestOsIdentifierSolaris10Guest,
+		VirtualMachineGuestOsIdentifierSolaris10_64Guest,
+		VirtualMachineGuestOsIdentifierSolaris11_64Guest,
+		VirtualMachineGuestOsIdentifierOs2Guest,
+		VirtualMachineGuestOsIdentifierEComStationGuest,
+		VirtualMachineGuestOsIdentifierEComStation2Guest,
+		VirtualMachineGuestOsIdentifierNetware4Guest,
+		VirtualMachineGuestOsIdentifierNetware5Guest,
+		VirtualMachineGuestOsIdentifierNetware6Guest,
+		VirtualMachineGuestOsIdentifierOpenServer5Guest,
+		VirtualMachineGuestOsIdentifierOpenServer6Guest,
+		VirtualMachineGuestOsIdentifierUnixWare7Guest,
+		VirtualMachineGuestOsIdentifierDarwinGuest,
+		VirtualMachineGuestOsIdentifierDarwin64Guest,
+		VirtualMachineGuestOsIdentifierDarwin10Guest,
+		VirtualMachineGuestOsIdentifierDarwin10_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin11Guest,
+		VirtualMachineGuestOsIdentifierDarwin11_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin12_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin13_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin14_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin15_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin16_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin17_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin18_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin19_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin20_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin21_64Guest,
+		VirtualMachineGuestOsIdentifierVmkernelGuest,
+		VirtualMachineGuestOsIdentifierVmkernel5Guest,
+		VirtualMachineGuestOsIdentifierVmkernel6Guest,
+		VirtualMachineGuestOsIdentifierVmkernel65Guest,
+		VirtualMachineGuestOsIdentifierVmkernel7Guest,
+		VirtualMachineGuestOsIdentifierAmazonlinux2_64Guest,
+		VirtualMachineGuestOsIdentifierAmazonlinux3_64Guest,
+		VirtualMachineGuestOsIdentifierCrxPod1Guest,
+		VirtualMachineGuestOsIdentifierOtherGuest,
+		VirtualMachineGuestOsIdentifierOtherGuest64,
+	}
+}
+
 func init() {
 	t["VirtualMachineGuestOsIdentifier"] = reflect.TypeOf((*VirtualMachineGuestOsIdentifier)(nil)).Elem()
 }
@@ -4679,6 +8303,18 @@ const (
 	VirtualMachineGuestStateUnknown      = VirtualMachineGuestState("unknown")
 )
 
+// Values returns all known values of the VirtualMachineGuestState enum
+func (e VirtualMachineGuestState) Values() []VirtualMachineGuestState {
+	return []VirtualMachineGuestState{
+		VirtualMachineGuestStateRunning,
+		VirtualMachineGuestStateShuttingDown,
+		VirtualMachineGuestStateResetting,
+		VirtualMachineGuestStateStandby,
+		VirtualMachineGuestStateNotRunning,
+		VirtualMachineGuestStateUnknown,
+	}
+}
+
 func init() {
 	t["VirtualMachineGuestState"] = reflect.TypeOf((*VirtualMachineGuestState)(nil)).Elem()
 }
@@ -4691,6 +8327,15 @@ const (
 	VirtualMachineHtSharingInternal = VirtualMachineHtSharing("internal")
 )
 
+// Values returns all known values of the VirtualMachineHtSharing enum
+func (e VirtualMachineHtSharing) Values() []VirtualMachineHtSharing {
+	return []VirtualMachineHtSharing{
+		VirtualMachineHtSharingAny,
+		VirtualMachineHtSharingNone,
+		VirtualMachineHtSharingInternal,
+	}
+}
+
 func init() {
 	t["VirtualMachineHtSharing"] = reflect.TypeOf((*VirtualMachineHtSharing)(nil)).Elem()
 }
@@ -4703,6 +8348,15 @@ const (
 	VirtualMachineMemoryAllocationPolicySwapMost = VirtualMachineMemoryAllocationPolicy("swapMost")
 )
 
+// Values returns all known values of the VirtualMachineMemoryAllocationPolicy enum
+func (e VirtualMachineMemoryAllocationPolicy) Values() []VirtualMachineMemoryAllocationPolicy {
+	return []VirtualMachineMemoryAllocationPolicy{
+		VirtualMachineMemoryAllocationPolicySwapNone,
+		VirtualMachineMemoryAllocationPolicySwapSome,
+		VirtualMachineMemoryAllocationPolicySwapMost,
+	}
+}
+
 func init() {
 	t["VirtualMachineMemoryAllocationPolicy"] = reflect.TypeOf((*VirtualMachineMemoryAllocationPolicy)(nil)).Elem()
 }
@@ -4714,6 +8368,14 @@ const (
 	VirtualMachineMetadataManagerVmMetadataOpRemove = VirtualMachineMetadataManagerVmMetadataOp("Remove")
 )
 
+// Values returns all known values of the VirtualMachineMetadataManagerVmMetadataOp enum
+func (e VirtualMachineMetadataManagerVmMetadataOp) Values() []VirtualMachineMetadataManagerVmMetadataOp {
+	return []VirtualMachineMetadataManagerVmMetadataOp{
+		VirtualMachineMetadataManagerVmMetadataOpUpdate,
+		VirtualMachineMetadataManagerVmMetadataOpRemove,
+	}
+}
+
 func init() {
 	t["VirtualMachineMetadataManagerVmMetadataOp"] = reflect.TypeOf((*VirtualMachineMetadataManagerVmMetadataOp)(nil)).Elem()
 }
@@ -4724,6 +8386,13 @@ const (
 	VirtualMachineMetadataManagerVmMetadataOwnerOwnerComVmwareVsphereHA = VirtualMachineMetadataManagerVmMetadataOwnerOwner("ComVmwareVsphereHA")
 )
 
+// Values returns all known values of the VirtualMachineMetadataManagerVmMetadataOwnerOwner enum
+func (e VirtualMachineMetadataManagerVmMetadataOwnerOwner) Values() []VirtualMachineMetadataManagerVmMetadataOwnerOwner {
+	return []VirtualMachineMetadataManagerVmMetadataOwnerOwner{
+		VirtualMachineMetadataManagerVmMetadataOwnerOwnerComVmwareVsphereHA,
+	}
+}
+
 func init() {
 	t["VirtualMachineMetadataManagerVmMetadataOwnerOwner"] = reflect.TypeOf((*VirtualMachineMetadataManagerVmMetadataOwnerOwner)(nil)).Elem()
 }
@@ -4736,6 +8405,15 @@ const (
 	VirtualMachineMovePriorityDefaultPriority = VirtualMachineMovePriority("defaultPriority")
 )
 
+// Values returns all known values of the VirtualMachineMovePriority enum
+func (e VirtualMachineMovePriority) Values() []VirtualMachineMovePriority {
+	return []VirtualMachineMovePriority{
+		VirtualMachineMovePriorityLowPriority,
+		VirtualMachineMovePriorityHighPriority,
+		VirtualMachineMovePriorityDefaultPriority,
+	}
+}
+
 func init() {
 	t["VirtualMachineMovePriority"] = reflect.TypeOf((*VirtualMachineMovePriority)(nil)).Elem()
 }
@@ -4752,6 +8430,19 @@ const (
 	VirtualMachineNeedSecondaryReasonOther                  = VirtualMachineNeedSecondaryReason("other")
 )
 
+// Values returns all known values of the VirtualMachineNeedSecondaryReason enum
+func (e VirtualMachineNeedSecondaryReason) Values() []VirtualMachineNeedSecondaryReason {
+	return []VirtualMachineNeedSecondaryReason{
+		VirtualMachineNeedSecondaryReasonInitializing,
+		VirtualMachineNeedSecondaryReasonDivergence,
+		VirtualMachineNeedSecondaryReasonLostConnection,
+		VirtualMachineNeedSecondaryReasonPartialHardwareFailure,
+		VirtualMachineNeedSecondaryReasonUserAction,
+		VirtualMachineNeedSecondaryReasonCheckpointError,
+		VirtualMachineNeedSecondaryReasonOther,
+	}
+}
+
 func init() {
 	t["VirtualMachineNeedSecondaryReason"] = reflect.TypeOf((*VirtualMachineNeedSecondaryReason)(nil)).Elem()
 }
@@ -4765,6 +8456,16 @@ const (
 	VirtualMachinePowerOffBehaviorTake     = VirtualMachinePowerOffBehavior("take")
 )
 
+// Values returns all known values of the VirtualMachinePowerOffBehavior enum
+func (e VirtualMachinePowerOffBehavior) Values() []VirtualMachinePowerOffBehavior {
+	return []VirtualMachinePowerOffBehavior{
+		VirtualMachinePowerOffBehaviorPowerOff,
+		VirtualMachinePowerOffBehaviorRevert,
+		VirtualMachinePowerOffBehaviorPrompt,
+		VirtualMachinePowerOffBehaviorTake,
+	}
+}
+
 func init() {
 	t["VirtualMachinePowerOffBehavior"] = reflect.TypeOf((*VirtualMachinePowerOffBehavior)(nil)).Elem()
 }
@@ -4777,6 +8478,15 @@ const (
 	VirtualMachinePowerOpTypePreset = VirtualMachinePowerOpType("preset")
 )
 
+// Values returns all known values of the VirtualMachinePowerOpType enum
+func (e VirtualMachinePowerOpType) Values() []VirtualMachinePowerOpType {
+	return []VirtualMachinePowerOpType{
+		VirtualMachinePowerOpTypeSoft,
+		VirtualMachinePowerOpTypeHard,
+		VirtualMachinePowerOpTypePreset,
+	}
+}
+
 func init() {
 	t["VirtualMachinePowerOpType"] = reflect.TypeOf((*VirtualMachinePowerOpType)(nil)).Elem()
 }
@@ -4789,6 +8499,15 @@ const (
 	VirtualMachinePowerStateSuspended  = VirtualMachinePowerState("suspended")
 )
 
+// Values returns all known values of the VirtualMachinePowerState enum
+func (e VirtualMachinePowerState) Values() []VirtualMachinePowerState {
+	return []VirtualMachinePowerState{
+		VirtualMachinePowerStatePoweredOff,
+		VirtualMachinePowerStatePoweredOn,
+		VirtualMachinePowerStateSuspended,
+	}
+}
+
 func init() {
 	t["VirtualMachinePowerState"] = reflect.TypeOf((*VirtualMachinePowerState)(nil)).Elem()
 }
@@ -4801,6 +8520,15 @@ const (
 	VirtualMachineRecordReplayStateInactive  = VirtualMachineRecordReplayState("inactive")
 )
 
+// Values returns all known values of the VirtualMachineRecordReplayState enum
+func (e VirtualMachineRecordReplayState) Values() []VirtualMachineRecordReplayState {
+	return []VirtualMachineRecordReplayState{
+		VirtualMachineRecordReplayStateRecording,
+		VirtualMachineRecordReplayStateReplaying,
+		VirtualMachineRecordReplayStateInactive,
+	}
+}
+
 func init() {
 	t["VirtualMachineRecordReplayState"] = reflect.TypeOf((*VirtualMachineRecordReplayState)(nil)).Elem()
 }
@@ -4815,6 +8543,17 @@ const (
 	VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndConsolidate     = VirtualMachineRelocateDiskMoveOptions("moveAllDiskBackingsAndConsolidate")
 )
 
+// Values returns all known values of the VirtualMachineRelocateDiskMoveOptions enum
+func (e VirtualMachineRelocateDiskMoveOptions) Values() []VirtualMachineRelocateDiskMoveOptions {
+	return []VirtualMachineRelocateDiskMoveOptions{
+		VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndAllowSharing,
+		VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndDisallowSharing,
+		VirtualMachineRelocateDiskMoveOptionsMoveChildMostDiskBacking,
+		VirtualMachineRelocateDiskMoveOptionsCreateNewChildDiskBacking,
+		VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndConsolidate,
+	}
+}
+
 func init() {
 	t["VirtualMachineRelocateDiskMoveOptions"] = reflect.TypeOf((*VirtualMachineRelocateDiskMoveOptions)(nil)).Elem()
 }
@@ -4826,6 +8565,14 @@ const (
 	VirtualMachineRelocateTransformationSparse = VirtualMachineRelocateTransformation("sparse")
 )
 
+// Values returns all known values of the VirtualMachineRelocateTransformation enum
+func (e VirtualMachineRelocateTransformation) Values() []VirtualMachineRelocateTransformation {
+	return []VirtualMachineRelocateTransformation{
+		VirtualMachineRelocateTransformationFlat,
+		VirtualMachineRelocateTransformationSparse,
+	}
+}
+
 func init() {
 	t["VirtualMachineRelocateTransformation"] = reflect.TypeOf((*VirtualMachineRelocateTransformation)(nil)).Elem()
 }
@@ -4847,6 +8594,24 @@ const (
 	VirtualMachineScsiPassthroughTypeUnknown   = VirtualMachineScsiPassthroughType("unknown")
 )
 
+// Values returns all known values of the VirtualMachineScsiPassthroughType enum
+func (e VirtualMachineScsiPassthroughType) Values() []VirtualMachineScsiPassthroughType {
+	return []VirtualMachineScsiPassthroughType{
+		VirtualMachineScsiPassthroughTypeDisk,
+		VirtualMachineScsiPassthroughTypeTape,
+		VirtualMachineScsiPassthroughTypePrinter,
+		VirtualMachineScsiPassthroughTypeProcessor,
+		VirtualMachineScsiPassthroughTypeWorm,
+		VirtualMachineScsiPassthroughTypeCdrom,
+		VirtualMachineScsiPassthroughTypeScanner,
+		VirtualMachineScsiPassthroughTypeOptical,
+		VirtualMachineScsiPassthroughTypeMedia,
+		VirtualMachineScsiPassthroughTypeCom,
+		VirtualMachineScsiPassthroughTypeRaid,
+		VirtualMachineScsiPassthroughTypeUnknown,
+	}
+}
+
 func init() {
 	t["VirtualMachineScsiPassthroughType"] = reflect.TypeOf((*VirtualMachineScsiPassthroughType)(nil)).Elem()
 }
@@ -4858,6 +8623,14 @@ const (
 	VirtualMachineSgxInfoFlcModesUnlocked = VirtualMachineSgxInfoFlcModes("unlocked")
 )
 
+// Values returns all known values of the VirtualMachineSgxInfoFlcModes enum
+func (e VirtualMachineSgxInfoFlcModes) Values() []VirtualMachineSgxInfoFlcModes {
+	return []VirtualMachineSgxInfoFlcModes{
+		VirtualMachineSgxInfoFlcModesLocked,
+		VirtualMachineSgxInfoFlcModesUnlocked,
+	}
+}
+
 func init() {
 	t["VirtualMachineSgxInfoFlcModes"] = reflect.TypeOf((*VirtualMachineSgxInfoFlcModes)(nil)).Elem()
 }
@@ -4869,6 +8642,14 @@ const (
 	VirtualMachineStandbyActionTypePowerOnSuspend = VirtualMachineStandbyActionType("powerOnSuspend")
 )
 
+// Values returns all known values of the VirtualMachineStandbyActionType enum
+func (e VirtualMachineStandbyActionType) Values() []VirtualMachineStandbyActionType {
+	return []VirtualMachineStandbyActionType{
+		VirtualMachineStandbyActionTypeCheckpoint,
+		VirtualMachineStandbyActionTypePowerOnSuspend,
+	}
+}
+
 func init() {
 	t["VirtualMachineStandbyActionType"] = reflect.TypeOf((*VirtualMachineStandbyActionType)(nil)).Elem()
 }
@@ -4880,6 +8661,14 @@ const (
 	VirtualMachineTargetInfoConfigurationTagClusterWide = VirtualMachineTargetInfoConfigurationTag("clusterWide")
 )
 
+// Values returns all known values of the VirtualMachineTargetInfoConfigurationTag enum
+func (e VirtualMachineTargetInfoConfigurationTag) Values() []VirtualMachineTargetInfoConfigurationTag {
+	return []VirtualMachineTargetInfoConfigurationTag{
+		VirtualMachineTargetInfoConfigurationTagCompliant,
+		VirtualMachineTargetInfoConfigurationTagClusterWide,
+	}
+}
+
 func init() {
 	t["VirtualMachineTargetInfoConfigurationTag"] = reflect.TypeOf((*VirtualMachineTargetInfoConfigurationTag)(nil)).Elem()
 }
@@ -4895,6 +8684,18 @@ const (
 	VirtualMachineTicketTypeWebRemoteDevice = VirtualMachineTicketType("webRemoteDevice")
 )
 
+// Values returns all known values of the VirtualMachineTicketType enum
+func (e VirtualMachineTicketType) Values() []VirtualMachineTicketType {
+	return []VirtualMachineTicketType{
+		VirtualMachineTicketTypeMks,
+		VirtualMachineTicketTypeDevice,
+		VirtualMachineTicketTypeGuestControl,
+		VirtualMachineTicketTypeWebmks,
+		VirtualMachineTicketTypeGuestIntegrity,
+		VirtualMachineTicketTypeWebRemoteDevice,
+	}
+}
+
 func init() {
 	t["VirtualMachineTicketType"] = reflect.TypeOf((*VirtualMachineTicketType)(nil)).Elem()
 }
@@ -4909,6 +8710,17 @@ const (
 	VirtualMachineToolsInstallTypeGuestToolsTypeOpenVMTools = VirtualMachineToolsInstallType("guestToolsTypeOpenVMTools")
 )
 
+// Values returns all known values of the VirtualMachineToolsInstallType enum
+func (e VirtualMachineToolsInstallType) Values() []VirtualMachineToolsInstallType {
+	return []VirtualMachineToolsInstallType{
+		VirtualMachineToolsInstallTypeGuestToolsTypeUnknown,
+		VirtualMachineToolsInstallTypeGuestToolsTypeMSI,
+		VirtualMachineToolsInstallTypeGuestToolsTypeTar,
+		VirtualMachineToolsInstallTypeGuestToolsTypeOSP,
+		VirtualMachineToolsInstallTypeGuestToolsTypeOpenVMTools,
+	}
+}
+
 func init() {
 	t["VirtualMachineToolsInstallType"] = reflect.TypeOf((*VirtualMachineToolsInstallType)(nil)).Elem()
 }
@@ -4921,6 +8733,15 @@ const (
 	VirtualMachineToolsRunningStatusGuestToolsExecutingScripts = VirtualMachineToolsRunningStatus("guestToolsExecutingScripts")
 )
 
+// Values returns all known values of the VirtualMachineToolsRunningStatus enum
+func (e VirtualMachineToolsRunningStatus) Values() []VirtualMachineToolsRunningStatus {
+	return []VirtualMachineToolsRunningStatus{
+		VirtualMachineToolsRunningStatusGuestToolsNotRunning,
+		VirtualMachineToolsRunningStatusGuestToolsRunning,
+		VirtualMachineToolsRunningStatusGuestToolsExecutingScripts,
+	}
+}
+
 func init() {
 	t["VirtualMachineToolsRunningStatus"] = reflect.TypeOf((*VirtualMachineToolsRunningStatus)(nil)).Elem()
 }
@@ -4934,6 +8755,16 @@ const (
 	VirtualMachineToolsStatusToolsOk           = VirtualMachineToolsStatus("toolsOk")
 )
 
+// Values returns all known values of the VirtualMachineToolsStatus enum
+func (e VirtualMachineToolsStatus) Values() []VirtualMachineToolsStatus {
+	return []VirtualMachineToolsStatus{
+		VirtualMachineToolsStatusToolsNotInstalled,
+		VirtualMachineToolsStatusToolsNotRunning,
+		VirtualMachineToolsStatusToolsOld,
+		VirtualMachineToolsStatusToolsOk,
+	}
+}
+
 func init() {
 	t["VirtualMachineToolsStatus"] = reflect.TypeOf((*VirtualMachineToolsStatus)(nil)).Elem()
 }
@@ -4952,6 +8783,21 @@ const (
 	VirtualMachineToolsVersionStatusGuestToolsBlacklisted  = VirtualMachineToolsVersionStatus("guestToolsBlacklisted")
 )
 
+// Values returns all known values of the VirtualMachineToolsVersionStatus enum
+func (e VirtualMachineToolsVersionStatus) Values() []VirtualMachineToolsVersionStatus {
+	return []VirtualMachineToolsVersionStatus{
+		VirtualMachineToolsVersionStatusGuestToolsNotInstalled,
+		VirtualMachineToolsVersionStatusGuestToolsNeedUpgrade,
+		VirtualMachineToolsVersionStatusGuestToolsCurrent,
+		VirtualMachineToolsVersionStatusGuestToolsUnmanaged,
+		VirtualMachineToolsVersionStatusGuestToolsTooOld,
+		VirtualMachineToolsVersionStatusGuestToolsSupportedOld,
+		VirtualMachineToolsVersionStatusGuestToolsSupportedNew,
+		VirtualMachineToolsVersionStatusGuestToolsTooNew,
+		VirtualMachineToolsVersionStatusGuestToolsBlacklisted,
+	}
+}
+
 func init() {
 	t["VirtualMachineToolsVersionStatus"] = reflect.TypeOf((*VirtualMachineToolsVersionStatus)(nil)).Elem()
 }
@@ -4980,6 +8826,31 @@ const (
 	VirtualMachineUsbInfoFamilyUnknownFamily   = VirtualMachineUsbInfoFamily("unknownFamily")
 )
 
+// Values returns all known values of the VirtualMachineUsbInfoFamily enum
+func (e VirtualMachineUsbInfoFamily) Values() []VirtualMachineUsbInfoFamily {
+	return []VirtualMachineUsbInfoFamily{
+		VirtualMachineUsbInfoFamilyAudio,
+		VirtualMachineUsbInfoFamilyHid,
+		VirtualMachineUsbInfoFamilyHid_bootable,
+		VirtualMachineUsbInfoFamilyPhysical,
+		VirtualMachineUsbInfoFamilyCommunication,
+		VirtualMachineUsbInfoFamilyImaging,
+		VirtualMachineUsbInfoFamilyPrinter,
+		VirtualMachineUsbInfoFamilyStorage,
+		VirtualMachineUsbInfoFamilyHub,
+		VirtualMachineUsbInfoFamilySmart_card,
+		VirtualMachineUsbInfoFamilySecurity,
+		VirtualMachineUsbInfoFamilyVideo,
+		VirtualMachineUsbInfoFamilyWireless,
+		VirtualMachineUsbInfoFamilyBluetooth,
+		VirtualMachineUsbInfoFamilyWusb,
+		VirtualMachineUsbInfoFamilyPda,
+		VirtualMachineUsbInfoFamilyVendor_specific,
+		VirtualMachineUsbInfoFamilyOther,
+		VirtualMachineUsbInfoFamilyUnknownFamily,
+	}
+}
+
 func init() {
 	t["VirtualMachineUsbInfoFamily"] = reflect.TypeOf((*VirtualMachineUsbInfoFamily)(nil)).Elem()
 }
@@ -4995,6 +8866,18 @@ const (
 	VirtualMachineUsbInfoSpeedUnknownSpeed   = VirtualMachineUsbInfoSpeed("unknownSpeed")
 )
 
+// Values returns all known values of the VirtualMachineUsbInfoSpeed enum
+func (e VirtualMachineUsbInfoSpeed) Values() []VirtualMachineUsbInfoSpeed {
+	return []VirtualMachineUsbInfoSpeed{
+		VirtualMachineUsbInfoSpeedLow,
+		VirtualMachineUsbInfoSpeedFull,
+		VirtualMachineUsbInfoSpeedHigh,
+		VirtualMachineUsbInfoSpeedSuperSpeed,
+		VirtualMachineUsbInfoSpeedSuperSpeedPlus,
+		VirtualMachineUsbInfoSpeedUnknownSpeed,
+	}
+}
+
 func init() {
 	t["VirtualMachineUsbInfoSpeed"] = reflect.TypeOf((*VirtualMachineUsbInfoSpeed)(nil)).Elem()
 }
@@ -5006,6 +8889,14 @@ const (
 	VirtualMachineVMCIDeviceActionDeny  = VirtualMachineVMCIDeviceAction("deny")
 )
 
+// Values returns all known values of the VirtualMachineVMCIDeviceAction enum
+func (e VirtualMachineVMCIDeviceAction) Values() []VirtualMachineVMCIDeviceAction {
+	return []VirtualMachineVMCIDeviceAction{
+		VirtualMachineVMCIDeviceActionAllow,
+		VirtualMachineVMCIDeviceActionDeny,
+	}
+}
+
 func init() {
 	t["VirtualMachineVMCIDeviceAction"] = reflect.TypeOf((*VirtualMachineVMCIDeviceAction)(nil)).Elem()
 }
@@ -5018,6 +8909,15 @@ const (
 	VirtualMachineVMCIDeviceDirectionAnyDirection = VirtualMachineVMCIDeviceDirection("anyDirection")
 )
 
+// Values returns all known values of the VirtualMachineVMCIDeviceDirection enum
+func (e VirtualMachineVMCIDeviceDirection) Values() []VirtualMachineVMCIDeviceDirection {
+	return []VirtualMachineVMCIDeviceDirection{
+		VirtualMachineVMCIDeviceDirectionGuest,
+		VirtualMachineVMCIDeviceDirectionHost,
+		VirtualMachineVMCIDeviceDirectionAnyDirection,
+	}
+}
+
 func init() {
 	t["VirtualMachineVMCIDeviceDirection"] = reflect.TypeOf((*VirtualMachineVMCIDeviceDirection)(nil)).Elem()
 }
@@ -5033,6 +8933,18 @@ const (
 	VirtualMachineVMCIDeviceProtocolAnyProtocol = VirtualMachineVMCIDeviceProtocol("anyProtocol")
 )
 
+// Values returns all known values of the VirtualMachineVMCIDeviceProtocol enum
+func (e VirtualMachineVMCIDeviceProtocol) Values() []VirtualMachineVMCIDeviceProtocol {
+	return []VirtualMachineVMCIDeviceProtocol{
+		VirtualMachineVMCIDeviceProtocolHypervisor,
+		VirtualMachineVMCIDeviceProtocolDoorbell,
+		VirtualMachineVMCIDeviceProtocolQueuepair,
+		VirtualMachineVMCIDeviceProtocolDatagram,
+		VirtualMachineVMCIDeviceProtocolStream,
+		VirtualMachineVMCIDeviceProtocolAnyProtocol,
+	}
+}
+
 func init() {
 	t["VirtualMachineVMCIDeviceProtocol"] = reflect.TypeOf((*VirtualMachineVMCIDeviceProtocol)(nil)).Elem()
 }
@@ -5045,6 +8957,15 @@ const (
 	VirtualMachineVideoCardUse3dRendererHardware  = VirtualMachineVideoCardUse3dRenderer("hardware")
 )
 
+// Values returns all known values of the VirtualMachineVideoCardUse3dRenderer enum
+func (e VirtualMachineVideoCardUse3dRenderer) Values() []VirtualMachineVideoCardUse3dRenderer {
+	return []VirtualMachineVideoCardUse3dRenderer{
+		VirtualMachineVideoCardUse3dRendererAutomatic,
+		VirtualMachineVideoCardUse3dRendererSoftware,
+		VirtualMachineVideoCardUse3dRendererHardware,
+	}
+}
+
 func init() {
 	t["VirtualMachineVideoCardUse3dRenderer"] = reflect.TypeOf((*VirtualMachineVideoCardUse3dRenderer)(nil)).Elem()
 }
@@ -5056,6 +8977,14 @@ const (
 	VirtualMachineVirtualPMemSnapshotModeIndependent_eraseonrevert = VirtualMachineVirtualPMemSnapshotMode("independent_eraseonrevert")
 )
 
+// Values returns all known values of the VirtualMachineVirtualPMemSnapshotMode enum
+func (e VirtualMachineVirtualPMemSnapshotMode) Values() []VirtualMachineVirtualPMemSnapshotMode {
+	return []VirtualMachineVirtualPMemSnapshotMode{
+		VirtualMachineVirtualPMemSnapshotModeIndependent_persistent,
+		VirtualMachineVirtualPMemSnapshotModeIndependent_eraseonrevert,
+	}
+}
+
 func init() {
 	t["VirtualMachineVirtualPMemSnapshotMode"] = reflect.TypeOf((*VirtualMachineVirtualPMemSnapshotMode)(nil)).Elem()
 }
@@ -5068,6 +8997,15 @@ const (
 	VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_file_share_backup = VirtualMachineWindowsQuiesceSpecVssBackupContext("ctx_file_share_backup")
 )
 
+// Values returns all known values of the VirtualMachineWindowsQuiesceSpecVssBackupContext enum
+func (e VirtualMachineWindowsQuiesceSpecVssBackupContext) Values() []VirtualMachineWindowsQuiesceSpecVssBackupContext {
+	return []VirtualMachineWindowsQuiesceSpecVssBackupContext{
+		VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_auto,
+		VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_backup,
+		VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_file_share_backup,
+	}
+}
+
 func init() {
 	t["VirtualMachineWindowsQuiesceSpecVssBackupContext"] = reflect.TypeOf((*VirtualMachineWindowsQuiesceSpecVssBackupContext)(nil)).Elem()
 }
@@ -5085,6 +9023,20 @@ const (
 	VirtualPointingDeviceHostChoicePs2                  = VirtualPointingDeviceHostChoice("ps2")
 )
 
+// Values returns all known values of the VirtualPointingDeviceHostChoice enum
+func (e VirtualPointingDeviceHostChoice) Values() []VirtualPointingDeviceHostChoice {
+	return []VirtualPointingDeviceHostChoice{
+		VirtualPointingDeviceHostChoiceAutodetect,
+		VirtualPointingDeviceHostChoiceIntellimouseExplorer,
+		VirtualPointingDeviceHostChoiceIntellimousePs2,
+		VirtualPointingDeviceHostChoiceLogitechMouseman,
+		VirtualPointingDeviceHostChoiceMicrosoft_serial,
+		VirtualPointingDeviceHostChoiceMouseSystems,
+		VirtualPointingDeviceHostChoiceMousemanSerial,
+		VirtualPointingDeviceHostChoicePs2,
+	}
+}
+
 func init() {
 	t["VirtualPointingDeviceHostChoice"] = reflect.TypeOf((*VirtualPointingDeviceHostChoice)(nil)).Elem()
 }
@@ -5097,6 +9049,15 @@ const (
 	VirtualSCSISharingPhysicalSharing = VirtualSCSISharing("physicalSharing")
 )
 
+// Values returns all known values of the VirtualSCSISharing enum
+func (e VirtualSCSISharing) Values() []VirtualSCSISharing {
+	return []VirtualSCSISharing{
+		VirtualSCSISharingNoSharing,
+		VirtualSCSISharingVirtualSharing,
+		VirtualSCSISharingPhysicalSharing,
+	}
+}
+
 func init() {
 	t["VirtualSCSISharing"] = reflect.TypeOf((*VirtualSCSISharing)(nil)).Elem()
 }
@@ -5108,6 +9069,14 @@ const (
 	VirtualSerialPortEndPointServer = VirtualSerialPortEndPoint("server")
 )
 
+// Values returns all known values of the VirtualSerialPortEndPoint enum
+func (e VirtualSerialPortEndPoint) Values() []VirtualSerialPortEndPoint {
+	return []VirtualSerialPortEndPoint{
+		VirtualSerialPortEndPointClient,
+		VirtualSerialPortEndPointServer,
+	}
+}
+
 func init() {
 	t["VirtualSerialPortEndPoint"] = reflect.TypeOf((*VirtualSerialPortEndPoint)(nil)).Elem()
 }
@@ -5119,6 +9088,14 @@ const (
 	VirtualVmxnet3VrdmaOptionDeviceProtocolsRocev2 = VirtualVmxnet3VrdmaOptionDeviceProtocols("rocev2")
 )
 
+// Values returns all known values of the VirtualVmxnet3VrdmaOptionDeviceProtocols enum
+func (e VirtualVmxnet3VrdmaOptionDeviceProtocols) Values() []VirtualVmxnet3VrdmaOptionDeviceProtocols {
+	return []VirtualVmxnet3VrdmaOptionDeviceProtocols{
+		VirtualVmxnet3VrdmaOptionDeviceProtocolsRocev1,
+		VirtualVmxnet3VrdmaOptionDeviceProtocolsRocev2,
+	}
+}
+
 func init() {
 	t["VirtualVmxnet3VrdmaOptionDeviceProtocols"] = reflect.TypeOf((*VirtualVmxnet3VrdmaOptionDeviceProtocols)(nil)).Elem()
 }
@@ -5132,6 +9109,16 @@ const (
 	VmDasBeingResetEventReasonCodeVmcpResetApdCleared      = VmDasBeingResetEventReasonCode("vmcpResetApdCleared")
 )
 
+// Values returns all known values of the VmDasBeingResetEventReasonCode enum
+func (e VmDasBeingResetEventReasonCode) Values() []VmDasBeingResetEventReasonCode {
+	return []VmDasBeingResetEventReasonCode{
+		VmDasBeingResetEventReasonCodeVmtoolsHeartbeatFailure,
+		VmDasBeingResetEventReasonCodeAppHeartbeatFailure,
+		VmDasBeingResetEventReasonCodeAppImmediateResetRequest,
+		VmDasBeingResetEventReasonCodeVmcpResetApdCleared,
+	}
+}
+
 func init() {
 	t["VmDasBeingResetEventReasonCode"] = reflect.TypeOf((*VmDasBeingResetEventReasonCode)(nil)).Elem()
 }
@@ -5145,6 +9132,16 @@ const (
 	VmFailedStartingSecondaryEventFailureReasonMigrateFailed    = VmFailedStartingSecondaryEventFailureReason("migrateFailed")
 )
 
+// Values returns all known values of the VmFailedStartingSecondaryEventFailureReason enum
+func (e VmFailedStartingSecondaryEventFailureReason) Values() []VmFailedStartingSecondaryEventFailureReason {
+	return []VmFailedStartingSecondaryEventFailureReason{
+		VmFailedStartingSecondaryEventFailureReasonIncompatibleHost,
+		VmFailedStartingSecondaryEventFailureReasonLoginFailed,
+		VmFailedStartingSecondaryEventFailureReasonRegisterVmFailed,
+		VmFailedStartingSecondaryEventFailureReasonMigrateFailed,
+	}
+}
+
 func init() {
 	t["VmFailedStartingSecondaryEventFailureReason"] = reflect.TypeOf((*VmFailedStartingSecondaryEventFailureReason)(nil)).Elem()
 }
@@ -5185,6 +9182,43 @@ const (
 	VmFaultToleranceConfigIssueReasonForIssueUnsupportedPMemHAFailOver      = VmFaultToleranceConfigIssueReasonForIssue("unsupportedPMemHAFailOver")
 )
 
+// Values returns all known values of the VmFaultToleranceConfigIssueReasonForIssue enum
+func (e VmFaultToleranceConfigIssueReasonForIssue) Values() []VmFaultToleranceConfigIssueReasonForIssue {
+	return []VmFaultToleranceConfigIssueReasonForIssue{
+		VmFaultToleranceConfigIssueReasonForIssueHaNotEnabled,
+		VmFaultToleranceConfigIssueReasonForIssueMoreThanOneSecondary,
+		VmFaultToleranceConfigIssueReasonForIssueRecordReplayNotSupported,
+		VmFaultToleranceConfigIssueReasonForIssueReplayNotSupported,
+		VmFaultToleranceConfigIssueReasonForIssueTemplateVm,
+		VmFaultToleranceConfigIssueReasonForIssueMultipleVCPU,
+		VmFaultToleranceConfigIssueReasonForIssueHostInactive,
+		VmFaultToleranceConfigIssueReasonForIssueFtUnsupportedHardware,
+		VmFaultToleranceConfigIssueReasonForIssueFtUnsupportedProduct,
+		VmFaultToleranceConfigIssueReasonForIssueMissingVMotionNic,
+		VmFaultToleranceConfigIssueReasonForIssueMissingFTLoggingNic,
+		VmFaultToleranceConfigIssueReasonForIssueThinDisk,
+		VmFaultToleranceConfigIssueReasonForIssueVerifySSLCertificateFlagNotSet,
+		VmFaultToleranceConfigIssueReasonForIssueHasSnapshots,
+		VmFaultToleranceConfigIssueReasonForIssueNoConfig,
+		VmFaultToleranceConfigIssueReasonForIssueFtSecondaryVm,
+		VmFaultToleranceConfigIssueReasonForIssueHasLocalDisk,
+		VmFaultToleranceConfigIssueReasonForIssueEsxAgentVm,
+		VmFaultToleranceConfigIssueReasonForIssueVideo3dEnabled,
+		VmFaultToleranceConfigIssueReasonForIssueHasUnsupportedDisk,
+		VmFaultToleranceConfigIssueReasonForIssueInsufficientBandwidth,
+		VmFaultToleranceConfigIssueReasonForIssueHasNestedHVConfiguration,
+		VmFaultToleranceConfigIssueReasonForIssueHasVFlashConfiguration,
+		VmFaultToleranceConfigIssueReasonForIssueUnsupportedProduct,
+		VmFaultToleranceConfigIssueReasonForIssueCpuHvUnsupported,
+		VmFaultToleranceConfigIssueReasonForIssueCpuHwmmuUnsupported,
+		VmFaultToleranceConfigIssueReasonForIssueCpuHvDisabled,
+		VmFaultToleranceConfigIssueReasonForIssueHasEFIFirmware,
+		VmFaultToleranceConfigIssueReasonForIssueTooManyVCPUs,
+		VmFaultToleranceConfigIssueReasonForIssueTooMuchMemory,
+		VmFaultToleranceConfigIssueReasonForIssueUnsupportedPMemHAFailOver,
+	}
+}
+
 func init() {
 	t["VmFaultToleranceConfigIssueReasonForIssue"] = reflect.TypeOf((*VmFaultToleranceConfigIssueReasonForIssue)(nil)).Elem()
 }
@@ -5199,6 +9233,17 @@ const (
 	VmFaultToleranceInvalidFileBackingDeviceTypeVirtualDisk         = VmFaultToleranceInvalidFileBackingDeviceType("virtualDisk")
 )
 
+// Values returns all known values of the VmFaultToleranceInvalidFileBackingDeviceType enum
+func (e VmFaultToleranceInvalidFileBackingDeviceType) Values() []VmFaultToleranceInvalidFileBackingDeviceType {
+	return []VmFaultToleranceInvalidFileBackingDeviceType{
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualFloppy,
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualCdrom,
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualSerialPort,
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualParallelPort,
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualDisk,
+	}
+}
+
 func init() {
 	t["VmFaultToleranceInvalidFileBackingDeviceType"] = reflect.TypeOf((*VmFaultToleranceInvalidFileBackingDeviceType)(nil)).Elem()
 }
@@ -5210,6 +9255,14 @@ const (
 	VmShutdownOnIsolationEventOperationPoweredOff = VmShutdownOnIsolationEventOperation("poweredOff")
 )
 
+// Values returns all known values of the VmShutdownOnIsolationEventOperation enum
+func (e VmShutdownOnIsolationEventOperation) Values() []VmShutdownOnIsolationEventOperation {
+	return []VmShutdownOnIsolationEventOperation{
+		VmShutdownOnIsolationEventOperationShutdown,
+		VmShutdownOnIsolationEventOperationPoweredOff,
+	}
+}
+
 func init() {
 	t["VmShutdownOnIsolationEventOperation"] = reflect.TypeOf((*VmShutdownOnIsolationEventOperation)(nil)).Elem()
 }
@@ -5222,6 +9275,15 @@ const (
 	VmwareDistributedVirtualSwitchPvlanPortTypeCommunity   = VmwareDistributedVirtualSwitchPvlanPortType("community")
 )
 
+// Values returns all known values of the VmwareDistributedVirtualSwitchPvlanPortType enum
+func (e VmwareDistributedVirtualSwitchPvlanPortType) Values() []VmwareDistributedVirtualSwitchPvlanPortType {
+	return []VmwareDistributedVirtualSwitchPvlanPortType{
+		VmwareDistributedVirtualSwitchPvlanPortTypePromiscuous,
+		VmwareDistributedVirtualSwitchPvlanPortTypeIsolated,
+		VmwareDistributedVirtualSwitchPvlanPortTypeCommunity,
+	}
+}
+
 func init() {
 	t["VmwareDistributedVirtualSwitchPvlanPortType"] = reflect.TypeOf((*VmwareDistributedVirtualSwitchPvlanPortType)(nil)).Elem()
 }
@@ -5234,6 +9296,15 @@ const (
 	VsanDiskIssueTypeUnknown       = VsanDiskIssueType("unknown")
 )
 
+// Values returns all known values of the VsanDiskIssueType enum
+func (e VsanDiskIssueType) Values() []VsanDiskIssueType {
+	return []VsanDiskIssueType{
+		VsanDiskIssueTypeNonExist,
+		VsanDiskIssueTypeStampMismatch,
+		VsanDiskIssueTypeUnknown,
+	}
+}
+
 func init() {
 	t["VsanDiskIssueType"] = reflect.TypeOf((*VsanDiskIssueType)(nil)).Elem()
 }
@@ -5246,6 +9317,15 @@ const (
 	VsanHostDecommissionModeObjectActionEvacuateAllData           = VsanHostDecommissionModeObjectAction("evacuateAllData")
 )
 
+// Values returns all known values of the VsanHostDecommissionModeObjectAction enum
+func (e VsanHostDecommissionModeObjectAction) Values() []VsanHostDecommissionModeObjectAction {
+	return []VsanHostDecommissionModeObjectAction{
+		VsanHostDecommissionModeObjectActionNoAction,
+		VsanHostDecommissionModeObjectActionEnsureObjectAccessibility,
+		VsanHostDecommissionModeObjectActionEvacuateAllData,
+	}
+}
+
 func init() {
 	t["VsanHostDecommissionModeObjectAction"] = reflect.TypeOf((*VsanHostDecommissionModeObjectAction)(nil)).Elem()
 }
@@ -5258,6 +9338,15 @@ const (
 	VsanHostDiskResultStateIneligible = VsanHostDiskResultState("ineligible")
 )
 
+// Values returns all known values of the VsanHostDiskResultState enum
+func (e VsanHostDiskResultState) Values() []VsanHostDiskResultState {
+	return []VsanHostDiskResultState{
+		VsanHostDiskResultStateInUse,
+		VsanHostDiskResultStateEligible,
+		VsanHostDiskResultStateIneligible,
+	}
+}
+
 func init() {
 	t["VsanHostDiskResultState"] = reflect.TypeOf((*VsanHostDiskResultState)(nil)).Elem()
 }
@@ -5270,6 +9359,15 @@ const (
 	VsanHostHealthStateUnhealthy = VsanHostHealthState("unhealthy")
 )
 
+// Values returns all known values of the VsanHostHealthState enum
+func (e VsanHostHealthState) Values() []VsanHostHealthState {
+	return []VsanHostHealthState{
+		VsanHostHealthStateUnknown,
+		VsanHostHealthStateHealthy,
+		VsanHostHealthStateUnhealthy,
+	}
+}
+
 func init() {
 	t["VsanHostHealthState"] = reflect.TypeOf((*VsanHostHealthState)(nil)).Elem()
 }
@@ -5289,6 +9387,22 @@ const (
 	VsanHostNodeStateDecommissioning         = VsanHostNodeState("decommissioning")
 )
 
+// Values returns all known values of the VsanHostNodeState enum
+func (e VsanHostNodeState) Values() []VsanHostNodeState {
+	return []VsanHostNodeState{
+		VsanHostNodeStateError,
+		VsanHostNodeStateDisabled,
+		VsanHostNodeStateAgent,
+		VsanHostNodeStateMaster,
+		VsanHostNodeStateBackup,
+		VsanHostNodeStateStarting,
+		VsanHostNodeStateStopping,
+		VsanHostNodeStateEnteringMaintenanceMode,
+		VsanHostNodeStateExitingMaintenanceMode,
+		VsanHostNodeStateDecommissioning,
+	}
+}
+
 func init() {
 	t["VsanHostNodeState"] = reflect.TypeOf((*VsanHostNodeState)(nil)).Elem()
 }
@@ -5300,6 +9414,14 @@ const (
 	VsanUpgradeSystemUpgradeHistoryDiskGroupOpTypeRemove = VsanUpgradeSystemUpgradeHistoryDiskGroupOpType("remove")
 )
 
+// Values returns all known values of the VsanUpgradeSystemUpgradeHistoryDiskGroupOpType enum
+func (e VsanUpgradeSystemUpgradeHistoryDiskGroupOpType) Values() []VsanUpgradeSystemUpgradeHistoryDiskGroupOpType {
+	return []VsanUpgradeSystemUpgradeHistoryDiskGroupOpType{
+		VsanUpgradeSystemUpgradeHistoryDiskGroupOpTypeAdd,
+		VsanUpgradeSystemUpgradeHistoryDiskGroupOpTypeRemove,
+	}
+}
+
 func init() {
 	t["VsanUpgradeSystemUpgradeHistoryDiskGroupOpType"] = reflect.TypeOf((*VsanUpgradeSystemUpgradeHistoryDiskGroupOpType)(nil)).Elem()
 }
@@ -5314,6 +9436,17 @@ const (
 	WeekOfMonthLast   = WeekOfMonth("last")
 )
 
+// Values returns all known values of the WeekOfMonth enum
+func (e WeekOfMonth) Values() []WeekOfMonth {
+	return []WeekOfMonth{
+		WeekOfMonthFirst,
+		WeekOfMonthSecond,
+		WeekOfMonthThird,
+		WeekOfMonthFourth,
+		WeekOfMonthLast,
+	}
+}
+
 func init() {
 	t["WeekOfMonth"] = reflect.TypeOf((*WeekOfMonth)(nil)).Elem()
 }
@@ -5325,6 +9458,14 @@ const (
 	WillLoseHAProtectionResolutionRelocate = WillLoseHAProtectionResolution("relocate")
 )
 
+// Values returns all known values of the WillLoseHAProtectionResolution enum
+func (e WillLoseHAProtectionResolution) Values() []WillLoseHAProtectionResolution {
+	return []WillLoseHAProtectionResolution{
+		WillLoseHAProtectionResolutionSvmotion,
+		WillLoseHAProtectionResolutionRelocate,
+	}
+}
+
 func init() {
 	t["WillLoseHAProtectionResolution"] = reflect.TypeOf((*WillLoseHAProtectionResolution)(nil)).Elem()
 }
@@ -5337,6 +9478,15 @@ const (
 	VslmVStorageObjectControlFlagEnableChangedBlockTracking = VslmVStorageObjectControlFlag("enableChangedBlockTracking")
 )
 
+// Values returns all known values of the VslmVStorageObjectControlFlag enum
+func (e VslmVStorageObjectControlFlag) Values() []VslmVStorageObjectControlFlag {
+	return []VslmVStorageObjectControlFlag{
+		VslmVStorageObjectControlFlagKeepAfterDeleteVm,
+		VslmVStorageObjectControlFlagDisableRelocation,
+		VslmVStorageObjectControlFlagEnableChangedBlockTracking,
+	}
+}
+
 func init() {
 	t["vslmVStorageObjectControlFlag"] = reflect.TypeOf((*VslmVStorageObjectControlFlag)(nil)).Elem()
 }
@@ -52,6 +52,10 @@ var (
 		Type:  "PropertyCollector",
 		Value: "vsan-property-collector",
 	}
+	VsanVcClusterHealthSystemInstance = vimtypes.ManagedObjectReference{
+		Type:  "VsanVcClusterHealthSystem",
+		Value: "vsan-cluster-health-system",
+	}
 	VsanVcStretchedClusterSystem = vimtypes.ManagedObjectReference{
 		Type:  "VimClusterVsanVcStretchedClusterSystem",
 		Value: "vsan-stretched-cluster-system",
@@ -139,6 +143,23 @@ func (c *Client) VsanQueryObjectIdentities(ctx context.Context, cluster vimtypes
 	return res.Returnval, nil
 }
 
+// VsanQueryVcClusterHealthSummary calls the vsan cluster health system API.
+// The optional fields limit the summary to the given properties, such as "groups" or "objectHealth".
+func (c *Client) VsanQueryVcClusterHealthSummary(ctx context.Context, cluster vimtypes.ManagedObjectReference, fields ...string) (*vsantypes.VsanClusterHealthSummary, error) {
+	req := vsantypes.VsanQueryVcClusterHealthSummary{
+		This:    VsanVcClusterHealthSystemInstance,
+		Cluster: &cluster,
+		Fields:  fields,
+	}
+
+	res, err := methods.VsanQueryVcClusterHealthSummary(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
 // VsanHostGetConfig returns the config of host's vSAN system.
 func (c *Client) VsanHostGetConfig(ctx context.Context, vsanSystem vimtypes.ManagedObjectReference) (*vsantypes.VsanHostConfigInfoEx, error) {
 	req := vimtypes.RetrievePropertiesEx{
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vsan
+
+import (
+	"context"
+
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan/methods"
+	vsantypes "github.com/vmware/govmomi/vsan/types"
+)
+
+// VsanFileServiceSystemInstance is the vSAN file service system, used to manage file service domains and shares.
+var VsanFileServiceSystemInstance = vimtypes.ManagedObjectReference{
+	Type:  "VsanFileServiceSystem",
+	Value: "vsan-cluster-file-service-system",
+}
+
+// EnableFileService enables or disables the vSAN file service of the given cluster,
+// via VsanClusterReconfig with the given FileServiceConfig.
+func (c *Client) EnableFileService(ctx context.Context, cluster vimtypes.ManagedObjectReference, config vsantypes.VsanFileServiceConfig) (*object.Task, error) {
+	spec := vsantypes.VimVsanReconfigSpec{
+		Modify:            true,
+		FileServiceConfig: &config,
+	}
+
+	return c.VsanClusterReconfig(ctx, cluster, spec)
+}
+
+// VsanClusterCreateFsDomain creates a file service domain in the given cluster.
+func (c *Client) VsanClusterCreateFsDomain(ctx context.Context, cluster vimtypes.ManagedObjectReference, config vsantypes.VsanFileServiceDomainConfig) (*object.Task, error) {
+	req := vsantypes.VsanClusterCreateFsDomain{
+		This:         VsanFileServiceSystemInstance,
+		DomainConfig: config,
+		Cluster:      &cluster,
+	}
+
+	res, err := methods.VsanClusterCreateFsDomain(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return object.NewTask(c.vim25Client, res.Returnval), nil
+}
+
+// VsanClusterQueryFsDomains returns the file service domains of the given cluster.
+func (c *Client) VsanClusterQueryFsDomains(ctx context.Context, cluster vimtypes.ManagedObjectReference, spec *vsantypes.VsanFileServiceDomainQuerySpec) ([]vsantypes.VsanFileServiceDomain, error) {
+	req := vsantypes.VsanClusterQueryFsDomains{
+		This:      VsanFileServiceSystemInstance,
+		QuerySpec: spec,
+		Cluster:   &cluster,
+	}
+
+	res, err := methods.VsanClusterQueryFsDomains(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// VsanCreateFileShare creates a file share in the given cluster.
+// The result of the returned task is the UUID of the new share.
+func (c *Client) VsanCreateFileShare(ctx context.Context, cluster vimtypes.ManagedObjectReference, config vsantypes.VsanFileShareConfig) (*object.Task, error) {
+	req := vsantypes.VsanCreateFileShare{
+		This:    VsanFileServiceSystemInstance,
+		Config:  config,
+		Cluster: &cluster,
+	}
+
+	res, err := methods.VsanCreateFileShare(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return object.NewTask(c.vim25Client, res.Returnval), nil
+}
+
+// VsanClusterQueryFileShares returns the file shares of the given cluster that match the given spec.
+func (c *Client) VsanClusterQueryFileShares(ctx context.Context, cluster vimtypes.ManagedObjectReference, spec vsantypes.VsanFileShareQuerySpec) (*vsantypes.FileShareQueryResult, error) {
+	req := vsantypes.VsanClusterQueryFileShares{
+		This:      VsanFileServiceSystemInstance,
+		QuerySpec: spec,
+		Cluster:   &cluster,
+	}
+
+	res, err := methods.VsanClusterQueryFileShares(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// VsanReconfigureFileShare changes the config of the given file share.
+// Fields that are not set in the given config are not changed, the given label keys are removed.
+func (c *Client) VsanReconfigureFileShare(ctx context.Context, cluster vimtypes.ManagedObjectReference, uuid string, config vsantypes.VsanFileShareConfig, deleteLabelKeys ...string) (*object.Task, error) {
+	req := vsantypes.VsanReconfigureFileShare{
+		This:            VsanFileServiceSystemInstance,
+		ShareUuid:       uuid,
+		Config:          config,
+		Cluster:         &cluster,
+		DeleteLabelKeys: deleteLabelKeys,
+	}
+
+	res, err := methods.VsanReconfigureFileShare(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return object.NewTask(c.vim25Client, res.Returnval), nil
+}
+
+// VsanRemoveFileShare removes the given file share.
+// If force is false, shares that are in use cannot be removed.
+func (c *Client) VsanRemoveFileShare(ctx context.Context, cluster vimtypes.ManagedObjectReference, uuid string, force bool) (*object.Task, error) {
+	req := vsantypes.VsanRemoveFileShare{
+		This:      VsanFileServiceSystemInstance,
+		ShareUuid: uuid,
+		Cluster:   &cluster,
+		Force:     &force,
+	}
+
+	res, err := methods.VsanRemoveFileShare(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return object.NewTask(c.vim25Client, res.Returnval), nil
+}
+
+// VsanClusterQueryFileServiceHealthSummary returns the file service health of each host in the given cluster,
+// including the health of each file share.
+func (c *Client) VsanClusterQueryFileServiceHealthSummary(ctx context.Context, cluster vimtypes.ManagedObjectReference) (*vsantypes.VsanClusterFileServiceHealthSummary, error) {
+	req := vsantypes.VsanClusterQueryFileServiceHealthSummary{
+		This:    VsanVcClusterHealthSystemInstance,
+		Cluster: cluster,
+	}
+
+	res, err := methods.VsanClusterQueryFileServiceHealthSummary(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan"
+	"github.com/vmware/govmomi/vsan/types"
+)
+
+// Disk group layout of each host: one cache disk and capacityDisks capacity disks
+const (
+	cacheDiskSize    = 100 * 1024 * 1024 * 1024
+	capacityDiskSize = 1024 * 1024 * 1024 * 1024
+	capacityDisks    = 2
+)
+
+// vsanUUID returns a stable UUID for the given simulator object and name
+func vsanUUID(ref vim.ManagedObjectReference, name ...interface{}) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprint(ref.Value, name))).String()
+}
+
+// Datastore models the vSAN datastore of a cluster, backed by a disk group on each host
+// and an object for each VM namespace, virtual disk and swap file.
+type Datastore struct {
+	Cluster *simulator.ClusterComputeResource
+	Uuid    string
+	Hosts   []*simulator.HostSystem
+
+	vimMap *simulator.Registry
+}
+
+// datastore returns the vSAN Datastore model for the given cluster
+func datastore(ctx *simulator.Context, ref *vim.ManagedObjectReference) (*Datastore, vim.BaseMethodFault) {
+	if ref == nil {
+		return nil, &vim.InvalidArgument{InvalidProperty: "cluster"}
+	}
+
+	vimMap := ctx.VimMap()
+
+	cluster, ok := vimMap.Get(*ref).(*simulator.ClusterComputeResource)
+	if !ok {
+		return nil, &vim.ManagedObjectNotFound{Obj: *ref}
+	}
+
+	config := ctx.Map.Get(vsan.VsanVcClusterConfigSystemInstance).(*ClusterConfigSystem)
+
+	ds := &Datastore{
+		Cluster: cluster,
+		Uuid:    config.info(cluster.Self).DefaultConfig.Uuid,
+		vimMap:  vimMap,
+	}
+
+	for _, h := range cluster.Host {
+		if host, ok := vimMap.Get(h).(*simulator.HostSystem); ok {
+			ds.Hosts = append(ds.Hosts, host)
+		}
+	}
+
+	return ds, nil
+}
+
+// connected returns true if the given host is connected and not in maintenance mode
+func connected(host *simulator.HostSystem) bool {
+	return host.Runtime.ConnectionState == vim.HostSystemConnectionStateConnected && !host.Runtime.InMaintenanceMode
+}
+
+// NodeUuid returns the vSAN node UUID of the given host
+func (ds *Datastore) NodeUuid(host *simulator.HostSystem) string {
+	if config := host.Config.VsanHostConfig; config != nil && config.ClusterInfo != nil && config.ClusterInfo.NodeUuid != "" {
+		return config.ClusterInfo.NodeUuid
+	}
+	return vsanUUID(host.Self, "node")
+}
+
+// disk returns a vSAN claimed disk for the given host
+func (ds *Datastore) disk(host *simulator.HostSystem, i int, ssd bool, size int64) vim.HostScsiDisk {
+	id := vsanUUID(host.Self, "disk", i)
+	name := "naa." + id[24:]
+	const blockSize = 512
+
+	return vim.HostScsiDisk{
+		ScsiLun: vim.ScsiLun{
+			HostDevice: vim.HostDevice{
+				DeviceName: "/vmfs/devices/disks/" + name,
+				DeviceType: "disk",
+			},
+			Key:           "key-vim.host.ScsiDisk-" + id,
+			Uuid:          id,
+			CanonicalName: name,
+			DisplayName:   fmt.Sprintf("Local VMware Disk (%s)", name),
+			LunType:       "disk",
+			Vendor:        "VMware  ",
+			Model:         "Virtual disk",
+		},
+		Capacity: vim.HostDiskDimensionsLba{
+			BlockSize: blockSize,
+			Block:     size / blockSize,
+		},
+		DevicePath: "/vmfs/devices/disks/" + name,
+		Ssd:        vim.NewBool(ssd),
+		LocalDisk:  vim.NewBool(true),
+		VsanDiskInfo: &vim.VsanHostVsanDiskInfo{
+			VsanUuid:      id,
+			FormatVersion: 13,
+		},
+	}
+}
+
+// DiskMapping returns the disk groups of the given host.
+// If the host's vSAN storage config has no disk mappings, a single disk group is modeled.
+func (ds *Datastore) DiskMapping(host *simulator.HostSystem) []vim.VsanHostDiskMapping {
+	if config := host.Config.VsanHostConfig; config != nil && config.StorageInfo != nil && len(config.StorageInfo.DiskMapping) != 0 {
+		return config.StorageInfo.DiskMapping
+	}
+
+	group := vim.VsanHostDiskMapping{
+		Ssd: ds.disk(host, 0, true, cacheDiskSize),
+	}
+	for i := 1; i <= capacityDisks; i++ {
+		group.NonSsd = append(group.NonSsd, ds.disk(host, i, false, capacityDiskSize))
+	}
+
+	return []vim.VsanHostDiskMapping{group}
+}
+
+// HostConfig returns the vSAN config of the given host, as a member of this cluster
+func (ds *Datastore) HostConfig(host *simulator.HostSystem) types.VsanHostConfigInfoEx {
+	var config types.VsanHostConfigInfoEx
+	if c := host.Config.VsanHostConfig; c != nil {
+		config.VsanHostConfigInfo = *c
+	}
+
+	config.HostSystem = &host.Self
+	config.ClusterInfo = &vim.VsanHostConfigInfoClusterInfo{
+		Uuid:     ds.Uuid,
+		NodeUuid: ds.NodeUuid(host),
+	}
+
+	storage := vim.VsanHostConfigInfoStorageInfo{AutoClaimStorage: vim.NewBool(false)}
+	if c := config.StorageInfo; c != nil {
+		storage = *c
+	}
+	storage.DiskMapping = ds.DiskMapping(host)
+	config.StorageInfo = &storage
+
+	return config
+}
+
+// Capacity returns the total size of the capacity disks of each connected host
+func (ds *Datastore) Capacity() int64 {
+	var size int64
+	for _, host := range ds.Hosts {
+		if !connected(host) {
+			continue
+		}
+		for _, group := range ds.DiskMapping(host) {
+			for _, disk := range group.NonSsd {
+				size += disk.Capacity.Block * int64(disk.Capacity.BlockSize)
+			}
+		}
+	}
+	return size
+}
+
+// Object is a vSAN object, along with the host that owns it
+type Object struct {
+	types.VsanObjectIdentity
+
+	Host *simulator.HostSystem
+	Size int64
+}
+
+// Objects returns the vSAN objects of each VM in the cluster: namespace, virtual disks and swap
+func (ds *Datastore) Objects() []Object {
+	var objects []Object
+
+	for _, host := range ds.Hosts {
+		for _, ref := range host.Vm {
+			vm, ok := ds.vimMap.Get(ref).(*simulator.VirtualMachine)
+			if !ok || vm.Config == nil {
+				continue
+			}
+
+			ns := vsanUUID(vm.Self, "namespace")
+			add := func(kind, id, description string, size int64) {
+				objects = append(objects, Object{
+					VsanObjectIdentity: types.VsanObjectIdentity{
+						Uuid:           id,
+						Type:           kind,
+						VmInstanceUuid: vm.Config.InstanceUuid,
+						VmNsObjectUuid: ns,
+						Vm:             &vm.Self,
+						Description:    description,
+					},
+					Host: host,
+					Size: size,
+				})
+			}
+
+			add("vmnamespace", ns, vm.Name, 0)
+
+			disks := object.VirtualDeviceList(vm.Config.Hardware.Device).SelectByType((*vim.VirtualDisk)(nil))
+			for _, device := range disks {
+				disk := device.(*vim.VirtualDisk)
+				description := ""
+				if b, ok := disk.Backing.(vim.BaseVirtualDeviceFileBackingInfo); ok {
+					description = b.GetVirtualDeviceFileBackingInfo().FileName
+				}
+				add("vdisk", vsanUUID(vm.Self, "disk", disk.Key), description, disk.CapacityInBytes)
+			}
+
+			if vm.Runtime.PowerState == vim.VirtualMachinePowerStatePoweredOn {
+				add("vmswap", vsanUUID(vm.Self, "swap"), vm.Name+".vswp", int64(vm.Config.Hardware.MemoryMB)*1024*1024)
+			}
+		}
+	}
+
+	return objects
+}
+
+// Health returns the health of the given object: objects owned by a disconnected host have reduced availability
+func (ds *Datastore) Health(obj Object) string {
+	if connected(obj.Host) {
+		return "healthy"
+	}
+	return "reducedavailabilitywithnorebuild"
+}
+
+// ObjectHealth returns the overall health of the given objects, grouped by health state
+func (ds *Datastore) ObjectHealth(objects []Object, includeUuids bool) *types.VsanObjectOverallHealth {
+	var detail []types.VsanObjectHealth
+	index := make(map[string]int)
+
+	for _, obj := range objects {
+		health := ds.Health(obj)
+		i, ok := index[health]
+		if !ok {
+			i = len(detail)
+			index[health] = i
+			detail = append(detail, types.VsanObjectHealth{Health: health, VsanClusterUuid: ds.Uuid})
+		}
+		detail[i].NumObjects++
+		if includeUuids {
+			detail[i].ObjUuids = append(detail[i].ObjUuids, obj.Uuid)
+		}
+	}
+
+	return &types.VsanObjectOverallHealth{
+		ObjectHealthDetail:      detail,
+		ObjectVersionCompliance: vim.NewBool(true),
+	}
+}
@@ -0,0 +1,650 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan"
+	"github.com/vmware/govmomi/vsan/methods"
+	"github.com/vmware/govmomi/vsan/types"
+	fstypes "github.com/vmware/govmomi/vsan/vsanfs/types"
+)
+
+// FileShare is a vSAN file share, backed by a directory on the cluster's datastore
+type FileShare struct {
+	types.VsanFileShare
+
+	Cluster vim.ManagedObjectReference
+	Dir     string
+}
+
+type FileServiceSystem struct {
+	vim.ManagedObjectReference
+
+	Shares map[string]*FileShare
+}
+
+var (
+	fileShareProtocols        = []string{"NFSv3", "NFSv4", "SMB"}
+	fileShareDefaultProtocols = []string{"NFSv3", "NFSv4"}
+)
+
+// parseQuota parses a file share quota, such as "10GB".
+// An empty quota is unlimited.
+func parseQuota(s string) (int64, bool) {
+	if s == "" {
+		return 0, true
+	}
+
+	units := []string{"TB", "GB", "MB", "KB", "B"}
+	for i, unit := range units {
+		if !strings.HasSuffix(s, unit) {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSuffix(s, unit), 10, 64)
+		if err != nil || n < 0 {
+			return 0, false
+		}
+		for j := i; j < len(units)-1; j++ {
+			n *= 1024
+		}
+		return n, true
+	}
+
+	return 0, false
+}
+
+// usedCapacity returns the size of the files in the given directory
+func usedCapacity(dir string) int64 {
+	var size int64
+	_ = filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// fileService returns the file service config of the given cluster, if enabled
+func fileService(ctx *simulator.Context, cluster vim.ManagedObjectReference) (*types.VsanFileServiceConfig, vim.BaseMethodFault) {
+	config := ctx.Map.Get(vsan.VsanVcClusterConfigSystemInstance).(*ClusterConfigSystem)
+
+	fs := config.info(cluster).FileServiceConfig
+	if fs == nil || !fs.Enabled {
+		return nil, &vim.InvalidState{}
+	}
+
+	return fs, nil
+}
+
+// domain returns the file service domain of the given name, or the first domain if name is empty
+func domain(fs *types.VsanFileServiceConfig, name string) (*types.VsanFileServiceDomainConfig, vim.BaseMethodFault) {
+	for i := range fs.Domains {
+		if name == "" || fs.Domains[i].Name == name {
+			return &fs.Domains[i], nil
+		}
+	}
+
+	if name == "" {
+		return nil, nil
+	}
+
+	return nil, &vim.NotFound{}
+}
+
+// validateShareConfig validates the given share config fields, if set
+func validateShareConfig(config *types.VsanFileShareConfig) vim.BaseMethodFault {
+	if name := config.Name; name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return &vim.InvalidArgument{InvalidProperty: "config.name"}
+	}
+
+	quota, ok := parseQuota(config.Quota)
+	if !ok {
+		return &vim.InvalidArgument{InvalidProperty: "config.quota"}
+	}
+
+	soft, ok := parseQuota(config.SoftQuota)
+	if !ok || (quota != 0 && soft > quota) {
+		return &vim.InvalidArgument{InvalidProperty: "config.softQuota"}
+	}
+
+	for _, p := range config.Permission {
+		switch fstypes.VsanFileShareAccessType(p.Permissions) {
+		case fstypes.VsanFileShareAccessTypeREAD_ONLY,
+			fstypes.VsanFileShareAccessTypeREAD_WRITE,
+			fstypes.VsanFileShareAccessTypeNO_ACCESS:
+		default:
+			return &vim.InvalidArgument{InvalidProperty: "config.permission.permissions"}
+		}
+		if p.Ips == "" {
+			return &vim.InvalidArgument{InvalidProperty: "config.permission.ips"}
+		}
+	}
+
+	for _, p := range config.Protocols {
+		if !contains(fileShareProtocols, p) {
+			return &vim.InvalidArgument{InvalidProperty: "config.protocols"}
+		}
+	}
+
+	return nil
+}
+
+// shareRoot returns the directory in which file shares of the given cluster are created
+func shareRoot(ds *Datastore) (string, vim.BaseMethodFault) {
+	for _, ref := range ds.Cluster.Datastore {
+		if d, ok := ds.vimMap.Get(ref).(*simulator.Datastore); ok {
+			return filepath.Join(d.Info.GetDatastoreInfo().Url, ".vsanfs"), nil
+		}
+	}
+	return "", &vim.InvalidState{}
+}
+
+// shareDir returns the directory of the given share name, which must be a direct child of root
+func shareDir(root, name string) (string, vim.BaseMethodFault) {
+	dir := filepath.Join(root, name)
+	if !isShareDir(root, dir) {
+		return "", &vim.InvalidArgument{InvalidProperty: "config.name"}
+	}
+	return dir, nil
+}
+
+// isShareDir returns true if dir is a direct child of root
+func isShareDir(root, dir string) bool {
+	rel, err := filepath.Rel(root, dir)
+	return err == nil && rel != "." && rel != ".." && !strings.ContainsRune(rel, filepath.Separator)
+}
+
+// runtime returns the runtime info of the given share, served by the domain's primary file server
+func (s *FileShare) runtime(ds *Datastore, fs *types.VsanFileServiceConfig) *types.VsanFileShareRuntimeInfo {
+	info := &types.VsanFileShareRuntimeInfo{
+		UsedCapacity:    usedCapacity(s.Dir),
+		VsanObjectUuids: []string{vsanUUID(s.Cluster, "share", s.Uuid)},
+		ManagedBy:       "vSAN",
+	}
+
+	if d, _ := domain(fs, s.Config.DomainName); d != nil && len(d.FileServerIpConfig) != 0 {
+		ip := d.FileServerIpConfig[0]
+		info.Hostname = ip.Fqdn
+		info.Address = ip.IpAddress
+		info.FileServerFQDN = ip.Fqdn
+	}
+
+	server := info.Hostname
+	if server == "" {
+		server = info.Address
+	}
+	if server == "" && len(ds.Hosts) != 0 {
+		server = ds.Hosts[0].Name
+	}
+
+	for _, p := range s.Config.Protocols {
+		point := fmt.Sprintf("%s:%s", server, path.Join("/vsanfs", s.Config.Name))
+		if p == "SMB" {
+			point = fmt.Sprintf(`\\%s\%s`, server, s.Config.Name)
+		}
+		info.AccessPoints = append(info.AccessPoints, vim.KeyValue{Key: p, Value: point})
+	}
+
+	return info
+}
+
+// health returns the share health, based on its quota usage
+func (s *FileShare) health() (string, string) {
+	used := usedCapacity(s.Dir)
+	quota, _ := parseQuota(s.Config.Quota)
+	soft, _ := parseQuota(s.Config.SoftQuota)
+
+	switch {
+	case quota != 0 && used >= quota:
+		return healthRed, "Share usage has reached the hard quota"
+	case soft != 0 && used >= soft:
+		return healthYellow, "Share usage has exceeded the soft quota"
+	default:
+		return healthGreen, "Share is healthy"
+	}
+}
+
+// shares returns the file shares of the given cluster, sorted by name
+func (s *FileServiceSystem) shares(cluster vim.ManagedObjectReference) []*FileShare {
+	var shares []*FileShare
+	for _, share := range s.Shares {
+		if share.Cluster == cluster {
+			shares = append(shares, share)
+		}
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].Config.Name < shares[j].Config.Name
+	})
+	return shares
+}
+
+func (s *FileServiceSystem) share(cluster vim.ManagedObjectReference, id string) (*FileShare, vim.BaseMethodFault) {
+	share, ok := s.Shares[id]
+	if !ok || share.Cluster != cluster {
+		return nil, &vim.NotFound{}
+	}
+	return share, nil
+}
+
+func (s *FileServiceSystem) VsanClusterCreateFsDomain(ctx *simulator.Context, req *types.VsanClusterCreateFsDomain) soap.HasFault {
+	task := simulator.CreateTask(ctx, s, "createFileServiceDomain", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
+		ds, err := datastore(ctx, req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		fs, err := fileService(ctx, ds.Cluster.Self)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.DomainConfig.Name == "" {
+			return nil, &vim.InvalidArgument{InvalidProperty: "domainConfig.name"}
+		}
+		for _, d := range fs.Domains {
+			if d.Name == req.DomainConfig.Name {
+				return nil, &vim.AlreadyExists{Name: d.Name}
+			}
+		}
+
+		fs.Domains = append(fs.Domains, req.DomainConfig)
+
+		return nil, nil
+	})
+
+	return &methods.VsanClusterCreateFsDomainBody{
+		Res: &types.VsanClusterCreateFsDomainResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (s *FileServiceSystem) VsanClusterQueryFsDomains(ctx *simulator.Context, req *types.VsanClusterQueryFsDomains) soap.HasFault {
+	body := new(methods.VsanClusterQueryFsDomainsBody)
+
+	ds, err := datastore(ctx, req.Cluster)
+	if err != nil {
+		body.Fault_ = simulator.Fault("", err)
+		return body
+	}
+
+	var res []types.VsanFileServiceDomain
+
+	if fs, _ := fileService(ctx, ds.Cluster.Self); fs != nil {
+		for i := range fs.Domains {
+			d := fs.Domains[i]
+			if spec := req.QuerySpec; spec != nil && len(spec.Uuids) != 0 && !contains(spec.Uuids, vsanUUID(ds.Cluster.Self, "domain", d.Name)) {
+				continue
+			}
+			res = append(res, types.VsanFileServiceDomain{
+				Uuid:   vsanUUID(ds.Cluster.Self, "domain", d.Name),
+				Config: &d,
+			})
+		}
+	}
+
+	body.Res = &types.VsanClusterQueryFsDomainsResponse{
+		Returnval: res,
+	}
+
+	return body
+}
+
+func (s *FileServiceSystem) VsanCreateFileShare(ctx *simulator.Context, req *types.VsanCreateFileShare) soap.HasFault {
+	task := simulator.CreateTask(ctx, s, "createFileShare", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
+		ds, err := datastore(ctx, req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		fs, err := fileService(ctx, ds.Cluster.Self)
+		if err != nil {
+			return nil, err
+		}
+
+		config := req.Config
+		if config.Name == "" {
+			return nil, &vim.InvalidArgument{InvalidProperty: "config.name"}
+		}
+		if err = validateShareConfig(&config); err != nil {
+			return nil, err
+		}
+		for _, share := range s.shares(ds.Cluster.Self) {
+			if share.Config.Name == config.Name {
+				return nil, &vim.AlreadyExists{Name: config.Name}
+			}
+		}
+
+		d, err := domain(fs, config.DomainName)
+		if err != nil {
+			return nil, err
+		}
+		if d != nil {
+			config.DomainName = d.Name
+		}
+		if len(config.Protocols) == 0 {
+			config.Protocols = fileShareDefaultProtocols
+		}
+		if len(config.Permission) == 0 {
+			config.Permission = []types.VsanFileShareNetPermission{{
+				Ips:         "*",
+				Permissions: string(fstypes.VsanFileShareAccessTypeREAD_WRITE),
+				AllowRoot:   vim.NewBool(true),
+			}}
+		}
+
+		root, err := shareRoot(ds)
+		if err != nil {
+			return nil, err
+		}
+		dir, err := shareDir(root, config.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		share := &FileShare{
+			VsanFileShare: types.VsanFileShare{
+				Uuid:   uuid.New().String(),
+				Config: &config,
+			},
+			Cluster: ds.Cluster.Self,
+			Dir:     dir,
+		}
+
+		if err := os.MkdirAll(share.Dir, 0750); err != nil {
+			return nil, &vim.CannotCreateFile{FileFault: vim.FileFault{File: share.Dir}}
+		}
+
+		if s.Shares == nil {
+			s.Shares = make(map[string]*FileShare)
+		}
+		s.Shares[share.Uuid] = share
+
+		return share.Uuid, nil
+	})
+
+	return &methods.VsanCreateFileShareBody{
+		Res: &types.VsanCreateFileShareResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// labels returns the given share labels, filtered by the given query properties
+func labels(labels []vim.KeyValue, props *types.VsanFileShareQueryProperties) []vim.KeyValue {
+	if props == nil || (props.IncludeAllLabels != nil && *props.IncludeAllLabels) {
+		return labels
+	}
+
+	var res []vim.KeyValue
+	for _, label := range labels {
+		if contains(props.LabelKeys, label.Key) {
+			res = append(res, label)
+		}
+	}
+	return res
+}
+
+func (s *FileServiceSystem) VsanClusterQueryFileShares(ctx *simulator.Context, req *types.VsanClusterQueryFileShares) soap.HasFault {
+	body := new(methods.VsanClusterQueryFileSharesBody)
+
+	ds, err := datastore(ctx, req.Cluster)
+	if err != nil {
+		body.Fault_ = simulator.Fault("", err)
+		return body
+	}
+	fs, err := fileService(ctx, ds.Cluster.Self)
+	if err != nil {
+		body.Fault_ = simulator.Fault("", err)
+		return body
+	}
+
+	spec := req.QuerySpec
+	var shares []types.VsanFileShare
+
+	for _, share := range s.shares(ds.Cluster.Self) {
+		config := *share.Config
+		runtime := share.runtime(ds, fs)
+
+		switch {
+		case spec.DomainName != "" && spec.DomainName != config.DomainName:
+			continue
+		case len(spec.Uuids) != 0 && !contains(spec.Uuids, share.Uuid):
+			continue
+		case len(spec.Names) != 0 && !contains(spec.Names, config.Name):
+			continue
+		case len(spec.ManagedBy) != 0 && !contains(spec.ManagedBy, runtime.ManagedBy):
+			continue
+		}
+		if len(spec.Protocols) != 0 {
+			match := false
+			for _, p := range config.Protocols {
+				match = match || contains(spec.Protocols, p)
+			}
+			if !match {
+				continue
+			}
+		}
+
+		if props := spec.Properties; props != nil {
+			if props.IncludeUsedCapacity == nil || !*props.IncludeUsedCapacity {
+				runtime.UsedCapacity = 0
+			}
+			if props.IncludeVsanObjectUuids == nil || !*props.IncludeVsanObjectUuids {
+				runtime.VsanObjectUuids = nil
+			}
+		}
+		config.Labels = labels(config.Labels, spec.Properties)
+
+		shares = append(shares, types.VsanFileShare{
+			Uuid:    share.Uuid,
+			Config:  &config,
+			Runtime: runtime,
+		})
+	}
+
+	res := &types.FileShareQueryResult{TotalShareCount: int64(len(shares))}
+
+	offset := 0
+	if spec.Offset != "" {
+		offset, _ = strconv.Atoi(spec.Offset)
+	}
+	if offset > len(shares) {
+		offset = len(shares)
+	}
+	shares = shares[offset:]
+	if spec.Limit > 0 && int(spec.Limit) < len(shares) {
+		shares = shares[:spec.Limit]
+		res.NextOffset = strconv.Itoa(offset + len(shares))
+	}
+	res.FileShares = shares
+
+	body.Res = &types.VsanClusterQueryFileSharesResponse{
+		Returnval: res,
+	}
+
+	return body
+}
+
+func (s *FileServiceSystem) VsanReconfigureFileShare(ctx *simulator.Context, req *types.VsanReconfigureFileShare) soap.HasFault {
+	task := simulator.CreateTask(ctx, s, "reconfigureFileShare", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
+		ds, err := datastore(ctx, req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		fs, err := fileService(ctx, ds.Cluster.Self)
+		if err != nil {
+			return nil, err
+		}
+		share, err := s.share(ds.Cluster.Self, req.ShareUuid)
+		if err != nil {
+			return nil, err
+		}
+
+		spec := req.Config
+		if err = validateShareConfig(&spec); err != nil {
+			return nil, err
+		}
+
+		config := *share.Config
+		dir := share.Dir
+
+		if spec.Name != "" && spec.Name != config.Name {
+			for _, other := range s.shares(ds.Cluster.Self) {
+				if other.Config.Name == spec.Name {
+					return nil, &vim.AlreadyExists{Name: spec.Name}
+				}
+			}
+			root, err := shareRoot(ds)
+			if err != nil {
+				return nil, err
+			}
+			config.Name = spec.Name
+			if dir, err = shareDir(root, spec.Name); err != nil {
+				return nil, err
+			}
+		}
+		if spec.DomainName != "" {
+			if _, err = domain(fs, spec.DomainName); err != nil {
+				return nil, err
+			}
+			config.DomainName = spec.DomainName
+		}
+		if spec.Quota != "" {
+			config.Quota = spec.Quota
+		}
+		if spec.SoftQuota != "" {
+			config.SoftQuota = spec.SoftQuota
+		}
+		quota, _ := parseQuota(config.Quota)
+		soft, _ := parseQuota(config.SoftQuota)
+		if quota != 0 && soft > quota {
+			return nil, &vim.InvalidArgument{InvalidProperty: "config.softQuota"}
+		}
+		if quota != 0 && usedCapacity(share.Dir) > quota && !include(req.Force, false) {
+			return nil, &vim.InvalidArgument{InvalidProperty: "config.quota"}
+		}
+		if spec.Permission != nil {
+			config.Permission = spec.Permission
+		}
+		if spec.Protocols != nil {
+			config.Protocols = spec.Protocols
+		}
+		if spec.StoragePolicy != nil {
+			config.StoragePolicy = spec.StoragePolicy
+		}
+		if spec.SmbOptions != nil {
+			config.SmbOptions = spec.SmbOptions
+		}
+		if spec.NfsSecType != "" {
+			config.NfsSecType = spec.NfsSecType
+		}
+
+		var labels []vim.KeyValue
+		for _, label := range config.Labels {
+			if contains(req.DeleteLabelKeys, label.Key) {
+				continue
+			}
+			for _, l := range spec.Labels {
+				if l.Key == label.Key {
+					label.Value = l.Value
+				}
+			}
+			labels = append(labels, label)
+		}
+		for _, l := range spec.Labels {
+			found := false
+			for _, label := range labels {
+				found = found || label.Key == l.Key
+			}
+			if !found {
+				labels = append(labels, l)
+			}
+		}
+		config.Labels = labels
+
+		if dir != share.Dir {
+			if !isShareDir(filepath.Dir(dir), share.Dir) {
+				return nil, &vim.InvalidState{}
+			}
+			if err := os.Rename(share.Dir, dir); err != nil {
+				return nil, &vim.CannotCreateFile{FileFault: vim.FileFault{File: dir}}
+			}
+			share.Dir = dir
+		}
+		share.Config = &config
+
+		return nil, nil
+	})
+
+	return &methods.VsanReconfigureFileShareBody{
+		Res: &types.VsanReconfigureFileShareResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (s *FileServiceSystem) VsanRemoveFileShare(ctx *simulator.Context, req *types.VsanRemoveFileShare) soap.HasFault {
+	task := simulator.CreateTask(ctx, s, "removeFileShare", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
+		ds, err := datastore(ctx, req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		share, err := s.share(ds.Cluster.Self, req.ShareUuid)
+		if err != nil {
+			return nil, err
+		}
+
+		// a share with files is in use, unless forced
+		if usedCapacity(share.Dir) != 0 && !include(req.Force, false) {
+			return nil, &vim.ResourceInUse{Type: "VsanFileShare", Name: share.Config.Name}
+		}
+
+		root, err := shareRoot(ds)
+		if err != nil {
+			return nil, err
+		}
+		if !isShareDir(root, share.Dir) {
+			return nil, &vim.CannotDeleteFile{FileFault: vim.FileFault{File: share.Dir}}
+		}
+
+		if err := os.RemoveAll(share.Dir); err != nil {
+			return nil, &vim.CannotDeleteFile{FileFault: vim.FileFault{File: share.Dir}}
+		}
+		delete(s.Shares, share.Uuid)
+
+		return nil, nil
+	})
+
+	return &methods.VsanRemoveFileShareBody{
+		Res: &types.VsanRemoveFileShareResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
@@ -0,0 +1,304 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan"
+	"github.com/vmware/govmomi/vsan/methods"
+	"github.com/vmware/govmomi/vsan/types"
+)
+
+type ClusterHealthSystem struct {
+	vim.ManagedObjectReference
+}
+
+// Health states, in order of severity
+const (
+	healthGreen  = "green"
+	healthYellow = "yellow"
+	healthRed    = "red"
+)
+
+// worse returns the more severe of the given health states
+func worse(a, b string) string {
+	rank := map[string]int{healthGreen: 0, healthYellow: 1, healthRed: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// healthTest returns a VsanClusterHealthTest, which is green if all entities are healthy
+func healthTest(id, name string, healthy, all int, failed string) types.VsanClusterHealthTest {
+	health := healthGreen
+	if healthy != all {
+		health = failed
+	}
+
+	return types.VsanClusterHealthTest{
+		TestId:              id,
+		TestName:            name,
+		TestHealthyEntities: int32(healthy),
+		TestAllEntities:     int32(all),
+		TestHealth:          health,
+	}
+}
+
+// healthGroup returns a VsanClusterHealthGroup, with the health of its most severe test
+func healthGroup(id, name string, tests ...types.VsanClusterHealthTest) types.VsanClusterHealthGroup {
+	group := types.VsanClusterHealthGroup{
+		GroupId:     id,
+		GroupName:   name,
+		GroupHealth: healthGreen,
+		GroupTests:  tests,
+		InProgress:  vim.NewBool(false),
+	}
+
+	for _, test := range tests {
+		group.GroupHealth = worse(group.GroupHealth, test.TestHealth)
+	}
+
+	return group
+}
+
+// clusterStatus returns the health service status of each host
+func (ds *Datastore) clusterStatus() *types.VsanClusterHealthSystemStatusResult {
+	res := &types.VsanClusterHealthSystemStatusResult{
+		Status:    healthGreen,
+		GoalState: "installed",
+	}
+
+	for _, host := range ds.Hosts {
+		status := types.VsanHostHealthSystemStatusResult{
+			Hostname: host.Name,
+			Status:   healthGreen,
+		}
+		if !connected(host) {
+			status.Status = healthRed
+			status.Issues = append(status.Issues, "Host is not connected")
+			res.Status = healthRed
+		}
+		res.TrackedHostsStatus = append(res.TrackedHostsStatus, status)
+	}
+
+	return res
+}
+
+// physicalDisksHealth returns the health of the disks in each host's disk groups
+func (ds *Datastore) physicalDisksHealth() []types.VsanPhysicalDiskHealthSummary {
+	var res []types.VsanPhysicalDiskHealthSummary
+
+	for _, host := range ds.Hosts {
+		summary := types.VsanPhysicalDiskHealthSummary{
+			OverallHealth: healthGreen,
+			Hostname:      host.Name,
+		}
+
+		for _, group := range ds.DiskMapping(host) {
+			disks := append([]vim.HostScsiDisk{group.Ssd}, group.NonSsd...)
+			for i := range disks {
+				disk := &disks[i]
+				capacity := disk.Capacity.Block * int64(disk.Capacity.BlockSize)
+				summary.Disks = append(summary.Disks, types.VsanPhysicalDiskHealth{
+					Name:              disk.CanonicalName,
+					Uuid:              disk.VsanDiskInfo.VsanUuid,
+					InCmmds:           connected(host),
+					InVsi:             true,
+					FormatVersion:     disk.VsanDiskInfo.FormatVersion,
+					OperationalHealth: healthGreen,
+					CapacityHealth:    healthGreen,
+					SummaryHealth:     healthGreen,
+					Capacity:          capacity,
+					TotalBytes:        capacity,
+					FreeBytes:         capacity,
+					ScsiDisk:          disk,
+				})
+			}
+		}
+
+		res = append(res, summary)
+	}
+
+	return res
+}
+
+// summary returns the health summary of the cluster, optionally limited to the given objects
+func (ds *Datastore) summary(uuids []string, includeUuids bool) types.VsanClusterHealthSummary {
+	now := time.Now()
+	objects := filterObjects(ds.Objects(), uuids, nil)
+
+	res := types.VsanClusterHealthSummary{
+		Cluster:             &ds.Cluster.Self,
+		Timestamp:           &now,
+		ClusterStatus:       ds.clusterStatus(),
+		ObjectHealth:        ds.ObjectHealth(objects, includeUuids),
+		PhysicalDisksHealth: ds.physicalDisksHealth(),
+		OverallHealth:       healthGreen,
+	}
+
+	connectedHosts := 0
+	for _, host := range ds.Hosts {
+		if connected(host) {
+			connectedHosts++
+		}
+	}
+
+	healthy := 0
+	for _, obj := range objects {
+		if ds.Health(obj) == "healthy" {
+			healthy++
+		}
+	}
+
+	disks, healthyDisks := 0, 0
+	for _, summary := range res.PhysicalDisksHealth {
+		for _, disk := range summary.Disks {
+			disks++
+			if disk.InCmmds {
+				healthyDisks++
+			}
+		}
+	}
+
+	res.Groups = []types.VsanClusterHealthGroup{
+		healthGroup("com.vmware.vsan.health.test.cluster", "Cluster",
+			healthTest("com.vmware.vsan.health.test.hostdisconnected", "Hosts disconnected from VC", connectedHosts, len(ds.Hosts), healthRed)),
+		healthGroup("com.vmware.vsan.health.test.data", "Data",
+			healthTest("com.vmware.vsan.health.test.objecthealth", "vSAN object health", healthy, len(objects), healthYellow)),
+		healthGroup("com.vmware.vsan.health.test.physicaldisks", "Physical disk",
+			healthTest("com.vmware.vsan.health.test.physdiskoverall", "Operation health", healthyDisks, disks, healthRed)),
+	}
+
+	var issues []string
+	for _, group := range res.Groups {
+		res.OverallHealth = worse(res.OverallHealth, group.GroupHealth)
+		if group.GroupHealth != healthGreen {
+			issues = append(issues, group.GroupName)
+		}
+	}
+
+	if len(issues) == 0 {
+		res.OverallHealthDescription = "No issues found"
+	} else {
+		res.OverallHealthDescription = "Issues found in health test groups: " + strings.Join(issues, ", ")
+	}
+
+	return res
+}
+
+// fields limits the given summary to the given field names, if any
+func fields(res *types.VsanClusterHealthSummary, names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	if !contains(names, "clusterStatus") {
+		res.ClusterStatus = nil
+	}
+	if !contains(names, "objectHealth") {
+		res.ObjectHealth = nil
+	}
+	if !contains(names, "physicalDisksHealth") {
+		res.PhysicalDisksHealth = nil
+	}
+	if !contains(names, "groups") {
+		res.Groups = nil
+	}
+}
+
+func (s *ClusterHealthSystem) VsanQueryVcClusterHealthSummary(ctx *simulator.Context, req *types.VsanQueryVcClusterHealthSummary) soap.HasFault {
+	body := new(methods.VsanQueryVcClusterHealthSummaryBody)
+
+	ds, err := datastore(ctx, req.Cluster)
+	if err != nil {
+		body.Fault_ = simulator.Fault("", err)
+		return body
+	}
+
+	res := ds.summary(req.ObjUuids, include(req.IncludeObjUuids, false))
+	fields(&res, req.Fields)
+
+	body.Res = &types.VsanQueryVcClusterHealthSummaryResponse{
+		Returnval: res,
+	}
+
+	return body
+}
+
+func (s *ClusterHealthSystem) VsanClusterQueryFileServiceHealthSummary(ctx *simulator.Context, req *types.VsanClusterQueryFileServiceHealthSummary) soap.HasFault {
+	body := new(methods.VsanClusterQueryFileServiceHealthSummaryBody)
+
+	ds, err := datastore(ctx, &req.Cluster)
+	if err != nil {
+		body.Fault_ = simulator.Fault("", err)
+		return body
+	}
+
+	enabled := true
+	if _, err = fileService(ctx, ds.Cluster.Self); err != nil {
+		enabled = false
+	}
+
+	res := &types.VsanClusterFileServiceHealthSummary{OverallHealth: healthGreen}
+
+	for _, host := range ds.Hosts {
+		summary := types.VsanFileServiceHealthSummary{
+			Hostname:      host.Name,
+			OverallHealth: healthGreen,
+			Enabled:       vim.NewBool(enabled),
+		}
+		if enabled && !connected(host) {
+			summary.OverallHealth = healthRed
+		}
+		res.HostResults = append(res.HostResults, summary)
+	}
+
+	// shares are distributed across the cluster's file servers, one per host
+	fs := ctx.Map.Get(vsan.VsanFileServiceSystemInstance).(*FileServiceSystem)
+	for i, share := range fs.shares(ds.Cluster.Self) {
+		if len(res.HostResults) == 0 {
+			break
+		}
+		health, description := share.health()
+		summary := &res.HostResults[i%len(res.HostResults)]
+
+		summary.FileShareHealth = append(summary.FileShareHealth, types.VsanFileServiceShareHealthSummary{
+			OverallHealth: health,
+			DomainName:    share.Config.DomainName,
+			ShareUuid:     share.Uuid,
+			ShareName:     share.Config.Name,
+			Description:   description,
+		})
+		summary.OverallHealth = worse(summary.OverallHealth, health)
+	}
+
+	for _, summary := range res.HostResults {
+		res.OverallHealth = worse(res.OverallHealth, summary.OverallHealth)
+	}
+
+	body.Res = &types.VsanClusterQueryFileServiceHealthSummaryResponse{
+		Returnval: res,
+	}
+
+	return body
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan/methods"
+	"github.com/vmware/govmomi/vsan/types"
+)
+
+type ObjectSystem struct {
+	vim.ManagedObjectReference
+}
+
+// include returns the value of an optional request flag, with the given default
+func include(flag *bool, def bool) bool {
+	if flag == nil {
+		return def
+	}
+	return *flag
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// filterObjects returns the objects matching the given UUIDs and types, if any
+func filterObjects(objects []Object, uuids, kinds []string) []Object {
+	var res []Object
+	for _, obj := range objects {
+		if len(uuids) != 0 && !contains(uuids, obj.Uuid) {
+			continue
+		}
+		if len(kinds) != 0 && !contains(kinds, obj.Type) {
+			continue
+		}
+		res = append(res, obj)
+	}
+	return res
+}
+
+// spaceSummary returns the space used by the given objects, per object type.
+// Objects use the default storage policy (RAID-1, FTT=1), using twice their size.
+func spaceSummary(objects []Object) []types.VsanObjectSpaceSummary {
+	var summary []types.VsanObjectSpaceSummary
+	index := make(map[string]int)
+
+	for _, obj := range objects {
+		i, ok := index[obj.Type]
+		if !ok {
+			i = len(summary)
+			index[obj.Type] = i
+			summary = append(summary, types.VsanObjectSpaceSummary{ObjType: obj.Type})
+		}
+		s := &summary[i]
+		s.PrimaryCapacityB += obj.Size
+		s.ProvisionCapacityB += obj.Size
+		s.OverheadB += obj.Size
+		s.UsedB += 2 * obj.Size
+		s.PhysicalUsedB += 2 * obj.Size
+	}
+
+	return summary
+}
+
+func (s *ObjectSystem) VsanQueryObjectIdentities(ctx *simulator.Context, req *types.VsanQueryObjectIdentities) soap.HasFault {
+	body := new(methods.VsanQueryObjectIdentitiesBody)
+
+	ds, err := datastore(ctx, req.Cluster)
+	if err != nil {
+		body.Fault_ = simulator.Fault("", err)
+		return body
+	}
+
+	objects := filterObjects(ds.Objects(), req.ObjUuids, req.ObjTypes)
+	res := new(types.VsanObjectIdentityAndHealth)
+
+	// vSAN defaults to identities only, the simulator includes health unless disabled
+	if include(req.IncludeObjIdentity, true) {
+		for _, obj := range objects {
+			res.Identities = append(res.Identities, obj.VsanObjectIdentity)
+		}
+	}
+	if include(req.IncludeHealth, true) {
+		res.Health = ds.ObjectHealth(objects, true)
+	}
+	if include(req.IncludeSpaceSummary, false) {
+		res.SpaceSummary = spaceSummary(objects)
+	}
+
+	body.Res = &types.VsanQueryObjectIdentitiesResponse{
+		Returnval: res,
+	}
+
+	return body
+}
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan/methods"
+	"github.com/vmware/govmomi/vsan/types"
+)
+
+type PerformanceManager struct {
+	vim.ManagedObjectReference
+}
+
+// perfMetric describes a synthetic performance metric series
+type perfMetric struct {
+	label string
+	base  float64 // mean value, varied over time
+}
+
+var (
+	perfDOM = []perfMetric{
+		{"iopsRead", 800},
+		{"iopsWrite", 400},
+		{"throughputRead", 32 * 1024 * 1024},
+		{"throughputWrite", 16 * 1024 * 1024},
+		{"latencyAvgRead", 900},
+		{"latencyAvgWrite", 1800},
+		{"congestion", 0},
+		{"oio", 4},
+	}
+
+	perfVM = []perfMetric{
+		{"iopsRead", 100},
+		{"iopsWrite", 50},
+		{"throughputRead", 4 * 1024 * 1024},
+		{"throughputWrite", 2 * 1024 * 1024},
+		{"latencyRead", 1000},
+		{"latencyWrite", 2000},
+	}
+
+	perfDiskGroup = []perfMetric{
+		{"iopsRead", 400},
+		{"iopsWrite", 200},
+		{"latencyAvgRead", 500},
+		{"latencyAvgWrite", 1000},
+		{"capacityUsed", 256 * 1024 * 1024 * 1024},
+	}
+
+	// perfEntityTypes maps the vSAN performance entity type to its metrics
+	perfEntityTypes = map[string][]perfMetric{
+		"cluster-domclient":  perfDOM,
+		"cluster-domcompmgr": perfDOM,
+		"host-domclient":     perfDOM,
+		"host-domcompmgr":    perfDOM,
+		"virtual-machine":    perfVM,
+		"disk-group":         perfDiskGroup,
+	}
+)
+
+// perfTimeFormat is the format of timestamps in VsanPerfEntityMetricCSV.SampleInfo
+const perfTimeFormat = "2006-01-02 15:04:05"
+
+// maxPerfSamples limits the number of samples in each series
+const maxPerfSamples = 2016 // 1 week of 5 minute samples
+
+// perfEntities returns the IDs of the given type within the given cluster
+func perfEntities(ds *Datastore, kind string) []string {
+	var ids []string
+
+	switch kind {
+	case "cluster-domclient", "cluster-domcompmgr":
+		ids = append(ids, ds.Uuid)
+	case "host-domclient", "host-domcompmgr":
+		for _, host := range ds.Hosts {
+			ids = append(ids, ds.NodeUuid(host))
+		}
+	case "virtual-machine":
+		seen := make(map[string]bool)
+		for _, obj := range ds.Objects() {
+			if !seen[obj.VmInstanceUuid] {
+				seen[obj.VmInstanceUuid] = true
+				ids = append(ids, obj.VmInstanceUuid)
+			}
+		}
+	case "disk-group":
+		for _, host := range ds.Hosts {
+			for _, group := range ds.DiskMapping(host) {
+				ids = append(ids, group.Ssd.VsanDiskInfo.VsanUuid)
+			}
+		}
+	}
+
+	return ids
+}
+
+// perfValue returns a synthetic value for the given series at the given time.
+// Values vary over a daily cycle, with a phase derived from the series name, such that queries are repeatable.
+func perfValue(series string, m perfMetric, t time.Time) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(series))
+	phase := float64(h.Sum32()%360) * math.Pi / 180
+
+	day := float64(t.Unix()%86400) / 86400
+	val := m.base * (1 + 0.5*math.Sin(2*math.Pi*day+phase))
+
+	return strconv.FormatInt(int64(val), 10)
+}
+
+// perfQuery returns the metric series for the given spec and entity ID
+func perfQuery(spec *types.VsanPerfQuerySpec, kind, id string, metrics []perfMetric) types.VsanPerfEntityMetricCSV {
+	interval := time.Duration(spec.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	end := time.Now()
+	if spec.EndTime != nil {
+		end = *spec.EndTime
+	}
+	start := end.Add(-time.Hour)
+	if spec.StartTime != nil {
+		start = *spec.StartTime
+	}
+	if n := end.Sub(start) / interval; n > maxPerfSamples {
+		start = end.Add(-maxPerfSamples * interval)
+	}
+
+	var samples []time.Time
+	for t := start.Truncate(interval); !t.After(end); t = t.Add(interval) {
+		if !t.Before(start) {
+			samples = append(samples, t.UTC())
+		}
+	}
+
+	ref := kind + ":" + id
+	res := types.VsanPerfEntityMetricCSV{EntityRefId: ref}
+
+	var info []string
+	for _, t := range samples {
+		info = append(info, t.Format(perfTimeFormat))
+	}
+	res.SampleInfo = strings.Join(info, ",")
+
+	for _, m := range metrics {
+		if len(spec.Labels) != 0 && !contains(spec.Labels, m.label) {
+			continue
+		}
+
+		values := make([]string, len(samples))
+		for i, t := range samples {
+			values[i] = perfValue(ref+m.label, m, t)
+		}
+
+		res.Value = append(res.Value, types.VsanPerfMetricSeriesCSV{
+			MetricId: types.VsanPerfMetricId{
+				Label:                  m.label,
+				Group:                  spec.Group,
+				MetricsCollectInterval: int32(interval.Seconds()),
+			},
+			Values: strings.Join(values, ","),
+		})
+	}
+
+	return res
+}
+
+func (m *PerformanceManager) VsanPerfQueryPerf(ctx *simulator.Context, req *types.VsanPerfQueryPerf) soap.HasFault {
+	body := new(methods.VsanPerfQueryPerfBody)
+
+	ds, err := datastore(ctx, req.Cluster)
+	if err != nil {
+		body.Fault_ = simulator.Fault("", err)
+		return body
+	}
+
+	var res []types.VsanPerfEntityMetricCSV
+
+	for i := range req.QuerySpecs {
+		spec := &req.QuerySpecs[i]
+
+		// EntityRefId is of the form "type:id", where id may be "*" for all entities of the given type
+		s := strings.SplitN(spec.EntityRefId, ":", 2)
+		metrics, ok := perfEntityTypes[s[0]]
+		if len(s) != 2 || !ok {
+			body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "entityRefId"})
+			return body
+		}
+
+		for _, id := range perfEntities(ds, s[0]) {
+			if s[1] == "*" || s[1] == id {
+				res = append(res, perfQuery(spec, s[0], id, metrics))
+			}
+		}
+	}
+
+	body.Res = &types.VsanPerfQueryPerfResponse{
+		Returnval: res,
+	}
+
+	return body
+}
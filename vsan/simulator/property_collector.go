@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/simulator"
+	vimmethods "github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// PropertyCollector of the vSAN endpoint, which supports HostVsanSystem "config" only.
+// The config is returned as VsanHostConfigInfoEx, including the disk groups of the host's vSAN Datastore.
+type PropertyCollector struct {
+	vim.ManagedObjectReference
+}
+
+// hostVsanConfig returns the vSAN config of the host that owns the given HostVsanSystem
+func hostVsanConfig(ctx *simulator.Context, ref vim.ManagedObjectReference) (vim.AnyType, bool) {
+	for _, e := range ctx.VimMap().All("HostSystem") {
+		host := e.(*simulator.HostSystem)
+		if vs := host.ConfigManager.VsanSystem; vs == nil || *vs != ref {
+			continue
+		}
+
+		ds := &Datastore{Uuid: vsanUUID(host.Self, "standalone"), vimMap: ctx.VimMap()}
+		if host.Parent != nil && host.Parent.Type == "ClusterComputeResource" {
+			if cds, err := datastore(ctx, host.Parent); err == nil {
+				ds = cds
+			}
+		}
+
+		return ds.HostConfig(host), true
+	}
+
+	return nil, false
+}
+
+func (pc *PropertyCollector) RetrievePropertiesEx(ctx *simulator.Context, req *vim.RetrievePropertiesEx) soap.HasFault {
+	body := new(vimmethods.RetrievePropertiesExBody)
+	res := new(vim.RetrieveResult)
+
+	for _, spec := range req.SpecSet {
+		for _, os := range spec.ObjectSet {
+			config, ok := hostVsanConfig(ctx, os.Obj)
+			if !ok {
+				body.Fault_ = simulator.Fault("", &vim.ManagedObjectNotFound{Obj: os.Obj})
+				return body
+			}
+
+			content := vim.ObjectContent{Obj: os.Obj}
+
+			for _, ps := range spec.PropSet {
+				for _, path := range ps.PathSet {
+					if path != "config" {
+						content.MissingSet = append(content.MissingSet, vim.MissingProperty{
+							Path:  path,
+							Fault: vim.LocalizedMethodFault{Fault: &vim.InvalidProperty{Name: path}},
+						})
+						continue
+					}
+					content.PropSet = append(content.PropSet, vim.DynamicProperty{Name: path, Val: config})
+				}
+			}
+
+			res.Objects = append(res.Objects, content)
+		}
+	}
+
+	body.Res = &vim.RetrievePropertiesExResponse{
+		Returnval: res,
+	}
+
+	return body
+}
@@ -48,6 +48,26 @@ func New() *simulator.Registry {
 		ManagedObjectReference: vsan.VsanVcClusterConfigSystemInstance,
 	})
 
+	r.Put(&ClusterHealthSystem{
+		ManagedObjectReference: vsan.VsanVcClusterHealthSystemInstance,
+	})
+
+	r.Put(&FileServiceSystem{
+		ManagedObjectReference: vsan.VsanFileServiceSystemInstance,
+	})
+
+	r.Put(&ObjectSystem{
+		ManagedObjectReference: vsan.VsanQueryObjectIdentitiesInstance,
+	})
+
+	r.Put(&PerformanceManager{
+		ManagedObjectReference: vsan.VsanPerformanceManagerInstance,
+	})
+
+	r.Put(&PropertyCollector{
+		ManagedObjectReference: vsan.VsanPropertyCollectorInstance,
+	})
+
 	return r
 }
 
@@ -56,7 +76,7 @@ type StretchedClusterSystem struct {
 }
 
 func (s *StretchedClusterSystem) VSANVcConvertToStretchedCluster(ctx *simulator.Context, req *types.VSANVcConvertToStretchedCluster) soap.HasFault {
-	task := simulator.CreateTask(s, "convertToStretchedCluster", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
+	task := simulator.CreateTask(ctx, s, "convertToStretchedCluster", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
 		// TODO: validate req fields
 		return nil, nil
 	})
@@ -100,12 +120,22 @@ func (s *ClusterConfigSystem) VsanClusterGetConfig(ctx *simulator.Context, req *
 }
 
 func (s *ClusterConfigSystem) VsanClusterReconfig(ctx *simulator.Context, req *types.VsanClusterReconfig) soap.HasFault {
-	task := simulator.CreateTask(s, "vsanClusterReconfig", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
+	task := simulator.CreateTask(ctx, s, "vsanClusterReconfig", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
 		// TODO: validate req fields
 		info := s.info(req.Cluster)
 		if req.VsanReconfigSpec.UnmapConfig != nil {
 			info.UnmapConfig = req.VsanReconfigSpec.UnmapConfig
 		}
+		if config := req.VsanReconfigSpec.FileServiceConfig; config != nil {
+			if config.Network != nil && ctx.VimMap().Get(*config.Network) == nil {
+				return nil, &vim.ManagedObjectNotFound{Obj: *config.Network}
+			}
+			fs := ctx.Map.Get(vsan.VsanFileServiceSystemInstance).(*FileServiceSystem)
+			if !config.Enabled && len(fs.shares(req.Cluster)) != 0 {
+				return nil, &vim.ResourceInUse{Type: "VsanFileShare"}
+			}
+			info.FileServiceConfig = config
+		}
 		return nil, nil
 	})
 
@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vsan"
+	"github.com/vmware/govmomi/vsan/types"
+)
+
+func TestHealthObjectsPerf(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vc, err := vsan.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		finder := find.NewFinder(c)
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		hosts, err := cluster.Hosts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// an object per VM namespace, VM disk and powered on VM swap file
+		res, err := vc.VsanQueryObjectIdentities(ctx, cluster.Reference())
+		if err != nil {
+			t.Fatal(err)
+		}
+		kinds := make(map[string]int)
+		for _, id := range res.Identities {
+			kinds[id.Type]++
+		}
+		if kinds["vmnamespace"] == 0 || kinds["vdisk"] != kinds["vmnamespace"] || kinds["vmswap"] != kinds["vmnamespace"] {
+			t.Errorf("objects=%v", kinds)
+		}
+		if len(res.Health.ObjectHealthDetail) != 1 || res.Health.ObjectHealthDetail[0].Health != "healthy" {
+			t.Errorf("health=%#v", res.Health)
+		}
+
+		summary, err := vc.VsanQueryVcClusterHealthSummary(ctx, cluster.Reference())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.OverallHealth != "green" || len(summary.Groups) != 3 || len(summary.PhysicalDisksHealth) != len(hosts) {
+			t.Errorf("summary=%#v", summary)
+		}
+
+		// disk groups are returned with the host's vSAN config
+		vs, err := hosts[0].ConfigManager().VsanSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config, err := vc.VsanHostGetConfig(ctx, vs.Reference())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(config.StorageInfo.DiskMapping) != 1 || len(config.StorageInfo.DiskMapping[0].NonSsd) != capacityDisks {
+			t.Errorf("storage=%#v", config.StorageInfo)
+		}
+		if config.ClusterInfo.Uuid == "" || config.ClusterInfo.NodeUuid == "" {
+			t.Errorf("cluster=%#v", config.ClusterInfo)
+		}
+
+		// a disconnected host reduces object availability
+		host := simulator.Map.Get(hosts[0].Reference()).(*simulator.HostSystem)
+		host.Runtime.ConnectionState = vim.HostSystemConnectionStateDisconnected
+
+		summary, err = vc.VsanQueryVcClusterHealthSummary(ctx, cluster.Reference(), "groups")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.OverallHealth != "red" || summary.ObjectHealth != nil || summary.Groups[0].GroupHealth != "red" {
+			t.Errorf("summary=%#v", summary)
+		}
+		host.Runtime.ConnectionState = vim.HostSystemConnectionStateConnected
+
+		end := time.Now()
+		start := end.Add(-time.Hour)
+		perf, err := vc.VsanPerfQueryPerf(ctx, vim.NewReference(cluster.Reference()), []types.VsanPerfQuerySpec{
+			{
+				EntityRefId: "host-domclient:*",
+				StartTime:   &start,
+				EndTime:     &end,
+				Labels:      []string{"iopsRead", "latencyAvgRead"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(perf) != len(hosts) {
+			t.Fatalf("%d entities", len(perf))
+		}
+		samples := len(strings.Split(perf[0].SampleInfo, ","))
+		if samples != 12 && samples != 13 {
+			t.Errorf("%d samples", samples)
+		}
+		if len(perf[0].Value) != 2 || len(strings.Split(perf[0].Value[0].Values, ",")) != samples {
+			t.Errorf("values=%#v", perf[0].Value)
+		}
+
+		// synthetic values are repeatable
+		again, err := vc.VsanPerfQueryPerf(ctx, vim.NewReference(cluster.Reference()), []types.VsanPerfQuerySpec{
+			{EntityRefId: perf[0].EntityRefId, StartTime: &start, EndTime: &end, Labels: []string{"iopsRead"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(again) != 1 || again[0].Value[0].Values != perf[0].Value[0].Values {
+			t.Errorf("values=%#v", again)
+		}
+
+		_, err = vc.VsanPerfQueryPerf(ctx, vim.NewReference(cluster.Reference()), []types.VsanPerfQuerySpec{{EntityRefId: "enoent"}})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestFileService(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vc, err := vsan.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		finder := find.NewFinder(c)
+		cluster, err := finder.ClusterComputeResource(ctx, "DC0_C0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref := cluster.Reference()
+
+		wait := func(task *object.Task, err error) vim.AnyType {
+			t.Helper()
+			if err != nil {
+				t.Fatal(err)
+			}
+			info, err := task.WaitForResult(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return info.Result
+		}
+
+		config := types.VsanFileShareConfig{Name: "share1", Quota: "1MB", SoftQuota: "512KB"}
+
+		// file service is not enabled
+		task, err := vc.VsanCreateFileShare(ctx, ref, config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = task.WaitForResult(ctx, nil); err == nil {
+			t.Error("expected error")
+		}
+
+		wait(vc.EnableFileService(ctx, ref, types.VsanFileServiceConfig{Enabled: true}))
+		wait(vc.VsanClusterCreateFsDomain(ctx, ref, types.VsanFileServiceDomainConfig{Name: "example.com"}))
+
+		domains, err := vc.VsanClusterQueryFsDomains(ctx, ref, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(domains) != 1 || domains[0].Config.Name != "example.com" {
+			t.Errorf("domains=%#v", domains)
+		}
+
+		id := wait(vc.VsanCreateFileShare(ctx, ref, config)).(string)
+
+		task, err = vc.VsanCreateFileShare(ctx, ref, config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = task.WaitForResult(ctx, nil); err == nil {
+			t.Error("expected error") // duplicate name
+		}
+
+		// names must not escape the share root
+		for _, name := range []string{"..", ".", "../..", "a/b", `a\b`} {
+			task, err = vc.VsanCreateFileShare(ctx, ref, types.VsanFileShareConfig{Name: name})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = task.WaitForResult(ctx, nil); err == nil {
+				t.Errorf("expected error for name %q", name)
+			}
+
+			task, err = vc.VsanReconfigureFileShare(ctx, ref, id, types.VsanFileShareConfig{Name: name})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = task.WaitForResult(ctx, nil); err == nil {
+				t.Errorf("expected error for name %q", name)
+			}
+		}
+
+		res, err := vc.VsanClusterQueryFileShares(ctx, ref, types.VsanFileShareQuerySpec{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.FileShares) != 1 {
+			t.Fatalf("shares=%d", len(res.FileShares))
+		}
+		share := res.FileShares[0]
+		if share.Uuid != id || share.Config.DomainName != "example.com" || len(share.Runtime.AccessPoints) != 2 {
+			t.Errorf("share=%#v", share)
+		}
+
+		update := types.VsanFileShareConfig{Labels: []vim.KeyValue{{Key: "env", Value: "dev"}}}
+		wait(vc.VsanReconfigureFileShare(ctx, ref, id, update))
+
+		res, err = vc.VsanClusterQueryFileShares(ctx, ref, types.VsanFileShareQuerySpec{Uuids: []string{id}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if labels := res.FileShares[0].Config.Labels; len(labels) != 1 || labels[0].Value != "dev" {
+			t.Errorf("labels=%#v", labels)
+		}
+
+		health := func(expect string) {
+			t.Helper()
+			summary, err := vc.VsanClusterQueryFileServiceHealthSummary(ctx, ref)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if summary.OverallHealth != expect {
+				t.Errorf("health=%s, expected %s", summary.OverallHealth, expect)
+			}
+		}
+
+		health("green")
+
+		// fill the share beyond its soft quota
+		var dir string
+		for _, ds := range simulator.Map.Get(ref).(*simulator.ClusterComputeResource).Datastore {
+			dir = filepath.Join(simulator.Map.Get(ds).(*simulator.Datastore).Info.GetDatastoreInfo().Url, ".vsanfs", "share1")
+			break
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, "data"), make([]byte, 768*1024), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		health("yellow")
+
+		task, err = vc.VsanRemoveFileShare(ctx, ref, id, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = task.WaitForResult(ctx, nil); err == nil {
+			t.Error("expected error") // share is not empty
+		}
+
+		wait(vc.VsanRemoveFileShare(ctx, ref, id, true))
+
+		res, err = vc.VsanClusterQueryFileShares(ctx, ref, types.VsanFileShareQuerySpec{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.FileShares) != 0 {
+			t.Errorf("shares=%d", len(res.FileShares))
+		}
+	})
+}
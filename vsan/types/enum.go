@@ -29,6 +29,17 @@ const (
 	VsanPerfDiagnosticQueryTypeeval                                = VsanPerfDiagnosticQueryType("eval")
 )
 
+// Values returns all known values of the VsanPerfDiagnosticQueryType enum
+func (e VsanPerfDiagnosticQueryType) Values() []VsanPerfDiagnosticQueryType {
+	return []VsanPerfDiagnosticQueryType{
+		VsanPerfDiagnosticQueryTypeiops,
+		VsanPerfDiagnosticQueryTypelat,
+		VsanPerfDiagnosticQueryTypetput,
+		VsanPerfDiagnosticQueryTypeVsanPerfDiagnosticQueryType_Unknown,
+		VsanPerfDiagnosticQueryTypeeval,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanPerfDiagnosticQueryType", reflect.TypeOf((*VsanPerfDiagnosticQueryType)(nil)).Elem())
 }
@@ -42,6 +53,16 @@ const (
 	VsanCompositeConstraintConjoinerEnumEXCEPT                                       = VsanCompositeConstraintConjoinerEnum("EXCEPT")
 )
 
+// Values returns all known values of the VsanCompositeConstraintConjoinerEnum enum
+func (e VsanCompositeConstraintConjoinerEnum) Values() []VsanCompositeConstraintConjoinerEnum {
+	return []VsanCompositeConstraintConjoinerEnum{
+		VsanCompositeConstraintConjoinerEnumAND,
+		VsanCompositeConstraintConjoinerEnumVsanCompositeConstraintConjoinerEnum_Unknown,
+		VsanCompositeConstraintConjoinerEnumOR,
+		VsanCompositeConstraintConjoinerEnumEXCEPT,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanCompositeConstraintConjoinerEnum", reflect.TypeOf((*VsanCompositeConstraintConjoinerEnum)(nil)).Elem())
 }
@@ -56,6 +77,17 @@ const (
 	VsanCapacityReservationStateReported      = VsanCapacityReservationState("Reported")
 )
 
+// Values returns all known values of the VsanCapacityReservationState enum
+func (e VsanCapacityReservationState) Values() []VsanCapacityReservationState {
+	return []VsanCapacityReservationState{
+		VsanCapacityReservationStateDisabled,
+		VsanCapacityReservationStateState_Unknown,
+		VsanCapacityReservationStateEnforced,
+		VsanCapacityReservationStateUnsupported,
+		VsanCapacityReservationStateReported,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanCapacityReservationState", reflect.TypeOf((*VsanCapacityReservationState)(nil)).Elem())
 }
@@ -68,6 +100,15 @@ const (
 	VsanFileShareManagingEntityuser                            = VsanFileShareManagingEntity("user")
 )
 
+// Values returns all known values of the VsanFileShareManagingEntity enum
+func (e VsanFileShareManagingEntity) Values() []VsanFileShareManagingEntity {
+	return []VsanFileShareManagingEntity{
+		VsanFileShareManagingEntitycns,
+		VsanFileShareManagingEntityFileShareManagingEntity_Unknown,
+		VsanFileShareManagingEntityuser,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanFileShareManagingEntity", reflect.TypeOf((*VsanFileShareManagingEntity)(nil)).Elem())
 }
@@ -109,6 +150,44 @@ const (
 	VsanObjectTypeEnumdetachedCnsVolFile           = VsanObjectTypeEnum("detachedCnsVolFile")
 )
 
+// Values returns all known values of the VsanObjectTypeEnum enum
+func (e VsanObjectTypeEnum) Values() []VsanObjectTypeEnum {
+	return []VsanObjectTypeEnum{
+		VsanObjectTypeEnumfileServiceRoot,
+		VsanObjectTypeEnumvmswap,
+		VsanObjectTypeEnumchecksumOverhead,
+		VsanObjectTypeEnumhaMetadataObject,
+		VsanObjectTypeEnumslackSpaceCapRequiredForHost,
+		VsanObjectTypeEnumdedupOverhead,
+		VsanObjectTypeEnumfileSystemOverhead,
+		VsanObjectTypeEnumresynPauseThresholdForHost,
+		VsanObjectTypeEnumattachedCnsVolBlock,
+		VsanObjectTypeEnumspaceUnderDedupConsideration,
+		VsanObjectTypeEnumdetachedCnsVolBlock,
+		VsanObjectTypeEnumminSpaceRequiredForVsanOp,
+		VsanObjectTypeEnumiscsiLun,
+		VsanObjectTypeEnumhbrPersist,
+		VsanObjectTypeEnumhostRebuildCapacity,
+		VsanObjectTypeEnumcnsVolFile,
+		VsanObjectTypeEnumhbrDisk,
+		VsanObjectTypeEnumattachedCnsVolFile,
+		VsanObjectTypeEnumfileShare,
+		VsanObjectTypeEnumimprovedVirtualDisk,
+		VsanObjectTypeEnumvdisk,
+		VsanObjectTypeEnumVsanObjectTypeEnum_Unknown,
+		VsanObjectTypeEnumnamespace,
+		VsanObjectTypeEnumstatsdb,
+		VsanObjectTypeEnumvmem,
+		VsanObjectTypeEnumother,
+		VsanObjectTypeEnumextension,
+		VsanObjectTypeEnumtransientSpace,
+		VsanObjectTypeEnumhbrCfg,
+		VsanObjectTypeEnumphysicalTransientSpace,
+		VsanObjectTypeEnumiscsiTarget,
+		VsanObjectTypeEnumdetachedCnsVolFile,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanObjectTypeEnum", reflect.TypeOf((*VsanObjectTypeEnum)(nil)).Elem())
 }
@@ -123,6 +202,17 @@ const (
 	VsanPerfsvcRemediateActionno_action                      = VsanPerfsvcRemediateAction("no_action")
 )
 
+// Values returns all known values of the VsanPerfsvcRemediateAction enum
+func (e VsanPerfsvcRemediateAction) Values() []VsanPerfsvcRemediateAction {
+	return []VsanPerfsvcRemediateAction{
+		VsanPerfsvcRemediateActionupdate_profile,
+		VsanPerfsvcRemediateActionPerfsvcRemediateAction_Unknown,
+		VsanPerfsvcRemediateActionenable,
+		VsanPerfsvcRemediateActiondisable,
+		VsanPerfsvcRemediateActionno_action,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanPerfsvcRemediateAction", reflect.TypeOf((*VsanPerfsvcRemediateAction)(nil)).Elem())
 }
@@ -136,6 +226,16 @@ const (
 	VsanIoInsightInstanceStateVsanIoInsightInstanceState_unknown = VsanIoInsightInstanceState("VsanIoInsightInstanceState_unknown")
 )
 
+// Values returns all known values of the VsanIoInsightInstanceState enum
+func (e VsanIoInsightInstanceState) Values() []VsanIoInsightInstanceState {
+	return []VsanIoInsightInstanceState{
+		VsanIoInsightInstanceStatecrashed,
+		VsanIoInsightInstanceStaterunning,
+		VsanIoInsightInstanceStatecompleted,
+		VsanIoInsightInstanceStateVsanIoInsightInstanceState_unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanIoInsightInstanceState", reflect.TypeOf((*VsanIoInsightInstanceState)(nil)).Elem())
 }
@@ -147,6 +247,14 @@ const (
 	VsanUpdateItemImpactTypereboot                           = VsanUpdateItemImpactType("reboot")
 )
 
+// Values returns all known values of the VsanUpdateItemImpactType enum
+func (e VsanUpdateItemImpactType) Values() []VsanUpdateItemImpactType {
+	return []VsanUpdateItemImpactType{
+		VsanUpdateItemImpactTypeVsanUpdateItemImpactType_Unknown,
+		VsanUpdateItemImpactTypereboot,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanUpdateItemImpactType", reflect.TypeOf((*VsanUpdateItemImpactType)(nil)).Elem())
 }
@@ -161,6 +269,17 @@ const (
 	VsanUpdateItemTypeVsanUpdateItemType_Unknown = VsanUpdateItemType("VsanUpdateItemType_Unknown")
 )
 
+// Values returns all known values of the VsanUpdateItemType enum
+func (e VsanUpdateItemType) Values() []VsanUpdateItemType {
+	return []VsanUpdateItemType{
+		VsanUpdateItemTypevib,
+		VsanUpdateItemTypeofflinebundle,
+		VsanUpdateItemTypefullStackFirmware,
+		VsanUpdateItemTypevmhbaFirmware,
+		VsanUpdateItemTypeVsanUpdateItemType_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanUpdateItemType", reflect.TypeOf((*VsanUpdateItemType)(nil)).Elem())
 }
@@ -185,6 +304,27 @@ const (
 	VsanEncryptionIssuedisabledwhenclusterenabled      = VsanEncryptionIssue("disabledwhenclusterenabled")
 )
 
+// Values returns all known values of the VsanEncryptionIssue enum
+func (e VsanEncryptionIssue) Values() []VsanEncryptionIssue {
+	return []VsanEncryptionIssue{
+		VsanEncryptionIssuekeyencryptionkeyinconsistent,
+		VsanEncryptionIssuecmknotinenabledstate,
+		VsanEncryptionIssueclientkeyinconsistent,
+		VsanEncryptionIssuekeknotavailable,
+		VsanEncryptionIssuehostkeynotavailable,
+		VsanEncryptionIssueservercertificatesinconsistent,
+		VsanEncryptionIssueVsanEncryptionIssue_Unknown,
+		VsanEncryptionIssuedataencryptionkeyinconsistent,
+		VsanEncryptionIssuehostkeyinconsistent,
+		VsanEncryptionIssueerasedisksbeforeuseinconsistent,
+		VsanEncryptionIssueclientcertificateinconsistent,
+		VsanEncryptionIssuecmkcannotretrieve,
+		VsanEncryptionIssuekmsinfoinconsistent,
+		VsanEncryptionIssueenabledwhenclusterdisabled,
+		VsanEncryptionIssuedisabledwhenclusterenabled,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanEncryptionIssue", reflect.TypeOf((*VsanEncryptionIssue)(nil)).Elem())
 }
@@ -201,6 +341,19 @@ const (
 	VsanPropertyConstraintComparatorEnumTEXTUALLY_MATCHES                            = VsanPropertyConstraintComparatorEnum("TEXTUALLY_MATCHES")
 )
 
+// Values returns all known values of the VsanPropertyConstraintComparatorEnum enum
+func (e VsanPropertyConstraintComparatorEnum) Values() []VsanPropertyConstraintComparatorEnum {
+	return []VsanPropertyConstraintComparatorEnum{
+		VsanPropertyConstraintComparatorEnumSMALLER,
+		VsanPropertyConstraintComparatorEnumGREATER,
+		VsanPropertyConstraintComparatorEnumCONTAINS,
+		VsanPropertyConstraintComparatorEnumEQUALS,
+		VsanPropertyConstraintComparatorEnumPOP,
+		VsanPropertyConstraintComparatorEnumVsanPropertyConstraintComparatorEnum_Unknown,
+		VsanPropertyConstraintComparatorEnumTEXTUALLY_MATCHES,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanPropertyConstraintComparatorEnum", reflect.TypeOf((*VsanPropertyConstraintComparatorEnum)(nil)).Elem())
 }
@@ -215,6 +368,17 @@ const (
 	VimVsanHostDiskMappingCreationTypevsandirect                      = VimVsanHostDiskMappingCreationType("vsandirect")
 )
 
+// Values returns all known values of the VimVsanHostDiskMappingCreationType enum
+func (e VimVsanHostDiskMappingCreationType) Values() []VimVsanHostDiskMappingCreationType {
+	return []VimVsanHostDiskMappingCreationType{
+		VimVsanHostDiskMappingCreationTypeDiskMappingCreationType_Unknown,
+		VimVsanHostDiskMappingCreationTypeallFlash,
+		VimVsanHostDiskMappingCreationTypepmem,
+		VimVsanHostDiskMappingCreationTypehybrid,
+		VimVsanHostDiskMappingCreationTypevsandirect,
+	}
+}
+
 func init() {
 	types.Add("vsan:VimVsanHostDiskMappingCreationType", reflect.TypeOf((*VimVsanHostDiskMappingCreationType)(nil)).Elem())
 }
@@ -235,6 +399,23 @@ const (
 	VsanDiskBalanceStateVsanDiskBalanceState_Unknown = VsanDiskBalanceState("VsanDiskBalanceState_Unknown")
 )
 
+// Values returns all known values of the VsanDiskBalanceState enum
+func (e VsanDiskBalanceState) Values() []VsanDiskBalanceState {
+	return []VsanDiskBalanceState{
+		VsanDiskBalanceStatereactiverebalancefailed,
+		VsanDiskBalanceStateproactivenotmustdo,
+		VsanDiskBalanceStaterebalancediskunhealthy,
+		VsanDiskBalanceStateimbalancewithintolerance,
+		VsanDiskBalanceStateproactiverebalancefailed,
+		VsanDiskBalanceStaterebalanceentitydecom,
+		VsanDiskBalanceStateproactiveneededbutdisabled,
+		VsanDiskBalanceStateproactiverebalanceinprogress,
+		VsanDiskBalanceStaterebalanceoff,
+		VsanDiskBalanceStatereactiverebalanceinprogress,
+		VsanDiskBalanceStateVsanDiskBalanceState_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanDiskBalanceState", reflect.TypeOf((*VsanDiskBalanceState)(nil)).Elem())
 }
@@ -247,6 +428,15 @@ const (
 	VsanFileShareSmbEncryptionTypeFileShareSmbEncryptionType_Unknown = VsanFileShareSmbEncryptionType("FileShareSmbEncryptionType_Unknown")
 )
 
+// Values returns all known values of the VsanFileShareSmbEncryptionType enum
+func (e VsanFileShareSmbEncryptionType) Values() []VsanFileShareSmbEncryptionType {
+	return []VsanFileShareSmbEncryptionType{
+		VsanFileShareSmbEncryptionTypedisabled,
+		VsanFileShareSmbEncryptionTypemandatory,
+		VsanFileShareSmbEncryptionTypeFileShareSmbEncryptionType_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanFileShareSmbEncryptionType", reflect.TypeOf((*VsanFileShareSmbEncryptionType)(nil)).Elem())
 }
@@ -260,6 +450,16 @@ const (
 	VsanSiteLocationTypePreferred                    = VsanSiteLocationType("Preferred")
 )
 
+// Values returns all known values of the VsanSiteLocationType enum
+func (e VsanSiteLocationType) Values() []VsanSiteLocationType {
+	return []VsanSiteLocationType{
+		VsanSiteLocationTypeNone,
+		VsanSiteLocationTypeVsanSiteLocationType_Unknown,
+		VsanSiteLocationTypeNonPreferred,
+		VsanSiteLocationTypePreferred,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanSiteLocationType", reflect.TypeOf((*VsanSiteLocationType)(nil)).Elem())
 }
@@ -280,6 +480,23 @@ const (
 	VsanMassCollectorObjectCollectionEnumALL_VSAN_ENABLED_CLUSTERS                     = VsanMassCollectorObjectCollectionEnum("ALL_VSAN_ENABLED_CLUSTERS")
 )
 
+// Values returns all known values of the VsanMassCollectorObjectCollectionEnum enum
+func (e VsanMassCollectorObjectCollectionEnum) Values() []VsanMassCollectorObjectCollectionEnum {
+	return []VsanMassCollectorObjectCollectionEnum{
+		VsanMassCollectorObjectCollectionEnumVsanMassCollectorObjectCollectionEnum_Unknown,
+		VsanMassCollectorObjectCollectionEnumALL_HOSTS,
+		VsanMassCollectorObjectCollectionEnumALL_CLUSTERS,
+		VsanMassCollectorObjectCollectionEnumALL_VSAN_DATASTORES,
+		VsanMassCollectorObjectCollectionEnumVCENTER,
+		VsanMassCollectorObjectCollectionEnumALL_DATASTORES,
+		VsanMassCollectorObjectCollectionEnumALL_VSAN_ENABLED_HOSTS,
+		VsanMassCollectorObjectCollectionEnumSERVICE_INSTANCE,
+		VsanMassCollectorObjectCollectionEnumALL_VMFS_DATASTORES,
+		VsanMassCollectorObjectCollectionEnumALL_VSAN_ENABLED_HOSTS_EXCEPT_WITNESS,
+		VsanMassCollectorObjectCollectionEnumALL_VSAN_ENABLED_CLUSTERS,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanMassCollectorObjectCollectionEnum", reflect.TypeOf((*VsanMassCollectorObjectCollectionEnum)(nil)).Elem())
 }
@@ -293,6 +510,16 @@ const (
 	VsanHostWipeDiskEligibleNo                       = VsanHostWipeDiskEligible("No")
 )
 
+// Values returns all known values of the VsanHostWipeDiskEligible enum
+func (e VsanHostWipeDiskEligible) Values() []VsanHostWipeDiskEligible {
+	return []VsanHostWipeDiskEligible{
+		VsanHostWipeDiskEligibleUnknown,
+		VsanHostWipeDiskEligibleYes,
+		VsanHostWipeDiskEligibleWipeDiskEligible_Unknown,
+		VsanHostWipeDiskEligibleNo,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanHostWipeDiskEligible", reflect.TypeOf((*VsanHostWipeDiskEligible)(nil)).Elem())
 }
@@ -316,6 +543,26 @@ const (
 	VimVsanMountPrecheckTypeprecheck_unknown       = VimVsanMountPrecheckType("precheck_unknown")
 )
 
+// Values returns all known values of the VimVsanMountPrecheckType enum
+func (e VimVsanMountPrecheckType) Values() []VimVsanMountPrecheckType {
+	return []VimVsanMountPrecheckType{
+		VimVsanMountPrecheckTypelocalVsanDatastore,
+		VimVsanMountPrecheckTypenetworkLatency,
+		VimVsanMountPrecheckTyperemoteDatastoreLimit,
+		VimVsanMountPrecheckTypedatastorePolicy,
+		VimVsanMountPrecheckTypeconnectivity,
+		VimVsanMountPrecheckTypeclientClusterLimit,
+		VimVsanMountPrecheckTypedatacenter,
+		VimVsanMountPrecheckTypesupportedConfiguration,
+		VimVsanMountPrecheckTypeserverClusterHealth,
+		VimVsanMountPrecheckTypevsanFormatVersion,
+		VimVsanMountPrecheckTypelicense,
+		VimVsanMountPrecheckTypedatastoreType,
+		VimVsanMountPrecheckTypeserverClusterLimit,
+		VimVsanMountPrecheckTypeprecheck_unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VimVsanMountPrecheckType", reflect.TypeOf((*VimVsanMountPrecheckType)(nil)).Elem())
 }
@@ -329,6 +576,16 @@ const (
 	VsanEncryptionTransitionStateprepared                          = VsanEncryptionTransitionState("prepared")
 )
 
+// Values returns all known values of the VsanEncryptionTransitionState enum
+func (e VsanEncryptionTransitionState) Values() []VsanEncryptionTransitionState {
+	return []VsanEncryptionTransitionState{
+		VsanEncryptionTransitionStateEncryptionTransitionState_Unknown,
+		VsanEncryptionTransitionStatesettled,
+		VsanEncryptionTransitionStatepreparing,
+		VsanEncryptionTransitionStateprepared,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanEncryptionTransitionState", reflect.TypeOf((*VsanEncryptionTransitionState)(nil)).Elem())
 }
@@ -343,6 +600,17 @@ const (
 	VimVsanClusterComplianceResourceCheckStatusTypeaborted                                   = VimVsanClusterComplianceResourceCheckStatusType("aborted")
 )
 
+// Values returns all known values of the VimVsanClusterComplianceResourceCheckStatusType enum
+func (e VimVsanClusterComplianceResourceCheckStatusType) Values() []VimVsanClusterComplianceResourceCheckStatusType {
+	return []VimVsanClusterComplianceResourceCheckStatusType{
+		VimVsanClusterComplianceResourceCheckStatusTypeuninitialized,
+		VimVsanClusterComplianceResourceCheckStatusTypeinProgress,
+		VimVsanClusterComplianceResourceCheckStatusTypeComplianceResourceCheckStatusType_Unknown,
+		VimVsanClusterComplianceResourceCheckStatusTypecompleted,
+		VimVsanClusterComplianceResourceCheckStatusTypeaborted,
+	}
+}
+
 func init() {
 	types.Add("vsan:VimVsanClusterComplianceResourceCheckStatusType", reflect.TypeOf((*VimVsanClusterComplianceResourceCheckStatusType)(nil)).Elem())
 }
@@ -355,6 +623,15 @@ const (
 	VsanIscsiLUNStatusOnline                     = VsanIscsiLUNStatus("Online")
 )
 
+// Values returns all known values of the VsanIscsiLUNStatus enum
+func (e VsanIscsiLUNStatus) Values() []VsanIscsiLUNStatus {
+	return []VsanIscsiLUNStatus{
+		VsanIscsiLUNStatusOffline,
+		VsanIscsiLUNStatusVsanIscsiLUNStatus_Unknown,
+		VsanIscsiLUNStatusOnline,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanIscsiLUNStatus", reflect.TypeOf((*VsanIscsiLUNStatus)(nil)).Elem())
 }
@@ -475,6 +752,123 @@ const (
 	VsanCapabilityTypeperfanalysis                   = VsanCapabilityType("perfanalysis")
 )
 
+// Values returns all known values of the VsanCapabilityType enum
+func (e VsanCapabilityType) Values() []VsanCapabilityType {
+	return []VsanCapabilityType{
+		VsanCapabilityTypediagnosticmode,
+		VsanCapabilityTypeobjectidentities,
+		VsanCapabilityTypesharedwitness,
+		VsanCapabilityTypevumbaselinerecommendation,
+		VsanCapabilityTypeupgrade,
+		VsanCapabilityTypevitstretchedcluster,
+		VsanCapabilityTypeenhancedresyncapi,
+		VsanCapabilityTypepolicyhostapi,
+		VsanCapabilityTypefileservicecrx,
+		VsanCapabilityTypecnsvolumes,
+		VsanCapabilityTypethrottleresync,
+		VsanCapabilityTypeverbosemodeconfiguration,
+		VsanCapabilityTypelargecapacitydrive,
+		VsanCapabilityTypeiscsitargets,
+		VsanCapabilityTypecapacityoversubscription,
+		VsanCapabilityTypevsanencrkmx,
+		VsanCapabilityTypepurgeinaccessiblevmswapobjects,
+		VsanCapabilityTypevsanclient,
+		VsanCapabilityTypevsandefaultgatewaysupported,
+		VsanCapabilityTyperesyncetaimprovement,
+		VsanCapabilityTypevmlevelcapacity,
+		VsanCapabilityTypevitonlineresize,
+		VsanCapabilityTypevsanrdma,
+		VsanCapabilityTypesecurewipe,
+		VsanCapabilityTypedataefficiency,
+		VsanCapabilityTypemetricsconfig,
+		VsanCapabilityTypehistoricalcapacity,
+		VsanCapabilityTypeallflash,
+		VsanCapabilityTypeioinsight,
+		VsanCapabilityTypeunicasttest,
+		VsanCapabilityTypewcpappplatform,
+		VsanCapabilityTypeVsanFileAnalytics,
+		VsanCapabilityTypefileservicesmb,
+		VsanCapabilityTypenestedfd,
+		VsanCapabilityTypepr1741414fixed,
+		VsanCapabilityTypedit4sw,
+		VsanCapabilityTypegethcllastupdateonvc,
+		VsanCapabilityTypecapability,
+		VsanCapabilityTypedecomwhatif,
+		VsanCapabilityTypeclusterconfig,
+		VsanCapabilityTypevsandiagnostics,
+		VsanCapabilityTypepolicyassociation,
+		VsanCapabilityTypesupportinsight,
+		VsanCapabilityTypeperfsvcautoconfig,
+		VsanCapabilityTypegenericnestedfd,
+		VsanCapabilityTypeperfsvcverbosemode,
+		VsanCapabilityTypefilevolumes,
+		VsanCapabilityTypeupdatevumreleasecatalogoffline,
+		VsanCapabilityTyperesourceprecheck,
+		VsanCapabilityTypeunicastmode,
+		VsanCapabilityTypefileservicesc,
+		VsanCapabilityTypehardwaremgmt,
+		VsanCapabilityTypehealthcheck2018q2,
+		VsanCapabilityTypeperformanceforsupport,
+		VsanCapabilityTypefirmwareupdate,
+		VsanCapabilityTypeimprovedcapacityscreen,
+		VsanCapabilityTypevalidateconfigspec,
+		VsanCapabilityTypediskresourceprecheck,
+		VsanCapabilityTypedevice4ksupport,
+		VsanCapabilityTypevsanmanagedvmfs,
+		VsanCapabilityTypefullStackFw,
+		VsanCapabilityTypemasspropertycollector,
+		VsanCapabilityTypenondatamovementdfc,
+		VsanCapabilityTypevumintegration,
+		VsanCapabilityTyperemotedatastore,
+		VsanCapabilityTypeencryption,
+		VsanCapabilityTypehostreservedcapacity,
+		VsanCapabilityTypefileservicenfsv3,
+		VsanCapabilityTypenetperftest,
+		VsanCapabilityTypeslackspacecapacity,
+		VsanCapabilityTypevsananalyticsevents,
+		VsanCapabilityTypewhatifcapacity,
+		VsanCapabilityTypereadlocalitytodrs,
+		VsanCapabilityTypeautomaticrebalance,
+		VsanCapabilityTypecompressiononly,
+		VsanCapabilityTypeumap,
+		VsanCapabilityTypefileservicekerberos,
+		VsanCapabilityTypedataintransitencryption,
+		VsanCapabilityTyperecreatediskgroup,
+		VsanCapabilityTypeconfigassist,
+		VsanCapabilityTypeupgraderesourceprecheck,
+		VsanCapabilityTypelocaldataprotection,
+		VsanCapabilityTypeapidevversionenabled,
+		VsanCapabilityTypeclusteradvancedoptions,
+		VsanCapabilityTypeensuredurability,
+		VsanCapabilityTypefileserviceowe,
+		VsanCapabilityTypehostaffinity,
+		VsanCapabilityTypepmanintegration,
+		VsanCapabilityTypewitnessmanagement,
+		VsanCapabilityTypenativelargeclustersupport,
+		VsanCapabilityTypecapacityreservation,
+		VsanCapabilityTypeperfsvctwoyaxisgraph,
+		VsanCapabilityTypecloudhealth,
+		VsanCapabilityTypeidentitiessupportpolicyid,
+		VsanCapabilityTypefileservices,
+		VsanCapabilityTypeVsanCapabilityType_Unknown,
+		VsanCapabilityTypevsanmetadatanode,
+		VsanCapabilityTypediagnosticsfeedback,
+		VsanCapabilityTypefileservicesnapshot,
+		VsanCapabilityTypehistoricalhealth,
+		VsanCapabilityTypevsanmanagedpmem,
+		VsanCapabilityTyperemotedataprotection,
+		VsanCapabilityTypecapacityevaluationonvc,
+		VsanCapabilityTypestretchedcluster,
+		VsanCapabilityTypepspairgap,
+		VsanCapabilityTypearchivaldataprotection,
+		VsanCapabilityTypecomplianceprecheck,
+		VsanCapabilityTypefcd,
+		VsanCapabilityTypesupportApiVersion,
+		VsanCapabilityTyperepairtimerinresyncstats,
+		VsanCapabilityTypeperfanalysis,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanCapabilityType", reflect.TypeOf((*VsanCapabilityType)(nil)).Elem())
 }
@@ -487,6 +881,15 @@ const (
 	VsanVibTypedriver              = VsanVibType("driver")
 )
 
+// Values returns all known values of the VsanVibType enum
+func (e VsanVibType) Values() []VsanVibType {
+	return []VsanVibType{
+		VsanVibTypetool,
+		VsanVibTypeVsanVibType_Unknown,
+		VsanVibTypedriver,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanVibType", reflect.TypeOf((*VsanVibType)(nil)).Elem())
 }
@@ -499,6 +902,15 @@ const (
 	VsanRelayoutObjectsErrorCodeVsanRelayoutObjectsErrorCode_Unknown = VsanRelayoutObjectsErrorCode("VsanRelayoutObjectsErrorCode_Unknown")
 )
 
+// Values returns all known values of the VsanRelayoutObjectsErrorCode enum
+func (e VsanRelayoutObjectsErrorCode) Values() []VsanRelayoutObjectsErrorCode {
+	return []VsanRelayoutObjectsErrorCode{
+		VsanRelayoutObjectsErrorCodeoutOfResources,
+		VsanRelayoutObjectsErrorCodegeneric,
+		VsanRelayoutObjectsErrorCodeVsanRelayoutObjectsErrorCode_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanRelayoutObjectsErrorCode", reflect.TypeOf((*VsanRelayoutObjectsErrorCode)(nil)).Elem())
 }
@@ -512,6 +924,16 @@ const (
 	VsanBaselinePreferenceTypeVsanBaselinePreferenceType_Unknown = VsanBaselinePreferenceType("VsanBaselinePreferenceType_Unknown")
 )
 
+// Values returns all known values of the VsanBaselinePreferenceType enum
+func (e VsanBaselinePreferenceType) Values() []VsanBaselinePreferenceType {
+	return []VsanBaselinePreferenceType{
+		VsanBaselinePreferenceTypenoRecommendation,
+		VsanBaselinePreferenceTypelatestRelease,
+		VsanBaselinePreferenceTypelatestPatch,
+		VsanBaselinePreferenceTypeVsanBaselinePreferenceType_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanBaselinePreferenceType", reflect.TypeOf((*VsanBaselinePreferenceType)(nil)).Elem())
 }
@@ -525,6 +947,16 @@ const (
 	VsanStorageComplianceStatusnotApplicable = VsanStorageComplianceStatus("notApplicable")
 )
 
+// Values returns all known values of the VsanStorageComplianceStatus enum
+func (e VsanStorageComplianceStatus) Values() []VsanStorageComplianceStatus {
+	return []VsanStorageComplianceStatus{
+		VsanStorageComplianceStatusunknown,
+		VsanStorageComplianceStatuscompliant,
+		VsanStorageComplianceStatusnonCompliant,
+		VsanStorageComplianceStatusnotApplicable,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanStorageComplianceStatus", reflect.TypeOf((*VsanStorageComplianceStatus)(nil)).Elem())
 }
@@ -538,6 +970,16 @@ const (
 	VsanHealthStatusTypeyellow  = VsanHealthStatusType("yellow")
 )
 
+// Values returns all known values of the VsanHealthStatusType enum
+func (e VsanHealthStatusType) Values() []VsanHealthStatusType {
+	return []VsanHealthStatusType{
+		VsanHealthStatusTypeunknown,
+		VsanHealthStatusTypegreen,
+		VsanHealthStatusTypered,
+		VsanHealthStatusTypeyellow,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanHealthStatusType", reflect.TypeOf((*VsanHealthStatusType)(nil)).Elem())
 }
@@ -551,6 +993,16 @@ const (
 	VsanPerfStatsTypeabsolute                  = VsanPerfStatsType("absolute")
 )
 
+// Values returns all known values of the VsanPerfStatsType enum
+func (e VsanPerfStatsType) Values() []VsanPerfStatsType {
+	return []VsanPerfStatsType{
+		VsanPerfStatsTypeVsanPerfStatsType_Unknown,
+		VsanPerfStatsTyperate,
+		VsanPerfStatsTypedelta,
+		VsanPerfStatsTypeabsolute,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanPerfStatsType", reflect.TypeOf((*VsanPerfStatsType)(nil)).Elem())
 }
@@ -564,6 +1016,16 @@ const (
 	VsanFileProtocolNFSv3                     = VsanFileProtocol("NFSv3")
 )
 
+// Values returns all known values of the VsanFileProtocol enum
+func (e VsanFileProtocol) Values() []VsanFileProtocol {
+	return []VsanFileProtocol{
+		VsanFileProtocolNFSv4,
+		VsanFileProtocolFileShareProtocol_Unknown,
+		VsanFileProtocolSMB,
+		VsanFileProtocolNFSv3,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanFileProtocol", reflect.TypeOf((*VsanFileProtocol)(nil)).Elem())
 }
@@ -581,6 +1043,20 @@ const (
 	VsanResourceCheckStatusTyperesourceCheckRunning            = VsanResourceCheckStatusType("resourceCheckRunning")
 )
 
+// Values returns all known values of the VsanResourceCheckStatusType enum
+func (e VsanResourceCheckStatusType) Values() []VsanResourceCheckStatusType {
+	return []VsanResourceCheckStatusType{
+		VsanResourceCheckStatusTyperesourceCheckCompleted,
+		VsanResourceCheckStatusTyperesourceCheckNotSupported,
+		VsanResourceCheckStatusTyperesourceCheckCancelled,
+		VsanResourceCheckStatusTypeResourceCheckStatusType_Unknown,
+		VsanResourceCheckStatusTyperesourceCheckFailed,
+		VsanResourceCheckStatusTyperesourceCheckNoRecentValue,
+		VsanResourceCheckStatusTyperesourceCheckUninitialized,
+		VsanResourceCheckStatusTyperesourceCheckRunning,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanResourceCheckStatusType", reflect.TypeOf((*VsanResourceCheckStatusType)(nil)).Elem())
 }
@@ -593,6 +1069,15 @@ const (
 	VsanServiceStatusVsanServiceStatus_Unknown = VsanServiceStatus("VsanServiceStatus_Unknown")
 )
 
+// Values returns all known values of the VsanServiceStatus enum
+func (e VsanServiceStatus) Values() []VsanServiceStatus {
+	return []VsanServiceStatus{
+		VsanServiceStatusstarted,
+		VsanServiceStatusstopped,
+		VsanServiceStatusVsanServiceStatus_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanServiceStatus", reflect.TypeOf((*VsanServiceStatus)(nil)).Elem())
 }
@@ -618,6 +1103,28 @@ const (
 	VsanObjectHealthStatereducedavailabilitywithnorebuild                          = VsanObjectHealthState("reducedavailabilitywithnorebuild")
 )
 
+// Values returns all known values of the VsanObjectHealthState enum
+func (e VsanObjectHealthState) Values() []VsanObjectHealthState {
+	return []VsanObjectHealthState{
+		VsanObjectHealthStateVsanObjectHealthState_Unknown,
+		VsanObjectHealthStatereducedavailabilitywithnorebuilddelaytimer,
+		VsanObjectHealthStatereducedavailabilitywithpausedrebuild,
+		VsanObjectHealthStatenonavailabilityrelatedincompliancewithpolicypendingfailed,
+		VsanObjectHealthStatenonavailabilityrelatedincompliancewithpausedrebuild,
+		VsanObjectHealthStatehealthy,
+		VsanObjectHealthStateinaccessible,
+		VsanObjectHealthStatereducedavailabilitywithactiverebuild,
+		VsanObjectHealthStatedatamove,
+		VsanObjectHealthStateremoteAccessible,
+		VsanObjectHealthStatenonavailabilityrelatedincompliancewithpolicypending,
+		VsanObjectHealthStatereducedavailabilitywithpolicypending,
+		VsanObjectHealthStatenonavailabilityrelatedincompliance,
+		VsanObjectHealthStatereducedavailabilitywithpolicypendingfailed,
+		VsanObjectHealthStatenonavailabilityrelatedreconfig,
+		VsanObjectHealthStatereducedavailabilitywithnorebuild,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanObjectHealthState", reflect.TypeOf((*VsanObjectHealthState)(nil)).Elem())
 }
@@ -642,6 +1149,27 @@ const (
 	VsanHealthPerspectivevmcUpgradePreChecks           = VsanHealthPerspective("vmcUpgradePreChecks")
 )
 
+// Values returns all known values of the VsanHealthPerspective enum
+func (e VsanHealthPerspective) Values() []VsanHealthPerspective {
+	return []VsanHealthPerspective{
+		VsanHealthPerspectiveupgradeBeforeExitMM,
+		VsanHealthPerspectiveupgradePreCheck,
+		VsanHealthPerspectiveupgradePreCheckPman,
+		VsanHealthPerspectiveupgradeAfterExitMM,
+		VsanHealthPerspectiveupgradeBeforeExitMMPman,
+		VsanHealthPerspectivebeforeConfigureHost,
+		VsanHealthPerspectivedefaultView,
+		VsanHealthPerspectivevsanUpgradeAfterExitMM,
+		VsanHealthPerspectivedeployAssist,
+		VsanHealthPerspectivevsanUpgradePreCheck,
+		VsanHealthPerspectiveVsanHealthPerspective_Unknown,
+		VsanHealthPerspectiveupgradeAfterExitMMPman,
+		VsanHealthPerspectiveCreateExtendClusterView,
+		VsanHealthPerspectivevsanUpgradeBeforeExitMM,
+		VsanHealthPerspectivevmcUpgradePreChecks,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanHealthPerspective", reflect.TypeOf((*VsanHealthPerspective)(nil)).Elem())
 }
@@ -655,6 +1183,16 @@ const (
 	VsanDatastoreTypepmem                      = VsanDatastoreType("pmem")
 )
 
+// Values returns all known values of the VsanDatastoreType enum
+func (e VsanDatastoreType) Values() []VsanDatastoreType {
+	return []VsanDatastoreType{
+		VsanDatastoreTypevsandirect,
+		VsanDatastoreTypevsan,
+		VsanDatastoreTypeVsanDatastoreType_Unknown,
+		VsanDatastoreTypepmem,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanDatastoreType", reflect.TypeOf((*VsanDatastoreType)(nil)).Elem())
 }
@@ -673,6 +1211,21 @@ const (
 	VsanSyncReasonmerge_concat           = VsanSyncReason("merge_concat")
 )
 
+// Values returns all known values of the VsanSyncReason enum
+func (e VsanSyncReason) Values() []VsanSyncReason {
+	return []VsanSyncReason{
+		VsanSyncReasonobject_format_change,
+		VsanSyncReasonrepair,
+		VsanSyncReasondying_evacuate,
+		VsanSyncReasonreconfigure,
+		VsanSyncReasonVsanSyncReason_Unknown,
+		VsanSyncReasonstale,
+		VsanSyncReasonrebalance,
+		VsanSyncReasonevacuate,
+		VsanSyncReasonmerge_concat,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanSyncReason", reflect.TypeOf((*VsanSyncReason)(nil)).Elem())
 }
@@ -688,6 +1241,18 @@ const (
 	VsanHealthLogLevelEnumDEBUG                          = VsanHealthLogLevelEnum("DEBUG")
 )
 
+// Values returns all known values of the VsanHealthLogLevelEnum enum
+func (e VsanHealthLogLevelEnum) Values() []VsanHealthLogLevelEnum {
+	return []VsanHealthLogLevelEnum{
+		VsanHealthLogLevelEnumINFO,
+		VsanHealthLogLevelEnumCRITICAL,
+		VsanHealthLogLevelEnumVsanHealthLogLevelEnum_Unknown,
+		VsanHealthLogLevelEnumWARNING,
+		VsanHealthLogLevelEnumERROR,
+		VsanHealthLogLevelEnumDEBUG,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanHealthLogLevelEnum", reflect.TypeOf((*VsanHealthLogLevelEnum)(nil)).Elem())
 }
@@ -704,6 +1269,19 @@ const (
 	VsanPerfSummaryTypelatest                      = VsanPerfSummaryType("latest")
 )
 
+// Values returns all known values of the VsanPerfSummaryType enum
+func (e VsanPerfSummaryType) Values() []VsanPerfSummaryType {
+	return []VsanPerfSummaryType{
+		VsanPerfSummaryTypenone,
+		VsanPerfSummaryTypeaverage,
+		VsanPerfSummaryTypemaximum,
+		VsanPerfSummaryTypeVsanPerfSummaryType_Unknown,
+		VsanPerfSummaryTypeminimum,
+		VsanPerfSummaryTypesummation,
+		VsanPerfSummaryTypelatest,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanPerfSummaryType", reflect.TypeOf((*VsanPerfSummaryType)(nil)).Elem())
 }
@@ -721,6 +1299,20 @@ const (
 	VsanPerfStatsUnitTypeVsanPerfStatsUnitType_Unknown = VsanPerfStatsUnitType("VsanPerfStatsUnitType_Unknown")
 )
 
+// Values returns all known values of the VsanPerfStatsUnitType enum
+func (e VsanPerfStatsUnitType) Values() []VsanPerfStatsUnitType {
+	return []VsanPerfStatsUnitType{
+		VsanPerfStatsUnitTypesize_bytes,
+		VsanPerfStatsUnitTypepermille,
+		VsanPerfStatsUnitTypetime_ms,
+		VsanPerfStatsUnitTypepercentage,
+		VsanPerfStatsUnitTypetime_s,
+		VsanPerfStatsUnitTyperate_bytes,
+		VsanPerfStatsUnitTypenumber,
+		VsanPerfStatsUnitTypeVsanPerfStatsUnitType_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanPerfStatsUnitType", reflect.TypeOf((*VsanPerfStatsUnitType)(nil)).Elem())
 }
@@ -759,6 +1351,41 @@ const (
 	VsanClusterHealthActionIdEnumCreateVMKnicWithVMotion               = VsanClusterHealthActionIdEnum("CreateVMKnicWithVMotion")
 )
 
+// Values returns all known values of the VsanClusterHealthActionIdEnum enum
+func (e VsanClusterHealthActionIdEnum) Values() []VsanClusterHealthActionIdEnum {
+	return []VsanClusterHealthActionIdEnum{
+		VsanClusterHealthActionIdEnumVsanClusterHealthActionIdEnum_Unknown,
+		VsanClusterHealthActionIdEnumConfigureVSAN,
+		VsanClusterHealthActionIdEnumUploadHclDb,
+		VsanClusterHealthActionIdEnumRemediateDedup,
+		VsanClusterHealthActionIdEnumEnablePerformanceServiceAction,
+		VsanClusterHealthActionIdEnumEnableCeip,
+		VsanClusterHealthActionIdEnumLoginVumIsoDepot,
+		VsanClusterHealthActionIdEnumRelayoutVsanObjects,
+		VsanClusterHealthActionIdEnumRemediateFileService,
+		VsanClusterHealthActionIdEnumConfigureHA,
+		VsanClusterHealthActionIdEnumConfigureAutomaticRebalance,
+		VsanClusterHealthActionIdEnumCreateDVS,
+		VsanClusterHealthActionIdEnumRemediateFileServiceImbalance,
+		VsanClusterHealthActionIdEnumRunBurnInTest,
+		VsanClusterHealthActionIdEnumUploadReleaseCatalog,
+		VsanClusterHealthActionIdEnumUpgradeVsanDiskFormat,
+		VsanClusterHealthActionIdEnumEnableHealthService,
+		VsanClusterHealthActionIdEnumPurgeInaccessSwapObjs,
+		VsanClusterHealthActionIdEnumDiskBalance,
+		VsanClusterHealthActionIdEnumEnableIscsiTargetService,
+		VsanClusterHealthActionIdEnumRepairClusterObjectsAction,
+		VsanClusterHealthActionIdEnumClaimVSANDisks,
+		VsanClusterHealthActionIdEnumStopDiskBalance,
+		VsanClusterHealthActionIdEnumConfigureDRS,
+		VsanClusterHealthActionIdEnumClusterUpgrade,
+		VsanClusterHealthActionIdEnumCreateVMKnic,
+		VsanClusterHealthActionIdEnumUpdateHclDbFromInternet,
+		VsanClusterHealthActionIdEnumRemediateClusterConfig,
+		VsanClusterHealthActionIdEnumCreateVMKnicWithVMotion,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanClusterHealthActionIdEnum", reflect.TypeOf((*VsanClusterHealthActionIdEnum)(nil)).Elem())
 }
@@ -782,6 +1409,26 @@ const (
 	VsanSmartParameterTypesmartreaderrorcount            = VsanSmartParameterType("smartreaderrorcount")
 )
 
+// Values returns all known values of the VsanSmartParameterType enum
+func (e VsanSmartParameterType) Values() []VsanSmartParameterType {
+	return []VsanSmartParameterType{
+		VsanSmartParameterTypesmartdrivetemperature,
+		VsanSmartParameterTypeVsanSmartParameterType_Unknown,
+		VsanSmartParameterTypesmartinitialbadblockcount,
+		VsanSmartParameterTypesmartdriveratedmaxtemperature,
+		VsanSmartParameterTypesmartmediawearoutindicator,
+		VsanSmartParameterTypesmartwritesectorstotct,
+		VsanSmartParameterTypesmartreallocatedsectorct,
+		VsanSmartParameterTypesmartreadsectorstotct,
+		VsanSmartParameterTypesmartpowercyclecount,
+		VsanSmartParameterTypesmarthealthstatus,
+		VsanSmartParameterTypesmartpoweronhours,
+		VsanSmartParameterTypesmartwriteerrorcount,
+		VsanSmartParameterTypesmartrawreaderrorrate,
+		VsanSmartParameterTypesmartreaderrorcount,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanSmartParameterType", reflect.TypeOf((*VsanSmartParameterType)(nil)).Elem())
 }
@@ -795,6 +1442,16 @@ const (
 	VsanSyncStatussuspended              = VsanSyncStatus("suspended")
 )
 
+// Values returns all known values of the VsanSyncStatus enum
+func (e VsanSyncStatus) Values() []VsanSyncStatus {
+	return []VsanSyncStatus{
+		VsanSyncStatusactive,
+		VsanSyncStatusVsanSyncStatus_Unknown,
+		VsanSyncStatusqueued,
+		VsanSyncStatussuspended,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanSyncStatus", reflect.TypeOf((*VsanSyncStatus)(nil)).Elem())
 }
@@ -809,6 +1466,17 @@ const (
 	VsanFileShareNfsSecTypeKRB5P                       = VsanFileShareNfsSecType("KRB5P")
 )
 
+// Values returns all known values of the VsanFileShareNfsSecType enum
+func (e VsanFileShareNfsSecType) Values() []VsanFileShareNfsSecType {
+	return []VsanFileShareNfsSecType{
+		VsanFileShareNfsSecTypeSYS,
+		VsanFileShareNfsSecTypeKRB5,
+		VsanFileShareNfsSecTypeFileShareNfsSecType_Unknown,
+		VsanFileShareNfsSecTypeKRB5I,
+		VsanFileShareNfsSecTypeKRB5P,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanFileShareNfsSecType", reflect.TypeOf((*VsanFileShareNfsSecType)(nil)).Elem())
 }
@@ -821,6 +1489,15 @@ const (
 	VimVsanHostTrafficTypewitness             = VimVsanHostTrafficType("witness")
 )
 
+// Values returns all known values of the VimVsanHostTrafficType enum
+func (e VimVsanHostTrafficType) Values() []VimVsanHostTrafficType {
+	return []VimVsanHostTrafficType{
+		VimVsanHostTrafficTypeTrafficType_Unknown,
+		VimVsanHostTrafficTypevsan,
+		VimVsanHostTrafficTypewitness,
+	}
+}
+
 func init() {
 	types.Add("vsan:VimVsanHostTrafficType", reflect.TypeOf((*VimVsanHostTrafficType)(nil)).Elem())
 }
@@ -835,6 +1512,17 @@ const (
 	VimClusterVsanDiskGroupCreationTypevsandirect                        = VimClusterVsanDiskGroupCreationType("vsandirect")
 )
 
+// Values returns all known values of the VimClusterVsanDiskGroupCreationType enum
+func (e VimClusterVsanDiskGroupCreationType) Values() []VimClusterVsanDiskGroupCreationType {
+	return []VimClusterVsanDiskGroupCreationType{
+		VimClusterVsanDiskGroupCreationTypeallflash,
+		VimClusterVsanDiskGroupCreationTypepmem,
+		VimClusterVsanDiskGroupCreationTypehybrid,
+		VimClusterVsanDiskGroupCreationTypeVsanDiskGroupCreationType_Unknown,
+		VimClusterVsanDiskGroupCreationTypevsandirect,
+	}
+}
+
 func init() {
 	types.Add("vsan:VimClusterVsanDiskGroupCreationType", reflect.TypeOf((*VimClusterVsanDiskGroupCreationType)(nil)).Elem())
 }
@@ -850,6 +1538,18 @@ const (
 	VsanHostQueryCheckLimitsOptionTypediskTransientCapacityUsed                  = VsanHostQueryCheckLimitsOptionType("diskTransientCapacityUsed")
 )
 
+// Values returns all known values of the VsanHostQueryCheckLimitsOptionType enum
+func (e VsanHostQueryCheckLimitsOptionType) Values() []VsanHostQueryCheckLimitsOptionType {
+	return []VsanHostQueryCheckLimitsOptionType{
+		VsanHostQueryCheckLimitsOptionTypelogicalCapacityUsed,
+		VsanHostQueryCheckLimitsOptionTypededupMetadata,
+		VsanHostQueryCheckLimitsOptionTypeVsanHostQueryCheckLimitsOptionType_Unknown,
+		VsanHostQueryCheckLimitsOptionTypelogicalCapacity,
+		VsanHostQueryCheckLimitsOptionTypedgTransientCapacityUsed,
+		VsanHostQueryCheckLimitsOptionTypediskTransientCapacityUsed,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanHostQueryCheckLimitsOptionType", reflect.TypeOf((*VsanHostQueryCheckLimitsOptionType)(nil)).Elem())
 }
@@ -863,6 +1563,16 @@ const (
 	VsanHostWipeDiskStateSuccess               = VsanHostWipeDiskState("Success")
 )
 
+// Values returns all known values of the VsanHostWipeDiskState enum
+func (e VsanHostWipeDiskState) Values() []VsanHostWipeDiskState {
+	return []VsanHostWipeDiskState{
+		VsanHostWipeDiskStateFailure,
+		VsanHostWipeDiskStateWiping,
+		VsanHostWipeDiskStateWipeDiskState_Unknown,
+		VsanHostWipeDiskStateSuccess,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanHostWipeDiskState", reflect.TypeOf((*VsanHostWipeDiskState)(nil)).Elem())
 }
@@ -879,6 +1589,19 @@ const (
 	VsanHostStatsTypeStatsType_Unknown        = VsanHostStatsType("StatsType_Unknown")
 )
 
+// Values returns all known values of the VsanHostStatsType enum
+func (e VsanHostStatsType) Values() []VsanHostStatsType {
+	return []VsanHostStatsType{
+		VsanHostStatsTypeconfigGeneration,
+		VsanHostStatsTyperesyncIopsInfo,
+		VsanHostStatsTypecomponentLimitPerCluster,
+		VsanHostStatsTypesupportedClusterSize,
+		VsanHostStatsTyperepairTimerInfo,
+		VsanHostStatsTypemaxWitnessClusters,
+		VsanHostStatsTypeStatsType_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanHostStatsType", reflect.TypeOf((*VsanHostStatsType)(nil)).Elem())
 }
@@ -897,6 +1620,21 @@ const (
 	VimVsanVsanVcsaDeploymentPhasevsanbootstrap                   = VimVsanVsanVcsaDeploymentPhase("vsanbootstrap")
 )
 
+// Values returns all known values of the VimVsanVsanVcsaDeploymentPhase enum
+func (e VimVsanVsanVcsaDeploymentPhase) Values() []VimVsanVsanVcsaDeploymentPhase {
+	return []VimVsanVsanVcsaDeploymentPhase{
+		VimVsanVsanVcsaDeploymentPhasefailed,
+		VimVsanVsanVcsaDeploymentPhasevcsadeploy,
+		VimVsanVsanVcsaDeploymentPhaseovaunpack,
+		VimVsanVsanVcsaDeploymentPhasedone,
+		VimVsanVsanVcsaDeploymentPhaseVsanVcsaDeploymentPhase_Unknown,
+		VimVsanVsanVcsaDeploymentPhaseinitializing,
+		VimVsanVsanVcsaDeploymentPhasevalidation,
+		VimVsanVsanVcsaDeploymentPhasevcconfig,
+		VimVsanVsanVcsaDeploymentPhasevsanbootstrap,
+	}
+}
+
 func init() {
 	types.Add("vsan:VimVsanVsanVcsaDeploymentPhase", reflect.TypeOf((*VimVsanVsanVcsaDeploymentPhase)(nil)).Elem())
 }
@@ -910,6 +1648,16 @@ const (
 	VsanCapabilityStatusoldversion   = VsanCapabilityStatus("oldversion")
 )
 
+// Values returns all known values of the VsanCapabilityStatus enum
+func (e VsanCapabilityStatus) Values() []VsanCapabilityStatus {
+	return []VsanCapabilityStatus{
+		VsanCapabilityStatusunknown,
+		VsanCapabilityStatuscalculated,
+		VsanCapabilityStatusdisconnected,
+		VsanCapabilityStatusoldversion,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanCapabilityStatus", reflect.TypeOf((*VsanCapabilityStatus)(nil)).Elem())
 }
@@ -924,6 +1672,17 @@ const (
 	VsanSpaceReportingEntityTypeFileShare                            = VsanSpaceReportingEntityType("FileShare")
 )
 
+// Values returns all known values of the VsanSpaceReportingEntityType enum
+func (e VsanSpaceReportingEntityType) Values() []VsanSpaceReportingEntityType {
+	return []VsanSpaceReportingEntityType{
+		VsanSpaceReportingEntityTypeHost,
+		VsanSpaceReportingEntityTypeFaultDomain,
+		VsanSpaceReportingEntityTypeVsanSpaceReportingEntityType_Unknown,
+		VsanSpaceReportingEntityTypeVM,
+		VsanSpaceReportingEntityTypeFileShare,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanSpaceReportingEntityType", reflect.TypeOf((*VsanSpaceReportingEntityType)(nil)).Elem())
 }
@@ -937,6 +1696,16 @@ const (
 	VsanIscsiTargetAuthTypeVsanIscsiTargetAuthType_Unknown = VsanIscsiTargetAuthType("VsanIscsiTargetAuthType_Unknown")
 )
 
+// Values returns all known values of the VsanIscsiTargetAuthType enum
+func (e VsanIscsiTargetAuthType) Values() []VsanIscsiTargetAuthType {
+	return []VsanIscsiTargetAuthType{
+		VsanIscsiTargetAuthTypeCHAP,
+		VsanIscsiTargetAuthTypeNoAuth,
+		VsanIscsiTargetAuthTypeCHAP_Mutual,
+		VsanIscsiTargetAuthTypeVsanIscsiTargetAuthType_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanIscsiTargetAuthType", reflect.TypeOf((*VsanIscsiTargetAuthType)(nil)).Elem())
 }
@@ -950,6 +1719,16 @@ const (
 	VsanIoInsightStateVsanIoInsightState_unknown = VsanIoInsightState("VsanIoInsightState_unknown")
 )
 
+// Values returns all known values of the VsanIoInsightState enum
+func (e VsanIoInsightState) Values() []VsanIoInsightState {
+	return []VsanIoInsightState{
+		VsanIoInsightStatenotFound,
+		VsanIoInsightStaterunning,
+		VsanIoInsightStatestopped,
+		VsanIoInsightStateVsanIoInsightState_unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanIoInsightState", reflect.TypeOf((*VsanIoInsightState)(nil)).Elem())
 }
@@ -962,6 +1741,15 @@ const (
 	VsanPerfThresholdDirectionTypeVsanPerfThresholdDirectionType_Unknown = VsanPerfThresholdDirectionType("VsanPerfThresholdDirectionType_Unknown")
 )
 
+// Values returns all known values of the VsanPerfThresholdDirectionType enum
+func (e VsanPerfThresholdDirectionType) Values() []VsanPerfThresholdDirectionType {
+	return []VsanPerfThresholdDirectionType{
+		VsanPerfThresholdDirectionTypeupper,
+		VsanPerfThresholdDirectionTypelower,
+		VsanPerfThresholdDirectionTypeVsanPerfThresholdDirectionType_Unknown,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanPerfThresholdDirectionType", reflect.TypeOf((*VsanPerfThresholdDirectionType)(nil)).Elem())
 }
@@ -975,6 +1763,16 @@ const (
 	VsanFileShareAccessTypeNO_ACCESS                   = VsanFileShareAccessType("NO_ACCESS")
 )
 
+// Values returns all known values of the VsanFileShareAccessType enum
+func (e VsanFileShareAccessType) Values() []VsanFileShareAccessType {
+	return []VsanFileShareAccessType{
+		VsanFileShareAccessTypeREAD_ONLY,
+		VsanFileShareAccessTypeFileShareAccessType_Unknown,
+		VsanFileShareAccessTypeREAD_WRITE,
+		VsanFileShareAccessTypeNO_ACCESS,
+	}
+}
+
 func init() {
 	types.Add("vsan:VsanFileShareAccessType", reflect.TypeOf((*VsanFileShareAccessType)(nil)).Elem())
 }
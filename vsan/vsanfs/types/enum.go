@@ -30,6 +30,15 @@ const (
 	VsanFileShareAccessTypeNO_ACCESS  = VsanFileShareAccessType("NO_ACCESS")
 )
 
+// Values returns all known values of the VsanFileShareAccessType enum
+func (e VsanFileShareAccessType) Values() []VsanFileShareAccessType {
+	return []VsanFileShareAccessType{
+		VsanFileShareAccessTypeREAD_ONLY,
+		VsanFileShareAccessTypeREAD_WRITE,
+		VsanFileShareAccessTypeNO_ACCESS,
+	}
+}
+
 func init() {
 	types.Add("VsanFileShareAccessType", reflect.TypeOf((*VsanFileShareAccessType)(nil)).Elem())
 }
@@ -0,0 +1,974 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/simulator"
+	vimmethods "github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm/methods"
+	"github.com/vmware/govmomi/vslm/types"
+)
+
+// VStorageObjectManager implements the vslm global catalog API.
+// Objects are shared with the vim25 VcenterVStorageObjectManager, where most methods are delegated,
+// such that disks created with either API are visible to both.
+type VStorageObjectManager struct {
+	vim.ManagedObjectReference
+}
+
+// invoke calls f with the vim25 VcenterVStorageObjectManager, while holding its lock.
+// The Context passed to f is a copy of ctx for use with the vim25 Registry.
+func (m *VStorageObjectManager) invoke(ctx *simulator.Context, f func(*simulator.Context, *simulator.VcenterVStorageObjectManager)) {
+	vctx := *ctx
+	vctx.Map = ctx.VimMap()
+
+	vsom := vctx.Map.VStorageObjectManager()
+
+	vctx.Map.WithLock(&vctx, vsom, func() {
+		f(&vctx, vsom)
+	})
+}
+
+// object returns the object with the given ID and the datastore on which it resides
+func object(vsom *simulator.VcenterVStorageObjectManager, id vim.ID) (*simulator.VStorageObject, vim.ManagedObjectReference, vim.BaseMethodFault) {
+	obj, ok := vsom.Catalog()[id]
+	if !ok {
+		return nil, vim.ManagedObjectReference{}, new(vim.NotFound)
+	}
+
+	return obj, obj.Config.Backing.GetBaseConfigInfoBackingInfo().Datastore, nil
+}
+
+// objectTask looks up the object with the given ID and calls the vim25 task method f,
+// returning a vslm Task for the vim25 Task.
+func (m *VStorageObjectManager) objectTask(ctx *simulator.Context, id vim.ID, f func(*simulator.Context, *simulator.VcenterVStorageObjectManager, vim.ManagedObjectReference) soap.HasFault) (vim.ManagedObjectReference, *soap.Fault) {
+	var res soap.HasFault
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		_, ds, err := object(vsom, id)
+		if err != nil {
+			res = &vimmethods.RetrieveVStorageObjectBody{Fault_: simulator.Fault("", err)}
+			return
+		}
+		res = f(vctx, vsom, ds)
+	})
+
+	return taskResult(ctx, res)
+}
+
+// taskResult returns a vslm Task for the vim25 Task returned by a vim25 method, or the method's Fault
+func taskResult(ctx *simulator.Context, res soap.HasFault) (vim.ManagedObjectReference, *soap.Fault) {
+	if fault := res.Fault(); fault != nil {
+		return vim.ManagedObjectReference{}, fault
+	}
+
+	var ref vim.ManagedObjectReference
+
+	switch body := res.(type) {
+	case *vimmethods.CreateDisk_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.DeleteVStorageObject_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.ExtendDisk_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.InflateDisk_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.CloneVStorageObject_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.RelocateVStorageObject_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.VStorageObjectCreateSnapshot_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.DeleteSnapshot_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.RevertVStorageObject_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.CreateDiskFromSnapshot_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.ReconcileDatastoreInventory_TaskBody:
+		ref = body.Res.Returnval
+	case *vimmethods.AttachDisk_TaskBody:
+		ref = body.Res.Returnval
+	default:
+		return vim.ManagedObjectReference{}, simulator.Fault(fmt.Sprintf("unexpected response type %T", body), &vim.RuntimeFault{})
+	}
+
+	return newTask(ctx, ref), nil
+}
+
+func (m *VStorageObjectManager) VslmCreateDiskTask(ctx *simulator.Context, req *types.VslmCreateDisk_Task) soap.HasFault {
+	body := new(methods.VslmCreateDisk_TaskBody)
+	var res soap.HasFault
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		res = vsom.CreateDiskTask(vctx, &vim.CreateDisk_Task{This: vsom.Self, Spec: req.Spec})
+	})
+
+	task, fault := taskResult(ctx, res)
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmCreateDisk_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRegisterDisk(ctx *simulator.Context, req *types.VslmRegisterDisk) soap.HasFault {
+	body := new(methods.VslmRegisterDiskBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		res := vsom.RegisterDisk(vctx, &vim.RegisterDisk{This: vsom.Self, Path: req.Path, Name: req.Name}).(*vimmethods.RegisterDiskBody)
+		if res.Fault_ != nil {
+			body.Fault_ = res.Fault_
+		} else {
+			body.Res = &types.VslmRegisterDiskResponse{Returnval: res.Res.Returnval}
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmDeleteVStorageObjectTask(ctx *simulator.Context, req *types.VslmDeleteVStorageObject_Task) soap.HasFault {
+	body := new(methods.VslmDeleteVStorageObject_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.DeleteVStorageObjectTask(vctx, &vim.DeleteVStorageObject_Task{This: vsom.Self, Id: req.Id, Datastore: ds})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmDeleteVStorageObject_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmExtendDiskTask(ctx *simulator.Context, req *types.VslmExtendDisk_Task) soap.HasFault {
+	body := new(methods.VslmExtendDisk_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.ExtendDiskTask(vctx, &vim.ExtendDisk_Task{This: vsom.Self, Id: req.Id, Datastore: ds, NewCapacityInMB: req.NewCapacityInMB})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmExtendDisk_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmInflateDiskTask(ctx *simulator.Context, req *types.VslmInflateDisk_Task) soap.HasFault {
+	body := new(methods.VslmInflateDisk_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.InflateDiskTask(vctx, &vim.InflateDisk_Task{This: vsom.Self, Id: req.Id, Datastore: ds})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmInflateDisk_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmCloneVStorageObjectTask(ctx *simulator.Context, req *types.VslmCloneVStorageObject_Task) soap.HasFault {
+	body := new(methods.VslmCloneVStorageObject_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.CloneVStorageObjectTask(vctx, &vim.CloneVStorageObject_Task{This: vsom.Self, Id: req.Id, Datastore: ds, Spec: req.Spec})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmCloneVStorageObject_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRelocateVStorageObjectTask(ctx *simulator.Context, req *types.VslmRelocateVStorageObject_Task) soap.HasFault {
+	body := new(methods.VslmRelocateVStorageObject_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.RelocateVStorageObjectTask(vctx, &vim.RelocateVStorageObject_Task{This: vsom.Self, Id: req.Id, Datastore: ds, Spec: req.Spec})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmRelocateVStorageObject_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmCreateSnapshotTask(ctx *simulator.Context, req *types.VslmCreateSnapshot_Task) soap.HasFault {
+	body := new(methods.VslmCreateSnapshot_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.VStorageObjectCreateSnapshotTask(vctx, &vim.VStorageObjectCreateSnapshot_Task{This: vsom.Self, Id: req.Id, Datastore: ds, Description: req.Description})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmCreateSnapshot_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmDeleteSnapshotTask(ctx *simulator.Context, req *types.VslmDeleteSnapshot_Task) soap.HasFault {
+	body := new(methods.VslmDeleteSnapshot_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.DeleteSnapshotTask(vctx, &vim.DeleteSnapshot_Task{This: vsom.Self, Id: req.Id, Datastore: ds, SnapshotId: req.SnapshotId})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmDeleteSnapshot_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRevertVStorageObjectTask(ctx *simulator.Context, req *types.VslmRevertVStorageObject_Task) soap.HasFault {
+	body := new(methods.VslmRevertVStorageObject_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.RevertVStorageObjectTask(vctx, &vim.RevertVStorageObject_Task{This: vsom.Self, Id: req.Id, Datastore: ds, SnapshotId: req.SnapshotId})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmRevertVStorageObject_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmCreateDiskFromSnapshotTask(ctx *simulator.Context, req *types.VslmCreateDiskFromSnapshot_Task) soap.HasFault {
+	body := new(methods.VslmCreateDiskFromSnapshot_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		return vsom.CreateDiskFromSnapshotTask(vctx, &vim.CreateDiskFromSnapshot_Task{
+			This:       vsom.Self,
+			Id:         req.Id,
+			Datastore:  ds,
+			SnapshotId: req.SnapshotId,
+			Name:       req.Name,
+			Path:       req.Path,
+		})
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmCreateDiskFromSnapshot_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmReconcileDatastoreInventoryTask(ctx *simulator.Context, req *types.VslmReconcileDatastoreInventory_Task) soap.HasFault {
+	body := new(methods.VslmReconcileDatastoreInventory_TaskBody)
+	var res soap.HasFault
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		if _, ok := vctx.Map.Get(req.Datastore).(*simulator.Datastore); !ok {
+			res = &vimmethods.ReconcileDatastoreInventory_TaskBody{Fault_: simulator.Fault("", &vim.ManagedObjectNotFound{Obj: req.Datastore})}
+			return
+		}
+		res = vsom.ReconcileDatastoreInventoryTask(vctx, &vim.ReconcileDatastoreInventory_Task{This: vsom.Self, Datastore: req.Datastore})
+	})
+
+	task, fault := taskResult(ctx, res)
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmReconcileDatastoreInventory_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmScheduleReconcileDatastoreInventory(ctx *simulator.Context, req *types.VslmScheduleReconcileDatastoreInventory) soap.HasFault {
+	body := new(methods.VslmScheduleReconcileDatastoreInventoryBody)
+
+	res := m.VslmReconcileDatastoreInventoryTask(ctx, &types.VslmReconcileDatastoreInventory_Task{This: req.This, Datastore: req.Datastore})
+	if fault := res.Fault(); fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = new(types.VslmScheduleReconcileDatastoreInventoryResponse)
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmAttachDiskTask(ctx *simulator.Context, req *types.VslmAttachDisk_Task) soap.HasFault {
+	body := new(methods.VslmAttachDisk_TaskBody)
+
+	task, fault := m.objectTask(ctx, req.Id, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager, ds vim.ManagedObjectReference) soap.HasFault {
+		vm, ok := vctx.Map.Get(req.Vm).(*simulator.VirtualMachine)
+		if !ok {
+			return &vimmethods.AttachDisk_TaskBody{Fault_: simulator.Fault("", &vim.ManagedObjectNotFound{Obj: req.Vm})}
+		}
+
+		var res soap.HasFault
+		vctx.WithLock(vm, func() {
+			res = vm.AttachDiskTask(vctx, &vim.AttachDisk_Task{
+				This:          vm.Self,
+				DiskId:        req.Id,
+				Datastore:     ds,
+				ControllerKey: req.ControllerKey,
+				UnitNumber:    req.UnitNumber,
+			})
+		})
+		return res
+	})
+	if fault != nil {
+		body.Fault_ = fault
+	} else {
+		body.Res = &types.VslmAttachDisk_TaskResponse{Returnval: task}
+	}
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRenameVStorageObject(ctx *simulator.Context, req *types.VslmRenameVStorageObject) soap.HasFault {
+	body := new(methods.VslmRenameVStorageObjectBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		_, ds, err := object(vsom, req.Id)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		res := vsom.RenameVStorageObject(&vim.RenameVStorageObject{This: vsom.Self, Id: req.Id, Datastore: ds, Name: req.Name})
+		if body.Fault_ = res.Fault(); body.Fault_ == nil {
+			body.Res = new(types.VslmRenameVStorageObjectResponse)
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRetrieveVStorageObject(ctx *simulator.Context, req *types.VslmRetrieveVStorageObject) soap.HasFault {
+	body := new(methods.VslmRetrieveVStorageObjectBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		_, ds, err := object(vsom, req.Id)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		res := vsom.RetrieveVStorageObject(vctx, &vim.RetrieveVStorageObject{This: vsom.Self, Id: req.Id, Datastore: ds}).(*vimmethods.RetrieveVStorageObjectBody)
+		if body.Fault_ = res.Fault_; body.Fault_ == nil {
+			body.Res = &types.VslmRetrieveVStorageObjectResponse{Returnval: res.Res.Returnval}
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRetrieveVStorageObjectState(ctx *simulator.Context, req *types.VslmRetrieveVStorageObjectState) soap.HasFault {
+	body := new(methods.VslmRetrieveVStorageObjectStateBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		_, ds, err := object(vsom, req.Id)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		res := vsom.RetrieveVStorageObjectState(&vim.RetrieveVStorageObjectState{This: vsom.Self, Id: req.Id, Datastore: ds}).(*vimmethods.RetrieveVStorageObjectStateBody)
+		if body.Fault_ = res.Fault_; body.Fault_ == nil {
+			body.Res = &types.VslmRetrieveVStorageObjectStateResponse{Returnval: res.Res.Returnval}
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRetrieveSnapshotInfo(ctx *simulator.Context, req *types.VslmRetrieveSnapshotInfo) soap.HasFault {
+	body := new(methods.VslmRetrieveSnapshotInfoBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		_, ds, err := object(vsom, req.Id)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		res := vsom.RetrieveSnapshotInfo(&vim.RetrieveSnapshotInfo{This: vsom.Self, Id: req.Id, Datastore: ds}).(*vimmethods.RetrieveSnapshotInfoBody)
+		if body.Fault_ = res.Fault_; body.Fault_ == nil {
+			body.Res = &types.VslmRetrieveSnapshotInfoResponse{Returnval: res.Res.Returnval}
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRetrieveSnapshotDetails(ctx *simulator.Context, req *types.VslmRetrieveSnapshotDetails) soap.HasFault {
+	body := new(methods.VslmRetrieveSnapshotDetailsBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		_, ds, err := object(vsom, req.Id)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		res := vsom.RetrieveSnapshotDetails(&vim.RetrieveSnapshotDetails{This: vsom.Self, Id: req.Id, Datastore: ds, SnapshotId: req.SnapshotId}).(*vimmethods.RetrieveSnapshotDetailsBody)
+		if body.Fault_ = res.Fault_; body.Fault_ == nil {
+			body.Res = &types.VslmRetrieveSnapshotDetailsResponse{Returnval: res.Res.Returnval}
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmSetVStorageObjectControlFlags(ctx *simulator.Context, req *types.VslmSetVStorageObjectControlFlags) soap.HasFault {
+	body := new(methods.VslmSetVStorageObjectControlFlagsBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		_, ds, err := object(vsom, req.Id)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		res := vsom.SetVStorageObjectControlFlags(&vim.SetVStorageObjectControlFlags{This: vsom.Self, Id: req.Id, Datastore: ds, ControlFlags: req.ControlFlags})
+		if body.Fault_ = res.Fault(); body.Fault_ == nil {
+			body.Res = new(types.VslmSetVStorageObjectControlFlagsResponse)
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmClearVStorageObjectControlFlags(ctx *simulator.Context, req *types.VslmClearVStorageObjectControlFlags) soap.HasFault {
+	body := new(methods.VslmClearVStorageObjectControlFlagsBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		_, ds, err := object(vsom, req.Id)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		res := vsom.ClearVStorageObjectControlFlags(&vim.ClearVStorageObjectControlFlags{This: vsom.Self, Id: req.Id, Datastore: ds, ControlFlags: req.ControlFlags})
+		if body.Fault_ = res.Fault(); body.Fault_ == nil {
+			body.Res = new(types.VslmClearVStorageObjectControlFlagsResponse)
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRetrieveVStorageObjectAssociations(ctx *simulator.Context, req *types.VslmRetrieveVStorageObjectAssociations) soap.HasFault {
+	body := &methods.VslmRetrieveVStorageObjectAssociationsBody{
+		Res: new(types.VslmRetrieveVStorageObjectAssociationsResponse),
+	}
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		spec := &vim.RetrieveVStorageObjectAssociations{This: vsom.Self}
+		for _, id := range req.Ids {
+			_, ds, _ := object(vsom, id)
+			spec.Ids = append(spec.Ids, vim.RetrieveVStorageObjSpec{Id: id, Datastore: ds})
+		}
+
+		res := vsom.RetrieveVStorageObjectAssociations(vctx, spec).(*vimmethods.RetrieveVStorageObjectAssociationsBody)
+		for _, a := range res.Res.Returnval {
+			association := types.VslmVsoVStorageObjectAssociations{Id: a.Id, Fault: a.Fault}
+			for _, disk := range a.VmDiskAssociations {
+				association.VmDiskAssociation = append(association.VmDiskAssociation, types.VslmVsoVStorageObjectAssociationsVmDiskAssociation{
+					VmId:    disk.VmId,
+					DiskKey: disk.DiskKey,
+				})
+			}
+			body.Res.Returnval = append(body.Res.Returnval, association)
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmAttachTagToVStorageObject(ctx *simulator.Context, req *types.VslmAttachTagToVStorageObject) soap.HasFault {
+	body := new(methods.VslmAttachTagToVStorageObjectBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		if _, _, err := object(vsom, req.Id); err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		res := vsom.AttachTagToVStorageObject(vctx, &vim.AttachTagToVStorageObject{This: vsom.Self, Id: req.Id, Category: req.Category, Tag: req.Tag})
+		if body.Fault_ = res.Fault(); body.Fault_ == nil {
+			body.Res = new(types.VslmAttachTagToVStorageObjectResponse)
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmDetachTagFromVStorageObject(ctx *simulator.Context, req *types.VslmDetachTagFromVStorageObject) soap.HasFault {
+	body := new(methods.VslmDetachTagFromVStorageObjectBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		res := vsom.DetachTagFromVStorageObject(vctx, &vim.DetachTagFromVStorageObject{This: vsom.Self, Id: req.Id, Category: req.Category, Tag: req.Tag})
+		if body.Fault_ = res.Fault(); body.Fault_ == nil {
+			body.Res = new(types.VslmDetachTagFromVStorageObjectResponse)
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmListVStorageObjectsAttachedToTag(ctx *simulator.Context, req *types.VslmListVStorageObjectsAttachedToTag) soap.HasFault {
+	body := new(methods.VslmListVStorageObjectsAttachedToTagBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		res := vsom.ListVStorageObjectsAttachedToTag(vctx, &vim.ListVStorageObjectsAttachedToTag{This: vsom.Self, Category: req.Category, Tag: req.Tag}).(*vimmethods.ListVStorageObjectsAttachedToTagBody)
+		if body.Fault_ = res.Fault_; body.Fault_ == nil {
+			body.Res = &types.VslmListVStorageObjectsAttachedToTagResponse{Returnval: res.Res.Returnval}
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmListTagsAttachedToVStorageObject(ctx *simulator.Context, req *types.VslmListTagsAttachedToVStorageObject) soap.HasFault {
+	body := new(methods.VslmListTagsAttachedToVStorageObjectBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		res := vsom.ListTagsAttachedToVStorageObject(vctx, &vim.ListTagsAttachedToVStorageObject{This: vsom.Self, Id: req.Id}).(*vimmethods.ListTagsAttachedToVStorageObjectBody)
+		if body.Fault_ = res.Fault_; body.Fault_ == nil {
+			body.Res = &types.VslmListTagsAttachedToVStorageObjectResponse{Returnval: res.Res.Returnval}
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmUpdateVStorageObjectMetadataTask(ctx *simulator.Context, req *types.VslmUpdateVStorageObjectMetadata_Task) soap.HasFault {
+	body := new(methods.VslmUpdateVStorageObjectMetadata_TaskBody)
+	var ref vim.ManagedObjectReference
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		task := simulator.CreateTask(ctx, vsom, "updateVStorageObjectMetadata", func(*simulator.Task) (vim.AnyType, vim.BaseMethodFault) {
+			obj, _, err := object(vsom, req.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			metadata := obj.Config.Metadata[:0]
+			for _, kv := range obj.Config.Metadata {
+				if !contains(req.DeleteKeys, kv.Key) && !containsKey(req.Metadata, kv.Key) {
+					metadata = append(metadata, kv)
+				}
+			}
+			obj.Config.Metadata = append(metadata, req.Metadata...)
+
+			return nil, nil
+		})
+		ref = task.Run(vctx)
+	})
+
+	body.Res = &types.VslmUpdateVStorageObjectMetadata_TaskResponse{
+		Returnval: newTask(ctx, ref),
+	}
+
+	return body
+}
+
+// metadata returns the metadata of the given object, validating the snapshot ID if any.
+// Note that metadata is not versioned per snapshot, the current metadata is returned in all cases.
+func metadata(vsom *simulator.VcenterVStorageObjectManager, id vim.ID, snapshot *vim.ID) ([]vim.KeyValue, vim.BaseMethodFault) {
+	obj, _, err := object(vsom, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot != nil {
+		found := false
+		for _, s := range obj.Snapshots {
+			if *s.Id == *snapshot {
+				found = true
+			}
+		}
+		if !found {
+			return nil, new(vim.NotFound)
+		}
+	}
+
+	return obj.Config.Metadata, nil
+}
+
+func (m *VStorageObjectManager) VslmRetrieveVStorageObjectMetadata(ctx *simulator.Context, req *types.VslmRetrieveVStorageObjectMetadata) soap.HasFault {
+	body := new(methods.VslmRetrieveVStorageObjectMetadataBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		kvs, err := metadata(vsom, req.Id, req.SnapshotId)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		body.Res = new(types.VslmRetrieveVStorageObjectMetadataResponse)
+		for _, kv := range kvs {
+			if strings.HasPrefix(kv.Key, req.Prefix) {
+				body.Res.Returnval = append(body.Res.Returnval, kv)
+			}
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmRetrieveVStorageObjectMetadataValue(ctx *simulator.Context, req *types.VslmRetrieveVStorageObjectMetadataValue) soap.HasFault {
+	body := new(methods.VslmRetrieveVStorageObjectMetadataValueBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		kvs, err := metadata(vsom, req.Id, req.SnapshotId)
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		for _, kv := range kvs {
+			if kv.Key == req.Key {
+				body.Res = &types.VslmRetrieveVStorageObjectMetadataValueResponse{Returnval: kv.Value}
+				return
+			}
+		}
+
+		body.Fault_ = simulator.Fault("", &vim.NotFound{})
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmQueryChangedDiskAreas(ctx *simulator.Context, req *types.VslmQueryChangedDiskAreas) soap.HasFault {
+	body := new(methods.VslmQueryChangedDiskAreasBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		obj, _, err := object(vsom, req.Id)
+		if err == nil {
+			if _, err = metadata(vsom, req.Id, &req.SnapshotId); err == nil && !isTrue(obj.Config.ChangedBlockTrackingEnabled) {
+				err = new(vim.InvalidState)
+			}
+		}
+		if err != nil {
+			body.Fault_ = simulator.Fault("", err)
+			return
+		}
+
+		capacity := obj.Config.CapacityInMB * 1024 * 1024
+		if req.StartOffset < 0 || req.StartOffset > capacity {
+			body.Fault_ = simulator.Fault("", &vim.InvalidArgument{InvalidProperty: "startOffset"})
+			return
+		}
+
+		info := vim.DiskChangeInfo{
+			StartOffset: req.StartOffset,
+			Length:      capacity - req.StartOffset,
+		}
+
+		// changeId "*" requests all allocated areas, otherwise there are no changes since the given changeId
+		if req.ChangeId == "*" && info.Length != 0 {
+			info.ChangedArea = []vim.DiskChangeExtent{{Start: info.StartOffset, Length: info.Length}}
+		}
+
+		body.Res = &types.VslmQueryChangedDiskAreasResponse{Returnval: info}
+	})
+
+	return body
+}
+
+// result returns the VslmVsoVStorageObjectResult for the given object
+func result(ctx *simulator.Context, obj *simulator.VStorageObject) types.VslmVsoVStorageObjectResult {
+	backing := obj.Config.Backing.(*vim.BaseConfigInfoDiskFileBackingInfo)
+	created := obj.Config.CreateTime
+
+	res := types.VslmVsoVStorageObjectResult{
+		Id:              obj.Config.Id,
+		Name:            obj.Config.Name,
+		CapacityInMB:    obj.Config.CapacityInMB,
+		CreateTime:      &created,
+		DiskPath:        backing.FilePath,
+		BackingObjectId: &vim.ID{Id: backing.BackingObjectId},
+		Metadata:        obj.Config.Metadata,
+	}
+
+	if ds, ok := ctx.Map.Get(backing.Datastore).(*simulator.Datastore); ok {
+		res.DatastoreUrl = ds.Info.GetDatastoreInfo().Url
+	}
+
+	for _, s := range obj.Snapshots {
+		res.SnapshotInfo = append(res.SnapshotInfo, types.VslmVsoVStorageObjectSnapshotResult{
+			BackingObjectId: vim.ID{Id: s.BackingObjectId},
+			Description:     s.Description,
+			SnapshotId:      s.Id,
+			DiskPath:        backing.FilePath,
+		})
+	}
+
+	return res
+}
+
+func (m *VStorageObjectManager) VslmRetrieveVStorageObjects(ctx *simulator.Context, req *types.VslmRetrieveVStorageObjects) soap.HasFault {
+	body := &methods.VslmRetrieveVStorageObjectsBody{
+		Res: new(types.VslmRetrieveVStorageObjectsResponse),
+	}
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		for _, id := range req.Ids {
+			obj, _, err := object(vsom, id)
+			if err != nil {
+				body.Res.Returnval = append(body.Res.Returnval, types.VslmVsoVStorageObjectResult{
+					Id:    id,
+					Error: &vim.LocalizedMethodFault{Fault: err},
+				})
+				continue
+			}
+			body.Res.Returnval = append(body.Res.Returnval, result(vctx, obj))
+		}
+	})
+
+	return body
+}
+
+// queryValues returns the values of the given object for the given query field
+func queryValues(obj *simulator.VStorageObject, field string) ([]string, bool) {
+	switch types.VslmVsoVStorageObjectQuerySpecQueryFieldEnum(field) {
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumId:
+		return []string{obj.Config.Id.Id}, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumName:
+		return []string{obj.Config.Name}, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumCapacity:
+		return []string{strconv.FormatInt(obj.Config.CapacityInMB, 10)}, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumCreateTime:
+		return []string{obj.Config.CreateTime.Format(time.RFC3339)}, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumBackingObjectId:
+		return []string{obj.Config.Backing.(*vim.BaseConfigInfoDiskFileBackingInfo).BackingObjectId}, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumDatastoreMoId:
+		return []string{obj.Config.Backing.GetBaseConfigInfoBackingInfo().Datastore.Value}, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey, types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataValue:
+		var values []string
+		for _, kv := range obj.Config.Metadata {
+			if field == string(types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey) {
+				values = append(values, kv.Key)
+			} else {
+				values = append(values, kv.Value)
+			}
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// compare returns the result of comparing a and b, numerically if both are numbers
+func compare(a, b string) int {
+	x, errx := strconv.ParseInt(a, 10, 64)
+	y, erry := strconv.ParseInt(b, 10, 64)
+	if errx == nil && erry == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+// queryMatch returns true if the value matches the given operator and query value
+func queryMatch(op, val, query string) (bool, bool) {
+	switch types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnum(op) {
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEquals:
+		return compare(val, query) == 0, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumNotEquals:
+		return compare(val, query) != 0, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumLessThan:
+		return compare(val, query) < 0, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThan:
+		return compare(val, query) > 0, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumLessThanOrEqual:
+		return compare(val, query) <= 0, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThanOrEqual:
+		return compare(val, query) >= 0, true
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumContains:
+		return strings.Contains(val, query), true
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumStartsWith:
+		return strings.HasPrefix(val, query), true
+	case types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEndsWith:
+		return strings.HasSuffix(val, query), true
+	}
+	return false, false
+}
+
+// match returns true if the object matches the given query spec.
+// The spec matches if any object value matches any of the query values.
+func match(obj *simulator.VStorageObject, spec types.VslmVsoVStorageObjectQuerySpec) (bool, vim.BaseMethodFault) {
+	values, ok := queryValues(obj, spec.QueryField)
+	if !ok {
+		return false, &vim.InvalidArgument{InvalidProperty: "queryField"}
+	}
+
+	for _, val := range values {
+		for _, query := range spec.QueryValue {
+			matched, ok := queryMatch(spec.QueryOperator, val, query)
+			if !ok {
+				return false, &vim.InvalidArgument{InvalidProperty: "queryOperator"}
+			}
+			if matched {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (m *VStorageObjectManager) VslmListVStorageObjectForSpec(ctx *simulator.Context, req *types.VslmListVStorageObjectForSpec) soap.HasFault {
+	body := new(methods.VslmListVStorageObjectForSpecBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		var objs []*simulator.VStorageObject
+
+	catalog:
+		for _, obj := range vsom.Catalog() {
+			for _, spec := range req.Query {
+				ok, err := match(obj, spec)
+				if err != nil {
+					body.Fault_ = simulator.Fault("", err)
+					return
+				}
+				if !ok {
+					continue catalog
+				}
+			}
+			objs = append(objs, obj)
+		}
+
+		sort.Slice(objs, func(i, j int) bool {
+			return objs[i].Config.Id.Id < objs[j].Config.Id.Id
+		})
+
+		res := &types.VslmVsoVStorageObjectQueryResult{AllRecordsReturned: true}
+		if req.MaxResult > 0 && len(objs) > int(req.MaxResult) {
+			objs = objs[:req.MaxResult]
+			res.AllRecordsReturned = false
+		}
+
+		for _, obj := range objs {
+			res.Id = append(res.Id, obj.Config.Id)
+			res.QueryResults = append(res.QueryResults, result(vctx, obj))
+		}
+
+		body.Res = &types.VslmListVStorageObjectForSpecResponse{Returnval: res}
+	})
+
+	return body
+}
+
+// syncStatus returns the global catalog sync status of the given datastore, which is always in sync
+func syncStatus(vsom *simulator.VcenterVStorageObjectManager, ds *simulator.Datastore) types.VslmDatastoreSyncStatus {
+	var n int64
+	for _, obj := range vsom.Catalog() {
+		if obj.Config.Backing.GetBaseConfigInfoBackingInfo().Datastore == ds.Self {
+			n++
+		}
+	}
+
+	now := time.Now()
+
+	return types.VslmDatastoreSyncStatus{
+		DatastoreURL: ds.Info.GetDatastoreInfo().Url,
+		ObjectVClock: n,
+		SyncVClock:   n,
+		SyncTime:     &now,
+	}
+}
+
+func (m *VStorageObjectManager) VslmQueryGlobalCatalogSyncStatus(ctx *simulator.Context, req *types.VslmQueryGlobalCatalogSyncStatus) soap.HasFault {
+	body := &methods.VslmQueryGlobalCatalogSyncStatusBody{
+		Res: new(types.VslmQueryGlobalCatalogSyncStatusResponse),
+	}
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		for _, e := range vctx.Map.All("Datastore") {
+			body.Res.Returnval = append(body.Res.Returnval, syncStatus(vsom, e.(*simulator.Datastore)))
+		}
+	})
+
+	return body
+}
+
+func (m *VStorageObjectManager) VslmQueryGlobalCatalogSyncStatusForDatastore(ctx *simulator.Context, req *types.VslmQueryGlobalCatalogSyncStatusForDatastore) soap.HasFault {
+	body := new(methods.VslmQueryGlobalCatalogSyncStatusForDatastoreBody)
+
+	m.invoke(ctx, func(vctx *simulator.Context, vsom *simulator.VcenterVStorageObjectManager) {
+		for _, e := range vctx.Map.All("Datastore") {
+			ds := e.(*simulator.Datastore)
+			if ds.Info.GetDatastoreInfo().Url == req.DatastoreURL {
+				status := syncStatus(vsom, ds)
+				body.Res = &types.VslmQueryGlobalCatalogSyncStatusForDatastoreResponse{Returnval: &status}
+				return
+			}
+		}
+
+		body.Fault_ = simulator.Fault("", &vim.NotFound{})
+	})
+
+	return body
+}
+
+func contains(s []string, v string) bool {
+	for i := range s {
+		if s[i] == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsKey(kvs []vim.KeyValue, key string) bool {
+	for i := range kvs {
+		if kvs[i].Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func isTrue(v *bool) bool {
+	return v != nil && *v
+}
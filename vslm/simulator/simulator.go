@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm"
+	"github.com/vmware/govmomi/vslm/methods"
+	"github.com/vmware/govmomi/vslm/types"
+)
+
+var content = types.VslmServiceInstanceContent{
+	AboutInfo: types.VslmAboutInfo{
+		Name:         "VMware Virtual Storage Lifecycle Manager Service",
+		FullName:     "VMware Virtual Storage Lifecycle Manager Service 1.0.0",
+		Vendor:       "VMware, Inc.",
+		ApiVersion:   "1.0.0",
+		InstanceUuid: "0edd4cb5-4ef3-4fa3-8d7b-9f6a3b9d2c7e",
+	},
+	SessionManager:          vim.ManagedObjectReference{Type: "VslmSessionManager", Value: "SessionManager"},
+	VStorageObjectManager:   vim.ManagedObjectReference{Type: "VslmVStorageObjectManager", Value: "VStorageObjectManager"},
+	StorageLifecycleManager: vim.ManagedObjectReference{Type: "VslmStorageLifecycleManager", Value: "StorageLifecycleManager"},
+}
+
+func init() {
+	simulator.RegisterEndpoint(func(s *simulator.Service, r *simulator.Registry) {
+		if r.IsVPX() {
+			s.RegisterSDK(New())
+		}
+	})
+}
+
+func New() *simulator.Registry {
+	r := simulator.NewRegistry()
+	r.Namespace = vslm.Namespace
+	r.Path = vslm.Path
+
+	r.Put(&ServiceInstance{
+		ManagedObjectReference: vslm.ServiceInstance,
+		Content:                content,
+	})
+
+	r.Put(&VStorageObjectManager{
+		ManagedObjectReference: content.VStorageObjectManager,
+	})
+
+	return r
+}
+
+type ServiceInstance struct {
+	vim.ManagedObjectReference
+
+	Content types.VslmServiceInstanceContent
+}
+
+func (s *ServiceInstance) RetrieveContent(_ *types.RetrieveContent) soap.HasFault {
+	return &methods.RetrieveContentBody{
+		Res: &types.RetrieveContentResponse{
+			Returnval: s.Content,
+		},
+	}
+}
+
+// Task wraps a vim25 Task, as the vslm API has its own task type.
+type Task struct {
+	vim.ManagedObjectReference
+
+	Task vim.ManagedObjectReference
+}
+
+// newTask registers a vslm Task for the given vim25 Task
+func newTask(ctx *simulator.Context, ref vim.ManagedObjectReference) vim.ManagedObjectReference {
+	task := &Task{
+		ManagedObjectReference: vim.ManagedObjectReference{Type: "VslmTask", Value: ref.Value},
+		Task:                   ref,
+	}
+	ctx.Map.Put(task)
+	return task.ManagedObjectReference
+}
+
+func (t *Task) info(ctx *simulator.Context) types.VslmTaskInfo {
+	vimMap := ctx.VimMap()
+	task := vimMap.Get(t.Task).(*simulator.Task)
+
+	var info vim.TaskInfo
+	vimMap.WithLock(ctx, task, func() {
+		info = task.Info
+	})
+
+	return types.VslmTaskInfo{
+		Key:           info.Key,
+		Task:          t.ManagedObjectReference,
+		Name:          info.Name,
+		DescriptionId: info.DescriptionId,
+		Entity:        info.Entity,
+		EntityName:    info.EntityName,
+		State:         types.VslmTaskInfoState(info.State),
+		Cancelled:     info.Cancelled,
+		Cancelable:    info.Cancelable,
+		Error:         info.Error,
+		Result:        info.Result,
+		Progress:      info.Progress,
+		Reason:        &types.VslmTaskReasonUser{UserName: "vcsim"},
+		QueueTime:     info.QueueTime,
+		StartTime:     info.StartTime,
+		CompleteTime:  info.CompleteTime,
+	}
+}
+
+func (t *Task) VslmQueryInfo(ctx *simulator.Context, _ *types.VslmQueryInfo) soap.HasFault {
+	return &methods.VslmQueryInfoBody{
+		Res: &types.VslmQueryInfoResponse{
+			Returnval: t.info(ctx),
+		},
+	}
+}
+
+func (t *Task) VslmQueryTaskResult(ctx *simulator.Context, _ *types.VslmQueryTaskResult) soap.HasFault {
+	body := new(methods.VslmQueryTaskResultBody)
+
+	info := t.info(ctx)
+	switch info.State {
+	case types.VslmTaskInfoStateSuccess:
+		body.Res = &types.VslmQueryTaskResultResponse{
+			Returnval: info.Result,
+		}
+	case types.VslmTaskInfoStateError:
+		body.Fault_ = simulator.Fault(info.Error.LocalizedMessage, info.Error.Fault)
+	default:
+		body.Fault_ = simulator.Fault("", new(vim.InvalidState))
+	}
+
+	return body
+}
+
+func (t *Task) VslmCancelTask(ctx *simulator.Context, _ *types.VslmCancelTask) soap.HasFault {
+	body := new(methods.VslmCancelTaskBody)
+
+	switch t.info(ctx).State {
+	case types.VslmTaskInfoStateSuccess, types.VslmTaskInfoStateError:
+		body.Fault_ = simulator.Fault("", new(vim.InvalidState))
+	default:
+		body.Fault_ = simulator.Fault("", new(vim.NotSupported))
+	}
+
+	return body
+}
@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	vim "github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vslm"
+	"github.com/vmware/govmomi/vslm/types"
+
+	_ "github.com/vmware/govmomi/vapi/simulator"
+	_ "github.com/vmware/govmomi/vslm/simulator"
+)
+
+func TestGlobalObjectManager(t *testing.T) {
+	model := simulator.VPX()
+	model.Datastore = 2
+
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vc, err := vslm.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := vslm.NewGlobalObjectManager(vc)
+
+		finder := find.NewFinder(c)
+		ds, err := finder.DatastoreList(ctx, "*")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wait := func(task *vslm.Task, err error) vim.AnyType {
+			t.Helper()
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := task.Wait(ctx, time.Minute)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return res
+		}
+
+		spec := vim.VslmCreateSpec{
+			Name:         "disk1",
+			CapacityInMB: 10,
+			Metadata:     []vim.KeyValue{{Key: "app", Value: "db"}},
+			BackingSpec: &vim.VslmCreateSpecDiskFileBackingSpec{
+				VslmCreateSpecBackingSpec: vim.VslmCreateSpecBackingSpec{
+					Datastore: ds[0].Reference(),
+				},
+			},
+		}
+		disk := wait(m.CreateDisk(ctx, spec)).(vim.VStorageObject)
+		id := disk.Config.Id
+
+		// objects created via vslm are visible via the per-datastore vim25 API and vice versa
+		om := vslm.NewObjectManager(c)
+		if _, err = om.Retrieve(ctx, ds[0], id.Id); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = m.Retrieve(ctx, vim.ID{Id: "invalid"}); err == nil {
+			t.Error("expected error")
+		}
+
+		if err = m.Rename(ctx, id, "disk1-renamed"); err != nil {
+			t.Fatal(err)
+		}
+		obj, err := m.Retrieve(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if obj.Config.Name != "disk1-renamed" {
+			t.Errorf("name=%s", obj.Config.Name)
+		}
+
+		wait(m.ExtendDisk(ctx, id, 20))
+
+		// metadata
+		wait(m.UpdateMetadata(ctx, id, []vim.KeyValue{{Key: "app-owner", Value: "ops"}}, nil))
+		kvs, err := m.RetrieveMetadata(ctx, id, nil, "app")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != 2 {
+			t.Errorf("metadata=%v", kvs)
+		}
+		wait(m.UpdateMetadata(ctx, id, nil, []string{"app-owner"}))
+		val, err := m.RetrieveMetadataValue(ctx, id, nil, "app")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if val != "db" {
+			t.Errorf("value=%s", val)
+		}
+		if _, err = m.RetrieveMetadataValue(ctx, id, nil, "app-owner"); err == nil {
+			t.Error("expected error")
+		}
+
+		// snapshots and changed block tracking
+		snapshot := wait(m.CreateSnapshot(ctx, id, "snap1")).(vim.ID)
+		info, err := m.RetrieveSnapshotInfo(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(info) != 1 {
+			t.Errorf("snapshots=%d", len(info))
+		}
+		if _, err = m.QueryChangedDiskAreas(ctx, id, snapshot, 0, "*"); err == nil {
+			t.Error("expected error")
+		}
+		_, err = methods.SetVStorageObjectControlFlags(ctx, c, &vim.SetVStorageObjectControlFlags{
+			This:         om.Reference(),
+			Id:           id,
+			Datastore:    ds[0].Reference(),
+			ControlFlags: []string{string(vim.VslmVStorageObjectControlFlagEnableChangedBlockTracking)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		areas, err := m.QueryChangedDiskAreas(ctx, id, snapshot, 0, "*")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(areas.ChangedArea) != 1 || areas.ChangedArea[0].Length != 20*1024*1024 {
+			t.Errorf("areas=%#v", areas)
+		}
+		clone := wait(m.CreateDiskFromSnapshot(ctx, id, snapshot, "disk1-snap1", nil, nil, "")).(vim.VStorageObject)
+		wait(m.Revert(ctx, id, snapshot))
+
+		// clone and relocate to the other datastore
+		clone = wait(m.Clone(ctx, clone.Config.Id, vim.VslmCloneSpec{
+			Name: "disk1-clone",
+			VslmMigrateSpec: vim.VslmMigrateSpec{
+				BackingSpec: &vim.VslmCreateSpecDiskFileBackingSpec{
+					VslmCreateSpecBackingSpec: vim.VslmCreateSpecBackingSpec{
+						Datastore: ds[0].Reference(),
+					},
+				},
+			},
+		})).(vim.VStorageObject)
+		wait(m.Relocate(ctx, clone.Config.Id, vim.VslmRelocateSpec{
+			VslmMigrateSpec: vim.VslmMigrateSpec{
+				BackingSpec: &vim.VslmCreateSpecDiskFileBackingSpec{
+					VslmCreateSpecBackingSpec: vim.VslmCreateSpecBackingSpec{
+						Datastore: ds[1].Reference(),
+					},
+				},
+			},
+		}))
+		objs, err := m.RetrieveObjects(ctx, []vim.ID{id, clone.Config.Id, {Id: "invalid"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if objs[1].DatastoreUrl == objs[0].DatastoreUrl {
+			t.Errorf("clone not relocated: %s", objs[1].DatastoreUrl)
+		}
+		if objs[2].Error == nil {
+			t.Error("expected error")
+		}
+
+		// query
+		res, err := m.ListObjectsForSpec(ctx, []types.VslmVsoVStorageObjectQuerySpec{{
+			QueryField:    string(types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumName),
+			QueryOperator: string(types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumStartsWith),
+			QueryValue:    []string{"disk1"},
+		}}, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Id) != 3 || !res.AllRecordsReturned {
+			t.Errorf("ids=%v", res.Id)
+		}
+		res, err = m.ListObjectsForSpec(ctx, []types.VslmVsoVStorageObjectQuerySpec{
+			{
+				QueryField:    string(types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumCapacity),
+				QueryOperator: string(types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThan),
+				QueryValue:    []string{"10"},
+			},
+			{
+				QueryField:    string(types.VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey),
+				QueryOperator: string(types.VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEquals),
+				QueryValue:    []string{"app"},
+			},
+		}, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Id) != 1 || res.Id[0] != id {
+			t.Errorf("ids=%v", res.Id)
+		}
+
+		// tags
+		rc := rest.NewClient(c)
+		if err = rc.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+		tm := tags.NewManager(rc)
+		category, err := tm.CreateCategory(ctx, &tags.Category{Name: "fcd-category"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = tm.CreateTag(ctx, &tags.Tag{CategoryID: category, Name: "fcd-tag"}); err != nil {
+			t.Fatal(err)
+		}
+		if err = m.AttachTag(ctx, id, "fcd-category", "fcd-tag"); err != nil {
+			t.Fatal(err)
+		}
+		ids, err := m.ListObjectsAttachedToTag(ctx, id, "fcd-category", "fcd-tag")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 1 || ids[0] != id {
+			t.Errorf("ids=%v", ids)
+		}
+		if err = m.DetachTag(ctx, id, "fcd-category", "fcd-tag"); err != nil {
+			t.Fatal(err)
+		}
+
+		// attach to a VM
+		vmo, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		wait(m.AttachDisk(ctx, id, vmo, 0, 0))
+		associations, err := m.RetrieveAssociations(ctx, []vim.ID{id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(associations) != 1 || len(associations[0].VmDiskAssociation) != 1 {
+			t.Errorf("associations=%#v", associations)
+		} else if associations[0].VmDiskAssociation[0].VmId != vmo.Reference().Value {
+			t.Errorf("vm=%s", associations[0].VmDiskAssociation[0].VmId)
+		}
+
+		status, err := m.QueryGlobalCatalogSyncStatus(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(status) != len(ds) {
+			t.Errorf("status=%d", len(status))
+		}
+
+		wait(m.Delete(ctx, clone.Config.Id))
+		if _, err = m.Retrieve(ctx, clone.Config.Id); err == nil {
+			t.Error("expected error")
+		}
+	}, model)
+}
@@ -29,6 +29,14 @@ const (
 	VslmEventTypePostFcdMigrateEvent = VslmEventType("postFcdMigrateEvent")
 )
 
+// Values returns all known values of the VslmEventType enum
+func (e VslmEventType) Values() []VslmEventType {
+	return []VslmEventType{
+		VslmEventTypePreFcdMigrateEvent,
+		VslmEventTypePostFcdMigrateEvent,
+	}
+}
+
 func init() {
 	types.Add("vslm:VslmEventType", reflect.TypeOf((*VslmEventType)(nil)).Elem())
 }
@@ -40,6 +48,14 @@ const (
 	VslmEventVslmEventInfoStateError   = VslmEventVslmEventInfoState("error")
 )
 
+// Values returns all known values of the VslmEventVslmEventInfoState enum
+func (e VslmEventVslmEventInfoState) Values() []VslmEventVslmEventInfoState {
+	return []VslmEventVslmEventInfoState{
+		VslmEventVslmEventInfoStateSuccess,
+		VslmEventVslmEventInfoStateError,
+	}
+}
+
 func init() {
 	types.Add("vslm:VslmEventVslmEventInfoState", reflect.TypeOf((*VslmEventVslmEventInfoState)(nil)).Elem())
 }
@@ -53,6 +69,16 @@ const (
 	VslmTaskInfoStateError   = VslmTaskInfoState("error")
 )
 
+// Values returns all known values of the VslmTaskInfoState enum
+func (e VslmTaskInfoState) Values() []VslmTaskInfoState {
+	return []VslmTaskInfoState{
+		VslmTaskInfoStateQueued,
+		VslmTaskInfoStateRunning,
+		VslmTaskInfoStateSuccess,
+		VslmTaskInfoStateError,
+	}
+}
+
 func init() {
 	types.Add("vslm:VslmTaskInfoState", reflect.TypeOf((*VslmTaskInfoState)(nil)).Elem())
 }
@@ -70,6 +96,20 @@ const (
 	VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataValue   = VslmVsoVStorageObjectQuerySpecQueryFieldEnum("metadataValue")
 )
 
+// Values returns all known values of the VslmVsoVStorageObjectQuerySpecQueryFieldEnum enum
+func (e VslmVsoVStorageObjectQuerySpecQueryFieldEnum) Values() []VslmVsoVStorageObjectQuerySpecQueryFieldEnum {
+	return []VslmVsoVStorageObjectQuerySpecQueryFieldEnum{
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumId,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumName,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumCapacity,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumCreateTime,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumBackingObjectId,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumDatastoreMoId,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataValue,
+	}
+}
+
 func init() {
 	types.Add("vslm:VslmVsoVStorageObjectQuerySpecQueryFieldEnum", reflect.TypeOf((*VslmVsoVStorageObjectQuerySpecQueryFieldEnum)(nil)).Elem())
 }
@@ -88,6 +128,21 @@ const (
 	VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEndsWith           = VslmVsoVStorageObjectQuerySpecQueryOperatorEnum("endsWith")
 )
 
+// Values returns all known values of the VslmVsoVStorageObjectQuerySpecQueryOperatorEnum enum
+func (e VslmVsoVStorageObjectQuerySpecQueryOperatorEnum) Values() []VslmVsoVStorageObjectQuerySpecQueryOperatorEnum {
+	return []VslmVsoVStorageObjectQuerySpecQueryOperatorEnum{
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEquals,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumNotEquals,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumLessThan,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThan,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumLessThanOrEqual,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThanOrEqual,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumContains,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumStartsWith,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEndsWith,
+	}
+}
+
 func init() {
 	types.Add("vslm:VslmVsoVStorageObjectQuerySpecQueryOperatorEnum", reflect.TypeOf((*VslmVsoVStorageObjectQuerySpecQueryOperatorEnum)(nil)).Elem())
 }